@@ -0,0 +1,249 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterCertificationReportName is the name of the single ClusterCertificationReport maintained by the operator
+const ClusterCertificationReportName = "cluster"
+
+// DefaultReportTopImages is the default number of most vulnerable images listed in the report
+const DefaultReportTopImages = 10
+
+// ReportedImage identifies an image listed in a ClusterCertificationReport
+type ReportedImage struct {
+	// ImageCertificationInfo is the name of the ImageCertificationInfo describing the image
+	ImageCertificationInfo string `json:"imageCertificationInfo"`
+	// FullImageReference is the complete image reference including registry, repo, and digest
+	FullImageReference string `json:"fullImageReference"`
+	// CertificationStatus indicates the certification status of the image
+	// +optional
+	CertificationStatus CertificationStatus `json:"certificationStatus,omitempty"`
+	// Vulnerabilities contains vulnerability counts by severity
+	// +optional
+	Vulnerabilities *VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	// DaysUntilEOL is the number of days until end-of-life (negative if past EOL)
+	// +optional
+	DaysUntilEOL *int `json:"daysUntilEol,omitempty"`
+	// Workloads lists the workloads using the image (at most 20)
+	// +optional
+	Workloads []WorkloadReference `json:"workloads,omitempty"`
+}
+
+// NamespaceCertificationSummary breaks the report down for one namespace
+type NamespaceCertificationSummary struct {
+	// Namespace is the namespace name
+	Namespace string `json:"namespace"`
+	// Images is the number of distinct images used in the namespace
+	Images int `json:"images"`
+	// Workloads is the number of distinct workloads in the namespace using tracked images
+	// +optional
+	Workloads int `json:"workloads,omitempty"`
+	// ByCertificationStatus counts the namespace's images by certification status
+	// +optional
+	ByCertificationStatus map[string]int `json:"byCertificationStatus,omitempty"`
+	// ImagesWithCriticalVulnerabilities is the number of images with at least one critical vulnerability
+	// +optional
+	ImagesWithCriticalVulnerabilities int `json:"imagesWithCriticalVulnerabilities,omitempty"`
+	// ImagesPastEOL is the number of images past their end-of-life date
+	// +optional
+	ImagesPastEOL int `json:"imagesPastEol,omitempty"`
+	// AffectedWorkloads is the number of workloads using an image with a critical
+	// vulnerability, past its end-of-life date, or missing a node architecture
+	// +optional
+	AffectedWorkloads int `json:"affectedWorkloads,omitempty"`
+	// RiskScore weights the vulnerabilities of the namespace's images by severity (critical
+	// 10, important 5, moderate 2, low 1) and by the number of the namespace's pods running
+	// each image. A higher score is a better place to focus remediation.
+	// +optional
+	RiskScore int `json:"riskScore,omitempty"`
+}
+
+// ComplianceScore rates the cluster's images from 0 (worst) to 100 (best). The overall
+// score weights certification at 40%, vulnerabilities at 30%, end-of-life at 15%, and
+// policy violations at 15%.
+type ComplianceScore struct {
+	// Score is the weighted overall score
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Score int `json:"score"`
+	// Grade is the letter grade of Score: A (90 or more), B (80), C (70), D (60), or F
+	// +kubebuilder:validation:Enum=A;B;C;D;F
+	Grade string `json:"grade"`
+	// Certification is the percentage of images that are certified, official, or verified
+	// +optional
+	Certification int `json:"certification"`
+	// Vulnerabilities is the percentage of images without critical vulnerabilities, where
+	// images with important vulnerabilities count half
+	// +optional
+	Vulnerabilities int `json:"vulnerabilities"`
+	// EndOfLife is the percentage of images that are not past their end-of-life date
+	// +optional
+	EndOfLife int `json:"endOfLife"`
+	// Policy is the percentage of images that violate no ImageCertPolicy
+	// +optional
+	Policy int `json:"policy"`
+}
+
+// ProviderOutage summarizes an outage of a provider API detected by the operator's health
+// probes, for post-incident reviews
+type ProviderOutage struct {
+	// Provider is the unreachable provider: pyxis, dockerhub, or quay
+	Provider string `json:"provider"`
+	// StartedAt is when the first failed probe was observed
+	StartedAt metav1.Time `json:"startedAt"`
+	// EndedAt is when the provider was reachable again; unset while the outage lasts
+	// +optional
+	EndedAt *metav1.Time `json:"endedAt,omitempty"`
+	// Duration is how long the outage lasted, or has lasted so far
+	Duration metav1.Duration `json:"duration"`
+	// DeferredLookups is the number of images whose certification lookup was deferred
+	// during the outage
+	// +optional
+	DeferredLookups int `json:"deferredLookups"`
+	// PendingLookups is the number of deferred lookups that have not succeeded yet
+	// +optional
+	PendingLookups int `json:"pendingLookups"`
+	// DrainedAt is when the last deferred lookup succeeded after the outage ended
+	// +optional
+	DrainedAt *metav1.Time `json:"drainedAt,omitempty"`
+	// DrainDuration is how long the deferred lookups took to drain after the outage ended
+	// +optional
+	DrainDuration *metav1.Duration `json:"drainDuration,omitempty"`
+}
+
+// ClusterCertificationReportSpec defines the desired state of ClusterCertificationReport
+type ClusterCertificationReportSpec struct {
+	// TopImages is the number of most vulnerable images to list
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	TopImages int `json:"topImages,omitempty"`
+
+	// OperatingSystems limits the report to images published for one of these operating
+	// systems, e.g. windows. Images whose platforms are unknown are left out. All images
+	// are reported when empty.
+	// +optional
+	OperatingSystems []string `json:"operatingSystems,omitempty"`
+}
+
+// ClusterCertificationReportStatus defines the observed state of ClusterCertificationReport
+type ClusterCertificationReportStatus struct {
+	// TotalImages is the number of tracked images
+	// +optional
+	TotalImages int `json:"totalImages,omitempty"`
+
+	// Compliance is the cluster compliance score
+	// +optional
+	Compliance *ComplianceScore `json:"compliance,omitempty"`
+
+	// ByRegistryType counts images by registry type
+	// +optional
+	ByRegistryType map[string]int `json:"byRegistryType,omitempty"`
+
+	// ByCertificationStatus counts images by certification status
+	// +optional
+	ByCertificationStatus map[string]int `json:"byCertificationStatus,omitempty"`
+
+	// ByHealthGrade counts images by Pyxis health grade (A-F)
+	// +optional
+	ByHealthGrade map[string]int `json:"byHealthGrade,omitempty"`
+
+	// ByOperatingSystem counts images by operating system, then certification status.
+	// Images whose platforms are unknown are counted under "unknown".
+	// +optional
+	ByOperatingSystem map[string]map[string]int `json:"byOperatingSystem,omitempty"`
+
+	// ImagesPastEOL lists the images past their end-of-life date, longest past first (at most 100)
+	// +optional
+	ImagesPastEOL []ReportedImage `json:"imagesPastEol,omitempty"`
+
+	// MostVulnerableImages lists the images with the most critical, then important, vulnerabilities
+	// +optional
+	MostVulnerableImages []ReportedImage `json:"mostVulnerableImages,omitempty"`
+
+	// MissingArchitectures counts, per node architecture, the images that cannot run on it
+	// +optional
+	MissingArchitectures map[string]int `json:"missingArchitectures,omitempty"`
+
+	// AffectedWorkloads is the number of workloads using an image with a critical
+	// vulnerability, past its end-of-life date, or missing a node architecture
+	// +optional
+	AffectedWorkloads int `json:"affectedWorkloads,omitempty"`
+
+	// Namespaces breaks the report down per namespace
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	Namespaces []NamespaceCertificationSummary `json:"namespaces,omitempty"`
+
+	// NamespacesByRisk names the namespaces with a risk score above zero, highest score
+	// first (at most 20)
+	// +optional
+	NamespacesByRisk []string `json:"namespacesByRisk,omitempty"`
+
+	// ProviderOutages lists the provider outages observed by the elected leader since it
+	// started, most recent first (at most 20)
+	// +optional
+	ProviderOutages []ProviderOutage `json:"providerOutages,omitempty"`
+
+	// LastUpdatedAt is when the report was last rebuilt
+	// +optional
+	LastUpdatedAt *metav1.Time `json:"lastUpdatedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=ccr,categories=security;imagecertinfo
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'cluster'",message="the report must be named cluster"
+// +kubebuilder:printcolumn:name="Images",type=integer,JSONPath=`.status.totalImages`
+// +kubebuilder:printcolumn:name="Score",type=integer,JSONPath=`.status.compliance.score`
+// +kubebuilder:printcolumn:name="Grade",type=string,JSONPath=`.status.compliance.grade`
+// +kubebuilder:printcolumn:name="Affected-Workloads",type=integer,JSONPath=`.status.affectedWorkloads`
+// +kubebuilder:printcolumn:name="Updated",type=date,JSONPath=`.status.lastUpdatedAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterCertificationReport summarizes the certification, vulnerability, and lifecycle state
+// of every image in the cluster. The operator maintains a single report named "cluster".
+type ClusterCertificationReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of ClusterCertificationReport
+	// +optional
+	Spec ClusterCertificationReportSpec `json:"spec,omitempty"`
+
+	// Status defines the observed state of ClusterCertificationReport
+	// +optional
+	Status ClusterCertificationReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterCertificationReportList contains a list of ClusterCertificationReport
+type ClusterCertificationReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterCertificationReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterCertificationReport{}, &ClusterCertificationReportList{})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ConditionReason is a machine-readable reason recorded in conditions and in
+// ImageCertificationInfo status.reasonCode. Reasons are part of the API: existing values
+// are never renamed or given a new meaning, and new values may be added.
+type ConditionReason string
+
+// ImageCertificationInfoConditionAvailable is true once the image has been discovered in the cluster
+const ImageCertificationInfoConditionAvailable = "Available"
+
+// Reasons for the certification status of an ImageCertificationInfo, recorded in status.reasonCode
+const (
+	// ReasonImageDiscovered means the image was found in a pod and has not been checked yet
+	ReasonImageDiscovered ConditionReason = "ImageDiscovered"
+	// ReasonCertifiedByPyxis means Pyxis lists the image in a Red Hat or partner registry
+	ReasonCertifiedByPyxis ConditionReason = "CertifiedByPyxis"
+	// ReasonNotFoundInPyxis means Pyxis has no record of the image in a Red Hat registry
+	ReasonNotFoundInPyxis ConditionReason = "NotFoundInPyxis"
+	// ReasonPyxisQueryFailed means the last Pyxis query for the image failed
+	ReasonPyxisQueryFailed ConditionReason = "PyxisQueryFailed"
+	// ReasonDockerOfficialImage means the Docker Hub repository is a Docker Official Image
+	ReasonDockerOfficialImage ConditionReason = "DockerOfficialImage"
+	// ReasonDockerVerifiedPublisher means the Docker Hub repository is published by a Docker Verified Publisher
+	ReasonDockerVerifiedPublisher ConditionReason = "DockerVerifiedPublisher"
+	// ReasonNoDockerHubTrustProgram means the Docker Hub repository is in no Docker trust program
+	ReasonNoDockerHubTrustProgram ConditionReason = "NoDockerHubTrustProgram"
+	// ReasonPastEndOfLife means the image is certified by Pyxis and its end-of-life date has passed
+	ReasonPastEndOfLife ConditionReason = "PastEndOfLife"
+	// ReasonDeprecatedRelease means the image is certified by Pyxis and its repository's release
+	// category is Deprecated
+	ReasonDeprecatedRelease ConditionReason = "DeprecatedRelease"
+	// ReasonImagePullFailed means a pod could not pull the image, so it was discovered from the
+	// pod spec and has not been checked yet
+	ReasonImagePullFailed ConditionReason = "ImagePullFailed"
+	// ReasonCertificationStale means the provider that determined the certification status
+	// has not been consulted successfully within the staleness horizon, so the status was
+	// downgraded to Unknown until the next successful lookup
+	ReasonCertificationStale ConditionReason = "CertificationStale"
+)
+
+// Reasons of the ImageCertPolicy Compliant condition
+const (
+	// ReasonNoViolations means every matching image satisfies the policy
+	ReasonNoViolations ConditionReason = "NoViolations"
+	// ReasonViolationsFound means at least one matching image violates the policy
+	ReasonViolationsFound ConditionReason = "ViolationsFound"
+	// ReasonInvalidNamespaceSelector means the policy cannot be evaluated until its namespace selector is fixed
+	ReasonInvalidNamespaceSelector ConditionReason = "InvalidNamespaceSelector"
+)
+
+// Reasons of the ImageCertExemption Active condition
+const (
+	// ReasonExemptionInEffect means the exemption has not expired and applies to its images
+	ReasonExemptionInEffect ConditionReason = "InEffect"
+	// ReasonExemptionExpired means the expiry date has passed and the images are evaluated normally
+	ReasonExemptionExpired ConditionReason = "Expired"
+)
+
+// Reasons of the ImageCertInfoConfig Applied condition
+const (
+	// ReasonApplied means the settings are in effect
+	ReasonApplied ConditionReason = "Applied"
+	// ReasonInvalidSettings means the settings were rejected and the previous ones are still in effect
+	ReasonInvalidSettings ConditionReason = "InvalidSettings"
+)
+
+// Reasons of the ImageCertInfoConfig DeprecatedArtifacts condition
+const (
+	// ReasonNoDeprecatedArtifacts means no image uses a deprecated name or field
+	ReasonNoDeprecatedArtifacts ConditionReason = "NoDeprecatedArtifacts"
+	// ReasonDeprecatedArtifactsFound means some images still use a deprecated name or field
+	ReasonDeprecatedArtifactsFound ConditionReason = "DeprecatedArtifactsFound"
+)
+
+// Reasons of the ImageCertInfoConfig ProviderDegraded condition
+const (
+	// ReasonProvidersEnabled means no provider is disabled by its error budget guard
+	ReasonProvidersEnabled ConditionReason = "ProvidersEnabled"
+	// ReasonProviderDisabled means the error budget guard of a provider has disabled it
+	ReasonProviderDisabled ConditionReason = "ProviderDisabled"
+)
+
+// Reasons of the images-certified pod readiness gate condition
+const (
+	// ReasonImagesCertified means every container image of the pod is certified
+	ReasonImagesCertified ConditionReason = "ImagesCertified"
+	// ReasonImageNotCertified means a container image of the pod is not certified
+	ReasonImageNotCertified ConditionReason = "ImageNotCertified"
+	// ReasonImagePending means the certification of a container image is not known yet
+	ReasonImagePending ConditionReason = "ImagePending"
+)
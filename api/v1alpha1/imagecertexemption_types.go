@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageCertExemptionConditionActive is true while the exemption has not expired
+const ImageCertExemptionConditionActive = "Active"
+
+// ImageCertExemptionSpec identifies the exempted images and why the risk is accepted
+// +kubebuilder:validation:XValidation:rule="has(self.imageDigest) != has(self.repository)",message="exactly one of imageDigest and repository must be set"
+type ImageCertExemptionSpec struct {
+	// ImageDigest exempts the image with this sha256 digest
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Repository exempts every image of a repository, given as registry/repository,
+	// e.g. quay.io/example/app. Docker Hub repositories use the docker.io registry.
+	// +kubebuilder:validation:MaxLength=768
+	// +kubebuilder:validation:XValidation:rule="self.contains('/') && !self.contains('@')",message="repository must be registry/repository without a digest"
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// Justification records why the risk of running the images is accepted
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Justification string `json:"justification"`
+
+	// ExpiresAt is when the exemption ends. The images are evaluated normally again afterwards.
+	// +kubebuilder:validation:Required
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// ImageCertExemptionStatus defines the observed state of ImageCertExemption
+type ImageCertExemptionStatus struct {
+	// ObservedGeneration is the exemption generation the status was computed from
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// MatchedImages is the number of tracked images the exemption applies to
+	// +optional
+	MatchedImages int `json:"matchedImages,omitempty"`
+
+	// Conditions represent the current state of the ImageCertExemption resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=ice,categories=security;imagecertinfo
+// +kubebuilder:printcolumn:name="Digest",type=string,JSONPath=`.spec.imageDigest`,priority=1
+// +kubebuilder:printcolumn:name="Repository",type=string,JSONPath=`.spec.repository`
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.spec.expiresAt`
+// +kubebuilder:printcolumn:name="Images",type=integer,JSONPath=`.status.matchedImages`
+// +kubebuilder:printcolumn:name="Active",type=string,JSONPath=`.status.conditions[?(@.type=="Active")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ImageCertExemption records that the risk of running an image digest or repository is
+// accepted until an expiry date. Until then, the matching images are left out of policy
+// violations, the vulnerability and end-of-life metrics, warning events, and notifications.
+type ImageCertExemption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec identifies the exempted images
+	// +required
+	Spec ImageCertExemptionSpec `json:"spec"`
+
+	// Status defines the observed state of ImageCertExemption
+	// +optional
+	Status ImageCertExemptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageCertExemptionList contains a list of ImageCertExemption
+type ImageCertExemptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageCertExemption `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageCertExemption{}, &ImageCertExemptionList{})
+}
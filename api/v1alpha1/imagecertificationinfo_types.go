@@ -33,7 +33,7 @@ const (
 )
 
 // CertificationStatus indicates the certification status of an image
-// +kubebuilder:validation:Enum=Certified;Official;Verified;NotCertified;Pending;Unknown;Error
+// +kubebuilder:validation:Enum=Certified;Official;Verified;NotCertified;Pending;Unknown;Error;EOL;Deprecated
 type CertificationStatus string
 
 const (
@@ -44,6 +44,19 @@ const (
 	CertificationStatusPending      CertificationStatus = "Pending"
 	CertificationStatusUnknown      CertificationStatus = "Unknown"
 	CertificationStatusError        CertificationStatus = "Error"
+	CertificationStatusEOL          CertificationStatus = "EOL"        // Red Hat certified, past its end-of-life date
+	CertificationStatusDeprecated   CertificationStatus = "Deprecated" // Red Hat certified, in a deprecated release
+)
+
+// ContainerType indicates the category of a container within a pod
+// +kubebuilder:validation:Enum=App;Init;Sidecar;Ephemeral
+type ContainerType string
+
+const (
+	ContainerTypeApp       ContainerType = "App"
+	ContainerTypeInit      ContainerType = "Init"
+	ContainerTypeSidecar   ContainerType = "Sidecar" // init container with restartPolicy Always
+	ContainerTypeEphemeral ContainerType = "Ephemeral"
 )
 
 // PodReference contains information about a pod using this image
@@ -54,6 +67,28 @@ type PodReference struct {
 	Name string `json:"name"`
 	// Container name within the pod
 	Container string `json:"container"`
+	// ContainerType is the category of the container within the pod
+	// +optional
+	ContainerType ContainerType `json:"containerType,omitempty"`
+	// WorkloadKind is the kind of the workload that owns the pod (e.g., Deployment, StatefulSet,
+	// DaemonSet, Job). Empty for pods without a controller.
+	// +optional
+	WorkloadKind string `json:"workloadKind,omitempty"`
+	// WorkloadName is the name of the workload that owns the pod
+	// +optional
+	WorkloadName string `json:"workloadName,omitempty"`
+}
+
+// WorkloadReference summarizes the pods of one workload that use this image
+type WorkloadReference struct {
+	// Namespace of the workload
+	Namespace string `json:"namespace"`
+	// Kind of the workload, or Pod for pods without a controller
+	Kind string `json:"kind"`
+	// Name of the workload
+	Name string `json:"name"`
+	// Pods is the number of the workload's pods using this image
+	Pods int `json:"pods"`
 }
 
 // VulnerabilitySummary contains vulnerability counts by severity
@@ -72,6 +107,59 @@ type VulnerabilitySummary struct {
 	Low int `json:"low,omitempty"`
 }
 
+// TrackedCVE records when a critical or important CVE was first observed on an image
+type TrackedCVE struct {
+	// ID is the CVE identifier (e.g., CVE-2024-1234)
+	ID string `json:"id"`
+	// Severity is the severity rating reported by Pyxis or Quay (critical or important)
+	Severity string `json:"severity"`
+	// FirstObservedAt is when the CVE was first reported for this image
+	FirstObservedAt metav1.Time `json:"firstObservedAt"`
+	// AdvisoryID is the Red Hat advisory that fixes the CVE (e.g., RHSA-2024:1234)
+	// +optional
+	AdvisoryID string `json:"advisoryId,omitempty"`
+	// FixedIn is the image reference shipped by the advisory that resolves the CVE
+	// (e.g., registry.redhat.io/ubi9/ubi:9.4-1214)
+	// +optional
+	FixedIn string `json:"fixedIn,omitempty"`
+}
+
+// CVE is a vulnerability affecting an image
+type CVE struct {
+	// ID is the CVE identifier (e.g., CVE-2024-1234)
+	ID string `json:"id"`
+	// Severity is the severity rating reported by Pyxis (critical, important, moderate, or low)
+	// +optional
+	Severity string `json:"severity,omitempty"`
+	// AdvisoryID is the Red Hat advisory that addresses the CVE (e.g., RHSA-2024:1234)
+	// +optional
+	AdvisoryID string `json:"advisoryId,omitempty"`
+}
+
+// QuayData contains the Clair security scan published by Quay for a quay.io image
+type QuayData struct {
+	// ScanStatus is the state of the Quay security scan (scanned, queued, failed, or unsupported)
+	// +optional
+	ScanStatus string `json:"scanStatus,omitempty"`
+	// Vulnerabilities contains vulnerability counts by severity. Clair's High and Medium
+	// severities are reported as Important and Moderate.
+	// +optional
+	Vulnerabilities *VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	// FixableCount is the number of vulnerabilities with a fixed package version available
+	// +optional
+	FixableCount int `json:"fixableCount,omitempty"`
+	// LastScanAt is when a completed scan was last read from Quay
+	// +optional
+	LastScanAt *metav1.Time `json:"lastScanAt,omitempty"`
+
+	// Source is the Quay API URL the scan was read from
+	// +optional
+	Source string `json:"source,omitempty"`
+	// SyncedAt is when this data was last read from its source
+	// +optional
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+}
+
 // PyxisData contains certification data from Red Hat Pyxis API
 type PyxisData struct {
 	// ProjectID is the Red Hat Connect project ID
@@ -137,6 +225,54 @@ type PyxisData struct {
 	// AdvisoryIDs contains Red Hat advisory IDs related to this image (for security tracking)
 	// +optional
 	AdvisoryIDs []string `json:"advisoryIds,omitempty"`
+	// CVEs lists the CVEs affecting this image, most severe first. The list is capped by the
+	// operator's --max-cves-per-image setting.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	CVEs []CVE `json:"cves,omitempty"`
+	// TotalCVEs is the number of CVEs affecting this image, including any left out of CVEs
+	// +optional
+	TotalCVEs int `json:"totalCves,omitempty"`
+	// CVEsTruncated is true when CVEs lists only the most severe of TotalCVEs
+	// +optional
+	CVEsTruncated bool `json:"cvesTruncated,omitempty"`
+
+	// PartnerCertification describes the certification project of an image published
+	// through registry.connect.redhat.com, as needed for vendor audits
+	// +optional
+	PartnerCertification *PartnerCertification `json:"partnerCertification,omitempty"`
+
+	// Source is the Pyxis API URL the data was read from
+	// +optional
+	Source string `json:"source,omitempty"`
+	// SyncedAt is when this data was last read from its source
+	// +optional
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+}
+
+// PartnerCertification contains the details of a partner's certification project
+type PartnerCertification struct {
+	// ProjectID is the Pyxis certification project ID
+	ProjectID string `json:"projectID"`
+	// ProjectName is the name of the certification project
+	// +optional
+	ProjectName string `json:"projectName,omitempty"`
+	// Level is the certification level (e.g., Certified, Vendor Validated)
+	// +optional
+	Level string `json:"level,omitempty"`
+	// Status is the certification status of the project (e.g., Certified)
+	// +optional
+	Status string `json:"status,omitempty"`
+	// SupportedOCPVersions lists the OpenShift versions the partner supports (e.g., 4.14, 4.15)
+	// +optional
+	SupportedOCPVersions []string `json:"supportedOCPVersions,omitempty"`
+	// Badges lists the certification badges awarded to the project
+	// +optional
+	Badges []string `json:"badges,omitempty"`
+	// CNF is true when the project carries the CNF (cloud-native network function) badge
+	// +optional
+	CNF bool `json:"cnf,omitempty"`
 }
 
 // DockerHubData contains metadata from Docker Hub public API
@@ -168,30 +304,195 @@ type DockerHubData struct {
 	// PullCountFormatted is human-readable pull count (e.g., "12.7B", "434M")
 	// +optional
 	PullCountFormatted string `json:"pullCountFormatted,omitempty"`
+
+	// Description is the short description of the repository on Docker Hub
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Source is the Docker Hub API URL the data was read from
+	// +optional
+	Source string `json:"source,omitempty"`
+	// SyncedAt is when this data was last read from its source
+	// +optional
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+}
+
+// ImagePlatform is a platform an image is published for
+type ImagePlatform struct {
+	// OS is the operating system, e.g. linux or windows
+	OS string `json:"os"`
+
+	// Architecture is the CPU architecture, e.g. amd64
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// Variant is the CPU variant, e.g. v8 for arm64
+	// +optional
+	Variant string `json:"variant,omitempty"`
+}
+
+// RegistryData contains metadata read from the image's manifest and config in its registry
+type RegistryData struct {
+	// Architectures lists the CPU architectures the image is published for
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
+
+	// Platforms lists the operating system, architecture, and variant of each image in the
+	// manifest list, or of the image itself
+	// +optional
+	Platforms []ImagePlatform `json:"platforms,omitempty"`
+
+	// Labels are the image config labels (at most 64, values truncated to 256 characters)
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// LayerCount is the number of layers in the image
+	// +optional
+	LayerCount int `json:"layerCount,omitempty"`
+
+	// CompressedSizeBytes is the sum of the compressed layer sizes
+	// +optional
+	CompressedSizeBytes int64 `json:"compressedSizeBytes,omitempty"`
+
+	// Created is when the image was built
+	// +optional
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// Source is the registry host the metadata was read from
+	// +optional
+	Source string `json:"source,omitempty"`
+	// SyncedAt is when this data was last read from its source
+	// +optional
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+}
+
+// SBOMData records the software bill of materials attached to the image, discovered
+// through the OCI referrers API of its registry
+type SBOMData struct {
+	// Present is true if an SBOM is attached to the image
+	Present bool `json:"present"`
+
+	// Format is the SBOM format (SPDX or CycloneDX)
+	// +kubebuilder:validation:Enum=SPDX;CycloneDX
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// ArtifactType is the artifact type of the SBOM referrer, e.g. application/spdx+json
+	// +optional
+	ArtifactType string `json:"artifactType,omitempty"`
+
+	// Digest is the digest of the SBOM artifact manifest
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// PackageCount is the number of packages the SBOM lists (only with SBOM summaries enabled)
+	// +optional
+	PackageCount *int `json:"packageCount,omitempty"`
+
+	// TopLicenses lists the most common package licenses, most common first (only with
+	// SBOM summaries enabled)
+	// +optional
+	TopLicenses []string `json:"topLicenses,omitempty"`
+
+	// Source is the registry host the SBOM was discovered in
+	// +optional
+	Source string `json:"source,omitempty"`
+	// SyncedAt is when the registry was last checked for an SBOM
+	// +optional
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty"`
+}
+
+// ImageOwnership identifies who publishes an image and what it was built from, read from
+// the OCI standard labels of the image config
+type ImageOwnership struct {
+	// Vendor is the distributing entity (org.opencontainers.image.vendor, or the vendor label)
+	// +optional
+	Vendor string `json:"vendor,omitempty"`
+	// Version is the version of the packaged software (org.opencontainers.image.version, or the version label)
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Revision is the source control revision the image was built from
+	// (org.opencontainers.image.revision, or the vcs-ref label)
+	// +optional
+	Revision string `json:"revision,omitempty"`
+	// SourceURL is the URL of the source code the image was built from (org.opencontainers.image.source)
+	// +optional
+	SourceURL string `json:"sourceURL,omitempty"`
+}
+
+// Names of the providers recorded in DataSources
+const (
+	DataSourcePyxis     = "Pyxis"
+	DataSourceDockerHub = "DockerHub"
+	DataSourceQuay      = "Quay"
+	DataSourceRegistry  = "Registry"
+	DataSourceReferrers = "Referrers"
+)
+
+// DataSource records which status fields a provider populated and when it was last read
+type DataSource struct {
+	// Name is the provider (Pyxis, DockerHub, Quay, Registry, or Referrers)
+	// +kubebuilder:validation:Enum=Pyxis;DockerHub;Quay;Registry;Referrers
+	Name string `json:"name"`
+	// Source is the API URL or registry host the data was read from
+	// +optional
+	Source string `json:"source,omitempty"`
+	// Fields lists the status fields populated from this provider at the last sync
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// SyncedAt is when the provider was last read
+	SyncedAt metav1.Time `json:"syncedAt"`
 }
 
-// ImageCertificationInfoSpec defines the desired state of ImageCertificationInfo
+// ImageCertificationInfoSpec defines the desired state of ImageCertificationInfo.
+// The spec is derived from the image digest and cannot change once created.
+// +kubebuilder:validation:XValidation:rule="self.fullImageReference.contains(self.imageDigest)",message="imageDigest must appear in fullImageReference"
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
 type ImageCertificationInfoSpec struct {
-	// ImageDigest is the sha256 digest of the image
+	// ImageDigest is the sha256 digest of the image. It is empty for an image a pod could not
+	// pull whose tag could not be resolved to a digest; such a record is keyed by its tag.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +kubebuilder:validation:Pattern=`^(sha256:[a-f0-9]{64})?$`
+	// +kubebuilder:validation:MaxLength=71
 	ImageDigest string `json:"imageDigest"`
 
 	// FullImageReference is the complete image reference including registry, repo, and digest
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=1024
 	FullImageReference string `json:"fullImageReference"`
 
-	// Registry is the container registry hostname
-	// +kubebuilder:validation:Required
-	Registry string `json:"registry"`
+	// Registry is the container registry hostname, defaulting to docker.io like the container runtime
+	// +kubebuilder:default=docker.io
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?$`
+	// +optional
+	Registry string `json:"registry,omitempty"`
 
 	// Repository is the image repository path
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@') && !self.contains(':')",message="repository must not contain a digest or tag"
 	Repository string `json:"repository"`
 
 	// Tag is the image tag if available
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`
 	// +optional
 	Tag string `json:"tag,omitempty"`
+
+	// ObservedRegistry is the mirror registry the image was actually pulled from, set
+	// only when it differs from Registry. Registry always holds the canonical source
+	// that the image is enriched against.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?$`
+	// +optional
+	ObservedRegistry string `json:"observedRegistry,omitempty"`
+
+	// ObservedRepository is the repository path on ObservedRegistry
+	// +kubebuilder:validation:MaxLength=512
+	// +optional
+	ObservedRepository string `json:"observedRepository,omitempty"`
 }
 
 // ImageCertificationInfoStatus defines the observed state of ImageCertificationInfo
@@ -204,6 +505,12 @@ type ImageCertificationInfoStatus struct {
 	// +kubebuilder:default=Unknown
 	CertificationStatus CertificationStatus `json:"certificationStatus,omitempty"`
 
+	// ReasonCode is a machine-readable reason for the certification status, from the
+	// documented catalog of condition reasons
+	// +kubebuilder:validation:Enum=ImageDiscovered;CertifiedByPyxis;NotFoundInPyxis;PyxisQueryFailed;DockerOfficialImage;DockerVerifiedPublisher;NoDockerHubTrustProgram;PastEndOfLife;DeprecatedRelease;ImagePullFailed;CertificationStale
+	// +optional
+	ReasonCode ConditionReason `json:"reasonCode,omitempty"`
+
 	// PyxisData contains certification data from Red Hat Pyxis API
 	// +optional
 	PyxisData *PyxisData `json:"pyxisData,omitempty"`
@@ -212,10 +519,48 @@ type ImageCertificationInfoStatus struct {
 	// +optional
 	DockerHubData *DockerHubData `json:"dockerHubData,omitempty"`
 
+	// RegistryData contains metadata read from the image's registry (only populated for
+	// images that are neither in a Red Hat registry nor on Docker Hub)
+	// +optional
+	RegistryData *RegistryData `json:"registryData,omitempty"`
+
+	// QuayData contains the security scan published by Quay (only populated for quay.io images)
+	// +optional
+	QuayData *QuayData `json:"quayData,omitempty"`
+
+	// SBOM records whether an SBOM is attached to the image (only populated when SBOM
+	// discovery is enabled)
+	// +optional
+	SBOM *SBOMData `json:"sbom,omitempty"`
+
+	// Ownership identifies the publisher of the image from its OCI labels, so that images
+	// without certification data can still be attributed
+	// +optional
+	Ownership *ImageOwnership `json:"ownership,omitempty"`
+
+	// DataSources records which provider each enriched status field came from and how fresh it is
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DataSources []DataSource `json:"dataSources,omitempty"`
+
 	// PodReferences lists all pods currently using this image
 	// +optional
 	PodReferences []PodReference `json:"podReferences,omitempty"`
 
+	// Workloads groups PodReferences by owning workload
+	// +optional
+	Workloads []WorkloadReference `json:"workloads,omitempty"`
+
+	// WorkloadCount is the number of distinct workloads using this image
+	// +optional
+	WorkloadCount int `json:"workloadCount,omitempty"`
+
+	// NodeReferences lists the control-plane nodes that report this image in their status.
+	// Static pod images are tracked through them even while no mirror pod is visible.
+	// +optional
+	NodeReferences []string `json:"nodeReferences,omitempty"`
+
 	// FirstSeenAt is when this image was first observed in the cluster
 	// +optional
 	FirstSeenAt *metav1.Time `json:"firstSeenAt,omitempty"`
@@ -224,6 +569,11 @@ type ImageCertificationInfoStatus struct {
 	// +optional
 	LastSeenAt *metav1.Time `json:"lastSeenAt,omitempty"`
 
+	// OrphanedAt is when the cleanup loop first found no pods using this image.
+	// It is cleared as soon as a pod uses the image again.
+	// +optional
+	OrphanedAt *metav1.Time `json:"orphanedAt,omitempty"`
+
 	// LastPyxisCheckAt is when the Pyxis API was last queried for this image
 	// +optional
 	LastPyxisCheckAt *metav1.Time `json:"lastPyxisCheckAt,omitempty"`
@@ -242,23 +592,89 @@ type ImageCertificationInfoStatus struct {
 	// DaysUntilEOL is the number of days until end-of-life (negative if past EOL, nil if no EOL date)
 	// +optional
 	DaysUntilEOL *int `json:"daysUntilEol,omitempty"`
+
+	// Vulnerability aging fields
+
+	// TrackedCVEs lists the critical and important CVEs currently affecting this image with their first-observed time
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	TrackedCVEs []TrackedCVE `json:"trackedCves,omitempty"`
+	// MaxCVEAgeDays is the age in days of the oldest critical or important CVE still affecting this image
+	// +optional
+	MaxCVEAgeDays *int `json:"maxCveAgeDays,omitempty"`
+
+	// MissingArchitectures lists the CPU architectures of cluster nodes that this image does not
+	// support. Pods using the image cannot run on those nodes. Empty when the supported
+	// architectures are unknown.
+	// +optional
+	MissingArchitectures []string `json:"missingArchitectures,omitempty"`
+
+	// OperatingSystems lists the operating systems the image is published for, e.g. linux
+	// or windows. Empty when the platforms are unknown.
+	// +optional
+	OperatingSystems []string `json:"operatingSystems,omitempty"`
+
+	// ObjectSizeBytes is the size of this object serialized as JSON when its status was last
+	// written. etcd rejects objects above its request size limit (1.5MiB by default).
+	// +optional
+	ObjectSizeBytes int64 `json:"objectSizeBytes,omitempty"`
+
+	// LastReconcileAt is when the operator last looked up this image with its certification
+	// provider, successfully or not
+	// +optional
+	LastReconcileAt *metav1.Time `json:"lastReconcileAt,omitempty"`
+
+	// ConsecutiveErrorCount is the number of certification lookups for this image that have
+	// failed in a row. It is reset by the next successful lookup.
+	// +optional
+	ConsecutiveErrorCount int32 `json:"consecutiveErrorCount,omitempty"`
+
+	// LastErrorAt is when a certification lookup for this image last failed
+	// +optional
+	LastErrorAt *metav1.Time `json:"lastErrorAt,omitempty"`
+
+	// EOLWarningTier is the most urgent end-of-life tier an EOLApproaching event has been
+	// emitted for, so that each tier is warned about once
+	// +optional
+	EOLWarningTier string `json:"eolWarningTier,omitempty"`
+
+	// WarnedVulnerabilities holds the critical and important vulnerability counts last
+	// reported in a VulnerabilitiesFound event, so that the event is only emitted again when
+	// they increase
+	// +optional
+	WarnedVulnerabilities *VulnerabilitySummary `json:"warnedVulnerabilities,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,shortName=ici
+// +kubebuilder:resource:scope=Cluster,shortName=ici,categories=security;imagecertinfo
+// +kubebuilder:storageversion
+// +kubebuilder:selectablefield:JSONPath=`.spec.registry`
+// +kubebuilder:selectablefield:JSONPath=`.spec.repository`
 // +kubebuilder:printcolumn:name="Registry",type=string,JSONPath=`.spec.registry`
+// +kubebuilder:printcolumn:name="Repository",type=string,JSONPath=`.spec.repository`
 // +kubebuilder:printcolumn:name="Certified",type=string,JSONPath=`.status.certificationStatus`
 // +kubebuilder:printcolumn:name="Health",type=string,JSONPath=`.status.pyxisData.healthIndex`
 // +kubebuilder:printcolumn:name="Critical",type=integer,JSONPath=`.status.pyxisData.vulnerabilities.critical`
 // +kubebuilder:printcolumn:name="Important",type=integer,JSONPath=`.status.pyxisData.vulnerabilities.important`
-// +kubebuilder:printcolumn:name="Pulls",type=string,JSONPath=`.status.dockerHubData.pullCountFormatted`
-// +kubebuilder:printcolumn:name="Freshness",type=integer,JSONPath=`.status.dockerHubData.daysSinceUpdate`
+// +kubebuilder:printcolumn:name="Workloads",type=integer,JSONPath=`.status.workloadCount`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.reasonCode`,priority=1
+// +kubebuilder:printcolumn:name="Pulls",type=string,JSONPath=`.status.dockerHubData.pullCountFormatted`,priority=1
+// +kubebuilder:printcolumn:name="Freshness",type=integer,JSONPath=`.status.dockerHubData.daysSinceUpdate`,priority=1
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.status.registryType`,priority=1
+// +kubebuilder:printcolumn:name="Vendor",type=string,JSONPath=`.status.ownership.vendor`,priority=1
 // +kubebuilder:printcolumn:name="EOL-Days",type=integer,JSONPath=`.status.daysUntilEol`,priority=1
+// +kubebuilder:printcolumn:name="Partner-Level",type=string,JSONPath=`.status.pyxisData.partnerCertification.level`,priority=1
+// +kubebuilder:printcolumn:name="SBOM",type=string,JSONPath=`.status.sbom.format`,priority=1
 // +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.status.pyxisData.releaseCategory`,priority=1
 // +kubebuilder:printcolumn:name="EOL",type=date,JSONPath=`.status.pyxisData.eolDate`,priority=1
+// +kubebuilder:printcolumn:name="CVE-Age",type=integer,JSONPath=`.status.maxCveAgeDays`,priority=1
+// +kubebuilder:printcolumn:name="OS",type=string,JSONPath=`.status.operatingSystems`,priority=1
+// +kubebuilder:printcolumn:name="Missing-Arch",type=string,JSONPath=`.status.missingArchitectures`,priority=1
+// +kubebuilder:printcolumn:name="Orphaned",type=date,JSONPath=`.status.orphanedAt`,priority=1
+// +kubebuilder:printcolumn:name="Errors",type=integer,JSONPath=`.status.consecutiveErrorCount`,priority=1
 
 // ImageCertificationInfo is the Schema for the imagecertificationinfos API
 type ImageCertificationInfo struct {
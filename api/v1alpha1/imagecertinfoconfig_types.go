@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageCertInfoConfigConditionApplied is true when the operator is running with the config's settings
+const ImageCertInfoConfigConditionApplied = "Applied"
+
+// ImageCertInfoConfigConditionDeprecatedArtifacts is true when images written by earlier
+// versions still use a deprecated name or field
+const ImageCertInfoConfigConditionDeprecatedArtifacts = "DeprecatedArtifacts"
+
+// ImageCertInfoConfigConditionProviderDegraded is true while the error budget guard of a
+// certification data provider has disabled it
+const ImageCertInfoConfigConditionProviderDegraded = "ProviderDegraded"
+
+// ProviderSettings tunes the cache and rate limit of a certification data provider.
+// Unset fields keep the value given by the operator's command-line flags.
+type ProviderSettings struct {
+	// CacheTTL is how long provider responses are cached, at least 1m
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
+
+	// RateLimit is the number of provider requests allowed per second, e.g. "10" or "500m"
+	// +optional
+	RateLimit *resource.Quantity `json:"rateLimit,omitempty"`
+
+	// RateBurst is the number of requests that may exceed the rate limit in a burst
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RateBurst *int32 `json:"rateBurst,omitempty"`
+}
+
+// RegistryClassificationRule assigns a registry type to the registries matching a pattern
+type RegistryClassificationRule struct {
+	// Registry is a registry host, e.g. "mirror.example.com:5000", or a wildcard matching
+	// its subdomains, e.g. "*.mirror.example.com". An exact host wins over wildcards.
+	// +kubebuilder:validation:MinLength=1
+	Registry string `json:"registry"`
+
+	// Type is the registry type recorded for images from the matching registries
+	Type RegistryType `json:"type"`
+
+	// PyxisEligible marks the registries whose images are looked up in Red Hat Pyxis,
+	// such as mirrors of registry.redhat.io. Defaults to true for the RedHat type.
+	// +optional
+	PyxisEligible *bool `json:"pyxisEligible,omitempty"`
+}
+
+// ImageCertInfoConfigSpec holds the operator settings that can change without a restart
+type ImageCertInfoConfigSpec struct {
+	// Pyxis tunes the Red Hat Pyxis API client
+	// +optional
+	Pyxis *ProviderSettings `json:"pyxis,omitempty"`
+
+	// DockerHub tunes the Docker Hub API client
+	// +optional
+	DockerHub *ProviderSettings `json:"dockerHub,omitempty"`
+
+	// RefreshInterval is how often Pyxis data is refreshed for all images. It only takes
+	// effect when the refresh loop was enabled at startup, and must not be shorter than the
+	// Pyxis cache TTL.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// CleanupInterval is how often stale pod references are removed
+	// +optional
+	CleanupInterval *metav1.Duration `json:"cleanupInterval,omitempty"`
+
+	// RegistryClassification overrides the built-in registry types. Registries no rule
+	// matches keep their built-in type.
+	// +listType=map
+	// +listMapKey=registry
+	// +optional
+	RegistryClassification []RegistryClassificationRule `json:"registryClassification,omitempty"`
+}
+
+// ImageCertInfoConfigStatus defines the observed state of ImageCertInfoConfig
+type ImageCertInfoConfigStatus struct {
+	// ObservedGeneration is the config generation the status was computed from
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the current state of the ImageCertInfoConfig resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=icic,categories=imagecertinfo
+// +kubebuilder:printcolumn:name="Applied",type=string,JSONPath=`.status.conditions[?(@.type=="Applied")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ImageCertInfoConfig tunes a running operator. The operator watches the config named by
+// its --operator-config-name flag in its own namespace and applies changes without a
+// restart. Settings left unset, or a missing config, fall back to the command-line flags.
+type ImageCertInfoConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the operator settings
+	// +optional
+	Spec ImageCertInfoConfigSpec `json:"spec,omitempty"`
+
+	// Status defines the observed state of ImageCertInfoConfig
+	// +optional
+	Status ImageCertInfoConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageCertInfoConfigList contains a list of ImageCertInfoConfig
+type ImageCertInfoConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageCertInfoConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageCertInfoConfig{}, &ImageCertInfoConfigList{})
+}
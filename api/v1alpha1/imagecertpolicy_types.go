@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxPolicyViolations is the number of violations recorded in an ImageCertPolicy status.
+// ViolationCount always reports the full total.
+const MaxPolicyViolations = 100
+
+// MaxPolicyViolationHashes is the number of violations whose hashes are recorded in an
+// ImageCertPolicy status, which bounds the size of the status. Violations past it are
+// counted but not reported as events.
+const MaxPolicyViolationHashes = 10000
+
+// ImageCertPolicyConditionCompliant is true when no tracked image violates the policy
+const ImageCertPolicyConditionCompliant = "Compliant"
+
+// ImageCertPolicyRule is a requirement that every matching image must satisfy.
+// All requirements set on a rule are checked; unset requirements are ignored.
+type ImageCertPolicyRule struct {
+	// Name identifies the rule in violations and events
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Registries limits the rule to images from these registries. An entry ending in '*'
+	// matches by prefix. An empty list matches every registry.
+	// +optional
+	Registries []string `json:"registries,omitempty"`
+
+	// AllowedCertificationStatuses lists the certification statuses a matching image may have
+	// +optional
+	AllowedCertificationStatuses []CertificationStatus `json:"allowedCertificationStatuses,omitempty"`
+
+	// DisallowEOL flags images that are past their end-of-life date
+	// +optional
+	DisallowEOL bool `json:"disallowEol,omitempty"`
+
+	// MaxCriticalVulnerabilities is the highest allowed number of critical vulnerabilities
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxCriticalVulnerabilities *int32 `json:"maxCriticalVulnerabilities,omitempty"`
+
+	// MaxImportantVulnerabilities is the highest allowed number of important vulnerabilities
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxImportantVulnerabilities *int32 `json:"maxImportantVulnerabilities,omitempty"`
+}
+
+// ImageCertPolicySpec defines the rules of an ImageCertPolicy
+type ImageCertPolicySpec struct {
+	// NamespaceSelector limits the policy to images used by pods in matching namespaces.
+	// When unset, the policy applies to every tracked image.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Rules are the requirements images must satisfy
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Rules []ImageCertPolicyRule `json:"rules"`
+}
+
+// PolicyViolation records an image that fails a policy rule
+type PolicyViolation struct {
+	// ImageCertificationInfo is the name of the violating ImageCertificationInfo
+	ImageCertificationInfo string `json:"imageCertificationInfo"`
+	// FullImageReference is the complete image reference including registry, repo, and digest
+	FullImageReference string `json:"fullImageReference"`
+	// Rule is the name of the rule that failed
+	Rule string `json:"rule"`
+	// Message describes why the image fails the rule
+	Message string `json:"message"`
+	// Namespaces lists the matching namespaces with pods using the image
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// ImageCertPolicyStatus defines the observed state of ImageCertPolicy
+type ImageCertPolicyStatus struct {
+	// ObservedGeneration is the policy generation the status was computed from
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// EvaluatedImages is the number of images the policy applied to
+	// +optional
+	EvaluatedImages int `json:"evaluatedImages,omitempty"`
+
+	// ExemptedImages is the number of evaluated images skipped because an ImageCertExemption applies to them
+	// +optional
+	ExemptedImages int `json:"exemptedImages,omitempty"`
+
+	// ViolationCount is the total number of violations, including any not listed in Violations
+	// +optional
+	ViolationCount int `json:"violationCount,omitempty"`
+
+	// Violations lists up to 100 violations, sorted by image and rule
+	// +optional
+	Violations []PolicyViolation `json:"violations,omitempty"`
+
+	// ViolationHashes holds a hash of the image and rule of up to 10000 violations, including
+	// those not listed in Violations, so that an event is only emitted when an image newly
+	// violates a rule
+	// +kubebuilder:validation:MaxItems=10000
+	// +optional
+	ViolationHashes []string `json:"violationHashes,omitempty"`
+
+	// LastEvaluatedAt is when the violations last changed
+	// +optional
+	LastEvaluatedAt *metav1.Time `json:"lastEvaluatedAt,omitempty"`
+
+	// Conditions represent the current state of the ImageCertPolicy resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=icp,categories=security;imagecertinfo
+// +kubebuilder:printcolumn:name="Images",type=integer,JSONPath=`.status.evaluatedImages`
+// +kubebuilder:printcolumn:name="Violations",type=integer,JSONPath=`.status.violationCount`
+// +kubebuilder:printcolumn:name="Compliant",type=string,JSONPath=`.status.conditions[?(@.type=="Compliant")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ImageCertPolicy declares certification requirements for the images running in the cluster.
+// The operator evaluates every ImageCertificationInfo against each policy and records
+// violations in the policy status and as events.
+type ImageCertPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the rules of the policy
+	// +required
+	Spec ImageCertPolicySpec `json:"spec"`
+
+	// Status defines the observed state of ImageCertPolicy
+	// +optional
+	Status ImageCertPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageCertPolicyList contains a list of ImageCertPolicy
+type ImageCertPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageCertPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageCertPolicy{}, &ImageCertPolicyList{})
+}
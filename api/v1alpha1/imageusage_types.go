@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageUsageName is the name of the single ImageUsage resource maintained in each namespace
+const ImageUsageName = "image-usage"
+
+// ImageUsageEntry is a read-only projection of an ImageCertificationInfo for one namespace
+type ImageUsageEntry struct {
+	// ImageCertificationInfo is the name of the cluster-scoped ImageCertificationInfo this entry was projected from
+	ImageCertificationInfo string `json:"imageCertificationInfo"`
+	// FullImageReference is the complete image reference including registry, repo, and digest
+	FullImageReference string `json:"fullImageReference"`
+	// Registry is the container registry hostname
+	Registry string `json:"registry"`
+	// Repository is the image repository path
+	Repository string `json:"repository"`
+	// Tag is the image tag if available
+	// +optional
+	Tag string `json:"tag,omitempty"`
+	// RegistryType indicates the type of registry
+	// +optional
+	RegistryType RegistryType `json:"registryType,omitempty"`
+	// CertificationStatus indicates the certification status of the image
+	// +optional
+	CertificationStatus CertificationStatus `json:"certificationStatus,omitempty"`
+	// HealthIndex is the image health grade (A-F)
+	// +optional
+	HealthIndex string `json:"healthIndex,omitempty"`
+	// Vulnerabilities contains vulnerability counts by severity
+	// +optional
+	Vulnerabilities *VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	// DaysUntilEOL is the number of days until end-of-life (negative if past EOL)
+	// +optional
+	DaysUntilEOL *int `json:"daysUntilEol,omitempty"`
+	// PodReferences lists the pods in this namespace using the image
+	// +optional
+	PodReferences []PodReference `json:"podReferences,omitempty"`
+}
+
+// ImageUsageSpec defines the desired state of ImageUsage.
+// ImageUsage resources are generated by the operator and have no user-configurable fields.
+type ImageUsageSpec struct{}
+
+// ImageUsageStatus defines the observed state of ImageUsage
+type ImageUsageStatus struct {
+	// Images lists the images used by pods in this namespace
+	// +optional
+	Images []ImageUsageEntry `json:"images,omitempty"`
+
+	// ImageCount is the number of distinct images used in this namespace
+	// +optional
+	ImageCount int `json:"imageCount,omitempty"`
+
+	// LastUpdatedAt is when this projection was last rebuilt
+	// +optional
+	LastUpdatedAt *metav1.Time `json:"lastUpdatedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=iu,categories=security;imagecertinfo
+// +kubebuilder:printcolumn:name="Images",type=integer,JSONPath=`.status.imageCount`
+// +kubebuilder:printcolumn:name="Updated",type=date,JSONPath=`.status.lastUpdatedAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ImageUsage is a namespaced, read-only view of the ImageCertificationInfo data for images
+// used in a namespace, allowing users with namespace-scoped RBAC to see certification info.
+type ImageUsage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of ImageUsage
+	// +optional
+	Spec ImageUsageSpec `json:"spec,omitempty"`
+
+	// Status defines the observed state of ImageUsage
+	// +optional
+	Status ImageUsageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageUsageList contains a list of ImageUsage
+type ImageUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageUsage `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageUsage{}, &ImageUsageList{})
+}
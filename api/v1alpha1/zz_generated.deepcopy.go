@@ -25,6 +25,239 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CVE) DeepCopyInto(out *CVE) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CVE.
+func (in *CVE) DeepCopy() *CVE {
+	if in == nil {
+		return nil
+	}
+	out := new(CVE)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificationReport) DeepCopyInto(out *ClusterCertificationReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificationReport.
+func (in *ClusterCertificationReport) DeepCopy() *ClusterCertificationReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificationReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCertificationReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificationReportList) DeepCopyInto(out *ClusterCertificationReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterCertificationReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificationReportList.
+func (in *ClusterCertificationReportList) DeepCopy() *ClusterCertificationReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificationReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCertificationReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificationReportSpec) DeepCopyInto(out *ClusterCertificationReportSpec) {
+	*out = *in
+	if in.OperatingSystems != nil {
+		in, out := &in.OperatingSystems, &out.OperatingSystems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificationReportSpec.
+func (in *ClusterCertificationReportSpec) DeepCopy() *ClusterCertificationReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificationReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCertificationReportStatus) DeepCopyInto(out *ClusterCertificationReportStatus) {
+	*out = *in
+	if in.Compliance != nil {
+		in, out := &in.Compliance, &out.Compliance
+		*out = new(ComplianceScore)
+		**out = **in
+	}
+	if in.ByRegistryType != nil {
+		in, out := &in.ByRegistryType, &out.ByRegistryType
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ByCertificationStatus != nil {
+		in, out := &in.ByCertificationStatus, &out.ByCertificationStatus
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ByHealthGrade != nil {
+		in, out := &in.ByHealthGrade, &out.ByHealthGrade
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ByOperatingSystem != nil {
+		in, out := &in.ByOperatingSystem, &out.ByOperatingSystem
+		*out = make(map[string]map[string]int, len(*in))
+		for key, val := range *in {
+			var outVal map[string]int
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(map[string]int, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ImagesPastEOL != nil {
+		in, out := &in.ImagesPastEOL, &out.ImagesPastEOL
+		*out = make([]ReportedImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MostVulnerableImages != nil {
+		in, out := &in.MostVulnerableImages, &out.MostVulnerableImages
+		*out = make([]ReportedImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MissingArchitectures != nil {
+		in, out := &in.MissingArchitectures, &out.MissingArchitectures
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceCertificationSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NamespacesByRisk != nil {
+		in, out := &in.NamespacesByRisk, &out.NamespacesByRisk
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProviderOutages != nil {
+		in, out := &in.ProviderOutages, &out.ProviderOutages
+		*out = make([]ProviderOutage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdatedAt != nil {
+		in, out := &in.LastUpdatedAt, &out.LastUpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCertificationReportStatus.
+func (in *ClusterCertificationReportStatus) DeepCopy() *ClusterCertificationReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCertificationReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceScore) DeepCopyInto(out *ComplianceScore) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceScore.
+func (in *ComplianceScore) DeepCopy() *ComplianceScore {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSource) DeepCopyInto(out *DataSource) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.SyncedAt.DeepCopyInto(&out.SyncedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSource.
+func (in *DataSource) DeepCopy() *DataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerHubData) DeepCopyInto(out *DockerHubData) {
 	*out = *in
@@ -37,157 +270,903 @@ func (in *DockerHubData) DeepCopyInto(out *DockerHubData) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerHubData.
+func (in *DockerHubData) DeepCopy() *DockerHubData {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerHubData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertExemption) DeepCopyInto(out *ImageCertExemption) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertExemption.
+func (in *ImageCertExemption) DeepCopy() *ImageCertExemption {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertExemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertExemption) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertExemptionList) DeepCopyInto(out *ImageCertExemptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageCertExemption, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertExemptionList.
+func (in *ImageCertExemptionList) DeepCopy() *ImageCertExemptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertExemptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertExemptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertExemptionSpec) DeepCopyInto(out *ImageCertExemptionSpec) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertExemptionSpec.
+func (in *ImageCertExemptionSpec) DeepCopy() *ImageCertExemptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertExemptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertExemptionStatus) DeepCopyInto(out *ImageCertExemptionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertExemptionStatus.
+func (in *ImageCertExemptionStatus) DeepCopy() *ImageCertExemptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertExemptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertInfoConfig) DeepCopyInto(out *ImageCertInfoConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertInfoConfig.
+func (in *ImageCertInfoConfig) DeepCopy() *ImageCertInfoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertInfoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertInfoConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertInfoConfigList) DeepCopyInto(out *ImageCertInfoConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageCertInfoConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertInfoConfigList.
+func (in *ImageCertInfoConfigList) DeepCopy() *ImageCertInfoConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertInfoConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertInfoConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertInfoConfigSpec) DeepCopyInto(out *ImageCertInfoConfigSpec) {
+	*out = *in
+	if in.Pyxis != nil {
+		in, out := &in.Pyxis, &out.Pyxis
+		*out = new(ProviderSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DockerHub != nil {
+		in, out := &in.DockerHub, &out.DockerHub
+		*out = new(ProviderSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CleanupInterval != nil {
+		in, out := &in.CleanupInterval, &out.CleanupInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RegistryClassification != nil {
+		in, out := &in.RegistryClassification, &out.RegistryClassification
+		*out = make([]RegistryClassificationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertInfoConfigSpec.
+func (in *ImageCertInfoConfigSpec) DeepCopy() *ImageCertInfoConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertInfoConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertInfoConfigStatus) DeepCopyInto(out *ImageCertInfoConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertInfoConfigStatus.
+func (in *ImageCertInfoConfigStatus) DeepCopy() *ImageCertInfoConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertInfoConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertPolicy) DeepCopyInto(out *ImageCertPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertPolicy.
+func (in *ImageCertPolicy) DeepCopy() *ImageCertPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertPolicyList) DeepCopyInto(out *ImageCertPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageCertPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertPolicyList.
+func (in *ImageCertPolicyList) DeepCopy() *ImageCertPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertPolicyRule) DeepCopyInto(out *ImageCertPolicyRule) {
+	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedCertificationStatuses != nil {
+		in, out := &in.AllowedCertificationStatuses, &out.AllowedCertificationStatuses
+		*out = make([]CertificationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxCriticalVulnerabilities != nil {
+		in, out := &in.MaxCriticalVulnerabilities, &out.MaxCriticalVulnerabilities
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxImportantVulnerabilities != nil {
+		in, out := &in.MaxImportantVulnerabilities, &out.MaxImportantVulnerabilities
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertPolicyRule.
+func (in *ImageCertPolicyRule) DeepCopy() *ImageCertPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertPolicySpec) DeepCopyInto(out *ImageCertPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ImageCertPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertPolicySpec.
+func (in *ImageCertPolicySpec) DeepCopy() *ImageCertPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertPolicyStatus) DeepCopyInto(out *ImageCertPolicyStatus) {
+	*out = *in
+	if in.Violations != nil {
+		in, out := &in.Violations, &out.Violations
+		*out = make([]PolicyViolation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ViolationHashes != nil {
+		in, out := &in.ViolationHashes, &out.ViolationHashes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastEvaluatedAt != nil {
+		in, out := &in.LastEvaluatedAt, &out.LastEvaluatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertPolicyStatus.
+func (in *ImageCertPolicyStatus) DeepCopy() *ImageCertPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertificationInfo) DeepCopyInto(out *ImageCertificationInfo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfo.
+func (in *ImageCertificationInfo) DeepCopy() *ImageCertificationInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertificationInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertificationInfo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertificationInfoList) DeepCopyInto(out *ImageCertificationInfoList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageCertificationInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfoList.
+func (in *ImageCertificationInfoList) DeepCopy() *ImageCertificationInfoList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertificationInfoList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageCertificationInfoList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertificationInfoSpec) DeepCopyInto(out *ImageCertificationInfoSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfoSpec.
+func (in *ImageCertificationInfoSpec) DeepCopy() *ImageCertificationInfoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertificationInfoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCertificationInfoStatus) DeepCopyInto(out *ImageCertificationInfoStatus) {
+	*out = *in
+	if in.PyxisData != nil {
+		in, out := &in.PyxisData, &out.PyxisData
+		*out = new(PyxisData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DockerHubData != nil {
+		in, out := &in.DockerHubData, &out.DockerHubData
+		*out = new(DockerHubData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryData != nil {
+		in, out := &in.RegistryData, &out.RegistryData
+		*out = new(RegistryData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuayData != nil {
+		in, out := &in.QuayData, &out.QuayData
+		*out = new(QuayData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SBOMData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ownership != nil {
+		in, out := &in.Ownership, &out.Ownership
+		*out = new(ImageOwnership)
+		**out = **in
+	}
+	if in.DataSources != nil {
+		in, out := &in.DataSources, &out.DataSources
+		*out = make([]DataSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodReferences != nil {
+		in, out := &in.PodReferences, &out.PodReferences
+		*out = make([]PodReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeReferences != nil {
+		in, out := &in.NodeReferences, &out.NodeReferences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FirstSeenAt != nil {
+		in, out := &in.FirstSeenAt, &out.FirstSeenAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSeenAt != nil {
+		in, out := &in.LastSeenAt, &out.LastSeenAt
+		*out = (*in).DeepCopy()
+	}
+	if in.OrphanedAt != nil {
+		in, out := &in.OrphanedAt, &out.OrphanedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastPyxisCheckAt != nil {
+		in, out := &in.LastPyxisCheckAt, &out.LastPyxisCheckAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DaysUntilEOL != nil {
+		in, out := &in.DaysUntilEOL, &out.DaysUntilEOL
+		*out = new(int)
+		**out = **in
+	}
+	if in.TrackedCVEs != nil {
+		in, out := &in.TrackedCVEs, &out.TrackedCVEs
+		*out = make([]TrackedCVE, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxCVEAgeDays != nil {
+		in, out := &in.MaxCVEAgeDays, &out.MaxCVEAgeDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.MissingArchitectures != nil {
+		in, out := &in.MissingArchitectures, &out.MissingArchitectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperatingSystems != nil {
+		in, out := &in.OperatingSystems, &out.OperatingSystems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReconcileAt != nil {
+		in, out := &in.LastReconcileAt, &out.LastReconcileAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastErrorAt != nil {
+		in, out := &in.LastErrorAt, &out.LastErrorAt
+		*out = (*in).DeepCopy()
+	}
+	if in.WarnedVulnerabilities != nil {
+		in, out := &in.WarnedVulnerabilities, &out.WarnedVulnerabilities
+		*out = new(VulnerabilitySummary)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfoStatus.
+func (in *ImageCertificationInfoStatus) DeepCopy() *ImageCertificationInfoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCertificationInfoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOwnership) DeepCopyInto(out *ImageOwnership) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOwnership.
+func (in *ImageOwnership) DeepCopy() *ImageOwnership {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOwnership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePlatform) DeepCopyInto(out *ImagePlatform) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePlatform.
+func (in *ImagePlatform) DeepCopy() *ImagePlatform {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePlatform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUsage) DeepCopyInto(out *ImageUsage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUsage.
+func (in *ImageUsage) DeepCopy() *ImageUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageUsage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUsageEntry) DeepCopyInto(out *ImageUsageEntry) {
+	*out = *in
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = new(VulnerabilitySummary)
+		**out = **in
+	}
+	if in.DaysUntilEOL != nil {
+		in, out := &in.DaysUntilEOL, &out.DaysUntilEOL
+		*out = new(int)
+		**out = **in
+	}
+	if in.PodReferences != nil {
+		in, out := &in.PodReferences, &out.PodReferences
+		*out = make([]PodReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUsageEntry.
+func (in *ImageUsageEntry) DeepCopy() *ImageUsageEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUsageEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUsageList) DeepCopyInto(out *ImageUsageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUsageList.
+func (in *ImageUsageList) DeepCopy() *ImageUsageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageUsageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageUsageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerHubData.
-func (in *DockerHubData) DeepCopy() *DockerHubData {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageUsageSpec) DeepCopyInto(out *ImageUsageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUsageSpec.
+func (in *ImageUsageSpec) DeepCopy() *ImageUsageSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DockerHubData)
+	out := new(ImageUsageSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageCertificationInfo) DeepCopyInto(out *ImageCertificationInfo) {
+func (in *ImageUsageStatus) DeepCopyInto(out *ImageUsageStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageUsageEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdatedAt != nil {
+		in, out := &in.LastUpdatedAt, &out.LastUpdatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfo.
-func (in *ImageCertificationInfo) DeepCopy() *ImageCertificationInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUsageStatus.
+func (in *ImageUsageStatus) DeepCopy() *ImageUsageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageCertificationInfo)
+	out := new(ImageUsageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ImageCertificationInfo) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageCertificationInfoList) DeepCopyInto(out *ImageCertificationInfoList) {
+func (in *NamespaceCertificationSummary) DeepCopyInto(out *NamespaceCertificationSummary) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ImageCertificationInfo, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.ByCertificationStatus != nil {
+		in, out := &in.ByCertificationStatus, &out.ByCertificationStatus
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfoList.
-func (in *ImageCertificationInfoList) DeepCopy() *ImageCertificationInfoList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceCertificationSummary.
+func (in *NamespaceCertificationSummary) DeepCopy() *NamespaceCertificationSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageCertificationInfoList)
+	out := new(NamespaceCertificationSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ImageCertificationInfoList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartnerCertification) DeepCopyInto(out *PartnerCertification) {
+	*out = *in
+	if in.SupportedOCPVersions != nil {
+		in, out := &in.SupportedOCPVersions, &out.SupportedOCPVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.Badges != nil {
+		in, out := &in.Badges, &out.Badges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartnerCertification.
+func (in *PartnerCertification) DeepCopy() *PartnerCertification {
+	if in == nil {
+		return nil
+	}
+	out := new(PartnerCertification)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageCertificationInfoSpec) DeepCopyInto(out *ImageCertificationInfoSpec) {
+func (in *PodReference) DeepCopyInto(out *PodReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfoSpec.
-func (in *ImageCertificationInfoSpec) DeepCopy() *ImageCertificationInfoSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodReference.
+func (in *PodReference) DeepCopy() *PodReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageCertificationInfoSpec)
+	out := new(PodReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageCertificationInfoStatus) DeepCopyInto(out *ImageCertificationInfoStatus) {
+func (in *PolicyViolation) DeepCopyInto(out *PolicyViolation) {
 	*out = *in
-	if in.PyxisData != nil {
-		in, out := &in.PyxisData, &out.PyxisData
-		*out = new(PyxisData)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DockerHubData != nil {
-		in, out := &in.DockerHubData, &out.DockerHubData
-		*out = new(DockerHubData)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PodReferences != nil {
-		in, out := &in.PodReferences, &out.PodReferences
-		*out = make([]PodReference, len(*in))
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.FirstSeenAt != nil {
-		in, out := &in.FirstSeenAt, &out.FirstSeenAt
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyViolation.
+func (in *PolicyViolation) DeepCopy() *PolicyViolation {
+	if in == nil {
+		return nil
 	}
-	if in.LastSeenAt != nil {
-		in, out := &in.LastSeenAt, &out.LastSeenAt
+	out := new(PolicyViolation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderOutage) DeepCopyInto(out *ProviderOutage) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.EndedAt != nil {
+		in, out := &in.EndedAt, &out.EndedAt
 		*out = (*in).DeepCopy()
 	}
-	if in.LastPyxisCheckAt != nil {
-		in, out := &in.LastPyxisCheckAt, &out.LastPyxisCheckAt
+	out.Duration = in.Duration
+	if in.DrainedAt != nil {
+		in, out := &in.DrainedAt, &out.DrainedAt
 		*out = (*in).DeepCopy()
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.DaysUntilEOL != nil {
-		in, out := &in.DaysUntilEOL, &out.DaysUntilEOL
-		*out = new(int)
+	if in.DrainDuration != nil {
+		in, out := &in.DrainDuration, &out.DrainDuration
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCertificationInfoStatus.
-func (in *ImageCertificationInfoStatus) DeepCopy() *ImageCertificationInfoStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderOutage.
+func (in *ProviderOutage) DeepCopy() *ProviderOutage {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageCertificationInfoStatus)
+	out := new(ProviderOutage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodReference) DeepCopyInto(out *PodReference) {
+func (in *ProviderSettings) DeepCopyInto(out *ProviderSettings) {
 	*out = *in
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RateBurst != nil {
+		in, out := &in.RateBurst, &out.RateBurst
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodReference.
-func (in *PodReference) DeepCopy() *PodReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSettings.
+func (in *ProviderSettings) DeepCopy() *ProviderSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(PodReference)
+	out := new(ProviderSettings)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -225,6 +1204,20 @@ func (in *PyxisData) DeepCopyInto(out *PyxisData) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CVEs != nil {
+		in, out := &in.CVEs, &out.CVEs
+		*out = make([]CVE, len(*in))
+		copy(*out, *in)
+	}
+	if in.PartnerCertification != nil {
+		in, out := &in.PartnerCertification, &out.PartnerCertification
+		*out = new(PartnerCertification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PyxisData.
@@ -237,6 +1230,169 @@ func (in *PyxisData) DeepCopy() *PyxisData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayData) DeepCopyInto(out *QuayData) {
+	*out = *in
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = new(VulnerabilitySummary)
+		**out = **in
+	}
+	if in.LastScanAt != nil {
+		in, out := &in.LastScanAt, &out.LastScanAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayData.
+func (in *QuayData) DeepCopy() *QuayData {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryClassificationRule) DeepCopyInto(out *RegistryClassificationRule) {
+	*out = *in
+	if in.PyxisEligible != nil {
+		in, out := &in.PyxisEligible, &out.PyxisEligible
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryClassificationRule.
+func (in *RegistryClassificationRule) DeepCopy() *RegistryClassificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryClassificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryData) DeepCopyInto(out *RegistryData) {
+	*out = *in
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]ImagePlatform, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryData.
+func (in *RegistryData) DeepCopy() *RegistryData {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportedImage) DeepCopyInto(out *ReportedImage) {
+	*out = *in
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = new(VulnerabilitySummary)
+		**out = **in
+	}
+	if in.DaysUntilEOL != nil {
+		in, out := &in.DaysUntilEOL, &out.DaysUntilEOL
+		*out = new(int)
+		**out = **in
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportedImage.
+func (in *ReportedImage) DeepCopy() *ReportedImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportedImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMData) DeepCopyInto(out *SBOMData) {
+	*out = *in
+	if in.PackageCount != nil {
+		in, out := &in.PackageCount, &out.PackageCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.TopLicenses != nil {
+		in, out := &in.TopLicenses, &out.TopLicenses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMData.
+func (in *SBOMData) DeepCopy() *SBOMData {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrackedCVE) DeepCopyInto(out *TrackedCVE) {
+	*out = *in
+	in.FirstObservedAt.DeepCopyInto(&out.FirstObservedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrackedCVE.
+func (in *TrackedCVE) DeepCopy() *TrackedCVE {
+	if in == nil {
+		return nil
+	}
+	out := new(TrackedCVE)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VulnerabilitySummary) DeepCopyInto(out *VulnerabilitySummary) {
 	*out = *in
@@ -251,3 +1407,18 @@ func (in *VulnerabilitySummary) DeepCopy() *VulnerabilitySummary {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadReference) DeepCopyInto(out *WorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadReference.
+func (in *WorkloadReference) DeepCopy() *WorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// ConvertTo converts this ImageCertificationInfo to the Hub version (v1alpha1)
+func (src *ImageCertificationInfo) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.ImageCertificationInfo)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	dst.Spec = v1alpha1.ImageCertificationInfoSpec{
+		ImageDigest:        src.Spec.Digest,
+		FullImageReference: src.Spec.Reference,
+		Registry:           src.Spec.Registry,
+		Repository:         src.Spec.Repository,
+		Tag:                src.Spec.Tag,
+	}
+	if src.Spec.Mirror != nil {
+		dst.Spec.ObservedRegistry = src.Spec.Mirror.Registry
+		dst.Spec.ObservedRepository = src.Spec.Mirror.Repository
+	}
+
+	status := src.Status.DeepCopy()
+	dst.Status = v1alpha1.ImageCertificationInfoStatus{
+		RegistryType:          status.RegistryType,
+		CertificationStatus:   status.Certification.Status,
+		ReasonCode:            status.Certification.Reason,
+		LastPyxisCheckAt:      status.Certification.LastCheckedAt,
+		PyxisData:             status.Providers.Pyxis,
+		DockerHubData:         status.Providers.DockerHub,
+		QuayData:              status.Providers.Quay,
+		RegistryData:          status.Providers.Registry,
+		SBOM:                  status.Providers.SBOM,
+		Ownership:             status.Ownership,
+		DataSources:           status.DataSources,
+		PodReferences:         status.Usage.Pods,
+		Workloads:             status.Usage.Workloads,
+		WorkloadCount:         status.Usage.WorkloadCount,
+		NodeReferences:        status.Usage.Nodes,
+		FirstSeenAt:           status.Usage.FirstSeenAt,
+		LastSeenAt:            status.Usage.LastSeenAt,
+		OrphanedAt:            status.Usage.OrphanedAt,
+		ImageAge:              status.Lifecycle.ImageAge,
+		DaysUntilEOL:          status.Lifecycle.DaysUntilEOL,
+		TrackedCVEs:           status.Vulnerabilities.TrackedCVEs,
+		MaxCVEAgeDays:         status.Vulnerabilities.MaxAgeDays,
+		MissingArchitectures:  status.MissingArchitectures,
+		OperatingSystems:      status.OperatingSystems,
+		ObjectSizeBytes:       status.ObjectSizeBytes,
+		LastReconcileAt:       status.Enrichment.LastReconcileAt,
+		ConsecutiveErrorCount: status.Enrichment.ConsecutiveErrorCount,
+		LastErrorAt:           status.Enrichment.LastErrorAt,
+		EOLWarningTier:        status.Warnings.EOLTier,
+		WarnedVulnerabilities: status.Warnings.Vulnerabilities,
+		Conditions:            status.Conditions,
+	}
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+func (dst *ImageCertificationInfo) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.ImageCertificationInfo)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	dst.Spec = ImageCertificationInfoSpec{
+		Digest:     src.Spec.ImageDigest,
+		Reference:  src.Spec.FullImageReference,
+		Registry:   src.Spec.Registry,
+		Repository: src.Spec.Repository,
+		Tag:        src.Spec.Tag,
+	}
+	if src.Spec.ObservedRegistry != "" || src.Spec.ObservedRepository != "" {
+		dst.Spec.Mirror = &MirrorLocation{
+			Registry:   src.Spec.ObservedRegistry,
+			Repository: src.Spec.ObservedRepository,
+		}
+	}
+
+	status := src.Status.DeepCopy()
+	dst.Status = ImageCertificationInfoStatus{
+		RegistryType: status.RegistryType,
+		Certification: Certification{
+			Status:        status.CertificationStatus,
+			Reason:        status.ReasonCode,
+			LastCheckedAt: status.LastPyxisCheckAt,
+		},
+		Providers: Providers{
+			Pyxis:     status.PyxisData,
+			DockerHub: status.DockerHubData,
+			Quay:      status.QuayData,
+			Registry:  status.RegistryData,
+			SBOM:      status.SBOM,
+		},
+		Ownership:   status.Ownership,
+		DataSources: status.DataSources,
+		Usage: Usage{
+			Pods:          status.PodReferences,
+			Workloads:     status.Workloads,
+			WorkloadCount: status.WorkloadCount,
+			Nodes:         status.NodeReferences,
+			FirstSeenAt:   status.FirstSeenAt,
+			LastSeenAt:    status.LastSeenAt,
+			OrphanedAt:    status.OrphanedAt,
+		},
+		Lifecycle: Lifecycle{
+			ImageAge:     status.ImageAge,
+			DaysUntilEOL: status.DaysUntilEOL,
+		},
+		Vulnerabilities: VulnerabilityAging{
+			TrackedCVEs: status.TrackedCVEs,
+			MaxAgeDays:  status.MaxCVEAgeDays,
+		},
+		MissingArchitectures: status.MissingArchitectures,
+		OperatingSystems:     status.OperatingSystems,
+		ObjectSizeBytes:      status.ObjectSizeBytes,
+		Enrichment: Enrichment{
+			LastReconcileAt:       status.LastReconcileAt,
+			ConsecutiveErrorCount: status.ConsecutiveErrorCount,
+			LastErrorAt:           status.LastErrorAt,
+		},
+		Warnings: Warnings{
+			EOLTier:         status.EOLWarningTier,
+			Vulnerabilities: status.WarnedVulnerabilities,
+		},
+		Conditions: status.Conditions,
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// The provider payloads, references, and enumerations are shared with v1alpha1, which is the
+// storage version, so that conversion between the versions is lossless. v1beta1 regroups them.
+
+// MirrorLocation is where an image was actually pulled from when it came through a mirror
+type MirrorLocation struct {
+	// Registry is the mirror registry hostname
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?$`
+	// +optional
+	Registry string `json:"registry,omitempty"`
+
+	// Repository is the repository path on the mirror registry
+	// +kubebuilder:validation:MaxLength=512
+	// +optional
+	Repository string `json:"repository,omitempty"`
+}
+
+// ImageCertificationInfoSpec defines the desired state of ImageCertificationInfo.
+// The spec is derived from the image digest and cannot change once created.
+// +kubebuilder:validation:XValidation:rule="self.reference.contains(self.digest)",message="digest must appear in reference"
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type ImageCertificationInfoSpec struct {
+	// Digest is the sha256 digest of the image. It is empty for an image a pod could not
+	// pull whose tag could not be resolved to a digest; such a record is keyed by its tag.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(sha256:[a-f0-9]{64})?$`
+	// +kubebuilder:validation:MaxLength=71
+	Digest string `json:"digest"`
+
+	// Reference is the complete image reference including registry, repo, and digest
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=1024
+	Reference string `json:"reference"`
+
+	// Registry is the container registry hostname, defaulting to docker.io like the container runtime
+	// +kubebuilder:default=docker.io
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?$`
+	// +optional
+	Registry string `json:"registry,omitempty"`
+
+	// Repository is the image repository path
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:XValidation:rule="!self.contains('@') && !self.contains(':')",message="repository must not contain a digest or tag"
+	Repository string `json:"repository"`
+
+	// Tag is the image tag if available
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`
+	// +optional
+	Tag string `json:"tag,omitempty"`
+
+	// Mirror is where the image was actually pulled from, set only when it differs from
+	// Registry and Repository, which always hold the canonical source the image is enriched against
+	// +optional
+	Mirror *MirrorLocation `json:"mirror,omitempty"`
+}
+
+// Certification is the certification verdict for an image
+type Certification struct {
+	// Status indicates the certification status (Certified, NotCertified, Pending, Unknown, Error)
+	// +kubebuilder:default=Unknown
+	// +optional
+	Status v1alpha1.CertificationStatus `json:"status,omitempty"`
+
+	// Reason is a machine-readable reason for the status, from the documented catalog of
+	// condition reasons
+	// +kubebuilder:validation:Enum=ImageDiscovered;CertifiedByPyxis;NotFoundInPyxis;PyxisQueryFailed;DockerOfficialImage;DockerVerifiedPublisher;NoDockerHubTrustProgram;PastEndOfLife;DeprecatedRelease;ImagePullFailed;CertificationStale
+	// +optional
+	Reason v1alpha1.ConditionReason `json:"reason,omitempty"`
+
+	// LastCheckedAt is when the Pyxis API was last queried for this image
+	// +optional
+	LastCheckedAt *metav1.Time `json:"lastCheckedAt,omitempty"`
+}
+
+// Providers holds the data each external provider returned for an image
+type Providers struct {
+	// Pyxis contains certification data from the Red Hat Pyxis API
+	// +optional
+	Pyxis *v1alpha1.PyxisData `json:"pyxis,omitempty"`
+
+	// DockerHub contains metadata from Docker Hub (only populated for docker.io images)
+	// +optional
+	DockerHub *v1alpha1.DockerHubData `json:"dockerHub,omitempty"`
+
+	// Quay contains the security scan published by Quay (only populated for quay.io images)
+	// +optional
+	Quay *v1alpha1.QuayData `json:"quay,omitempty"`
+
+	// Registry contains metadata read from the image's registry (only populated for images
+	// that are neither in a Red Hat registry nor on Docker Hub)
+	// +optional
+	Registry *v1alpha1.RegistryData `json:"registry,omitempty"`
+
+	// SBOM records whether an SBOM is attached to the image (only populated when SBOM
+	// discovery is enabled)
+	// +optional
+	SBOM *v1alpha1.SBOMData `json:"sbom,omitempty"`
+}
+
+// Usage describes where an image is used in the cluster
+type Usage struct {
+	// Pods lists all pods currently using this image
+	// +optional
+	Pods []v1alpha1.PodReference `json:"pods,omitempty"`
+
+	// Workloads groups Pods by owning workload
+	// +optional
+	Workloads []v1alpha1.WorkloadReference `json:"workloads,omitempty"`
+
+	// WorkloadCount is the number of distinct workloads using this image
+	// +optional
+	WorkloadCount int `json:"workloadCount,omitempty"`
+
+	// Nodes lists the control-plane nodes that report this image in their status
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+
+	// FirstSeenAt is when this image was first observed in the cluster
+	// +optional
+	FirstSeenAt *metav1.Time `json:"firstSeenAt,omitempty"`
+
+	// LastSeenAt is when this image was last observed in a running pod
+	// +optional
+	LastSeenAt *metav1.Time `json:"lastSeenAt,omitempty"`
+
+	// OrphanedAt is when the cleanup loop first found no pods using this image.
+	// It is cleared as soon as a pod uses the image again.
+	// +optional
+	OrphanedAt *metav1.Time `json:"orphanedAt,omitempty"`
+}
+
+// Lifecycle describes the age and support window of an image
+type Lifecycle struct {
+	// ImageAge is the computed age of the image since it was published (e.g., "45 days")
+	// +optional
+	ImageAge string `json:"imageAge,omitempty"`
+
+	// DaysUntilEOL is the number of days until end-of-life (negative if past EOL, nil if no EOL date)
+	// +optional
+	DaysUntilEOL *int `json:"daysUntilEol,omitempty"`
+}
+
+// VulnerabilityAging tracks how long known vulnerabilities have affected an image
+type VulnerabilityAging struct {
+	// TrackedCVEs lists the critical and important CVEs currently affecting this image with their
+	// first-observed time
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	TrackedCVEs []v1alpha1.TrackedCVE `json:"trackedCves,omitempty"`
+
+	// MaxAgeDays is the age in days of the oldest critical or important CVE still affecting this image
+	// +optional
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+}
+
+// Enrichment records how the certification lookups for an image have been going
+type Enrichment struct {
+	// LastReconcileAt is when the operator last looked up this image with its certification
+	// provider, successfully or not
+	// +optional
+	LastReconcileAt *metav1.Time `json:"lastReconcileAt,omitempty"`
+
+	// ConsecutiveErrorCount is the number of certification lookups for this image that have
+	// failed in a row. It is reset by the next successful lookup.
+	// +optional
+	ConsecutiveErrorCount int32 `json:"consecutiveErrorCount,omitempty"`
+
+	// LastErrorAt is when a certification lookup for this image last failed
+	// +optional
+	LastErrorAt *metav1.Time `json:"lastErrorAt,omitempty"`
+}
+
+// Warnings records the warning events emitted for an image so that they are not repeated
+type Warnings struct {
+	// EOLTier is the most urgent end-of-life tier an EOLApproaching event has been emitted
+	// for, so that each tier is warned about once
+	// +optional
+	EOLTier string `json:"eolTier,omitempty"`
+
+	// Vulnerabilities holds the critical and important vulnerability counts last reported in
+	// a VulnerabilitiesFound event, so that the event is only emitted again when they increase
+	// +optional
+	Vulnerabilities *v1alpha1.VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+}
+
+// ImageCertificationInfoStatus defines the observed state of ImageCertificationInfo
+type ImageCertificationInfoStatus struct {
+	// RegistryType indicates the type of registry (RedHat, Partner, Community, Private, Unknown)
+	// +kubebuilder:default=Unknown
+	// +optional
+	RegistryType v1alpha1.RegistryType `json:"registryType,omitempty"`
+
+	// Certification is the certification verdict and when it was last checked
+	// +optional
+	Certification Certification `json:"certification,omitempty"`
+
+	// Providers holds the data returned by each external provider
+	// +optional
+	Providers Providers `json:"providers,omitempty"`
+
+	// Ownership identifies the publisher of the image from its OCI labels, so that images
+	// without certification data can still be attributed
+	// +optional
+	Ownership *v1alpha1.ImageOwnership `json:"ownership,omitempty"`
+
+	// DataSources records which provider each enriched status field came from and how fresh it is
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DataSources []v1alpha1.DataSource `json:"dataSources,omitempty"`
+
+	// Usage describes the pods, workloads, and nodes using this image
+	// +optional
+	Usage Usage `json:"usage,omitempty"`
+
+	// Lifecycle describes the age and end-of-life of this image
+	// +optional
+	Lifecycle Lifecycle `json:"lifecycle,omitempty"`
+
+	// Vulnerabilities tracks the age of the CVEs affecting this image
+	// +optional
+	Vulnerabilities VulnerabilityAging `json:"vulnerabilities,omitempty"`
+
+	// MissingArchitectures lists the CPU architectures of cluster nodes that this image does not
+	// support. Pods using the image cannot run on those nodes. Empty when the supported
+	// architectures are unknown.
+	// +optional
+	MissingArchitectures []string `json:"missingArchitectures,omitempty"`
+
+	// OperatingSystems lists the operating systems the image is published for, e.g. linux
+	// or windows. Empty when the platforms are unknown.
+	// +optional
+	OperatingSystems []string `json:"operatingSystems,omitempty"`
+
+	// ObjectSizeBytes is the size of this object serialized as JSON when its status was last
+	// written. etcd rejects objects above its request size limit (1.5MiB by default).
+	// +optional
+	ObjectSizeBytes int64 `json:"objectSizeBytes,omitempty"`
+
+	// Enrichment records how the certification lookups for this image have been going, so
+	// that images that keep failing can be found without the operator logs
+	// +optional
+	Enrichment Enrichment `json:"enrichment,omitempty"`
+
+	// Warnings records the warning events emitted for this image so that they are not repeated
+	// +optional
+	Warnings Warnings `json:"warnings,omitempty"`
+
+	// Conditions represent the current state of the ImageCertificationInfo resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:resource:scope=Cluster,shortName=ici,categories=security;imagecertinfo
+// +kubebuilder:selectablefield:JSONPath=`.spec.registry`
+// +kubebuilder:selectablefield:JSONPath=`.spec.repository`
+// +kubebuilder:printcolumn:name="Registry",type=string,JSONPath=`.spec.registry`
+// +kubebuilder:printcolumn:name="Repository",type=string,JSONPath=`.spec.repository`
+// +kubebuilder:printcolumn:name="Certified",type=string,JSONPath=`.status.certification.status`
+// +kubebuilder:printcolumn:name="Health",type=string,JSONPath=`.status.providers.pyxis.healthIndex`
+// +kubebuilder:printcolumn:name="Critical",type=integer,JSONPath=`.status.providers.pyxis.vulnerabilities.critical`
+// +kubebuilder:printcolumn:name="Important",type=integer,JSONPath=`.status.providers.pyxis.vulnerabilities.important`
+// +kubebuilder:printcolumn:name="Workloads",type=integer,JSONPath=`.status.usage.workloadCount`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.certification.reason`,priority=1
+// +kubebuilder:printcolumn:name="Pulls",type=string,JSONPath=`.status.providers.dockerHub.pullCountFormatted`,priority=1
+// +kubebuilder:printcolumn:name="Freshness",type=integer,JSONPath=`.status.providers.dockerHub.daysSinceUpdate`,priority=1
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.status.registryType`,priority=1
+// +kubebuilder:printcolumn:name="Vendor",type=string,JSONPath=`.status.ownership.vendor`,priority=1
+// +kubebuilder:printcolumn:name="EOL-Days",type=integer,JSONPath=`.status.lifecycle.daysUntilEol`,priority=1
+// +kubebuilder:printcolumn:name="Partner-Level",type=string,JSONPath=`.status.providers.pyxis.partnerCertification.level`,priority=1
+// +kubebuilder:printcolumn:name="SBOM",type=string,JSONPath=`.status.providers.sbom.format`,priority=1
+// +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.status.providers.pyxis.releaseCategory`,priority=1
+// +kubebuilder:printcolumn:name="EOL",type=date,JSONPath=`.status.providers.pyxis.eolDate`,priority=1
+// +kubebuilder:printcolumn:name="CVE-Age",type=integer,JSONPath=`.status.vulnerabilities.maxAgeDays`,priority=1
+// +kubebuilder:printcolumn:name="OS",type=string,JSONPath=`.status.operatingSystems`,priority=1
+// +kubebuilder:printcolumn:name="Missing-Arch",type=string,JSONPath=`.status.missingArchitectures`,priority=1
+// +kubebuilder:printcolumn:name="Orphaned",type=date,JSONPath=`.status.usage.orphanedAt`,priority=1
+// +kubebuilder:printcolumn:name="Errors",type=integer,JSONPath=`.status.enrichment.consecutiveErrorCount`,priority=1
+
+// ImageCertificationInfo is the Schema for the imagecertificationinfos API
+type ImageCertificationInfo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of ImageCertificationInfo
+	// +required
+	Spec ImageCertificationInfoSpec `json:"spec"`
+
+	// Status defines the observed state of ImageCertificationInfo
+	// +optional
+	Status ImageCertificationInfoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageCertificationInfoList contains a list of ImageCertificationInfo
+type ImageCertificationInfoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageCertificationInfo `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageCertificationInfo{}, &ImageCertificationInfoList{})
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command kubectl-imagecertinfo is the imagecertinfo CLI packaged as a kubectl plugin, run
+// as "kubectl imagecertinfo" once it is on the PATH.
+package main
+
+import (
+	"os"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/cli"
+)
+
+func main() {
+	cli.Program = "kubectl imagecertinfo"
+	os.Exit(cli.Main())
+}
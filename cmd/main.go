@@ -20,17 +20,27 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"net/http"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -39,9 +49,31 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	securityv1beta1 "github.com/sebrandon1/imagecertinfo-operator/api/v1beta1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/audit"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/consoleplugin"
 	"github.com/sebrandon1/imagecertinfo-operator/internal/controller"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/errorbudget"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/externalid"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/faults"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/prescan"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/rawstore"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/reporting"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/search"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/sharding"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/startup"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/tracing"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/version"
+	webhookv1 "github.com/sebrandon1/imagecertinfo-operator/internal/webhook/v1"
+	webhookv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/internal/webhook/v1alpha1"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/dockerhub"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/notify"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/quay"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/secrets"
 	// +kubebuilder:scaffold:imports
 )
@@ -55,11 +87,13 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(securityv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(securityv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
 // nolint:gocyclo
 func main() {
+	startTime := time.Now()
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
@@ -74,18 +108,138 @@ func main() {
 	var pyxisBaseURL string
 	var pyxisAPIKey string
 	var cleanupInterval time.Duration
+	var orphanCRTTL time.Duration
+	var deprecationScanInterval time.Duration
+	var cleanupDeprecated bool
 	var pyxisCacheTTL time.Duration
+	var pyxisNegativeCacheTTL time.Duration
 	var pyxisRateLimit float64
 	var pyxisRateBurst int
+	var pyxisCacheBackend string
 	var pyxisRefreshInterval time.Duration
+	var pyxisRetryMaxAttempts int
+	var pyxisRetryBaseDelay time.Duration
+	var pyxisRetryJitter float64
+	var enrichmentTimeout time.Duration
+	var enrichmentWorkers int
+	var podReconcilerConcurrency int
+	var podWorkqueue controller.WorkqueueSettings
+	var maxCVEsPerImage int
+	var objectSizeWarningBytes int64
+	var lifecycleEvaluationInterval time.Duration
+	var certificationStalenessHorizon time.Duration
+	var certificationRetryBaseInterval time.Duration
+	var certificationRetryMaxInterval time.Duration
+	var enrichmentJournalEnabled bool
+	var eolWarningTiers string
+	var summarizeRefreshEvents bool
 
 	// Docker Hub configuration flags
 	var dockerHubEnabled bool
 	var dockerHubCacheTTL time.Duration
+	var dockerHubNegativeCacheTTL time.Duration
 	var dockerHubRateLimit float64
 	var dockerHubRateBurst int
+	var quayEnabled bool
+	var quayCacheTTL time.Duration
+	var quayRateLimit float64
+	var quayRateBurst int
+	var registryInspectionEnabled bool
+	var registryCacheTTL time.Duration
+	var registryRateLimit float64
+	var registryRateBurst int
+	var registryPlainHTTP string
+	var sbomDiscoveryEnabled bool
+	var sbomSummaryEnabled bool
+	var registryPullSecretsEnabled bool
+	var globalPullSecret string
+
+	// Namespaced projection flags
+	var imageUsageEnabled bool
+
+	// Provider error budget flags
+	var errorBudgetThreshold float64
+	var errorBudgetWindow time.Duration
+	var errorBudgetCooldown time.Duration
+
+	// Container discovery flags
+	var includeInitContainers bool
+	var includeSidecarContainers bool
+	var includeEphemeralContainers bool
+	var discoverNodeImages bool
+	var trackUnpulledImages bool
+	var imageMirrors string
+	var openshiftMirrorSets bool
+	var watchNamespaces string
+	var excludeNamespaces string
+	var watchNamespaceSelector string
+
+	// Audit sink flags
+	var auditFilePath string
+	var auditFileMaxSizeMB int
+	var auditFileMaxBackups int
+	var auditHTTPURL string
+
+	// Notification flags
+	var notifySecretName string
+	var notifyFlushInterval time.Duration
+	var notifyDedupWindow time.Duration
+
+	// Pod admission flags
+	var podAdmissionEnabled bool
+	var podAdmissionWarnOnly bool
+	var podAdmissionMaxCritical int
+	var podAdmissionExcludedNamespaces string
+	var readinessGateEnabled bool
+	var podCertificationLabels bool
+	var conversionWebhookEnabled bool
+
+	// Sharding flags
+	var shardMode bool
+	var shardLeaseDuration time.Duration
+
+	// Raw response debug flags
+	var rawResponseStore string
+	var rawResponseDir string
+	var rawResponseMaxBytes int
+
+	// Console plugin flags
+	var consolePluginImage string
+
+	// Workload status propagation flags
+	var workloadStatusAnnotations bool
+	var workloadStatusWriteRate float64
+	var namespaceComplianceLabels bool
+	var clusterReportInterval time.Duration
+	var reportSchedule time.Duration
+	var reportFormats string
+	var reportDir string
+	var reportRetain int
+	var reportS3Endpoint string
+	var reportS3Bucket string
+	var reportS3Prefix string
+	var reportS3Region string
+	var inventoryMetricsInterval time.Duration
+
+	// Runtime configuration flags
+	var operatorConfigName string
+
+	// Search endpoint flags
+	var searchEndpoint bool
+	var externalIDsEndpoint bool
+	var prescanEndpoint bool
+	var otlpEndpoint string
+	var otlpInsecure bool
+	var traceSampleRatio float64
+
+	// Health probe flags
+	var readyzRequireLeader bool
+	var readyzCheckProviders bool
+	var readyzProviderGrace time.Duration
+	var outageProbeInterval time.Duration
 
 	// Pyxis API key secret configuration flags
+	var pyxisAPIKeySecret string
 	var pyxisAPIKeySecretName string
 	var pyxisAPIKeySecretNamespace string
 	var pyxisAPIKeySecretKey string
@@ -117,26 +271,268 @@ func main() {
 		"Optional API key for Pyxis authentication (public API works without auth, can also use PYXIS_API_KEY env var)")
 	flag.DurationVar(&cleanupInterval, "cleanup-interval", 5*time.Minute,
 		"Interval for cleaning up stale pod references")
+	flag.DurationVar(&orphanCRTTL, "orphan-cr-ttl", 0,
+		"Delete ImageCertificationInfos that no pod has used for this long (0 keeps them forever)")
+	flag.DurationVar(&deprecationScanInterval, "deprecation-scan-interval", controller.DefaultDeprecationScanInterval,
+		"Interval for reporting images that use a deprecated name or field (0 to disable)")
+	flag.BoolVar(&cleanupDeprecated, "cleanup-deprecated", true,
+		"Remove deprecated names and fields found by the deprecation scan when it is safe")
 	flag.DurationVar(&pyxisCacheTTL, "pyxis-cache-ttl", pyxis.DefaultCacheTTL,
 		"TTL for cached Pyxis API responses (default 1 hour)")
+	flag.DurationVar(&pyxisNegativeCacheTTL, "pyxis-negative-cache-ttl", pyxis.DefaultNegativeCacheTTL,
+		"TTL for cached lookups of images Pyxis has no certification data for, capped at --pyxis-cache-ttl (0 disables)")
 	flag.Float64Var(&pyxisRateLimit, "pyxis-rate-limit", pyxis.DefaultRateLimit,
 		"Rate limit for Pyxis API requests per second (default 10)")
 	flag.IntVar(&pyxisRateBurst, "pyxis-rate-burst", pyxis.DefaultRateBurst,
 		"Burst size for Pyxis API rate limiting (default 20)")
+	flag.StringVar(&pyxisCacheBackend, "pyxis-cache-backend", pyxis.CacheBackendMemory,
+		"Where the Pyxis cache is kept: \"memory\", or \"configmap\" to persist it across restarts")
+	flag.IntVar(&pyxisRetryMaxAttempts, "pyxis-retry-max-attempts", pyxis.DefaultRetryMaxAttempts,
+		"Attempts per Pyxis request before a network error, 5xx, or 429 is reported (1 disables retries)")
+	flag.DurationVar(&pyxisRetryBaseDelay, "pyxis-retry-base-delay", pyxis.DefaultRetryBaseDelay,
+		"Delay before the first Pyxis retry, doubled for each further retry")
+	flag.Float64Var(&pyxisRetryJitter, "pyxis-retry-jitter", pyxis.DefaultRetryJitter,
+		"Fraction by which each Pyxis retry delay is randomized (0 to 1)")
 	flag.DurationVar(&pyxisRefreshInterval, "pyxis-refresh-interval", 24*time.Hour,
 		"Interval for periodic refresh of Pyxis certification data (0 to disable, default 24h)")
+	flag.DurationVar(&enrichmentTimeout, "enrichment-timeout", controller.DefaultEnrichmentTimeout,
+		"Deadline for all Pyxis and Docker Hub calls made to enrich a single image (0 to disable)")
+	flag.IntVar(&enrichmentWorkers, "enrichment-workers", controller.DefaultEnrichmentWorkers,
+		"Number of newly discovered images enriched concurrently")
+	flag.IntVar(&podReconcilerConcurrency, "pod-reconciler-concurrency", controller.DefaultPodReconcilerConcurrency,
+		"Number of pods reconciled concurrently; raise it so large clusters are discovered faster at startup")
+	flag.DurationVar(&podWorkqueue.BaseDelay, "pod-workqueue-base-delay", controller.DefaultWorkqueueSettings.BaseDelay,
+		"First retry delay of a failed pod reconcile, doubled on each further failure")
+	flag.DurationVar(&podWorkqueue.MaxDelay, "pod-workqueue-max-delay", controller.DefaultWorkqueueSettings.MaxDelay,
+		"Longest retry delay of a failed pod reconcile")
+	flag.Float64Var(&podWorkqueue.QPS, "pod-workqueue-qps", controller.DefaultWorkqueueSettings.QPS,
+		"Overall rate of pod reconcile retries per second")
+	flag.IntVar(&podWorkqueue.Burst, "pod-workqueue-burst", controller.DefaultWorkqueueSettings.Burst,
+		"Burst size of pod reconcile retries")
+	flag.IntVar(&maxCVEsPerImage, "max-cves-per-image", controller.DefaultMaxCVEs,
+		"Most CVEs listed in an image's status.pyxisData.cves, keeping the most severe (0 lists all)")
+	flag.Int64Var(&objectSizeWarningBytes, "object-size-warning-bytes", controller.DefaultObjectSizeWarningBytes,
+		"Serialized size in bytes above which an ImageCertificationInfo triggers an ObjectSizeWarning event "+
+			"(0 to disable)")
+	flag.DurationVar(&lifecycleEvaluationInterval, "lifecycle-evaluation-interval",
+		controller.DefaultLifecycleEvaluationInterval,
+		"How often certified images are re-evaluated for end-of-life so they become EOL on their end-of-life date")
+	flag.DurationVar(&certificationStalenessHorizon, "certification-staleness-horizon",
+		controller.DefaultStalenessHorizon,
+		"How long after the last successful provider lookup a certification status is trusted before it is "+
+			"downgraded to Unknown (0 to trust it indefinitely)")
+	flag.DurationVar(&certificationRetryBaseInterval, "certification-retry-base-interval",
+		controller.DefaultCertificationRetryBaseInterval,
+		"Delay before retrying an image in the Error or Unknown state, doubled after each failure (0 to disable)")
+	flag.DurationVar(&certificationRetryMaxInterval, "certification-retry-max-interval",
+		controller.DefaultCertificationRetryMaxInterval,
+		"Longest delay between retries of an image in the Error or Unknown state")
+	flag.BoolVar(&enrichmentJournalEnabled, "enrichment-journal", false,
+		"Persist pending certification lookups in a ConfigMap so that a restarted operator resumes them")
+	flag.StringVar(&eolWarningTiers, "eol-warning-tiers", controller.FormatEOLTiers(controller.DefaultEOLTiers),
+		"Comma-separated name=days end-of-life warning tiers; an event is emitted as an image enters each tier (empty to disable)")
+	flag.BoolVar(&summarizeRefreshEvents, "summarize-refresh-events", false,
+		"Emit one summary event on the operator pod per refresh cycle instead of a warning event per image")
 
 	// Docker Hub flags
 	flag.BoolVar(&dockerHubEnabled, "dockerhub-enabled", true,
 		"Enable Docker Hub metadata enrichment for docker.io images")
 	flag.DurationVar(&dockerHubCacheTTL, "dockerhub-cache-ttl", dockerhub.DefaultCacheTTL,
 		"TTL for cached Docker Hub API responses (default 1 hour)")
+	flag.DurationVar(&dockerHubNegativeCacheTTL, "dockerhub-negative-cache-ttl", dockerhub.DefaultNegativeCacheTTL,
+		"TTL for cached lookups of repositories Docker Hub does not know, capped at --dockerhub-cache-ttl (0 disables)")
 	flag.Float64Var(&dockerHubRateLimit, "dockerhub-rate-limit", dockerhub.DefaultRateLimit,
 		"Rate limit for Docker Hub API requests per second (default 5)")
 	flag.IntVar(&dockerHubRateBurst, "dockerhub-rate-burst", dockerhub.DefaultRateBurst,
 		"Burst size for Docker Hub API rate limiting (default 10)")
 
+	// Quay flags
+	flag.BoolVar(&quayEnabled, "quay-enabled", true,
+		"Enable Quay security scan enrichment for quay.io images")
+	flag.DurationVar(&quayCacheTTL, "quay-cache-ttl", quay.DefaultCacheTTL,
+		"TTL for cached Quay security scans (default 1 hour)")
+	flag.Float64Var(&quayRateLimit, "quay-rate-limit", quay.DefaultRateLimit,
+		"Rate limit for Quay API requests per second (default 5)")
+	flag.IntVar(&quayRateBurst, "quay-rate-burst", quay.DefaultRateBurst,
+		"Burst size for Quay API rate limiting (default 10)")
+
+	// Generic registry flags
+	flag.BoolVar(&registryInspectionEnabled, "registry-inspection-enabled", true,
+		"Read manifests and config blobs from registries other than Red Hat and Docker Hub to record image metadata")
+	flag.DurationVar(&registryCacheTTL, "registry-cache-ttl", registry.DefaultCacheTTL,
+		"TTL for cached registry image metadata (default 24 hours)")
+	flag.Float64Var(&registryRateLimit, "registry-rate-limit", registry.DefaultRateLimit,
+		"Rate limit for registry requests per second across all registries (default 5)")
+	flag.IntVar(&registryRateBurst, "registry-rate-burst", registry.DefaultRateBurst,
+		"Burst size for registry request rate limiting (default 10)")
+	flag.StringVar(&registryPlainHTTP, "registry-plain-http", "",
+		"Comma-separated registry hosts to reach over plain HTTP instead of HTTPS")
+	flag.BoolVar(&sbomDiscoveryEnabled, "sbom-discovery", false,
+		"Look up SPDX and CycloneDX SBOMs attached to images through the OCI referrers API of their registry")
+	flag.BoolVar(&sbomSummaryEnabled, "sbom-summary", false,
+		"Read discovered SBOMs to record their package count and most common licenses (requires --sbom-discovery)")
+	flag.BoolVar(&registryPullSecretsEnabled, "registry-pull-secrets", false,
+		"Authenticate registry inspection and SBOM discovery with the imagePullSecrets of the pods using an image "+
+			"and the global pull secret (requires read access to Secrets)")
+	flag.StringVar(&globalPullSecret, "global-pull-secret", controller.DefaultGlobalPullSecret,
+		"namespace/name of the cluster-wide pull secret tried after the pods' pull secrets (empty to skip)")
+
+	// Namespaced projection flags
+	flag.BoolVar(&imageUsageEnabled, "image-usage-enabled", false,
+		"Maintain a namespaced ImageUsage resource per namespace so users with namespace-only RBAC "+
+			"can see certification info for their images")
+
+	// Provider error budget flags
+	flag.Float64Var(&errorBudgetThreshold, "provider-error-budget-threshold", errorbudget.DefaultThreshold,
+		"Error rate (0-1) over the window above which a provider is temporarily disabled (0 to disable the guard)")
+	flag.DurationVar(&errorBudgetWindow, "provider-error-budget-window", errorbudget.DefaultWindow,
+		"Sliding window over which provider error rates are measured")
+	flag.DurationVar(&errorBudgetCooldown, "provider-error-budget-cooldown", errorbudget.DefaultCooldown,
+		"How long a disabled provider stays disabled before a trial request is allowed")
+
+	// Container discovery flags
+	flag.BoolVar(&includeInitContainers, "include-init-containers", true,
+		"Discover images used by init containers")
+	flag.BoolVar(&includeSidecarContainers, "include-sidecar-containers", true,
+		"Discover images used by sidecar containers (init containers with restartPolicy Always)")
+	flag.BoolVar(&includeEphemeralContainers, "include-ephemeral-containers", false,
+		"Discover images used by ephemeral debug containers")
+	flag.BoolVar(&trackUnpulledImages, "track-unpulled-images", false,
+		"Discover the images of containers that fail to pull them (ErrImagePull, ImagePullBackOff) from the pod "+
+			"spec, resolving tags to digests in the registry or tracking them by tag")
+	flag.BoolVar(&discoverNodeImages, "discover-node-images", false,
+		"Discover static pod images from the status of control-plane nodes, even without mirror pods")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"Comma-separated namespaces whose pods are tracked, where a trailing * matches by prefix (all namespaces if empty)")
+	flag.StringVar(&excludeNamespaces, "exclude-namespaces", "",
+		"Comma-separated namespaces whose pods are never tracked, where a trailing * matches by prefix, e.g. kube-*,openshift-*")
+	flag.StringVar(&watchNamespaceSelector, "watch-namespace-selector", "",
+		"Label selector restricting tracking to pods in matching namespaces, e.g. tier=application")
+	flag.StringVar(&imageMirrors, "image-mirrors", "",
+		"Comma-separated mirror=source pairs, e.g. mirror.example.com/rh=registry.redhat.io, used to track "+
+			"images pulled through a mirror under the registry they mirror")
+	flag.BoolVar(&openshiftMirrorSets, "openshift-mirror-sets", true,
+		"Read registry mirrors from ImageDigestMirrorSets, ImageTagMirrorSets, and ImageContentSourcePolicies "+
+			"when the cluster serves them")
+
+	// Audit sink flags
+	flag.StringVar(&auditFilePath, "audit-file-path", "",
+		"Also write every emitted event as a JSON line to this file, e.g. on a mounted volume (disabled if empty)")
+	flag.IntVar(&auditFileMaxSizeMB, "audit-file-max-size-mb", audit.DefaultMaxFileSize/(1024*1024),
+		"Size in megabytes at which the audit file is rotated")
+	flag.IntVar(&auditFileMaxBackups, "audit-file-max-backups", audit.DefaultMaxBackups,
+		"Number of rotated audit files to keep")
+	flag.StringVar(&auditHTTPURL, "audit-http-url", "",
+		"Also POST every emitted event as JSON to this URL (disabled if empty)")
+
+	// Notification flags
+	flag.StringVar(&notifySecretName, "notify-secret-name", "",
+		"Name of a Secret in the operator namespace configuring Slack and webhook notifications (disabled if empty)")
+	flag.DurationVar(&notifyFlushInterval, "notify-flush-interval", notify.DefaultFlushInterval,
+		"How often pending notifications are sent, batched into one message per sink")
+	flag.DurationVar(&notifyDedupWindow, "notify-dedup-window", notify.DefaultDedupWindow,
+		"How long an identical notification about the same image is suppressed")
+
+	// Console plugin flags
+	flag.StringVar(&consolePluginImage, "console-plugin-image", "",
+		"Deploy the OpenShift Console plugin using this image (disabled if empty)")
+
+	// Pod admission flags
+	flag.BoolVar(&podAdmissionEnabled, "enable-pod-admission", false,
+		"Serve a validating webhook that checks pod images against their ImageCertificationInfo")
+	flag.BoolVar(&podAdmissionWarnOnly, "pod-admission-warn-only", true,
+		"Return admission warnings instead of rejecting pods that violate the policy")
+	flag.IntVar(&podAdmissionMaxCritical, "pod-admission-max-critical", 0,
+		"Highest allowed number of critical vulnerabilities per image (-1 to disable the check)")
+	flag.StringVar(&podAdmissionExcludedNamespaces, "pod-admission-excluded-namespaces", "kube-*,openshift-*",
+		"Comma-separated namespaces never checked by the pod admission webhook (a trailing * matches by prefix)")
+	flag.BoolVar(&readinessGateEnabled, "enable-readiness-gate", false,
+		"Set the "+string(controller.ConditionImagesCertified)+" condition on pods that declare it as a readiness gate")
+	flag.BoolVar(&podCertificationLabels, "enable-pod-certification-labels", false,
+		"Label pods with the certification status and worst health grade of their images")
+	flag.BoolVar(&conversionWebhookEnabled, "enable-conversion-webhook", false,
+		"Serve the conversion webhook between the v1alpha1 and v1beta1 ImageCertificationInfo APIs")
+
+	// Sharding flags
+	flag.BoolVar(&shardMode, "shard-mode", false,
+		"Split image processing across all replicas by consistent hashing instead of leader-only processing")
+	flag.DurationVar(&shardLeaseDuration, "shard-lease-duration", sharding.DefaultLeaseDuration,
+		"How long a replica stays in the shard ring without renewing its membership lease")
+
+	// Raw response debug flags
+	flag.StringVar(&rawResponseStore, "raw-response-store", "",
+		"Keep the last raw Pyxis and Docker Hub response per image for debugging: \"configmap\" or \"file\" "+
+			"(disabled if empty)")
+	flag.StringVar(&rawResponseDir, "raw-response-dir", "/tmp/imagecertinfo-raw-responses",
+		"Directory for raw responses when --raw-response-store=file")
+	flag.IntVar(&rawResponseMaxBytes, "raw-response-max-bytes", rawstore.DefaultMaxBytes,
+		"Size cap in bytes for a single raw response before compression")
+
+	// Workload status propagation flags
+	flag.BoolVar(&workloadStatusAnnotations, "workload-status-annotations", false,
+		"Annotate Deployments and StatefulSets with the worst certification status of their images")
+	flag.Float64Var(&workloadStatusWriteRate, "workload-status-write-rate", controller.DefaultWorkloadStatusWriteRate,
+		"Maximum workload annotation patches per second")
+	flag.BoolVar(&namespaceComplianceLabels, "namespace-compliance-labels", false,
+		"Label namespaces with the number of images their pods run that violate an ImageCertPolicy")
+	flag.DurationVar(&clusterReportInterval, "cluster-report-interval", controller.DefaultClusterReportInterval,
+		"Interval for rebuilding the ClusterCertificationReport (0 to disable)")
+	flag.DurationVar(&inventoryMetricsInterval, "inventory-metrics-interval", controller.DefaultInventoryMetricsInterval,
+		"Interval for recomputing the image inventory metrics")
+
+	// Inventory report export flags
+	flag.DurationVar(&reportSchedule, "report-schedule", 0,
+		"Interval for exporting the image inventory as report files (0 to disable)")
+	flag.StringVar(&reportFormats, "report-formats", "json,csv,sarif",
+		"Comma-separated formats of the exported reports: json, csv, sarif")
+	flag.StringVar(&reportDir, "report-dir", "", "Directory the reports are written to, e.g. a mounted PVC")
+	flag.IntVar(&reportRetain, "report-retain", reporting.DefaultRetain,
+		"Number of reports of each format kept in --report-dir (0 keeps every report)")
+	flag.StringVar(&reportS3Endpoint, "report-s3-endpoint", "",
+		"Base URL of the S3-compatible object store the reports are uploaded to")
+	flag.StringVar(&reportS3Bucket, "report-s3-bucket", "", "Bucket the reports are uploaded to")
+	flag.StringVar(&reportS3Prefix, "report-s3-prefix", "", "Prefix of the uploaded report object keys")
+	flag.StringVar(&reportS3Region, "report-s3-region", reporting.DefaultS3Region,
+		"Region the report uploads are signed for")
+
+	// Runtime configuration flags
+	flag.StringVar(&operatorConfigName, "operator-config-name", controller.DefaultOperatorConfigName,
+		"Name of the ImageCertInfoConfig in the operator namespace whose settings override the flags at "+
+			"runtime (disabled if empty)")
+
+	// Search endpoint flags
+	flag.BoolVar(&searchEndpoint, "search-endpoint", false,
+		"Serve "+search.Path+" on the metrics endpoint to query the image inventory with expressions such as "+
+			"registry=quay.io,criticalCVEs>0")
+	flag.BoolVar(&externalIDsEndpoint, "external-ids-endpoint", false,
+		"Serve "+externalid.Path+" on the metrics endpoint so external inventories can read and assign their "+
+			"IDs for tracked images in bulk")
+	flag.BoolVar(&prescanEndpoint, "prescan-endpoint", false,
+		"Serve "+prescan.Path+" on the metrics endpoint so chart authors and pipelines can check the images of "+
+			"a rendered pod spec against the cluster's ImageCertPolicies before installing it")
+
+	// Tracing flags
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "",
+		"host:port of an OTLP gRPC collector to export traces of reconciles, enrichment, and Pyxis and Docker Hub "+
+			"calls to (disabled if empty)")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Export traces to the OTLP collector without TLS")
+	flag.Float64Var(&traceSampleRatio, "trace-sample-ratio", tracing.DefaultSampleRatio,
+		"Fraction of traces to sample, between 0 and 1")
+
+	// Health probe flags
+	flag.BoolVar(&readyzRequireLeader, "readyz-require-leader", false,
+		"Report not ready until this replica is elected leader (only applies with --leader-elect)")
+	flag.BoolVar(&readyzCheckProviders, "readyz-check-providers", false,
+		"Include Pyxis, Docker Hub, and Quay API reachability in the readiness checks")
+	flag.DurationVar(&readyzProviderGrace, "readyz-provider-grace", health.DefaultProviderCheckGrace,
+		"How long a provider may be unreachable before its readiness check fails (0 to fail immediately)")
+	flag.DurationVar(&outageProbeInterval, "outage-probe-interval", health.DefaultOutageProbeInterval,
+		"Interval for probing Pyxis, Docker Hub, and Quay to record their outages (0 to disable)")
+
 	// Pyxis API key secret flags
+	flag.StringVar(&pyxisAPIKeySecret, "pyxis-api-key-secret", "",
+		"Secret key holding the Pyxis API key as [namespace/]name[/key]; the Secret is watched so a rotated "+
+			"key takes effect without a restart")
 	flag.StringVar(&pyxisAPIKeySecretName, "pyxis-api-key-secret-name", "",
 		"Name of the Kubernetes Secret containing the Pyxis API key")
 	flag.StringVar(&pyxisAPIKeySecretNamespace, "pyxis-api-key-secret-namespace", "",
@@ -152,6 +548,205 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	// Fail fast on flag values that would make the operator misbehave silently
+	v := &startup.Validator{}
+	v.Check(cleanupInterval > 0, "--cleanup-interval must be positive, got %s", cleanupInterval)
+	v.Check(orphanCRTTL >= 0, "--orphan-cr-ttl must not be negative (use 0 to disable), got %s", orphanCRTTL)
+	v.Check(deprecationScanInterval == 0 || deprecationScanInterval >= time.Minute,
+		"--deprecation-scan-interval must be 0 or at least 1m, got %s", deprecationScanInterval)
+	v.Check(enrichmentTimeout >= 0, "--enrichment-timeout must not be negative (use 0 to disable), got %s", enrichmentTimeout)
+	eolTiers, err := controller.ParseEOLTiers(eolWarningTiers)
+	v.Check(err == nil, "--eol-warning-tiers is invalid: %v", err)
+	v.Check(enrichmentWorkers >= 1, "--enrichment-workers must be at least 1, got %d", enrichmentWorkers)
+	v.Check(podReconcilerConcurrency >= 1, "--pod-reconciler-concurrency must be at least 1, got %d",
+		podReconcilerConcurrency)
+	v.Check(podWorkqueue.BaseDelay > 0, "--pod-workqueue-base-delay must be positive, got %s", podWorkqueue.BaseDelay)
+	v.Check(podWorkqueue.MaxDelay >= podWorkqueue.BaseDelay,
+		"--pod-workqueue-max-delay (%s) must not be shorter than --pod-workqueue-base-delay (%s)",
+		podWorkqueue.MaxDelay, podWorkqueue.BaseDelay)
+	v.Check(podWorkqueue.QPS > 0, "--pod-workqueue-qps must be positive, got %g", podWorkqueue.QPS)
+	v.Check(podWorkqueue.Burst >= 1, "--pod-workqueue-burst must be at least 1, got %d", podWorkqueue.Burst)
+	v.Check(maxCVEsPerImage >= 0, "--max-cves-per-image must not be negative (use 0 to list all), got %d",
+		maxCVEsPerImage)
+	v.Check(objectSizeWarningBytes >= 0, "--object-size-warning-bytes must not be negative (use 0 to disable), got %d",
+		objectSizeWarningBytes)
+	v.Check(lifecycleEvaluationInterval > 0, "--lifecycle-evaluation-interval must be positive, got %s",
+		lifecycleEvaluationInterval)
+	v.Check(certificationStalenessHorizon >= 0,
+		"--certification-staleness-horizon must not be negative (use 0 to disable), got %s",
+		certificationStalenessHorizon)
+	v.Check(certificationStalenessHorizon == 0 || certificationStalenessHorizon > pyxisRefreshInterval,
+		"--certification-staleness-horizon (%s) must be longer than --pyxis-refresh-interval (%s), "+
+			"or every image goes stale between refreshes", certificationStalenessHorizon, pyxisRefreshInterval)
+	v.Check(certificationRetryBaseInterval >= 0,
+		"--certification-retry-base-interval must not be negative (use 0 to disable), got %s",
+		certificationRetryBaseInterval)
+	v.Check(certificationRetryBaseInterval == 0 || certificationRetryMaxInterval >= certificationRetryBaseInterval,
+		"--certification-retry-max-interval (%s) must not be shorter than --certification-retry-base-interval (%s)",
+		certificationRetryMaxInterval, certificationRetryBaseInterval)
+	v.Check(!enrichmentJournalEnabled || os.Getenv("POD_NAMESPACE") != "",
+		"--enrichment-journal requires the POD_NAMESPACE environment variable")
+	v.Check(!enrichmentJournalEnabled || certificationRetryBaseInterval > 0,
+		"--enrichment-journal requires certification retries (--certification-retry-base-interval > 0)")
+	if pyxisEnabled {
+		v.Check(pyxisRateLimit > 0, "--pyxis-rate-limit must be positive, got %g; use --pyxis-enabled=false "+
+			"to turn off Pyxis", pyxisRateLimit)
+		v.Check(pyxisRateBurst >= 1, "--pyxis-rate-burst must be at least 1, got %d", pyxisRateBurst)
+		v.Check(slices.Contains([]string{pyxis.CacheBackendMemory, pyxis.CacheBackendConfigMap}, pyxisCacheBackend),
+			"--pyxis-cache-backend must be \"memory\" or \"configmap\", got %q", pyxisCacheBackend)
+		v.Check(pyxisCacheBackend != pyxis.CacheBackendConfigMap || os.Getenv("POD_NAMESPACE") != "",
+			"--pyxis-cache-backend=configmap requires the POD_NAMESPACE environment variable")
+		v.Check(pyxisCacheTTL >= startup.MinCacheTTL, "--pyxis-cache-ttl must be at least %s, got %s",
+			startup.MinCacheTTL, pyxisCacheTTL)
+		v.Check(pyxisNegativeCacheTTL >= 0, "--pyxis-negative-cache-ttl must not be negative (use 0 to disable), got %s",
+			pyxisNegativeCacheTTL)
+		v.Check(pyxisRefreshInterval >= 0, "--pyxis-refresh-interval must not be negative (use 0 to disable), got %s",
+			pyxisRefreshInterval)
+		v.Check(pyxisRefreshInterval == 0 || pyxisRefreshInterval >= pyxisCacheTTL,
+			"--pyxis-refresh-interval (%s) is shorter than --pyxis-cache-ttl (%s), so refreshes would only "+
+				"return cached data; raise the refresh interval or lower the cache TTL", pyxisRefreshInterval, pyxisCacheTTL)
+		v.Check(pyxisRetryMaxAttempts >= 1, "--pyxis-retry-max-attempts must be at least 1, got %d",
+			pyxisRetryMaxAttempts)
+		v.Check(pyxisRetryBaseDelay >= 0, "--pyxis-retry-base-delay must not be negative, got %s", pyxisRetryBaseDelay)
+		v.Check(pyxisRetryJitter >= 0 && pyxisRetryJitter <= 1, "--pyxis-retry-jitter must be between 0 and 1, got %g",
+			pyxisRetryJitter)
+	}
+	if dockerHubEnabled {
+		v.Check(dockerHubRateLimit > 0, "--dockerhub-rate-limit must be positive, got %g; use --dockerhub-enabled=false "+
+			"to turn off Docker Hub", dockerHubRateLimit)
+		v.Check(dockerHubRateBurst >= 1, "--dockerhub-rate-burst must be at least 1, got %d", dockerHubRateBurst)
+		v.Check(dockerHubCacheTTL >= startup.MinCacheTTL, "--dockerhub-cache-ttl must be at least %s, got %s",
+			startup.MinCacheTTL, dockerHubCacheTTL)
+		v.Check(dockerHubNegativeCacheTTL >= 0,
+			"--dockerhub-negative-cache-ttl must not be negative (use 0 to disable), got %s", dockerHubNegativeCacheTTL)
+	}
+	if quayEnabled {
+		v.Check(quayRateLimit > 0, "--quay-rate-limit must be positive, got %g; use --quay-enabled=false "+
+			"to turn off Quay", quayRateLimit)
+		v.Check(quayRateBurst >= 1, "--quay-rate-burst must be at least 1, got %d", quayRateBurst)
+		v.Check(quayCacheTTL >= startup.MinCacheTTL, "--quay-cache-ttl must be at least %s, got %s",
+			startup.MinCacheTTL, quayCacheTTL)
+	}
+	v.Check(!sbomSummaryEnabled || sbomDiscoveryEnabled, "--sbom-summary requires --sbom-discovery")
+	if registryInspectionEnabled || sbomDiscoveryEnabled {
+		v.Check(registryRateLimit > 0, "--registry-rate-limit must be positive, got %g; use "+
+			"--registry-inspection-enabled=false to turn off registry inspection", registryRateLimit)
+		v.Check(registryRateBurst >= 1, "--registry-rate-burst must be at least 1, got %d", registryRateBurst)
+		v.Check(registryCacheTTL >= startup.MinCacheTTL, "--registry-cache-ttl must be at least %s, got %s",
+			startup.MinCacheTTL, registryCacheTTL)
+	}
+	var globalPullSecretRef *types.NamespacedName
+	if registryPullSecretsEnabled && globalPullSecret != "" {
+		namespace, name, ok := strings.Cut(globalPullSecret, "/")
+		v.Check(ok && namespace != "" && name != "" && !strings.Contains(name, "/"),
+			"--global-pull-secret must be namespace/name, got %q", globalPullSecret)
+		globalPullSecretRef = &types.NamespacedName{Namespace: namespace, Name: name}
+	}
+	namespaceSelector, err := labels.Parse(watchNamespaceSelector)
+	v.Check(err == nil, "--watch-namespace-selector is invalid: %v", err)
+	mirrors, err := image.ParseMirrorMap(imageMirrors)
+	v.Check(err == nil, "--image-mirrors is invalid: %v", err)
+	v.Check(errorBudgetThreshold >= 0 && errorBudgetThreshold <= 1,
+		"--provider-error-budget-threshold must be between 0 and 1, got %g", errorBudgetThreshold)
+	if errorBudgetThreshold > 0 {
+		v.Check(errorBudgetWindow > 0, "--provider-error-budget-window must be positive, got %s", errorBudgetWindow)
+		v.Check(errorBudgetCooldown > 0, "--provider-error-budget-cooldown must be positive, got %s", errorBudgetCooldown)
+	}
+	if auditFilePath != "" {
+		v.Check(auditFileMaxSizeMB >= 1, "--audit-file-max-size-mb must be at least 1, got %d", auditFileMaxSizeMB)
+		v.Check(auditFileMaxBackups >= 0, "--audit-file-max-backups must not be negative, got %d", auditFileMaxBackups)
+	}
+	if notifySecretName != "" {
+		v.Check(os.Getenv("POD_NAMESPACE") != "", "--notify-secret-name requires the POD_NAMESPACE environment variable")
+		v.Check(notifyFlushInterval > 0, "--notify-flush-interval must be positive, got %s", notifyFlushInterval)
+		v.Check(notifyDedupWindow >= 0, "--notify-dedup-window must not be negative, got %s", notifyDedupWindow)
+	}
+	if podAdmissionEnabled {
+		v.Check(podAdmissionMaxCritical >= -1, "--pod-admission-max-critical must be -1 (disabled) or higher, got %d",
+			podAdmissionMaxCritical)
+		v.Warn(webhookCertPath != "", "--enable-pod-admission is set without --webhook-cert-path; "+
+			"the webhook server will look for certificates in its default directory")
+	}
+	if conversionWebhookEnabled {
+		v.Warn(webhookCertPath != "", "--enable-conversion-webhook is set without --webhook-cert-path; "+
+			"the webhook server will look for certificates in its default directory")
+	}
+	if shardMode {
+		v.Check(os.Getenv("POD_NAMESPACE") != "", "--shard-mode requires the POD_NAMESPACE environment variable")
+		v.Check(shardLeaseDuration >= 5*time.Second, "--shard-lease-duration must be at least 5s, got %s",
+			shardLeaseDuration)
+	}
+	v.Check(slices.Contains([]string{"", "configmap", "file"}, rawResponseStore),
+		"--raw-response-store must be \"configmap\" or \"file\" (or empty to disable), got %q", rawResponseStore)
+	if rawResponseStore != "" {
+		v.Check(rawResponseMaxBytes > 0, "--raw-response-max-bytes must be positive, got %d", rawResponseMaxBytes)
+	}
+	if rawResponseStore == "configmap" {
+		v.Check(os.Getenv("POD_NAMESPACE") != "", "--raw-response-store=configmap requires the POD_NAMESPACE "+
+			"environment variable")
+	}
+	if workloadStatusAnnotations {
+		v.Check(workloadStatusWriteRate > 0, "--workload-status-write-rate must be positive, got %g",
+			workloadStatusWriteRate)
+	}
+	v.Check(clusterReportInterval == 0 || clusterReportInterval >= time.Minute,
+		"--cluster-report-interval must be 0 or at least 1m, got %s", clusterReportInterval)
+	v.Check(inventoryMetricsInterval > 0, "--inventory-metrics-interval must be positive, got %s",
+		inventoryMetricsInterval)
+	reportFormatList, err := reporting.ParseFormats(reportFormats)
+	if reportSchedule > 0 {
+		v.Check(reportSchedule >= time.Minute, "--report-schedule must be 0 or at least 1m, got %s", reportSchedule)
+		v.Check(err == nil, "--report-formats is invalid: %v", err)
+		v.Check(reportDir != "" || reportS3Bucket != "", "--report-schedule requires --report-dir or --report-s3-bucket")
+		v.Check(reportRetain >= 0, "--report-retain must not be negative, got %d", reportRetain)
+	}
+	if reportSchedule > 0 && reportS3Bucket != "" {
+		v.Check(reportS3Endpoint != "", "--report-s3-bucket requires --report-s3-endpoint")
+		v.Check(os.Getenv("AWS_ACCESS_KEY_ID") != "" && os.Getenv("AWS_SECRET_ACCESS_KEY") != "",
+			"--report-s3-bucket requires the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables")
+	}
+	v.Warn(reportSchedule > 0 || (reportDir == "" && reportS3Bucket == ""),
+		"--report-dir and --report-s3-bucket have no effect without --report-schedule")
+	v.Warn(operatorConfigName == "" || os.Getenv("POD_NAMESPACE") != "",
+		"--operator-config-name has no effect without the POD_NAMESPACE environment variable")
+	v.Warn(!searchEndpoint || metricsAddr != "0",
+		"--search-endpoint has no effect while the metrics endpoint is disabled (--metrics-bind-address=0)")
+	v.Warn(!externalIDsEndpoint || metricsAddr != "0",
+		"--external-ids-endpoint has no effect while the metrics endpoint is disabled (--metrics-bind-address=0)")
+	v.Warn(!prescanEndpoint || metricsAddr != "0",
+		"--prescan-endpoint has no effect while the metrics endpoint is disabled (--metrics-bind-address=0)")
+	v.Check(traceSampleRatio >= 0 && traceSampleRatio <= 1, "--trace-sample-ratio must be between 0 and 1, got %g",
+		traceSampleRatio)
+	v.Warn(!otlpInsecure || otlpEndpoint != "", "--otlp-insecure has no effect without --otlp-endpoint")
+	v.Check(outageProbeInterval == 0 || outageProbeInterval >= 10*time.Second,
+		"--outage-probe-interval must be 0 or at least 10s, got %s", outageProbeInterval)
+	v.Warn(!readyzRequireLeader || enableLeaderElection,
+		"--readyz-require-leader has no effect without --leader-elect")
+	v.Check(readyzProviderGrace >= 0, "--readyz-provider-grace must not be negative, got %s", readyzProviderGrace)
+	v.Check(pyxisAPIKeySecretName == "" || pyxisAPIKeySecretNamespace != "" || os.Getenv("POD_NAMESPACE") != "",
+		"--pyxis-api-key-secret-name is set but neither --pyxis-api-key-secret-namespace nor POD_NAMESPACE is")
+	var pyxisAPIKeySecretRef *secrets.KeyRef
+	if pyxisAPIKeySecret != "" {
+		ref, err := secrets.ParseKeyRef(pyxisAPIKeySecret, os.Getenv("POD_NAMESPACE"), pyxisAPIKeySecretKey)
+		v.Check(err == nil, "--pyxis-api-key-secret is invalid: %v", err)
+		v.Check(pyxisAPIKeySecretName == "",
+			"--pyxis-api-key-secret and --pyxis-api-key-secret-name are mutually exclusive")
+		pyxisAPIKeySecretRef = &ref
+	}
+
+	buildInfo := version.Get()
+	metrics.SetBuildInfo(buildInfo.Version, buildInfo.Commit, buildInfo.BuildDate, buildInfo.GoVersion)
+	flagValues, overridden := startup.Report(flag.CommandLine, "pyxis-api-key")
+	setupLog.Info("Startup configuration", "version", buildInfo.Version, "commit", buildInfo.Commit,
+		"buildDate", buildInfo.BuildDate, "goVersion", buildInfo.GoVersion, "flags", flagValues, "overridden", overridden)
+	for _, warning := range v.Warnings() {
+		setupLog.Info("Configuration warning: " + warning)
+	}
+	if err := v.Err(); err != nil {
+		setupLog.Error(err, "invalid configuration")
+		os.Exit(1)
+	}
+
 	// Priority order for Pyxis API key: flag > env var > Secret
 	// Check for API key in environment variable if not set via flag
 	if pyxisAPIKey == "" {
@@ -162,6 +757,15 @@ func main() {
 	if pyxisAPIKeySecretNamespace == "" {
 		pyxisAPIKeySecretNamespace = os.Getenv("POD_NAMESPACE")
 	}
+	if pyxisAPIKeySecretName != "" {
+		pyxisAPIKeySecretRef = &secrets.KeyRef{
+			Namespace: pyxisAPIKeySecretNamespace,
+			Name:      pyxisAPIKeySecretName,
+			Key:       pyxisAPIKeySecretKey,
+		}
+	}
+	// A key read from a Secret is kept up to date by watching it
+	watchPyxisAPIKey := pyxisAPIKey == "" && pyxisAPIKeySecretRef != nil
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -230,8 +834,21 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	// The operator may only read the Pyxis API key Secret by name, so Secrets are cached
+	// for that one object rather than cluster-wide
+	var cacheOptions cache.Options
+	if watchPyxisAPIKey {
+		cacheOptions.ByObject = map[client.Object]cache.ByObject{
+			&corev1.Secret{}: {
+				Namespaces: map[string]cache.Config{pyxisAPIKeySecretRef.Namespace: {}},
+				Field:      fields.OneTermEqualSelector("metadata.name", pyxisAPIKeySecretRef.Name),
+			},
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  cacheOptions,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
@@ -254,12 +871,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Read Pyxis API key from Secret if not already set and secret name is provided
-	if pyxisAPIKey == "" && pyxisAPIKeySecretName != "" {
+	// Read Pyxis API key from Secret if not already set and a secret is provided
+	if watchPyxisAPIKey {
 		setupLog.Info("Reading Pyxis API key from Secret",
-			"secretName", pyxisAPIKeySecretName,
-			"secretNamespace", pyxisAPIKeySecretNamespace,
-			"secretKey", pyxisAPIKeySecretKey)
+			"secretName", pyxisAPIKeySecretRef.Name,
+			"secretNamespace", pyxisAPIKeySecretRef.Namespace,
+			"secretKey", pyxisAPIKeySecretRef.Key)
 
 		// Create a client for reading the secret
 		secretClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
@@ -271,9 +888,9 @@ func main() {
 		secretReader := secrets.NewSecretReader(secretClient)
 		apiKey, err := secretReader.ReadAPIKey(
 			context.Background(),
-			pyxisAPIKeySecretNamespace,
-			pyxisAPIKeySecretName,
-			pyxisAPIKeySecretKey,
+			pyxisAPIKeySecretRef.Namespace,
+			pyxisAPIKeySecretRef.Name,
+			pyxisAPIKeySecretRef.Key,
 		)
 		if err != nil {
 			setupLog.Error(err, "failed to read Pyxis API key from Secret")
@@ -283,26 +900,169 @@ func main() {
 		setupLog.Info("Successfully read Pyxis API key from Secret")
 	}
 
+	// Export events to durable audit sinks if configured
+	var eventRecorder record.EventRecorder = mgr.GetEventRecorderFor("imagecertinfo-controller") //nolint:staticcheck
+	var auditSinks []audit.Sink
+	var auditHTTPSink *audit.HTTPSink
+	if auditFilePath != "" {
+		fileSink, err := audit.NewFileSink(auditFilePath,
+			audit.WithMaxFileSize(int64(auditFileMaxSizeMB)*1024*1024),
+			audit.WithMaxBackups(auditFileMaxBackups))
+		if err != nil {
+			setupLog.Error(err, "unable to open audit file", "path", auditFilePath)
+			os.Exit(1)
+		}
+		auditSinks = append(auditSinks, fileSink)
+	}
+	if auditHTTPURL != "" {
+		auditHTTPSink = audit.NewHTTPSink(auditHTTPURL)
+		auditSinks = append(auditSinks, auditHTTPSink)
+	}
+	if len(auditSinks) > 0 {
+		setupLog.Info("Audit event export enabled", "file", auditFilePath, "url", auditHTTPURL)
+		eventRecorder = audit.NewRecorder(eventRecorder, mgr.GetScheme(), auditSinks...)
+	}
+
+	// Error budget guards temporarily disable a provider that keeps failing so an
+	// outage does not flip every tracked image to the Error status. Transitions are
+	// reported in the ProviderDegraded condition of the operator config.
+	providerDegradation := controller.NewProviderDegradationReporter(mgr.GetClient(), eventRecorder,
+		operatorConfigName, os.Getenv("POD_NAMESPACE"))
+	if err := mgr.Add(providerDegradation); err != nil {
+		setupLog.Error(err, "unable to set up provider degradation reporting")
+		os.Exit(1)
+	}
+	newGuard := func(provider string) *errorbudget.Guard {
+		return errorbudget.NewGuard(provider,
+			errorbudget.WithThreshold(errorBudgetThreshold),
+			errorbudget.WithWindow(errorBudgetWindow),
+			errorbudget.WithCooldown(errorBudgetCooldown),
+			errorbudget.WithStateChange(providerDegradation.StateChanged))
+	}
+
+	// Export traces if enabled; spans are dropped otherwise
+	var shutdownTracing func(context.Context) error
+	if otlpEndpoint != "" {
+		shutdownTracing, err = tracing.Setup(context.Background(), tracing.Options{
+			Endpoint:    otlpEndpoint,
+			Insecure:    otlpInsecure,
+			SampleRatio: traceSampleRatio,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+		setupLog.Info("Tracing enabled", "endpoint", otlpEndpoint, "sampleRatio", traceSampleRatio)
+	}
+
+	// Resilience tests inject faults into provider responses through faults.EnvVar. Only
+	// binaries built with the faultinjection tag honour it. Provider calls are traced if
+	// tracing is enabled.
+	newProviderClient := func(provider string, timeout time.Duration) *http.Client {
+		httpClient, err := faults.HTTPClient(provider, timeout)
+		if err != nil {
+			setupLog.Error(err, "invalid fault injection settings")
+			os.Exit(1)
+		}
+		if httpClient != nil {
+			setupLog.Info("Injecting faults into provider responses", "provider", provider, "env", faults.EnvVar)
+		}
+		if shutdownTracing != nil {
+			httpClient = tracing.HTTPClient(httpClient, timeout)
+		}
+		return httpClient
+	}
+
+	// Keep raw provider responses for debugging if enabled
+	var rawStore rawstore.Store
+	switch rawResponseStore {
+	case "":
+	case "configmap":
+		configMapStore := rawstore.NewConfigMapStore(mgr.GetClient(), os.Getenv("POD_NAMESPACE"),
+			rawstore.DefaultConfigMapName, rawResponseMaxBytes)
+		if err := mgr.Add(configMapStore); err != nil {
+			setupLog.Error(err, "unable to set up raw response store")
+			os.Exit(1)
+		}
+		rawStore = configMapStore
+	case "file":
+		fileStore, err := rawstore.NewFileStore(rawResponseDir, rawResponseMaxBytes)
+		if err != nil {
+			setupLog.Error(err, "unable to set up raw response store")
+			os.Exit(1)
+		}
+		rawStore = fileStore
+	default:
+		setupLog.Error(nil, "invalid --raw-response-store, must be \"configmap\" or \"file\"", "value", rawResponseStore)
+		os.Exit(1)
+	}
+	if rawStore != nil {
+		setupLog.Info("Storing raw provider responses for debugging", "store", rawResponseStore)
+	}
+
 	// Initialize Pyxis client if enabled
 	// The public Pyxis API works without authentication for read-only queries
 	var pyxisClient pyxis.Client
+	var pyxisHTTPClient *pyxis.HTTPClient
 	if pyxisEnabled {
 		setupLog.Info("Pyxis integration enabled (no auth required for public API)",
 			"baseURL", pyxisBaseURL,
 			"cacheTTL", pyxisCacheTTL,
+			"negativeCacheTTL", min(pyxisNegativeCacheTTL, pyxisCacheTTL),
 			"rateLimit", pyxisRateLimit,
-			"rateBurst", pyxisRateBurst)
+			"rateBurst", pyxisRateBurst,
+			"retryMaxAttempts", pyxisRetryMaxAttempts)
 		clientOpts := []pyxis.ClientOption{
 			pyxis.WithBaseURL(pyxisBaseURL),
+			pyxis.WithRetry(pyxis.RetryConfig{
+				MaxAttempts: pyxisRetryMaxAttempts,
+				BaseDelay:   pyxisRetryBaseDelay,
+				Jitter:      pyxisRetryJitter,
+			}),
 		}
 		if pyxisAPIKey != "" {
 			setupLog.Info("Using API key for Pyxis authentication")
 			clientOpts = append(clientOpts, pyxis.WithAPIKey(pyxisAPIKey))
 		}
-		baseClient := pyxis.NewHTTPClient(clientOpts...)
+		if rawStore != nil {
+			clientOpts = append(clientOpts, pyxis.WithRawResponseStore(rawStore))
+		}
+		if httpClient := newProviderClient("pyxis", pyxis.DefaultTimeout); httpClient != nil {
+			clientOpts = append(clientOpts, pyxis.WithHTTPClient(httpClient))
+		}
+		pyxisHTTPClient = pyxis.NewHTTPClient(clientOpts...)
+		var baseClient pyxis.Client = pyxisHTTPClient
+		if errorBudgetThreshold > 0 {
+			baseClient = pyxis.NewGuardedClient(baseClient, newGuard("pyxis"))
+		}
 
 		// Wrap with caching and rate limiting
-		pyxisClient = pyxis.NewCachedRateLimitedClient(baseClient, pyxisCacheTTL, pyxisRateLimit, pyxisRateBurst)
+		pyxisClient = pyxis.NewCachedRateLimitedClient(baseClient, pyxisCacheTTL, pyxisRateLimit, pyxisRateBurst,
+			pyxis.WithNegativeCacheTTL(pyxisNegativeCacheTTL))
+
+		// Restore the cache persisted by the previous run before any lookups are made
+		if cachedClient, ok := pyxisClient.(*pyxis.CachedClient); ok && pyxisCacheBackend == pyxis.CacheBackendConfigMap {
+			// Use an uncached client so the manager does not watch ConfigMaps cluster-wide
+			cacheClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+			if err != nil {
+				setupLog.Error(err, "unable to create Pyxis cache client")
+				os.Exit(1)
+			}
+			cacheStore := pyxis.NewConfigMapCacheStore(cacheClient, os.Getenv("POD_NAMESPACE"),
+				pyxis.DefaultCacheConfigMapName, cachedClient)
+			loadCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			restored, err := cacheStore.Load(loadCtx)
+			cancel()
+			if err != nil {
+				// Start with an empty cache rather than not at all
+				setupLog.Error(err, "unable to load persisted Pyxis cache")
+			}
+			setupLog.Info("Persisting Pyxis cache", "configMap", pyxis.DefaultCacheConfigMapName, "restored", restored)
+			if err := mgr.Add(cacheStore); err != nil {
+				setupLog.Error(err, "unable to set up Pyxis cache persistence")
+				os.Exit(1)
+			}
+		}
 	}
 
 	// Initialize Docker Hub client if enabled
@@ -310,22 +1070,255 @@ func main() {
 	if dockerHubEnabled {
 		setupLog.Info("Docker Hub integration enabled",
 			"cacheTTL", dockerHubCacheTTL,
+			"negativeCacheTTL", dockerHubNegativeCacheTTL,
 			"rateLimit", dockerHubRateLimit,
 			"rateBurst", dockerHubRateBurst)
-		baseDockerHubClient := dockerhub.NewHTTPClient()
+		var dockerHubOpts []dockerhub.ClientOption
+		if rawStore != nil {
+			dockerHubOpts = append(dockerHubOpts, dockerhub.WithRawResponseStore(rawStore))
+		}
+		if httpClient := newProviderClient("dockerhub", dockerhub.DefaultTimeout); httpClient != nil {
+			dockerHubOpts = append(dockerHubOpts, dockerhub.WithHTTPClient(httpClient))
+		}
+		var baseDockerHubClient dockerhub.Client = dockerhub.NewHTTPClient(dockerHubOpts...)
+		if errorBudgetThreshold > 0 {
+			baseDockerHubClient = dockerhub.NewGuardedClient(baseDockerHubClient, newGuard("dockerhub"))
+		}
 
 		// Wrap with caching and rate limiting
 		dockerHubClient = dockerhub.NewCachedRateLimitedClient(
-			baseDockerHubClient, dockerHubCacheTTL, dockerHubRateLimit, dockerHubRateBurst)
+			baseDockerHubClient, dockerHubCacheTTL, dockerHubRateLimit, dockerHubRateBurst,
+			dockerhub.WithNegativeCacheTTL(dockerHubNegativeCacheTTL))
+	}
+
+	// Initialize Quay client if enabled
+	var quayClient quay.Client
+	if quayEnabled {
+		setupLog.Info("Quay integration enabled",
+			"cacheTTL", quayCacheTTL,
+			"rateLimit", quayRateLimit,
+			"rateBurst", quayRateBurst)
+		var baseQuayClient quay.Client = quay.NewHTTPClient()
+		if errorBudgetThreshold > 0 {
+			baseQuayClient = quay.NewGuardedClient(baseQuayClient, newGuard("quay"))
+		}
+		quayClient = quay.NewCachedRateLimitedClient(baseQuayClient, quayCacheTTL, quayRateLimit, quayRateBurst)
+	}
+
+	// Initialize the generic registry client if enabled
+	var plainHTTP []string
+	for host := range strings.SplitSeq(registryPlainHTTP, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			plainHTTP = append(plainHTTP, host)
+		}
+	}
+	var registryClient registry.Client
+	if registryInspectionEnabled {
+		setupLog.Info("Registry inspection enabled",
+			"cacheTTL", registryCacheTTL,
+			"rateLimit", registryRateLimit,
+			"rateBurst", registryRateBurst,
+			"plainHTTP", plainHTTP)
+		registryClient = registry.NewCachedRateLimitedClient(
+			registry.NewHTTPClient(registry.WithPlainHTTP(plainHTTP...)),
+			registryCacheTTL, registryRateLimit, registryRateBurst)
+	}
+
+	// Initialize SBOM discovery if enabled. It has its own rate limiter with the registry settings.
+	var sbomClient registry.SBOMClient
+	if sbomDiscoveryEnabled {
+		opts := []registry.ClientOption{registry.WithPlainHTTP(plainHTTP...)}
+		if sbomSummaryEnabled {
+			opts = append(opts, registry.WithSBOMSummary())
+		}
+		setupLog.Info("SBOM discovery enabled", "summary", sbomSummaryEnabled)
+		sbomClient = registry.NewRateLimitedSBOMClient(registry.NewHTTPClient(opts...), registryRateLimit, registryRateBurst)
+	}
+
+	// Resolve the tags of images that pods fail to pull, with the registry rate limit
+	var tagResolver registry.TagResolver
+	if trackUnpulledImages {
+		tagResolver = registry.NewRateLimitedTagResolver(registry.NewHTTPClient(registry.WithPlainHTTP(plainHTTP...)),
+			registryRateLimit, registryRateBurst)
+	}
+
+	// Send notifications to the sinks configured in the notification Secret
+	var notifier *notify.Notifier
+	if notifySecretName != "" {
+		secretClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+		if err != nil {
+			setupLog.Error(err, "unable to create client for reading secret")
+			os.Exit(1)
+		}
+		data, err := secrets.NewSecretReader(secretClient).ReadData(context.Background(),
+			os.Getenv("POD_NAMESPACE"), notifySecretName)
+		if err != nil {
+			setupLog.Error(err, "failed to read notification Secret")
+			os.Exit(1)
+		}
+		sinks, err := notify.SinksFromSecret(data)
+		if err != nil {
+			setupLog.Error(err, "invalid notification Secret", "secretName", notifySecretName)
+			os.Exit(1)
+		}
+		notifier = notify.NewNotifier(sinks,
+			notify.WithFlushInterval(notifyFlushInterval),
+			notify.WithDedupWindow(notifyDedupWindow))
+		if err := mgr.Add(notifier); err != nil {
+			setupLog.Error(err, "unable to set up notifications")
+			os.Exit(1)
+		}
+		sinkNames := make([]string, len(sinks))
+		for i, sink := range sinks {
+			sinkNames[i] = sink.Name()
+		}
+		setupLog.Info("Notifications enabled", "secretName", notifySecretName, "sinks", sinkNames,
+			"flushInterval", notifyFlushInterval, "dedupWindow", notifyDedupWindow)
+	}
+
+	// Record provider outages detected by periodic health probes if enabled
+	var outages *health.OutageTracker
+	if outageProbeInterval > 0 {
+		outages = health.NewOutageTracker()
+		providers := map[string]func(context.Context) bool{}
+		if pyxisClient != nil {
+			providers["pyxis"] = pyxisClient.IsHealthy
+		}
+		if dockerHubClient != nil {
+			providers["dockerhub"] = dockerHubClient.IsHealthy
+		}
+		if quayClient != nil {
+			providers["quay"] = quayClient.IsHealthy
+		}
+		if err := mgr.Add(&health.OutageProber{
+			Tracker:   outages,
+			Providers: providers,
+			Interval:  outageProbeInterval,
+			Timeout:   health.DefaultProviderCheckTimeout,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up provider outage prober")
+			os.Exit(1)
+		}
 	}
 
 	// Set up the Pod controller
+	heartbeats := health.NewHeartbeats()
+	var refreshSummary *controller.RefreshSummarizer
+	if summarizeRefreshEvents {
+		summaryPodName, _ := os.Hostname()
+		refreshSummary = &controller.RefreshSummarizer{
+			Recorder:  eventRecorder,
+			Reader:    mgr.GetAPIReader(),
+			Namespace: os.Getenv("POD_NAMESPACE"),
+			PodName:   summaryPodName,
+		}
+		setupLog.Info("Summarizing refresh cycle warnings in one event per cycle")
+	}
+	enrichmentPool := controller.NewEnrichmentPool(enrichmentWorkers)
+	if err := mgr.Add(enrichmentPool); err != nil {
+		setupLog.Error(err, "unable to set up enrichment workers")
+		os.Exit(1)
+	}
+	mirrorMap := image.NewMirrorMap(mirrors)
+	registryClassifier := image.NewRegistryClassifier(nil)
+	imageClient := &controller.ObjectSizeClient{
+		Client:       mgr.GetClient(),
+		WarningBytes: objectSizeWarningBytes,
+		Recorder:     eventRecorder,
+	}
 	podReconciler := &controller.PodReconciler{
-		Client:          mgr.GetClient(),
-		Scheme:          mgr.GetScheme(),
-		PyxisClient:     pyxisClient,
-		DockerHubClient: dockerHubClient,
-		Recorder:        mgr.GetEventRecorderFor("imagecertinfo-controller"), //nolint:staticcheck
+		Client:            imageClient,
+		Scheme:            mgr.GetScheme(),
+		PyxisClient:       pyxisClient,
+		DockerHubClient:   dockerHubClient,
+		RegistryClient:    registryClient,
+		QuayClient:        quayClient,
+		SBOMClient:        sbomClient,
+		PyxisBaseURL:      pyxisBaseURL,
+		Recorder:          eventRecorder,
+		Heartbeats:        heartbeats,
+		Enrichment:        enrichmentPool,
+		EOLTiers:          eolTiers,
+		EnrichmentTimeout: enrichmentTimeout,
+		OrphanTTL:         orphanCRTTL,
+		Mirrors:           mirrorMap,
+		Registries:        registryClassifier,
+		MaxCVEs:           maxCVEsPerImage,
+		Notifier:          notifier,
+		RefreshSummary:    refreshSummary,
+		Namespaces: &controller.NamespaceFilter{
+			Reader:   mgr.GetClient(),
+			Include:  controller.ParseNamespaceList(watchNamespaces),
+			Exclude:  controller.ParseNamespaceList(excludeNamespaces),
+			Selector: namespaceSelector,
+		},
+		ExcludedContainerTypes: map[securityv1alpha1.ContainerType]bool{
+			securityv1alpha1.ContainerTypeInit:      !includeInitContainers,
+			securityv1alpha1.ContainerTypeSidecar:   !includeSidecarContainers,
+			securityv1alpha1.ContainerTypeEphemeral: !includeEphemeralContainers,
+		},
+		MaxConcurrentReconciles: podReconcilerConcurrency,
+		Workqueue:               &podWorkqueue,
+		TrackUnpulledImages:     trackUnpulledImages,
+		TagResolver:             tagResolver,
+	}
+	if registryPullSecretsEnabled && (registryClient != nil || sbomClient != nil) {
+		// Read pull secrets without the cache so the manager does not watch Secrets cluster-wide
+		podReconciler.PullSecrets = &controller.PullSecrets{
+			Pods:    mgr.GetClient(),
+			Secrets: mgr.GetAPIReader(),
+			Global:  globalPullSecretRef,
+		}
+		setupLog.Info("Registry pull secrets enabled", "globalPullSecret", globalPullSecret)
+	}
+
+	// Resume the certification lookups left pending by the previous run
+	if enrichmentJournalEnabled {
+		// Use an uncached client so the manager does not watch ConfigMaps cluster-wide
+		journalClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create enrichment journal client")
+			os.Exit(1)
+		}
+		journal := controller.NewEnrichmentJournal(journalClient, os.Getenv("POD_NAMESPACE"),
+			controller.DefaultEnrichmentJournalName)
+		loadCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		restored, err := journal.Load(loadCtx)
+		cancel()
+		if err != nil {
+			// Journal new lookups even if the previous ones are lost
+			setupLog.Error(err, "unable to load enrichment journal")
+		}
+		setupLog.Info("Enrichment journal enabled", "configMap", controller.DefaultEnrichmentJournalName,
+			"restored", restored)
+		if err := mgr.Add(journal); err != nil {
+			setupLog.Error(err, "unable to set up enrichment journal")
+			os.Exit(1)
+		}
+		podReconciler.Journal = journal
+	}
+
+	// Join the shard ring if enabled
+	if shardMode {
+		podNamespace := os.Getenv("POD_NAMESPACE")
+		hostname, err := os.Hostname()
+		if err != nil || podNamespace == "" {
+			setupLog.Error(err, "shard mode requires POD_NAMESPACE and a hostname")
+			os.Exit(1)
+		}
+		// Use an uncached client so the manager does not watch Leases cluster-wide
+		shardClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create shard membership client")
+			os.Exit(1)
+		}
+		podReconciler.Shard = sharding.NewMembership(shardClient, podNamespace, hostname,
+			sharding.WithLeaseDuration(shardLeaseDuration))
+		if err := mgr.Add(podReconciler.Shard); err != nil {
+			setupLog.Error(err, "unable to set up shard membership")
+			os.Exit(1)
+		}
+		setupLog.Info("Shard mode enabled", "member", hostname)
 	}
 
 	if err = podReconciler.SetupWithManager(mgr); err != nil {
@@ -333,19 +1326,413 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Start the cleanup loop for stale pod references
+	// Track static pod images that only control-plane nodes report
+	if discoverNodeImages {
+		if err = (&controller.NodeImageReconciler{
+			Client: mgr.GetClient(),
+			Pods:   podReconciler,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NodeImage")
+			os.Exit(1)
+		}
+	}
+
+	// Retry images whose certification lookup failed without waiting for the refresh loop
+	if certificationRetryBaseInterval > 0 {
+		if err = (&controller.CertificationRetryReconciler{
+			Client:       mgr.GetClient(),
+			Pods:         podReconciler,
+			BaseInterval: certificationRetryBaseInterval,
+			MaxInterval:  certificationRetryMaxInterval,
+			Outages:      outages,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CertificationRetry")
+			os.Exit(1)
+		}
+	}
+
+	// Hold pods that require certified images out of Ready until their images are certified
+	if readinessGateEnabled {
+		if err = (&controller.ReadinessGateReconciler{
+			Client: mgr.GetClient(),
+			Pods:   podReconciler,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ReadinessGate")
+			os.Exit(1)
+		}
+		setupLog.Info("Pod readiness gate enabled", "condition", controller.ConditionImagesCertified)
+	}
+
+	// Label pods with the certification posture of their images for label selectors
+	if podCertificationLabels {
+		if err = (&controller.PodLabelReconciler{
+			Client: mgr.GetClient(),
+			Pods:   podReconciler,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PodLabels")
+			os.Exit(1)
+		}
+	}
+
+	// Resolve images pulled through OpenShift registry mirrors to their source registry
+	if openshiftMirrorSets {
+		if kinds := controller.ServedMirrorSetKinds(mgr.GetRESTMapper()); len(kinds) > 0 {
+			if err = (&controller.MirrorSetReconciler{
+				Client:  mgr.GetClient(),
+				Mirrors: mirrorMap,
+				Static:  mirrors,
+				Kinds:   kinds,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "MirrorSet")
+				os.Exit(1)
+			}
+			setupLog.Info("Reading registry mirrors from OpenShift mirror sets", "kinds", len(kinds))
+		}
+	}
+
+	// Set up the namespaced ImageUsage projection controller if enabled
+	if imageUsageEnabled {
+		setupLog.Info("ImageUsage namespaced projection enabled")
+		if err = (&controller.ImageUsageReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ImageUsage")
+			os.Exit(1)
+		}
+	}
+
+	// Set up the ImageCertPolicy controller
+	if err = (&controller.ImageCertPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: eventRecorder,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ImageCertPolicy")
+		os.Exit(1)
+	}
+
+	// Set up the ImageCertExemption controller
+	if err = (&controller.ImageCertExemptionReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: eventRecorder,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ImageCertExemption")
+		os.Exit(1)
+	}
+
+	// Apply the ImageCertInfoConfig on every replica so settings can change without a restart
+	cleanupLoopInterval := controller.NewTunableInterval(cleanupInterval)
+	refreshLoopInterval := controller.NewTunableInterval(pyxisRefreshInterval)
+	if podNamespace := os.Getenv("POD_NAMESPACE"); operatorConfigName != "" && podNamespace != "" {
+		configReconciler := &controller.OperatorConfigReconciler{
+			Client:    mgr.GetClient(),
+			Name:      operatorConfigName,
+			Namespace: podNamespace,
+			Defaults: controller.OperatorSettings{
+				Pyxis: controller.ProviderDefaults{
+					CacheTTL: pyxisCacheTTL, RateLimit: pyxisRateLimit, RateBurst: pyxisRateBurst,
+				},
+				DockerHub: controller.ProviderDefaults{
+					CacheTTL: dockerHubCacheTTL, RateLimit: dockerHubRateLimit, RateBurst: dockerHubRateBurst,
+				},
+				RefreshInterval: pyxisRefreshInterval,
+				CleanupInterval: cleanupInterval,
+			},
+			RefreshInterval: refreshLoopInterval,
+			CleanupInterval: cleanupLoopInterval,
+			Registries:      registryClassifier,
+		}
+		if cachedClient, ok := pyxisClient.(*pyxis.CachedClient); ok {
+			configReconciler.Pyxis = cachedClient
+		}
+		if cachedClient, ok := dockerHubClient.(*dockerhub.CachedClient); ok {
+			configReconciler.DockerHub = cachedClient
+		}
+		if err = configReconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ImageCertInfoConfig")
+			os.Exit(1)
+		}
+		setupLog.Info("Watching ImageCertInfoConfig for runtime settings", "name", operatorConfigName,
+			"namespace", podNamespace)
+	}
+
+	// Pick up rotated Pyxis API keys on every replica
+	if watchPyxisAPIKey && pyxisHTTPClient != nil {
+		if err = (&controller.PyxisAPIKeyReconciler{
+			Client: mgr.GetClient(),
+			Secret: *pyxisAPIKeySecretRef,
+			Pyxis:  pyxisHTTPClient,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "PyxisAPIKey")
+			os.Exit(1)
+		}
+		setupLog.Info("Watching Secret for Pyxis API key rotation", "secret", pyxisAPIKeySecretRef.String())
+	}
+
+	// Clean up stale pod references on the leader
+	if err := mgr.Add(&controller.CleanupLoop{Pods: podReconciler, Interval: cleanupLoopInterval}); err != nil {
+		setupLog.Error(err, "unable to set up cleanup loop")
+		os.Exit(1)
+	}
+
 	ctx := ctrl.SetupSignalHandler()
-	podReconciler.StartCleanupLoop(ctx, cleanupInterval)
+
+	// Start delivering audit records to the HTTP sink
+	if auditHTTPSink != nil {
+		auditHTTPSink.Start(ctx)
+	}
 
 	// Start cache cleanup loop if using cached client
 	if cachedClient, ok := pyxisClient.(*pyxis.CachedClient); ok {
 		cachedClient.StartCleanupLoop(ctx, pyxisCacheTTL/2)
 	}
+	if cachedClient, ok := quayClient.(*quay.CachedClient); ok {
+		cachedClient.StartCleanupLoop(ctx, quayCacheTTL/2)
+	}
+	if cachedClient, ok := registryClient.(*registry.CachedClient); ok {
+		cachedClient.StartCleanupLoop(ctx, registryCacheTTL/2)
+	}
 
 	// Start the periodic refresh loop for Pyxis data
 	if pyxisRefreshInterval > 0 && pyxisClient != nil {
+		if err := mgr.Add(&controller.RefreshLoop{
+			Pods:         podReconciler,
+			Interval:     refreshLoopInterval,
+			StartupDelay: controller.DefaultRefreshStartupDelay,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up refresh loop")
+			os.Exit(1)
+		}
 		setupLog.Info("Starting Pyxis refresh loop", "interval", pyxisRefreshInterval)
-		podReconciler.StartRefreshLoop(ctx, pyxisRefreshInterval)
+	}
+
+	// Serve the pod admission webhook if enabled
+	if podAdmissionEnabled {
+		excluded := strings.Split(podAdmissionExcludedNamespaces, ",")
+		if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+			excluded = append(excluded, ns)
+		}
+		if err := webhookv1.SetupPodWebhookWithManager(mgr, &webhookv1.PodCustomValidator{
+			Client:                     mgr.GetClient(),
+			WarnOnly:                   podAdmissionWarnOnly,
+			MaxCriticalVulnerabilities: podAdmissionMaxCritical,
+			ExcludedNamespaces:         excluded,
+		}); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Pod")
+			os.Exit(1)
+		}
+		setupLog.Info("Pod admission webhook enabled", "warnOnly", podAdmissionWarnOnly,
+			"maxCritical", podAdmissionMaxCritical, "excludedNamespaces", excluded)
+	}
+
+	// Serve the ImageCertificationInfo conversion webhook if enabled
+	if conversionWebhookEnabled {
+		if err := webhookv1alpha1.SetupImageCertificationInfoWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ImageCertificationInfo")
+			os.Exit(1)
+		}
+		setupLog.Info("ImageCertificationInfo conversion webhook enabled")
+	}
+
+	// Migrate images written by earlier versions
+	migratorPodName, _ := os.Hostname()
+	if err := mgr.Add(&controller.InventoryMigrator{
+		Client:    imageClient,
+		Recorder:  eventRecorder,
+		Reader:    mgr.GetAPIReader(),
+		Namespace: os.Getenv("POD_NAMESPACE"),
+		PodName:   migratorPodName,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up inventory migration")
+		os.Exit(1)
+	}
+
+	// Report, and clean up where safe, the names and fields earlier versions left behind
+	if deprecationScanInterval > 0 {
+		if err := mgr.Add(&controller.DeprecationReporter{
+			Client:          imageClient,
+			Interval:        deprecationScanInterval,
+			Cleanup:         cleanupDeprecated,
+			ConfigName:      operatorConfigName,
+			ConfigNamespace: os.Getenv("POD_NAMESPACE"),
+		}); err != nil {
+			setupLog.Error(err, "unable to set up the deprecation scan")
+			os.Exit(1)
+		}
+	}
+
+	// Compare node architectures with the architectures each image supports
+	if err := mgr.Add(&controller.ArchitectureCoverage{
+		Client:   imageClient,
+		Interval: cleanupInterval,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up architecture coverage checks")
+		os.Exit(1)
+	}
+
+	if err := mgr.Add(&controller.LifecycleEvaluator{
+		Pods:             podReconciler,
+		Interval:         lifecycleEvaluationInterval,
+		StalenessHorizon: certificationStalenessHorizon,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up lifecycle evaluation")
+		os.Exit(1)
+	}
+
+	// Keep the image inventory gauges current
+	if err := mgr.Add(&controller.InventoryMetrics{
+		Client:   mgr.GetClient(),
+		Interval: inventoryMetricsInterval,
+		EOLTiers: eolTiers,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up image inventory metrics")
+		os.Exit(1)
+	}
+
+	// Maintain the cluster-wide certification report if enabled
+	if clusterReportInterval > 0 {
+		if err := mgr.Add(&controller.ClusterReportBuilder{
+			Client:   mgr.GetClient(),
+			Interval: clusterReportInterval,
+			Outages:  outages,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up cluster certification report")
+			os.Exit(1)
+		}
+	}
+
+	// Export the inventory as report files if enabled
+	if reportSchedule > 0 {
+		var destinations []reporting.Destination
+		if reportDir != "" {
+			destinations = append(destinations, &reporting.DirDestination{Dir: reportDir, Retain: reportRetain})
+		}
+		if reportS3Bucket != "" {
+			destinations = append(destinations, &reporting.S3Destination{
+				Endpoint:        reportS3Endpoint,
+				Bucket:          reportS3Bucket,
+				Prefix:          reportS3Prefix,
+				Region:          reportS3Region,
+				AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+				SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+				SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+			})
+		}
+		if err := mgr.Add(&reporting.Exporter{
+			Reader:       mgr.GetClient(),
+			Interval:     reportSchedule,
+			Formats:      reportFormatList,
+			Destinations: destinations,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up inventory report export")
+			os.Exit(1)
+		}
+		setupLog.Info("Inventory report export enabled", "schedule", reportSchedule, "formats", reportFormats)
+	}
+
+	// Propagate certification status to workloads if enabled
+	if workloadStatusAnnotations {
+		if err := mgr.Add(&controller.WorkloadStatusPropagator{
+			Client:   mgr.GetClient(),
+			Limiter:  rate.NewLimiter(rate.Limit(workloadStatusWriteRate), 1),
+			Interval: cleanupInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up workload status propagation")
+			os.Exit(1)
+		}
+		setupLog.Info("Workload certification status annotations enabled", "writeRate", workloadStatusWriteRate)
+	}
+
+	// Label namespaces with their count of policy-violating images if enabled
+	if namespaceComplianceLabels {
+		if err := mgr.Add(&controller.NamespaceComplianceLabeler{
+			Client:   mgr.GetClient(),
+			Interval: cleanupInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up namespace compliance labels")
+			os.Exit(1)
+		}
+		setupLog.Info("Namespace compliance labels enabled", "label", controller.LabelNonCompliantImages)
+	}
+
+	// Deploy the OpenShift Console plugin if enabled
+	if consolePluginImage != "" {
+		// Use an uncached client so the manager does not watch Deployments cluster-wide
+		pluginClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create console plugin client")
+			os.Exit(1)
+		}
+		if err := mgr.Add(&consoleplugin.Installer{
+			Client:    pluginClient,
+			Namespace: os.Getenv("POD_NAMESPACE"),
+			Name:      consoleplugin.DefaultName,
+			Image:     consolePluginImage,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up console plugin installer")
+			os.Exit(1)
+		}
+		setupLog.Info("OpenShift Console plugin enabled", "image", consolePluginImage)
+	}
+
+	// Report the running version on /statusz and, from the leader, in a ConfigMap
+	if err := mgr.AddMetricsServerExtraHandler("/statusz", &version.StatusHandler{
+		StartTime: startTime,
+		Elected:   mgr.Elected(),
+		Outages:   outages.Outages,
+		RefreshCycle: func() any {
+			if summary := refreshSummary.Last(); summary != nil {
+				return summary
+			}
+			return nil
+		},
+	}); err != nil {
+		setupLog.Error(err, "unable to set up statusz endpoint")
+		os.Exit(1)
+	}
+	// Serve inventory searches from the informer cache if enabled
+	if searchEndpoint {
+		if err := mgr.AddMetricsServerExtraHandler(search.Path, &search.Handler{Reader: mgr.GetClient()}); err != nil {
+			setupLog.Error(err, "unable to set up search endpoint")
+			os.Exit(1)
+		}
+		setupLog.Info("Image inventory search endpoint enabled", "path", search.Path)
+	}
+	// Let external inventories stamp their IDs onto tracked images if enabled
+	if externalIDsEndpoint {
+		if err := mgr.AddMetricsServerExtraHandler(externalid.Path, &externalid.Handler{Client: mgr.GetClient()}); err != nil {
+			setupLog.Error(err, "unable to set up external ID endpoint")
+			os.Exit(1)
+		}
+		setupLog.Info("External ID endpoint enabled", "path", externalid.Path)
+	}
+	// Let chart authors check images against the cluster's policies before installing if enabled
+	if prescanEndpoint {
+		if err := mgr.AddMetricsServerExtraHandler(prescan.Path, &prescan.Handler{Reader: mgr.GetClient()}); err != nil {
+			setupLog.Error(err, "unable to set up pre-scan endpoint")
+			os.Exit(1)
+		}
+		setupLog.Info("Pre-scan endpoint enabled", "path", prescan.Path)
+	}
+	if podNamespace := os.Getenv("POD_NAMESPACE"); podNamespace != "" {
+		infoClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create build information client")
+			os.Exit(1)
+		}
+		podName, _ := os.Hostname()
+		if err := mgr.Add(&version.Publisher{
+			Client:    infoClient,
+			Namespace: podNamespace,
+			Name:      version.DefaultConfigMapName,
+			PodName:   podName,
+			StartTime: startTime,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up build information publisher")
+			os.Exit(1)
+		}
 	}
 
 	// +kubebuilder:scaffold:builder
@@ -354,13 +1741,59 @@ func main() {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
-		setupLog.Error(err, "unable to set up ready check")
-		os.Exit(1)
+
+	// Each subsystem contributes its own named readiness check. Without sharding the loops
+	// run only on the leader, so standby replicas skip their heartbeat checks.
+	loopCheck := func(check healthz.Checker) healthz.Checker {
+		if podReconciler.Shard != nil {
+			return check
+		}
+		return health.LeaderOnlyChecker(mgr.Elected(), check)
+	}
+	readyChecks := map[string]healthz.Checker{
+		"informer-cache":   health.CacheSyncChecker(mgr.GetCache(), time.Second),
+		health.LoopCleanup: loopCheck(heartbeats.Checker(health.LoopCleanup, 3*cleanupInterval)),
+	}
+	if pyxisRefreshInterval > 0 && pyxisClient != nil {
+		// Allow for the randomized startup delay and one slow refresh cycle
+		readyChecks[health.LoopRefresh] = loopCheck(heartbeats.Checker(health.LoopRefresh,
+			2*pyxisRefreshInterval+controller.DefaultRefreshStartupDelay))
+	}
+	if enableLeaderElection && readyzRequireLeader {
+		readyChecks["leader-election"] = health.LeaderElectionChecker(mgr.Elected())
+	}
+	if readyzCheckProviders {
+		if pyxisClient != nil {
+			readyChecks["pyxis"] = health.ProviderChecker("Pyxis", pyxisClient.IsHealthy,
+				health.DefaultProviderCheckTimeout, readyzProviderGrace)
+		}
+		if dockerHubClient != nil {
+			readyChecks["dockerhub"] = health.ProviderChecker("Docker Hub", dockerHubClient.IsHealthy,
+				health.DefaultProviderCheckTimeout, readyzProviderGrace)
+		}
+		if quayClient != nil {
+			readyChecks["quay"] = health.ProviderChecker("Quay", quayClient.IsHealthy,
+				health.DefaultProviderCheckTimeout, readyzProviderGrace)
+		}
+	}
+	for name, check := range readyChecks {
+		if err := mgr.AddReadyzCheck(name, check); err != nil {
+			setupLog.Error(err, "unable to set up ready check", "check", name)
+			os.Exit(1)
+		}
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctx); err != nil {
+	err = mgr.Start(ctx)
+	if shutdownTracing != nil {
+		// Flush the spans still buffered
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := shutdownTracing(flushCtx); err != nil {
+			setupLog.Error(err, "unable to flush traces")
+		}
+		cancel()
+	}
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
@@ -0,0 +1,111 @@
+import * as React from 'react';
+import { useParams } from 'react-router-dom-v5-compat';
+import { ListPageHeader, ResourceLink, useK8sWatchResource } from '@openshift-console/dynamic-plugin-sdk';
+import {
+  DescriptionList,
+  DescriptionListDescription,
+  DescriptionListGroup,
+  DescriptionListTerm,
+  PageSection,
+  Title,
+} from '@patternfly/react-core';
+import { Table, Tbody, Td, Th, Thead, Tr } from '@patternfly/react-table';
+import { ImageCertificationInfo, imageCertificationInfoModel, PodReference, workloadName } from '../types';
+
+type WorkloadGroup = {
+  namespace: string;
+  workload: string;
+  pods: PodReference[];
+};
+
+// groupByWorkload groups pod references by namespace and owning workload
+const groupByWorkload = (refs: PodReference[] = []): WorkloadGroup[] => {
+  const groups = new Map<string, WorkloadGroup>();
+  refs.forEach((ref) => {
+    const workload = ref.workloadName ? `${ref.workloadKind}/${ref.workloadName}` : workloadName(ref.name);
+    const key = `${ref.namespace}/${workload}`;
+    if (!groups.has(key)) {
+      groups.set(key, { namespace: ref.namespace, workload, pods: [] });
+    }
+    groups.get(key)?.pods.push(ref);
+  });
+  return [...groups.values()].sort((a, b) =>
+    `${a.namespace}/${a.workload}`.localeCompare(`${b.namespace}/${b.workload}`),
+  );
+};
+
+const ImageCertificationDetailsPage: React.FC = () => {
+  const { name } = useParams<{ name: string }>();
+  const [image, loaded] = useK8sWatchResource<ImageCertificationInfo>({
+    groupVersionKind: imageCertificationInfoModel,
+    name,
+  });
+
+  if (!loaded || !image) {
+    return null;
+  }
+
+  const vulns = image.status?.pyxisData?.vulnerabilities;
+  const details: [string, React.ReactNode][] = [
+    ['Image', image.spec.fullImageReference],
+    ['Registry type', image.status?.registryType || '-'],
+    ['Certification', image.status?.certificationStatus || '-'],
+    ['Health', image.status?.pyxisData?.healthIndex || '-'],
+    [
+      'CVEs (critical/important/moderate/low)',
+      vulns ? `${vulns.critical ?? 0}/${vulns.important ?? 0}/${vulns.moderate ?? 0}/${vulns.low ?? 0}` : '-',
+    ],
+    ['Days until EOL', image.status?.daysUntilEol ?? '-'],
+  ];
+
+  return (
+    <>
+      <ListPageHeader title={`${image.spec.repository}${image.spec.tag ? `:${image.spec.tag}` : ''}`} />
+      <PageSection>
+        <DescriptionList>
+          {details.map(([term, value]) => (
+            <DescriptionListGroup key={term}>
+              <DescriptionListTerm>{term}</DescriptionListTerm>
+              <DescriptionListDescription>{value}</DescriptionListDescription>
+            </DescriptionListGroup>
+          ))}
+        </DescriptionList>
+      </PageSection>
+      <PageSection>
+        <Title headingLevel="h2">Workloads</Title>
+        <Table aria-label="Workloads using this image" variant="compact">
+          <Thead>
+            <Tr>
+              <Th>Namespace</Th>
+              <Th>Workload</Th>
+              <Th>Pods</Th>
+            </Tr>
+          </Thead>
+          <Tbody>
+            {groupByWorkload(image.status?.podReferences).map((group) => (
+              <Tr key={`${group.namespace}/${group.workload}`}>
+                <Td>
+                  <ResourceLink kind="Namespace" name={group.namespace} />
+                </Td>
+                <Td>{group.workload}</Td>
+                <Td>
+                  {group.pods.map((pod) => (
+                    <ResourceLink
+                      key={`${pod.name}/${pod.container}`}
+                      kind="Pod"
+                      name={pod.name}
+                      namespace={pod.namespace}
+                      displayName={`${pod.name} (${pod.container})`}
+                    />
+                  ))}
+                </Td>
+              </Tr>
+            ))}
+          </Tbody>
+        </Table>
+      </PageSection>
+    </>
+  );
+};
+
+export default ImageCertificationDetailsPage;
@@ -0,0 +1,98 @@
+import * as React from 'react';
+import { Link } from 'react-router-dom-v5-compat';
+import {
+  ListPageBody,
+  ListPageFilter,
+  ListPageHeader,
+  RowFilter,
+  RowProps,
+  TableColumn,
+  TableData,
+  useK8sWatchResource,
+  useListPageFilter,
+  VirtualizedTable,
+} from '@openshift-console/dynamic-plugin-sdk';
+import { ImageCertificationInfo, imageCertificationInfoModel } from '../types';
+
+const certificationStatuses = ['Certified', 'Official', 'Verified', 'NotCertified', 'Pending', 'Unknown', 'Error'];
+const registryTypes = ['RedHat', 'Partner', 'Community', 'Private', 'Unknown'];
+
+const filters: RowFilter<ImageCertificationInfo>[] = [
+  {
+    filterGroupName: 'Certification status',
+    type: 'certification-status',
+    reducer: (obj) => obj.status?.certificationStatus || 'Unknown',
+    items: certificationStatuses.map((id) => ({ id, title: id })),
+    filter: (input, obj) =>
+      !input.selected?.length || input.selected.includes(obj.status?.certificationStatus || 'Unknown'),
+  },
+  {
+    filterGroupName: 'Registry type',
+    type: 'registry-type',
+    reducer: (obj) => obj.status?.registryType || 'Unknown',
+    items: registryTypes.map((id) => ({ id, title: id })),
+    filter: (input, obj) => !input.selected?.length || input.selected.includes(obj.status?.registryType || 'Unknown'),
+  },
+];
+
+const columns: TableColumn<ImageCertificationInfo>[] = [
+  { title: 'Image', id: 'image' },
+  { title: 'Registry type', id: 'registry-type' },
+  { title: 'Certification', id: 'certification' },
+  { title: 'Health', id: 'health' },
+  { title: 'Critical CVEs', id: 'critical' },
+  { title: 'Pods', id: 'pods' },
+];
+
+const Row: React.FC<RowProps<ImageCertificationInfo>> = ({ obj, activeColumnIDs }) => (
+  <>
+    <TableData id="image" activeColumnIDs={activeColumnIDs}>
+      <Link to={`/image-certification/${obj.metadata?.name}`}>
+        {obj.spec.registry}/{obj.spec.repository}
+        {obj.spec.tag ? `:${obj.spec.tag}` : ''}
+      </Link>
+    </TableData>
+    <TableData id="registry-type" activeColumnIDs={activeColumnIDs}>
+      {obj.status?.registryType || '-'}
+    </TableData>
+    <TableData id="certification" activeColumnIDs={activeColumnIDs}>
+      {obj.status?.certificationStatus || '-'}
+    </TableData>
+    <TableData id="health" activeColumnIDs={activeColumnIDs}>
+      {obj.status?.pyxisData?.healthIndex || '-'}
+    </TableData>
+    <TableData id="critical" activeColumnIDs={activeColumnIDs}>
+      {obj.status?.pyxisData?.vulnerabilities?.critical ?? '-'}
+    </TableData>
+    <TableData id="pods" activeColumnIDs={activeColumnIDs}>
+      {obj.status?.podReferences?.length ?? 0}
+    </TableData>
+  </>
+);
+
+const ImageCertificationListPage: React.FC = () => {
+  const [images, loaded, loadError] = useK8sWatchResource<ImageCertificationInfo[]>({
+    groupVersionKind: imageCertificationInfoModel,
+    isList: true,
+  });
+  const [data, filteredData, onFilterChange] = useListPageFilter(images, filters);
+
+  return (
+    <>
+      <ListPageHeader title="Image Certification" />
+      <ListPageBody>
+        <ListPageFilter data={data} loaded={loaded} rowFilters={filters} onFilterChange={onFilterChange} />
+        <VirtualizedTable<ImageCertificationInfo>
+          data={filteredData}
+          unfilteredData={data}
+          loaded={loaded}
+          loadError={loadError}
+          columns={columns}
+          Row={Row}
+        />
+      </ListPageBody>
+    </>
+  );
+};
+
+export default ImageCertificationListPage;
@@ -0,0 +1,45 @@
+import { K8sResourceCommon } from '@openshift-console/dynamic-plugin-sdk';
+
+export const imageCertificationInfoModel = {
+  group: 'security.telco.openshift.io',
+  version: 'v1alpha1',
+  kind: 'ImageCertificationInfo',
+};
+
+export type PodReference = {
+  namespace: string;
+  name: string;
+  container: string;
+  containerType?: string;
+  workloadKind?: string;
+  workloadName?: string;
+};
+
+export type ImageCertificationInfo = K8sResourceCommon & {
+  spec: {
+    imageDigest: string;
+    fullImageReference: string;
+    registry?: string;
+    repository: string;
+    tag?: string;
+  };
+  status?: {
+    registryType?: string;
+    certificationStatus?: string;
+    podReferences?: PodReference[];
+    workloadCount?: number;
+    lastSeenAt?: string;
+    daysUntilEol?: number;
+    pyxisData?: {
+      healthIndex?: string;
+      catalogURL?: string;
+      vulnerabilities?: { critical?: number; important?: number; moderate?: number; low?: number };
+    };
+  };
+};
+
+// workloadName strips the generated suffixes from a pod name so that replicas of the
+// same Deployment, ReplicaSet, or StatefulSet are grouped together. It is used for pod
+// references recorded before the operator resolved owning workloads.
+export const workloadName = (podName: string): string =>
+  podName.replace(/-[a-z0-9]{8,10}-[a-z0-9]{5}$/, '').replace(/-([a-z0-9]{5}|\d+)$/, '');
@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+type memorySink struct {
+	mu      sync.Mutex
+	records []Record
+}
+
+func (m *memorySink) Name() string { return "memory" }
+
+func (m *memorySink) Write(rec Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records = append(m.records, rec)
+	return nil
+}
+
+func TestRecorder_Event(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = securityv1alpha1.AddToScheme(scheme)
+
+	fakeRecorder := record.NewFakeRecorder(10)
+	sink := &memorySink{}
+	r := NewRecorder(fakeRecorder, scheme, sink)
+
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry.redhat.io.ubi8.ubi.abc123de", UID: "uid-1"},
+	}
+	r.Eventf(cr, corev1.EventTypeWarning, "VulnerabilitiesFound", "%d critical", 2)
+
+	select {
+	case ev := <-fakeRecorder.Events:
+		if ev != "Warning VulnerabilitiesFound 2 critical" {
+			t.Errorf("forwarded event = %q", ev)
+		}
+	default:
+		t.Fatal("expected event to be forwarded to the wrapped recorder")
+	}
+
+	if len(sink.records) != 1 {
+		t.Fatalf("expected 1 audit record, got %d", len(sink.records))
+	}
+	rec := sink.records[0]
+	if rec.Message != "2 critical" || rec.Reason != "VulnerabilitiesFound" || rec.Type != corev1.EventTypeWarning {
+		t.Errorf("unexpected record: %+v", rec)
+	}
+	want := ObjectReference{
+		APIVersion: securityv1alpha1.GroupVersion.String(),
+		Kind:       "ImageCertificationInfo",
+		Name:       cr.Name,
+		UID:        "uid-1",
+	}
+	if rec.InvolvedObject != want {
+		t.Errorf("involvedObject = %+v, want %+v", rec.InvolvedObject, want)
+	}
+}
+
+func TestFileSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	// Small enough that every record triggers a rotation
+	sink, err := NewFileSink(path, WithMaxFileSize(10), WithMaxBackups(2))
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer func() { _ = sink.Close() }()
+
+	for _, reason := range []string{"first", "second", "third", "fourth"} {
+		if err := sink.Write(Record{Reason: reason}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	wantReasons := map[string]string{
+		path:        "fourth",
+		path + ".1": "third",
+		path + ".2": "second",
+	}
+	for file, want := range wantReasons {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatalf("expected %s to exist: %v", file, err)
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Scan()
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("invalid JSON line in %s: %v", file, err)
+		}
+		_ = f.Close()
+		if rec.Reason != want {
+			t.Errorf("%s reason = %q, want %q", file, rec.Reason, want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("expected backups beyond max to be removed")
+	}
+}
+
+func TestHTTPSink_Deliver(t *testing.T) {
+	received := make(chan Record, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var rec Record
+		if err := json.NewDecoder(r.Body).Decode(&rec); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- rec
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := NewHTTPSink(server.URL)
+	sink.Start(ctx)
+	if err := sink.Write(Record{Reason: "ImageDiscovered"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	select {
+	case rec := <-received:
+		if rec.Reason != "ImageDiscovered" {
+			t.Errorf("reason = %q, want ImageDiscovered", rec.Reason)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for audit record delivery")
+	}
+}
+
+func TestHTTPSink_QueueFull(t *testing.T) {
+	sink := NewHTTPSink("http://127.0.0.1:0", WithQueueSize(1))
+	if err := sink.Write(Record{}); err != nil {
+		t.Fatalf("first Write() error = %v", err)
+	}
+	if err := sink.Write(Record{}); err == nil {
+		t.Error("expected error when the queue is full")
+	}
+}
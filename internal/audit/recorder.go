@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit exports operator-emitted events to durable sinks so that they
+// outlive the cluster's event garbage collection.
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Record is a single audit entry, written as one JSON object per line
+type Record struct {
+	Timestamp      time.Time         `json:"timestamp"`
+	Type           string            `json:"type"`
+	Reason         string            `json:"reason"`
+	Message        string            `json:"message"`
+	InvolvedObject ObjectReference   `json:"involvedObject"`
+	Annotations    map[string]string `json:"annotations,omitempty"`
+}
+
+// ObjectReference identifies the object an event is about
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	UID        string `json:"uid,omitempty"`
+}
+
+// Sink receives audit records
+type Sink interface {
+	// Name identifies the sink in metrics and logs
+	Name() string
+	// Write stores a record; implementations must be safe for concurrent use
+	Write(rec Record) error
+}
+
+// Recorder is a record.EventRecorder that forwards events to a wrapped recorder
+// and also writes them to one or more audit sinks
+type Recorder struct {
+	recorder record.EventRecorder
+	scheme   *runtime.Scheme
+	sinks    []Sink
+	now      func() time.Time
+}
+
+var _ record.EventRecorder = &Recorder{}
+
+// NewRecorder wraps recorder so that every event is also written to sinks.
+// The scheme is used to resolve the kind of typed objects.
+func NewRecorder(recorder record.EventRecorder, scheme *runtime.Scheme, sinks ...Sink) *Recorder {
+	return &Recorder{
+		recorder: recorder,
+		scheme:   scheme,
+		sinks:    sinks,
+		now:      time.Now,
+	}
+}
+
+// Event records an event and writes it to the audit sinks
+func (r *Recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.recorder.Event(object, eventtype, reason, message)
+	r.audit(object, nil, eventtype, reason, message)
+}
+
+// Eventf is like Event but with Sprintf for the message field
+func (r *Recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...any) {
+	r.recorder.Eventf(object, eventtype, reason, messageFmt, args...)
+	r.audit(object, nil, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf is like Eventf but with annotations attached
+func (r *Recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string,
+	eventtype, reason, messageFmt string, args ...any) {
+	r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+	r.audit(object, annotations, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// audit builds a record for the event and writes it to every sink
+func (r *Recorder) audit(object runtime.Object, annotations map[string]string, eventtype, reason, message string) {
+	rec := Record{
+		Timestamp:      r.now().UTC(),
+		Type:           eventtype,
+		Reason:         reason,
+		Message:        message,
+		InvolvedObject: r.reference(object),
+		Annotations:    annotations,
+	}
+	for _, sink := range r.sinks {
+		// Sink failures are counted by the sink and must never block event emission
+		_ = sink.Write(rec)
+	}
+}
+
+// reference describes the involved object
+func (r *Recorder) reference(object runtime.Object) ObjectReference {
+	var ref ObjectReference
+	if accessor, err := meta.Accessor(object); err == nil {
+		ref.Namespace = accessor.GetNamespace()
+		ref.Name = accessor.GetName()
+		ref.UID = string(accessor.GetUID())
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() && r.scheme != nil {
+		if resolved, err := apiutil.GVKForObject(object, r.scheme); err == nil {
+			gvk = resolved
+		}
+	}
+	ref.APIVersion, ref.Kind = gvk.ToAPIVersionAndKind()
+	return ref
+}
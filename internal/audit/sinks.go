@@ -0,0 +1,267 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultMaxFileSize is the size in bytes at which the audit file is rotated
+const DefaultMaxFileSize = 100 * 1024 * 1024
+
+// DefaultMaxBackups is the number of rotated audit files kept
+const DefaultMaxBackups = 5
+
+// DefaultHTTPQueueSize is the number of records buffered for the HTTP sink
+const DefaultHTTPQueueSize = 1000
+
+// DefaultHTTPTimeout is the timeout for a single HTTP sink request
+const DefaultHTTPTimeout = 10 * time.Second
+
+// FileSink appends records as JSON lines to a file and rotates it by size.
+// Rotated files are named <path>.1 (newest) through <path>.<maxBackups>.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// FileSinkOption is a function that configures a FileSink
+type FileSinkOption func(*FileSink)
+
+// WithMaxFileSize sets the size in bytes at which the file is rotated
+func WithMaxFileSize(size int64) FileSinkOption {
+	return func(s *FileSink) {
+		s.maxSize = size
+	}
+}
+
+// WithMaxBackups sets the number of rotated files to keep
+func WithMaxBackups(n int) FileSinkOption {
+	return func(s *FileSink) {
+		s.maxBackups = n
+	}
+}
+
+// NewFileSink opens (or creates) the audit file at path
+func NewFileSink(path string, opts ...FileSinkOption) (*FileSink, error) {
+	s := &FileSink{
+		path:       path,
+		maxSize:    DefaultMaxFileSize,
+		maxBackups: DefaultMaxBackups,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name identifies the sink
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+// Write appends a record to the file, rotating first if it would exceed the size limit
+func (s *FileSink) Write(rec Record) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		metrics.RecordAuditRecord(s.Name(), "error")
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			metrics.RecordAuditRecord(s.Name(), "error")
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		metrics.RecordAuditRecord(s.Name(), "error")
+		return err
+	}
+	metrics.RecordAuditRecord(s.Name(), "written")
+	return nil
+}
+
+// Close closes the underlying file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// open opens the audit file for appending and records its current size
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// rotate shifts existing backups, moves the current file to <path>.1, and reopens
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	if s.maxBackups > 0 {
+		_ = os.Remove(fmt.Sprintf("%s.%d", s.path, s.maxBackups))
+		for i := s.maxBackups - 1; i >= 1; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+		}
+		if err := os.Rename(s.path, s.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(s.path); err != nil {
+		return err
+	}
+
+	return s.open()
+}
+
+// HTTPSink posts each record as JSON to an HTTP endpoint.
+// Records are buffered and sent in the background so event emission never blocks;
+// records are dropped when the buffer is full.
+type HTTPSink struct {
+	url        string
+	httpClient *http.Client
+	queue      chan Record
+}
+
+// HTTPSinkOption is a function that configures an HTTPSink
+type HTTPSinkOption func(*HTTPSink)
+
+// WithHTTPClient sets a custom HTTP client
+func WithHTTPClient(client *http.Client) HTTPSinkOption {
+	return func(s *HTTPSink) {
+		s.httpClient = client
+	}
+}
+
+// WithQueueSize sets the number of records buffered before dropping
+func WithQueueSize(size int) HTTPSinkOption {
+	return func(s *HTTPSink) {
+		s.queue = make(chan Record, size)
+	}
+}
+
+// NewHTTPSink creates a sink that posts records to url
+func NewHTTPSink(url string, opts ...HTTPSinkOption) *HTTPSink {
+	s := &HTTPSink{
+		url:        url,
+		httpClient: &http.Client{Timeout: DefaultHTTPTimeout},
+		queue:      make(chan Record, DefaultHTTPQueueSize),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Name identifies the sink
+func (s *HTTPSink) Name() string {
+	return "http"
+}
+
+// Write queues a record for delivery
+func (s *HTTPSink) Write(rec Record) error {
+	select {
+	case s.queue <- rec:
+		return nil
+	default:
+		metrics.RecordAuditRecord(s.Name(), "dropped")
+		return fmt.Errorf("audit HTTP sink queue is full")
+	}
+}
+
+// Start starts a goroutine that delivers queued records until ctx is cancelled
+func (s *HTTPSink) Start(ctx context.Context) {
+	go func() {
+		logger := log.FromContext(ctx).WithName("audit-http")
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case rec := <-s.queue:
+				if err := s.send(ctx, rec); err != nil {
+					metrics.RecordAuditRecord(s.Name(), "error")
+					logger.V(1).Info("failed to deliver audit record", "reason", rec.Reason, "error", err)
+					continue
+				}
+				metrics.RecordAuditRecord(s.Name(), "written")
+			}
+		}
+	}()
+}
+
+// send posts a single record
+func (s *HTTPSink) send(ctx context.Context, rec Record) error {
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit sink returned status %d", resp.StatusCode)
+	}
+	return nil
+}
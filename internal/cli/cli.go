@@ -0,0 +1,249 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Program is the command name shown in help output
+var Program = "imagecertinfo"
+
+// ErrUsage is returned when a command is invoked with invalid arguments
+var ErrUsage = errors.New("invalid usage")
+
+// Command is a CLI verb
+type Command struct {
+	// Name is the verb used on the command line
+	Name string
+	// Usage is the argument synopsis shown in help output
+	Usage string
+	// Short is the one-line description shown in help output
+	Short string
+	// Run executes the command with the remaining arguments
+	Run func(ctx context.Context, c client.Client, args []string, out io.Writer) error
+	// Offline commands work on local files and are run without a cluster client
+	Offline bool
+}
+
+// Commands lists all available verbs
+var Commands = []Command{
+	{
+		Name:  "compare",
+		Usage: "compare [--diff-only] <image-a> <image-b>",
+		Short: "Side-by-side comparison of two tracked images",
+		Run:   runCompare,
+	},
+	{
+		Name:  "export",
+		Usage: "export [--os linux,windows]",
+		Short: "Write all tracked images as YAML for a later diff",
+		Run:   runExport,
+	},
+	{
+		Name:  "summary",
+		Usage: "summary [-o table|json]",
+		Short: "Count tracked images by certification status and registry type",
+		Run:   runSummary,
+	},
+	{
+		Name:  "by-namespace",
+		Usage: "by-namespace [-o table|json]",
+		Short: "Summarize the images used in each namespace",
+		Run:   runByNamespace,
+	},
+	{
+		Name:  "eol",
+		Usage: "eol [--within days] [-o table|json]",
+		Short: "List images with an end-of-life date, soonest first",
+		Run:   runEOL,
+	},
+	{
+		Name:  "vulnerable",
+		Usage: "vulnerable [--severity level] [-o table|json]",
+		Short: "List images with CVEs of the given severity or higher",
+		Run:   runVulnerable,
+	},
+	{
+		Name:  "pods",
+		Usage: "pods [-o table|json] <image>",
+		Short: "List the pods and containers using an image",
+		Run:   runPods,
+	},
+	{
+		Name:  "status",
+		Usage: "status [--namespace ns] [--top n] [-o table|json]",
+		Short: "Show operator health, provider freshness, cache, refresh progress, and top risks",
+		Run:   runStatus,
+	},
+	{
+		Name:  "scan",
+		Usage: "scan [-o yaml|json] [--file path] [--namespace ns] [--pyxis-url url] [--dockerhub=false]",
+		Short: "Classify and enrich the images of running pods without installing the operator",
+		Run:   runScan,
+	},
+	{
+		Name:  "bundle",
+		Usage: "bundle [--file path] [--namespace ns] [--samples n] [--metrics-url url]",
+		Short: "Collect logs, configuration, statusz, metrics, and sample images into a redacted tar.gz",
+		Run:   runBundle,
+	},
+	{
+		Name:    "diff",
+		Usage:   "diff <export-a> <export-b>",
+		Short:   "Compare the images tracked by two clusters from their exports",
+		Run:     runDiff,
+		Offline: true,
+	},
+	{
+		Name:    "verify",
+		Usage:   "verify [-o table|json] <export> <pyxis-dump>",
+		Short:   "Recompute certification from an offline Pyxis dump and report mismatches",
+		Run:     runVerify,
+		Offline: true,
+	},
+}
+
+// Run dispatches args to the matching command
+func Run(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		PrintUsage(out)
+		return ErrUsage
+	}
+	i := slices.IndexFunc(Commands, func(cmd Command) bool { return cmd.Name == args[0] })
+	if i < 0 {
+		PrintUsage(out)
+		return fmt.Errorf("%w: unknown command %q", ErrUsage, args[0])
+	}
+	return Commands[i].Run(ctx, c, args[1:], out)
+}
+
+// RequiresCluster reports whether the command selected by args needs a cluster client
+func RequiresCluster(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	i := slices.IndexFunc(Commands, func(cmd Command) bool { return cmd.Name == args[0] })
+	return i >= 0 && !Commands[i].Offline
+}
+
+// PrintUsage writes the list of commands
+func PrintUsage(out io.Writer) {
+	_, _ = fmt.Fprintf(out, "Usage: %s [--kubeconfig path] <command> [args]\n\nCommands:\n", Program)
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	for _, cmd := range Commands {
+		_, _ = fmt.Fprintf(tw, "  %s\t%s\n", cmd.Usage, cmd.Short)
+	}
+	_ = tw.Flush()
+}
+
+// runCompare implements the compare verb
+func runCompare(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("compare", flag.ContinueOnError)
+	fs.SetOutput(out)
+	diffOnly := fs.Bool("diff-only", false, "Only show fields that differ")
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("%w: compare requires exactly two image references", ErrUsage)
+	}
+
+	left, err := Resolve(ctx, c, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	right, err := Resolve(ctx, c, fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	rows := Compare(left, right)
+	if *diffOnly {
+		rows = slices.DeleteFunc(rows, func(r ComparisonRow) bool { return !r.Differs() })
+	}
+	return WriteComparison(out, left.Name, right.Name, rows)
+}
+
+// runExport implements the export verb
+func runExport(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(out)
+	systems := fs.String("os", "", "Only export images published for one of these comma-separated operating systems")
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: export takes no arguments", ErrUsage)
+	}
+
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := c.List(ctx, &list); err != nil {
+		return fmt.Errorf("unable to list ImageCertificationInfos: %w", err)
+	}
+	if *systems != "" {
+		wanted := strings.Split(*systems, ",")
+		list.Items = slices.DeleteFunc(list.Items, func(cr securityv1alpha1.ImageCertificationInfo) bool {
+			return !slices.ContainsFunc(cr.Status.OperatingSystems, func(os string) bool {
+				return slices.Contains(wanted, os)
+			})
+		})
+	}
+	list.APIVersion = securityv1alpha1.GroupVersion.String()
+	list.Kind = "ImageCertificationInfoList"
+	for i := range list.Items {
+		list.Items[i].ManagedFields = nil
+	}
+
+	data, err := yaml.Marshal(&list)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// runDiff implements the diff verb
+func runDiff(_ context.Context, _ client.Client, args []string, out io.Writer) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: diff requires exactly two export files", ErrUsage)
+	}
+
+	left, err := LoadExport(args[0])
+	if err != nil {
+		return err
+	}
+	right, err := LoadExport(args[1])
+	if err != nil {
+		return err
+	}
+
+	d := DiffClusters(left, right)
+	return WriteClusterDiff(out, args[0], args[1], &d)
+}
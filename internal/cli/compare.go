@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// ComparisonRow is one field of a side-by-side image comparison
+type ComparisonRow struct {
+	Field string
+	Left  string
+	Right string
+}
+
+// Differs reports whether the two sides of the row have different values
+func (r ComparisonRow) Differs() bool {
+	return r.Left != r.Right
+}
+
+// Compare builds a side-by-side comparison of two images
+func Compare(a, b *securityv1alpha1.ImageCertificationInfo) []ComparisonRow {
+	fields := []struct {
+		name  string
+		value func(*securityv1alpha1.ImageCertificationInfo) string
+	}{
+		{"Image", func(cr *securityv1alpha1.ImageCertificationInfo) string { return cr.Spec.FullImageReference }},
+		{"Registry Type", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return string(cr.Status.RegistryType)
+		}},
+		{"Certification", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return string(cr.Status.CertificationStatus)
+		}},
+		{"Health Grade", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return pyxisField(cr, func(p *securityv1alpha1.PyxisData) string { return p.HealthIndex })
+		}},
+		{"Critical CVEs", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return vulnField(cr, func(v *securityv1alpha1.VulnerabilitySummary) int { return v.Critical })
+		}},
+		{"Important CVEs", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return vulnField(cr, func(v *securityv1alpha1.VulnerabilitySummary) int { return v.Important })
+		}},
+		{"Moderate CVEs", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return vulnField(cr, func(v *securityv1alpha1.VulnerabilitySummary) int { return v.Moderate })
+		}},
+		{"Low CVEs", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return vulnField(cr, func(v *securityv1alpha1.VulnerabilitySummary) int { return v.Low })
+		}},
+		{"Compressed Size", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return pyxisField(cr, func(p *securityv1alpha1.PyxisData) string { return formatBytes(p.CompressedSizeBytes) })
+		}},
+		{"Release Category", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return pyxisField(cr, func(p *securityv1alpha1.PyxisData) string { return p.ReleaseCategory })
+		}},
+		{"EOL Date", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			return pyxisField(cr, func(p *securityv1alpha1.PyxisData) string {
+				if p.EOLDate == nil {
+					return ""
+				}
+				return p.EOLDate.Format("2006-01-02")
+			})
+		}},
+		{"Days Until EOL", func(cr *securityv1alpha1.ImageCertificationInfo) string {
+			if cr.Status.DaysUntilEOL == nil {
+				return ""
+			}
+			return strconv.Itoa(*cr.Status.DaysUntilEOL)
+		}},
+	}
+
+	rows := make([]ComparisonRow, 0, len(fields))
+	for _, f := range fields {
+		rows = append(rows, ComparisonRow{Field: f.name, Left: f.value(a), Right: f.value(b)})
+	}
+	return rows
+}
+
+// WriteComparison prints the comparison as a table, marking rows that differ with '*'
+func WriteComparison(w io.Writer, leftName, rightName string, rows []ComparisonRow) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "\tFIELD\t%s\t%s\n", leftName, rightName)
+	for _, row := range rows {
+		marker := ""
+		if row.Differs() {
+			marker = "*"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", marker, row.Field, orDash(row.Left), orDash(row.Right))
+	}
+	return tw.Flush()
+}
+
+// pyxisField returns a Pyxis-derived value or empty when no Pyxis data is present
+func pyxisField(cr *securityv1alpha1.ImageCertificationInfo, get func(*securityv1alpha1.PyxisData) string) string {
+	if cr.Status.PyxisData == nil {
+		return ""
+	}
+	return get(cr.Status.PyxisData)
+}
+
+// vulnField returns a vulnerability count or empty when no vulnerability data is present
+func vulnField(cr *securityv1alpha1.ImageCertificationInfo, get func(*securityv1alpha1.VulnerabilitySummary) int) string {
+	if cr.Status.PyxisData == nil || cr.Status.PyxisData.Vulnerabilities == nil {
+		return ""
+	}
+	return strconv.Itoa(get(cr.Status.PyxisData.Vulnerabilities))
+}
+
+// formatBytes renders a byte count in human-readable binary units
+func formatBytes(n int64) string {
+	if n <= 0 {
+		return ""
+	}
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// orDash renders empty values as '-'
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// ImageChange pairs the two clusters' views of the same image
+type ImageChange struct {
+	Image string
+	Left  *securityv1alpha1.ImageCertificationInfo
+	Right *securityv1alpha1.ImageCertificationInfo
+}
+
+// ClusterDiff describes how the images tracked by two clusters differ
+type ClusterDiff struct {
+	// OnlyLeft lists images tracked only by the left cluster
+	OnlyLeft []*securityv1alpha1.ImageCertificationInfo
+	// OnlyRight lists images tracked only by the right cluster
+	OnlyRight []*securityv1alpha1.ImageCertificationInfo
+	// StatusChanges lists images whose certification status differs
+	StatusChanges []ImageChange
+	// VulnerabilityChanges lists images whose vulnerability counts differ
+	VulnerabilityChanges []ImageChange
+}
+
+// Empty reports whether the two clusters track the same images with the same data
+func (d *ClusterDiff) Empty() bool {
+	return len(d.OnlyLeft) == 0 && len(d.OnlyRight) == 0 &&
+		len(d.StatusChanges) == 0 && len(d.VulnerabilityChanges) == 0
+}
+
+// LoadExport reads the ImageCertificationInfos from a file written by the export verb or by
+// "kubectl get imagecertificationinfo -o yaml" (JSON works too)
+func LoadExport(path string) ([]securityv1alpha1.ImageCertificationInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	return list.Items, nil
+}
+
+// DiffClusters compares the images tracked by two clusters. Images are matched by
+// registry, repository, and digest, since CR names may differ between operator versions.
+func DiffClusters(left, right []securityv1alpha1.ImageCertificationInfo) ClusterDiff {
+	rightByImage := make(map[string]*securityv1alpha1.ImageCertificationInfo, len(right))
+	for i := range right {
+		rightByImage[imageKey(&right[i])] = &right[i]
+	}
+
+	var d ClusterDiff
+	seen := make(map[string]bool, len(left))
+	for i := range left {
+		l := &left[i]
+		key := imageKey(l)
+		seen[key] = true
+		r, ok := rightByImage[key]
+		if !ok {
+			d.OnlyLeft = append(d.OnlyLeft, l)
+			continue
+		}
+		if l.Status.CertificationStatus != r.Status.CertificationStatus {
+			d.StatusChanges = append(d.StatusChanges, ImageChange{Image: key, Left: l, Right: r})
+		}
+		if vulnerabilities(l) != vulnerabilities(r) {
+			d.VulnerabilityChanges = append(d.VulnerabilityChanges, ImageChange{Image: key, Left: l, Right: r})
+		}
+	}
+	for i := range right {
+		if !seen[imageKey(&right[i])] {
+			d.OnlyRight = append(d.OnlyRight, &right[i])
+		}
+	}
+
+	byImage := func(a, b *securityv1alpha1.ImageCertificationInfo) int { return cmp.Compare(imageKey(a), imageKey(b)) }
+	byChange := func(a, b ImageChange) int { return cmp.Compare(a.Image, b.Image) }
+	slices.SortFunc(d.OnlyLeft, byImage)
+	slices.SortFunc(d.OnlyRight, byImage)
+	slices.SortFunc(d.StatusChanges, byChange)
+	slices.SortFunc(d.VulnerabilityChanges, byChange)
+	return d
+}
+
+// WriteClusterDiff prints each non-empty section of the diff as a table
+func WriteClusterDiff(w io.Writer, leftName, rightName string, d *ClusterDiff) error {
+	if d.Empty() {
+		_, err := fmt.Fprintf(w, "%s and %s track the same images with the same certification data\n", leftName, rightName)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	writeOnly := func(name string, items []*securityv1alpha1.ImageCertificationInfo) {
+		if len(items) == 0 {
+			return
+		}
+		_, _ = fmt.Fprintf(tw, "Only in %s (%d):\n", name, len(items))
+		for _, cr := range items {
+			_, _ = fmt.Fprintf(tw, "  %s\t%s\n", imageKey(cr), orDash(string(cr.Status.CertificationStatus)))
+		}
+		_, _ = fmt.Fprintln(tw)
+	}
+	writeOnly(leftName, d.OnlyLeft)
+	writeOnly(rightName, d.OnlyRight)
+
+	if len(d.StatusChanges) > 0 {
+		_, _ = fmt.Fprintf(tw, "Certification status differs (%d):\n", len(d.StatusChanges))
+		_, _ = fmt.Fprintf(tw, "  IMAGE\t%s\t%s\n", leftName, rightName)
+		for _, c := range d.StatusChanges {
+			_, _ = fmt.Fprintf(tw, "  %s\t%s\t%s\n", c.Image,
+				orDash(string(c.Left.Status.CertificationStatus)), orDash(string(c.Right.Status.CertificationStatus)))
+		}
+		_, _ = fmt.Fprintln(tw)
+	}
+
+	if len(d.VulnerabilityChanges) > 0 {
+		_, _ = fmt.Fprintf(tw, "Vulnerabilities differ (%d, %s -> %s):\n", len(d.VulnerabilityChanges), leftName, rightName)
+		_, _ = fmt.Fprint(tw, "  IMAGE\tCRITICAL\tIMPORTANT\tMODERATE\tLOW\n")
+		for _, c := range d.VulnerabilityChanges {
+			l, r := vulnerabilities(c.Left), vulnerabilities(c.Right)
+			_, _ = fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", c.Image, countDelta(l.Critical, r.Critical),
+				countDelta(l.Important, r.Important), countDelta(l.Moderate, r.Moderate), countDelta(l.Low, r.Low))
+		}
+		_, _ = fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// imageKey identifies an image independently of the CR naming scheme
+func imageKey(cr *securityv1alpha1.ImageCertificationInfo) string {
+	return cr.Spec.Registry + "/" + cr.Spec.Repository + "@" + cr.Spec.ImageDigest
+}
+
+// vulnerabilities returns the vulnerability counts of an image, all zero when unknown
+func vulnerabilities(cr *securityv1alpha1.ImageCertificationInfo) securityv1alpha1.VulnerabilitySummary {
+	if cr.Status.PyxisData == nil || cr.Status.PyxisData.Vulnerabilities == nil {
+		return securityv1alpha1.VulnerabilitySummary{}
+	}
+	return *cr.Status.PyxisData.Vulnerabilities
+}
+
+// countDelta renders a count that may differ between clusters, e.g. "2 -> 5 (+3)"
+func countDelta(left, right int) string {
+	if left == right {
+		return fmt.Sprintf("%d", left)
+	}
+	return fmt.Sprintf("%d -> %d (%+d)", left, right, right-left)
+}
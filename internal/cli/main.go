@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Main parses the command line, connects to the cluster of the user's kubeconfig when the
+// command needs it, and runs the command. It returns the process exit code.
+func Main() int {
+	// controller-runtime registers --kubeconfig on the default flag set
+	flag.Usage = func() { PrintUsage(os.Stderr) }
+	flag.Parse()
+
+	// Commands that only read local files work without a kubeconfig
+	var c client.Client
+	if RequiresCluster(flag.Args()) {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(securityv1alpha1.AddToScheme(scheme))
+
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: unable to load kubeconfig: %v\n", err)
+			return 1
+		}
+		c, err = client.New(cfg, client.Options{Scheme: scheme})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: unable to create client: %v\n", err)
+			return 1
+		}
+		clientset, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: unable to create client: %v\n", err)
+			return 1
+		}
+		PodLogs = NewPodLogReader(clientset)
+	}
+
+	if err := Run(context.Background(), c, flag.Args(), os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		if errors.Is(err, ErrUsage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cli implements the commands of the imagecertinfo command-line tool.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// Resolve finds the ImageCertificationInfo for an argument that is either a CR name,
+// a digest reference (registry/repo@sha256:...), or a tag reference (registry/repo:tag)
+func Resolve(ctx context.Context, c client.Reader, arg string) (*securityv1alpha1.ImageCertificationInfo, error) {
+	var cr securityv1alpha1.ImageCertificationInfo
+
+	// A CR name is the cheapest lookup
+	err := c.Get(ctx, client.ObjectKey{Name: arg}, &cr)
+	if err == nil {
+		return &cr, nil
+	}
+	if !apierrors.IsNotFound(err) && !apierrors.IsInvalid(err) && !apierrors.IsBadRequest(err) {
+		return nil, err
+	}
+
+	// Digest references map directly to a CR name
+	if strings.Contains(arg, "@") {
+		ref, err := image.ParseImageID(arg)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.Get(ctx, client.ObjectKey{Name: image.ReferenceToCRName(ref)}, &cr); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("image %s is not tracked", arg)
+			}
+			return nil, err
+		}
+		return &cr, nil
+	}
+
+	// Tag references require a scan of all tracked images
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := c.List(ctx, &list); err != nil {
+		return nil, err
+	}
+	registry, repository, tag := image.ParseTagReference(arg)
+	var matches []*securityv1alpha1.ImageCertificationInfo
+	for i := range list.Items {
+		item := &list.Items[i]
+		if item.Spec.FullImageReference == arg ||
+			(item.Spec.Registry == registry && item.Spec.Repository == repository && item.Spec.Tag == tag) {
+			matches = append(matches, item)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("image %s is not tracked; use a digest reference or ImageCertificationInfo name", arg)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("image %s matches %d tracked digests; use a digest reference", arg, len(matches))
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/controller"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/dockerhub"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+// OutputYAML is the default output format of the scan command
+const OutputYAML = "yaml"
+
+// DefaultScanTimeout is the default deadline for enriching each image during a scan
+const DefaultScanTimeout = 2 * time.Minute
+
+// ScanOptions configures a one-shot scan
+type ScanOptions struct {
+	// Namespace limits the scan to pods in one namespace (empty scans all namespaces)
+	Namespace string
+	// PyxisClient looks up Red Hat registry images (nil skips them)
+	PyxisClient pyxis.Client
+	// DockerHubClient looks up docker.io images (nil skips them)
+	DockerHubClient dockerhub.Client
+	// Timeout is the deadline for enriching each image (0 disables it)
+	Timeout time.Duration
+}
+
+// Scan lists the pods of the cluster and returns the images they use, classified and
+// enriched as the operator would record them, without creating any resources. The result
+// has the format of an export, so it can be compared with diff or checked with verify.
+func Scan(ctx context.Context, c client.Client, opts ScanOptions) (*securityv1alpha1.ImageCertificationInfoList, error) {
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list pods: %w", err)
+	}
+
+	scanner := &controller.PodReconciler{
+		PyxisClient:       opts.PyxisClient,
+		DockerHubClient:   opts.DockerHubClient,
+		EnrichmentTimeout: opts.Timeout,
+	}
+	list := &securityv1alpha1.ImageCertificationInfoList{Items: scanner.ScanPods(ctx, pods.Items)}
+	list.APIVersion = securityv1alpha1.GroupVersion.String()
+	list.Kind = "ImageCertificationInfoList"
+	return list, nil
+}
+
+// runScan implements the scan verb
+func runScan(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("scan", flag.ContinueOnError)
+	fs.SetOutput(out)
+	output := fs.String("output", OutputYAML, "Output format: yaml or json")
+	fs.StringVar(output, "o", OutputYAML, "Shorthand for --output")
+	file := fs.String("file", "", "Write the report to this file instead of standard output")
+	namespace := fs.String("namespace", "", "Only scan pods in this namespace")
+	pyxisURL := fs.String("pyxis-url", pyxis.DefaultBaseURL, "Base URL of the Pyxis API")
+	dockerHub := fs.Bool("dockerhub", true, "Look up docker.io images on Docker Hub")
+	timeout := fs.Duration("timeout", DefaultScanTimeout, "Deadline for enriching each image (0 to disable)")
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: scan takes no arguments", ErrUsage)
+	}
+	if *output != OutputYAML && *output != OutputJSON {
+		return fmt.Errorf("%w: --output must be %s or %s, got %q", ErrUsage, OutputYAML, OutputJSON, *output)
+	}
+
+	// PYXIS_API_KEY raises the Pyxis rate limits, as for the operator
+	opts := ScanOptions{
+		Namespace: *namespace,
+		PyxisClient: pyxis.NewRateLimitedClient(pyxis.NewHTTPClient(
+			pyxis.WithBaseURL(*pyxisURL), pyxis.WithAPIKey(os.Getenv("PYXIS_API_KEY")))),
+		Timeout: *timeout,
+	}
+	if *dockerHub {
+		opts.DockerHubClient = dockerhub.NewRateLimitedClient(dockerhub.NewHTTPClient())
+	}
+	list, err := Scan(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if *output == OutputJSON {
+		data, err = json.MarshalIndent(list, "", "  ")
+		data = append(data, '\n')
+	} else {
+		data, err = yaml.Marshal(list)
+	}
+	if err != nil {
+		return err
+	}
+	if *file != "" {
+		return os.WriteFile(*file, data, 0o644)
+	}
+	_, err = out.Write(data)
+	return err
+}
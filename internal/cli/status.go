@@ -0,0 +1,452 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/controller"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/search"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/version"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+// DefaultOperatorNamespace is the namespace the operator is deployed to by config/default
+const DefaultOperatorNamespace = "imagecertinfo-operator-system"
+
+// DefaultTopRisks is the number of risk findings the status command shows
+const DefaultTopRisks = 5
+
+// operatorLabels select the operator Deployment
+var operatorLabels = client.MatchingLabels{
+	"app.kubernetes.io/name": "imagecertinfo-operator",
+	"control-plane":          "controller-manager",
+}
+
+// providers lists the enrichment providers in the order the status command shows them
+var providers = []string{
+	securityv1alpha1.DataSourcePyxis,
+	securityv1alpha1.DataSourceDockerHub,
+	securityv1alpha1.DataSourceQuay,
+	securityv1alpha1.DataSourceRegistry,
+	securityv1alpha1.DataSourceReferrers,
+}
+
+// StatusOptions configures the status command
+type StatusOptions struct {
+	// Namespace is the operator namespace
+	Namespace string
+	// Top is the number of risk findings to report
+	Top int
+	// Now is the time relative ages are computed against
+	Now time.Time
+}
+
+// Status is the overview printed by the status command
+type Status struct {
+	Operator  OperatorStatus   `json:"operator"`
+	Providers []ProviderStatus `json:"providers"`
+	Cache     CacheStatus      `json:"cache"`
+	Refresh   RefreshStatus    `json:"refresh"`
+	TopRisks  []RiskFinding    `json:"topRisks"`
+}
+
+// OperatorStatus is the health of the operator Deployment and the build it runs
+type OperatorStatus struct {
+	Namespace     string `json:"namespace"`
+	Deployment    string `json:"deployment,omitempty"`
+	Replicas      int32  `json:"replicas"`
+	ReadyReplicas int32  `json:"readyReplicas"`
+	Version       string `json:"version,omitempty"`
+	Leader        string `json:"leader,omitempty"`
+	StartTime     string `json:"startTime,omitempty"`
+	// Error explains why the Deployment could not be inspected
+	Error string `json:"error,omitempty"`
+}
+
+// Healthy reports whether every desired replica of the operator is ready
+func (s OperatorStatus) Healthy() bool {
+	return s.Error == "" && s.Replicas > 0 && s.ReadyReplicas == s.Replicas
+}
+
+// ProviderStatus is how recently the operator read an enrichment provider
+type ProviderStatus struct {
+	Name string `json:"name"`
+	// Images is the number of images with data from the provider
+	Images int `json:"images"`
+	// LastSyncedAt is the most recent time the operator read the provider
+	LastSyncedAt *metav1.Time `json:"lastSyncedAt,omitempty"`
+	// Failures is the number of images whose last lookup failed
+	Failures int `json:"failures"`
+}
+
+// CacheStatus describes the persisted Pyxis cache and the pending enrichment lookups
+type CacheStatus struct {
+	// Persisted reports whether the Pyxis cache is kept in a ConfigMap
+	Persisted bool `json:"persisted"`
+	// Entries is the number of unexpired cache entries
+	Entries int `json:"entries"`
+	// Negative is the number of unexpired entries for images Pyxis has no data for
+	Negative int `json:"negative"`
+	// Expired is the number of entries waiting to be dropped on the next flush
+	Expired int `json:"expired"`
+	// PendingLookups is the number of lookups recorded in the enrichment journal
+	PendingLookups int `json:"pendingLookups"`
+	// Error explains why the cache could not be read
+	Error string `json:"error,omitempty"`
+}
+
+// RefreshStatus is the progress of certification checks across the tracked images
+type RefreshStatus struct {
+	Images int `json:"images"`
+	// Checked is the number of images checked against Pyxis at least once
+	Checked int `json:"checked"`
+	// CheckedLastDay is the number of images checked within the last 24 hours
+	CheckedLastDay int `json:"checkedLastDay"`
+	// Pending is the number of images whose certification is still pending
+	Pending int `json:"pending"`
+	// OldestCheck is the least recent certification check of any checked image
+	OldestCheck *metav1.Time `json:"oldestCheck,omitempty"`
+}
+
+// RiskFinding is an image with the issues that make it risky
+type RiskFinding struct {
+	Name     string   `json:"name"`
+	Image    string   `json:"image"`
+	Findings []string `json:"findings"`
+	Pods     int      `json:"pods"`
+
+	critical, important int
+	pastEOL, notCert    bool
+}
+
+// BuildStatus collects the operator overview. Failing to read the operator namespace is
+// reported in the result rather than returned, since users often may list images but not
+// read the operator's own resources.
+func BuildStatus(ctx context.Context, c client.Client, opts StatusOptions) (Status, error) {
+	items, err := listImages(ctx, c)
+	if err != nil {
+		return Status{}, err
+	}
+	return Status{
+		Operator:  operatorStatus(ctx, c, opts.Namespace),
+		Providers: providerStatus(items),
+		Cache:     cacheStatus(ctx, c, opts.Namespace, opts.Now),
+		Refresh:   refreshStatus(items, opts.Now),
+		TopRisks:  topRisks(items, opts.Top),
+	}, nil
+}
+
+// operatorStatus reads the operator Deployment and the build information its leader publishes
+func operatorStatus(ctx context.Context, c client.Client, namespace string) OperatorStatus {
+	s := OperatorStatus{Namespace: namespace}
+	var deployments appsv1.DeploymentList
+	if err := c.List(ctx, &deployments, client.InNamespace(namespace), operatorLabels); err != nil {
+		s.Error = fmt.Sprintf("unable to list Deployments: %v", err)
+		return s
+	}
+	if len(deployments.Items) == 0 {
+		s.Error = "operator Deployment not found"
+		return s
+	}
+	deployment := deployments.Items[0]
+	s.Deployment = deployment.Name
+	s.Replicas = ptrValue(deployment.Spec.Replicas, 1)
+	s.ReadyReplicas = deployment.Status.ReadyReplicas
+
+	var info corev1.ConfigMap
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: version.DefaultConfigMapName}, &info); err == nil {
+		s.Version = info.Data["version"]
+		s.Leader = info.Data["leader"]
+		s.StartTime = info.Data["startTime"]
+	}
+	return s
+}
+
+// providerStatus reports when each provider was last read, from the data sources of the images
+func providerStatus(items []securityv1alpha1.ImageCertificationInfo) []ProviderStatus {
+	byName := make(map[string]*ProviderStatus, len(providers))
+	result := make([]ProviderStatus, len(providers))
+	for i, name := range providers {
+		result[i].Name = name
+		byName[name] = &result[i]
+	}
+	for i := range items {
+		cr := &items[i]
+		for _, source := range cr.Status.DataSources {
+			p, ok := byName[source.Name]
+			if !ok {
+				continue
+			}
+			p.Images++
+			if p.LastSyncedAt == nil || p.LastSyncedAt.Before(&source.SyncedAt) {
+				p.LastSyncedAt = source.SyncedAt.DeepCopy()
+			}
+		}
+		if cr.Status.ReasonCode == securityv1alpha1.ReasonPyxisQueryFailed {
+			byName[securityv1alpha1.DataSourcePyxis].Failures++
+		}
+	}
+	return result
+}
+
+// cacheStatus reads the persisted Pyxis cache and the enrichment journal. Either is absent
+// unless the operator runs with the matching flag.
+func cacheStatus(ctx context.Context, c client.Client, namespace string, now time.Time) CacheStatus {
+	var s CacheStatus
+	var cm corev1.ConfigMap
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: pyxis.DefaultCacheConfigMapName}, &cm)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		s.Error = fmt.Sprintf("unable to read the Pyxis cache: %v", err)
+	default:
+		s.Persisted = true
+		entries, err := pyxis.CacheEntriesFromConfigMap(&cm)
+		if err != nil {
+			s.Error = err.Error()
+		}
+		for _, entry := range entries {
+			switch {
+			case !now.Before(entry.ExpiresAt):
+				s.Expired++
+			case entry.Data == nil:
+				s.Entries++
+				s.Negative++
+			default:
+				s.Entries++
+			}
+		}
+	}
+
+	var journal corev1.ConfigMap
+	key := client.ObjectKey{Namespace: namespace, Name: controller.DefaultEnrichmentJournalName}
+	if err := c.Get(ctx, key, &journal); err == nil {
+		s.PendingLookups = len(journal.Data)
+	}
+	return s
+}
+
+// refreshStatus summarizes when the images were last checked against Pyxis
+func refreshStatus(items []securityv1alpha1.ImageCertificationInfo, now time.Time) RefreshStatus {
+	s := RefreshStatus{Images: len(items)}
+	dayAgo := now.Add(-24 * time.Hour)
+	for i := range items {
+		cr := &items[i]
+		if cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusPending {
+			s.Pending++
+		}
+		checked := cr.Status.LastPyxisCheckAt
+		if checked == nil {
+			continue
+		}
+		s.Checked++
+		if checked.After(dayAgo) {
+			s.CheckedLastDay++
+		}
+		if s.OldestCheck == nil || checked.Before(s.OldestCheck) {
+			s.OldestCheck = checked.DeepCopy()
+		}
+	}
+	return s
+}
+
+// topRisks returns the riskiest images: critical CVEs first, then images past end of life,
+// important CVEs, and uncertified images, breaking ties by the number of pods affected
+func topRisks(items []securityv1alpha1.ImageCertificationInfo, top int) []RiskFinding {
+	findings := []RiskFinding{}
+	for i := range items {
+		cr := &items[i]
+		f := RiskFinding{Name: cr.Name, Image: imageName(cr), Pods: len(cr.Status.PodReferences)}
+		if vulns := search.Vulnerabilities(cr); vulns != nil {
+			f.critical, f.important = vulns.Critical, vulns.Important
+		}
+		f.pastEOL = cr.Status.DaysUntilEOL != nil && *cr.Status.DaysUntilEOL < 0
+		f.notCert = cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusNotCertified
+
+		if f.critical > 0 {
+			f.Findings = append(f.Findings, plural(f.critical, "critical CVE"))
+		}
+		if f.pastEOL {
+			f.Findings = append(f.Findings, fmt.Sprintf("past end of life by %s", plural(-*cr.Status.DaysUntilEOL, "day")))
+		}
+		if f.important > 0 {
+			f.Findings = append(f.Findings, plural(f.important, "important CVE"))
+		}
+		if f.notCert {
+			f.Findings = append(f.Findings, "not certified")
+		}
+		if len(f.Findings) > 0 {
+			findings = append(findings, f)
+		}
+	}
+
+	slices.SortFunc(findings, func(a, b RiskFinding) int {
+		return cmp.Or(
+			cmp.Compare(b.critical, a.critical),
+			compareBool(b.pastEOL, a.pastEOL),
+			cmp.Compare(b.important, a.important),
+			compareBool(b.notCert, a.notCert),
+			cmp.Compare(b.Pods, a.Pods),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return findings[:min(top, len(findings))]
+}
+
+// imageName returns the image reference shown for a tracked image
+func imageName(cr *securityv1alpha1.ImageCertificationInfo) string {
+	name := cr.Spec.Registry + "/" + cr.Spec.Repository
+	if cr.Spec.Tag != "" {
+		name += ":" + cr.Spec.Tag
+	}
+	return name
+}
+
+// compareBool orders false before true
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+// plural formats a count with a noun, adding an s unless the count is one
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(n) + " " + noun + "s"
+}
+
+// ptrValue returns the value p points to, or def when p is nil
+func ptrValue[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// runStatus implements the status verb
+func runStatus(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("status", out)
+	namespace := fs.String("namespace", DefaultOperatorNamespace, "Namespace the operator is deployed to")
+	top := fs.Int("top", DefaultTopRisks, "Number of risk findings to show")
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: status takes no arguments", ErrUsage)
+	}
+	if *top < 0 {
+		return fmt.Errorf("%w: --top must not be negative, got %d", ErrUsage, *top)
+	}
+
+	now := time.Now()
+	s, err := BuildStatus(ctx, c, StatusOptions{Namespace: *namespace, Top: *top, Now: now})
+	if err != nil {
+		return err
+	}
+	if *output == OutputJSON {
+		return writeJSON(out, s)
+	}
+	return writeStatus(out, s, now)
+}
+
+// writeStatus prints the overview for humans
+func writeStatus(out io.Writer, s Status, now time.Time) error {
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+
+	op := s.Operator
+	switch {
+	case op.Error != "":
+		_, _ = fmt.Fprintf(tw, "Operator:\tunknown (%s in %s)\n", op.Error, op.Namespace)
+	default:
+		health := "healthy"
+		if !op.Healthy() {
+			health = "degraded"
+		}
+		_, _ = fmt.Fprintf(tw, "Operator:\t%s, %d/%d replicas ready (%s/%s)\n",
+			health, op.ReadyReplicas, op.Replicas, op.Namespace, op.Deployment)
+	}
+	if op.Version != "" {
+		_, _ = fmt.Fprintf(tw, "Version:\t%s, leader %s since %s\n", op.Version, orDash(op.Leader), orDash(op.StartTime))
+	}
+
+	c := s.Cache
+	switch {
+	case c.Error != "":
+		_, _ = fmt.Fprintf(tw, "Pyxis cache:\tunreadable (%s)\n", c.Error)
+	case c.Persisted:
+		_, _ = fmt.Fprintf(tw, "Pyxis cache:\t%s (%d for images without data), %d expired\n",
+			plural(c.Entries, "entry"), c.Negative, c.Expired)
+	default:
+		_, _ = fmt.Fprint(tw, "Pyxis cache:\tin memory only\n")
+	}
+	_, _ = fmt.Fprintf(tw, "Pending lookups:\t%d\n", c.PendingLookups)
+
+	r := s.Refresh
+	oldest := "never"
+	if r.OldestCheck != nil {
+		oldest = ago(r.OldestCheck.Time, now)
+	}
+	_, _ = fmt.Fprintf(tw, "Refresh:\t%d/%d images checked in the last 24h, oldest check %s, %d pending\n",
+		r.CheckedLastDay, r.Images, oldest, r.Pending)
+
+	_, _ = fmt.Fprint(tw, "\nPROVIDER\tIMAGES\tLAST SYNC\tFAILED\n")
+	for _, p := range s.Providers {
+		lastSync := "never"
+		if p.LastSyncedAt != nil {
+			lastSync = ago(p.LastSyncedAt.Time, now)
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\t%d\n", p.Name, p.Images, lastSync, p.Failures)
+	}
+
+	if len(s.TopRisks) == 0 {
+		_, _ = fmt.Fprint(tw, "\nNo risk findings\n")
+		return tw.Flush()
+	}
+	_, _ = fmt.Fprint(tw, "\nTOP RISK\tPODS\tFINDINGS\n")
+	for _, f := range s.TopRisks {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\n", f.Image, f.Pods, strings.Join(f.Findings, ", "))
+	}
+	return tw.Flush()
+}
+
+// ago formats how long before now t was
+func ago(t, now time.Time) string {
+	return duration.HumanDuration(now.Sub(t)) + " ago"
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+// ErrVerificationFailed is returned by the verify verb when recorded data does not match
+// the data recomputed from the Pyxis dump
+var ErrVerificationFailed = errors.New("verification failed")
+
+// Mismatch is a recorded value that differs from the value recomputed offline
+type Mismatch struct {
+	Image    string `json:"image"`
+	Field    string `json:"field"`
+	Recorded string `json:"recorded"`
+	Computed string `json:"computed"`
+}
+
+// Verification is the result of checking an inventory against a Pyxis dump
+type Verification struct {
+	// Checked is the number of images looked up in the dump
+	Checked int `json:"checked"`
+	// Skipped is the number of images from registries Pyxis does not certify
+	Skipped    int        `json:"skipped"`
+	Mismatches []Mismatch `json:"mismatches"`
+}
+
+// Verify recomputes the certification status, health grade, and critical and important
+// vulnerability counts of every Red Hat registry image in items from pyxisClient, reporting
+// each value that differs from the recorded one
+func Verify(ctx context.Context, items []securityv1alpha1.ImageCertificationInfo, pyxisClient pyxis.Client) (
+	Verification, error) {
+	result := Verification{Mismatches: []Mismatch{}}
+	for i := range items {
+		cr := &items[i]
+		if image.ClassifyRegistry(cr.Spec.Registry) != securityv1alpha1.RegistryTypeRedHat || cr.Spec.ImageDigest == "" {
+			result.Skipped++
+			continue
+		}
+		result.Checked++
+
+		certData, err := pyxisClient.GetImageCertification(ctx, cr.Spec.Registry, cr.Spec.Repository, cr.Spec.ImageDigest)
+		if err != nil {
+			return Verification{}, fmt.Errorf("unable to look up %s: %w", imageKey(cr), err)
+		}
+		mismatch := func(field, recorded, computed string) {
+			if recorded != computed {
+				result.Mismatches = append(result.Mismatches, Mismatch{
+					Image: imageKey(cr), Field: field, Recorded: orDash(recorded), Computed: orDash(computed),
+				})
+			}
+		}
+
+		computed := securityv1alpha1.CertificationStatusNotCertified
+		if certData != nil {
+			computed = securityv1alpha1.CertificationStatusCertified
+		}
+		recorded := cr.Status.CertificationStatus
+		// Deprecated and EOL refine Certified with lifecycle data the dump does not hold
+		if recorded == securityv1alpha1.CertificationStatusDeprecated || recorded == securityv1alpha1.CertificationStatusEOL {
+			recorded = securityv1alpha1.CertificationStatusCertified
+		}
+		mismatch("certificationStatus", string(recorded), string(computed))
+		if certData == nil || cr.Status.PyxisData == nil {
+			continue
+		}
+
+		mismatch("healthIndex", cr.Status.PyxisData.HealthIndex, certData.HealthIndex)
+		if certData.Vulnerabilities != nil {
+			recorded := vulnerabilities(cr)
+			mismatch("vulnerabilities.critical", strconv.Itoa(recorded.Critical),
+				strconv.Itoa(certData.Vulnerabilities.Critical))
+			mismatch("vulnerabilities.important", strconv.Itoa(recorded.Important),
+				strconv.Itoa(certData.Vulnerabilities.Important))
+		}
+	}
+	return result, nil
+}
+
+// runVerify implements the verify verb
+func runVerify(ctx context.Context, _ client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("verify", out)
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("%w: verify requires an export file and a Pyxis dump", ErrUsage)
+	}
+
+	items, err := LoadExport(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	dump, err := pyxis.LoadDump(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	result, err := Verify(ctx, items, dump)
+	if err != nil {
+		return err
+	}
+
+	if *output == OutputJSON {
+		if err := writeJSON(out, result); err != nil {
+			return err
+		}
+	} else if err := writeVerification(out, &result); err != nil {
+		return err
+	}
+	if len(result.Mismatches) > 0 {
+		return fmt.Errorf("%w: %d mismatches", ErrVerificationFailed, len(result.Mismatches))
+	}
+	return nil
+}
+
+// writeVerification prints the mismatches as a table after a one-line summary
+func writeVerification(w io.Writer, result *Verification) error {
+	_, _ = fmt.Fprintf(w, "Checked %d images against the Pyxis dump (%d from other registries skipped): %d mismatches\n",
+		result.Checked, result.Skipped, len(result.Mismatches))
+	if len(result.Mismatches) == 0 {
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprint(tw, "\nIMAGE\tFIELD\tRECORDED\tCOMPUTED\n")
+	for _, m := range result.Mismatches {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", m.Image, m.Field, m.Recorded, m.Computed)
+	}
+	return tw.Flush()
+}
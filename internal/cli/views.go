@@ -0,0 +1,431 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/search"
+)
+
+// Output formats of the listing commands
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+)
+
+// severities lists the vulnerability severities from most to least severe
+var severities = []string{"critical", "important", "moderate", "low"}
+
+// Summary is the inventory overview printed by the summary command
+type Summary struct {
+	Images                 int                                          `json:"images"`
+	Namespaces             int                                          `json:"namespaces"`
+	Pods                   int                                          `json:"pods"`
+	ByStatus               map[securityv1alpha1.CertificationStatus]int `json:"byStatus"`
+	ByRegistryType         map[securityv1alpha1.RegistryType]int        `json:"byRegistryType"`
+	ImagesWithCriticalCVEs int                                          `json:"imagesWithCriticalCVEs"`
+	ImagesPastEOL          int                                          `json:"imagesPastEOL"`
+}
+
+// NamespaceRow summarizes the images used by the pods of one namespace
+type NamespaceRow struct {
+	Namespace string `json:"namespace"`
+	Images    int    `json:"images"`
+	Certified int    `json:"certified"`
+	// Uncertified counts the images in any other certification state
+	Uncertified int `json:"uncertified"`
+	// Critical and Important total the CVEs of the namespace's images
+	Critical  int `json:"critical"`
+	Important int `json:"important"`
+	Pods      int `json:"pods"`
+}
+
+// ImageRow is one image in the eol and vulnerable listings
+type ImageRow struct {
+	Name                string                                 `json:"name"`
+	Image               string                                 `json:"image"`
+	CertificationStatus securityv1alpha1.CertificationStatus   `json:"certificationStatus,omitempty"`
+	HealthIndex         string                                 `json:"healthIndex,omitempty"`
+	Vulnerabilities     *securityv1alpha1.VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	EOLDate             string                                 `json:"eolDate,omitempty"`
+	DaysUntilEOL        *int                                   `json:"daysUntilEol,omitempty"`
+	Namespaces          []string                               `json:"namespaces,omitempty"`
+}
+
+// BuildSummary counts the tracked images by certification status and registry type
+func BuildSummary(items []securityv1alpha1.ImageCertificationInfo) Summary {
+	s := Summary{
+		Images:         len(items),
+		ByStatus:       make(map[securityv1alpha1.CertificationStatus]int),
+		ByRegistryType: make(map[securityv1alpha1.RegistryType]int),
+	}
+	namespaces := make(map[string]bool)
+	pods := make(map[client.ObjectKey]bool)
+	for i := range items {
+		cr := &items[i]
+		s.ByStatus[statusOf(cr)]++
+		if cr.Status.RegistryType != "" {
+			s.ByRegistryType[cr.Status.RegistryType]++
+		}
+		if vulns := search.Vulnerabilities(cr); vulns != nil && vulns.Critical > 0 {
+			s.ImagesWithCriticalCVEs++
+		}
+		if cr.Status.DaysUntilEOL != nil && *cr.Status.DaysUntilEOL < 0 {
+			s.ImagesPastEOL++
+		}
+		for _, ref := range cr.Status.PodReferences {
+			namespaces[ref.Namespace] = true
+			pods[client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}] = true
+		}
+	}
+	s.Namespaces = len(namespaces)
+	s.Pods = len(pods)
+	return s
+}
+
+// ByNamespace summarizes the tracked images per namespace, ordered by namespace
+func ByNamespace(items []securityv1alpha1.ImageCertificationInfo) []NamespaceRow {
+	rows := make(map[string]*NamespaceRow)
+	pods := make(map[string]map[string]bool)
+	for i := range items {
+		cr := &items[i]
+		vulns := search.Vulnerabilities(cr)
+		for _, ns := range search.Namespaces(cr) {
+			row, ok := rows[ns]
+			if !ok {
+				row = &NamespaceRow{Namespace: ns}
+				rows[ns] = row
+				pods[ns] = make(map[string]bool)
+			}
+			row.Images++
+			if statusOf(cr) == securityv1alpha1.CertificationStatusCertified {
+				row.Certified++
+			} else {
+				row.Uncertified++
+			}
+			if vulns != nil {
+				row.Critical += vulns.Critical
+				row.Important += vulns.Important
+			}
+		}
+		for _, ref := range cr.Status.PodReferences {
+			pods[ref.Namespace][ref.Name] = true
+		}
+	}
+
+	result := make([]NamespaceRow, 0, len(rows))
+	for ns, row := range rows {
+		row.Pods = len(pods[ns])
+		result = append(result, *row)
+	}
+	slices.SortFunc(result, func(a, b NamespaceRow) int { return cmp.Compare(a.Namespace, b.Namespace) })
+	return result
+}
+
+// EOLImages lists the images with a known end-of-life date, soonest first. A positive
+// within keeps only the images that reach end of life within that many days.
+func EOLImages(items []securityv1alpha1.ImageCertificationInfo, within int) []ImageRow {
+	rows := []ImageRow{}
+	for i := range items {
+		cr := &items[i]
+		days := cr.Status.DaysUntilEOL
+		if days == nil || (within > 0 && *days > within) {
+			continue
+		}
+		rows = append(rows, newImageRow(cr))
+	}
+	slices.SortFunc(rows, func(a, b ImageRow) int {
+		return cmp.Or(cmp.Compare(*a.DaysUntilEOL, *b.DaysUntilEOL), cmp.Compare(a.Name, b.Name))
+	})
+	return rows
+}
+
+// VulnerableImages lists the images with at least one CVE of the given severity or higher,
+// most critical first
+func VulnerableImages(items []securityv1alpha1.ImageCertificationInfo, severity string) ([]ImageRow, error) {
+	level := slices.Index(severities, strings.ToLower(severity))
+	if level < 0 {
+		return nil, fmt.Errorf("%w: severity must be one of %s, got %q", ErrUsage,
+			strings.Join(severities, ", "), severity)
+	}
+
+	rows := []ImageRow{}
+	for i := range items {
+		cr := &items[i]
+		vulns := search.Vulnerabilities(cr)
+		if vulns == nil || slices.IndexFunc(severityCounts(vulns)[:level+1], func(n int) bool { return n > 0 }) < 0 {
+			continue
+		}
+		rows = append(rows, newImageRow(cr))
+	}
+	slices.SortFunc(rows, func(a, b ImageRow) int {
+		ac, bc := severityCounts(a.Vulnerabilities), severityCounts(b.Vulnerabilities)
+		for i := range ac {
+			if c := cmp.Compare(bc[i], ac[i]); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return rows, nil
+}
+
+// severityCounts returns the vulnerability counts in the order of severities
+func severityCounts(v *securityv1alpha1.VulnerabilitySummary) []int {
+	return []int{v.Critical, v.Important, v.Moderate, v.Low}
+}
+
+// newImageRow summarizes an image for the eol and vulnerable listings
+func newImageRow(cr *securityv1alpha1.ImageCertificationInfo) ImageRow {
+	row := ImageRow{
+		Name:                cr.Name,
+		Image:               cr.Spec.FullImageReference,
+		CertificationStatus: cr.Status.CertificationStatus,
+		HealthIndex:         pyxisField(cr, func(p *securityv1alpha1.PyxisData) string { return p.HealthIndex }),
+		Vulnerabilities:     search.Vulnerabilities(cr),
+		DaysUntilEOL:        cr.Status.DaysUntilEOL,
+		Namespaces:          search.Namespaces(cr),
+	}
+	if cr.Status.PyxisData != nil && cr.Status.PyxisData.EOLDate != nil {
+		row.EOLDate = cr.Status.PyxisData.EOLDate.Format("2006-01-02")
+	}
+	return row
+}
+
+// statusOf returns the certification status of an image, Unknown when not yet set
+func statusOf(cr *securityv1alpha1.ImageCertificationInfo) securityv1alpha1.CertificationStatus {
+	if cr.Status.CertificationStatus == "" {
+		return securityv1alpha1.CertificationStatusUnknown
+	}
+	return cr.Status.CertificationStatus
+}
+
+// newListFlagSet returns the flag set of a listing command with its --output flag
+func newListFlagSet(name string, out io.Writer) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(out)
+	output := fs.String("output", OutputTable, "Output format: table or json")
+	fs.StringVar(output, "o", OutputTable, "Shorthand for --output")
+	return fs, output
+}
+
+// parseListFlags parses the arguments of a listing command and validates the output format
+func parseListFlags(fs *flag.FlagSet, output *string, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return ErrUsage
+	}
+	if *output != OutputTable && *output != OutputJSON {
+		return fmt.Errorf("%w: --output must be %s or %s, got %q", ErrUsage, OutputTable, OutputJSON, *output)
+	}
+	return nil
+}
+
+// listImages returns all tracked images
+func listImages(ctx context.Context, c client.Client) ([]securityv1alpha1.ImageCertificationInfo, error) {
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := c.List(ctx, &list); err != nil {
+		return nil, fmt.Errorf("unable to list ImageCertificationInfos: %w", err)
+	}
+	return list.Items, nil
+}
+
+// runSummary implements the summary verb
+func runSummary(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("summary", out)
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: summary takes no arguments", ErrUsage)
+	}
+	items, err := listImages(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	s := BuildSummary(items)
+	if *output == OutputJSON {
+		return writeJSON(out, s)
+	}
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "Images:\t%d\nNamespaces:\t%d\nPods:\t%d\n\n", s.Images, s.Namespaces, s.Pods)
+	_, _ = fmt.Fprint(tw, "CERTIFICATION\tIMAGES\n")
+	for _, status := range sortedKeys(s.ByStatus) {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\n", status, s.ByStatus[status])
+	}
+	_, _ = fmt.Fprint(tw, "\nREGISTRY TYPE\tIMAGES\n")
+	for _, registryType := range sortedKeys(s.ByRegistryType) {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\n", registryType, s.ByRegistryType[registryType])
+	}
+	_, _ = fmt.Fprintf(tw, "\nImages with critical CVEs:\t%d\nImages past end of life:\t%d\n",
+		s.ImagesWithCriticalCVEs, s.ImagesPastEOL)
+	return tw.Flush()
+}
+
+// runByNamespace implements the by-namespace verb
+func runByNamespace(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("by-namespace", out)
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: by-namespace takes no arguments", ErrUsage)
+	}
+	items, err := listImages(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	rows := ByNamespace(items)
+	if *output == OutputJSON {
+		return writeJSON(out, rows)
+	}
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprint(tw, "NAMESPACE\tIMAGES\tCERTIFIED\tUNCERTIFIED\tCRITICAL\tIMPORTANT\tPODS\n")
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%d\n", row.Namespace, row.Images, row.Certified,
+			row.Uncertified, row.Critical, row.Important, row.Pods)
+	}
+	return tw.Flush()
+}
+
+// runEOL implements the eol verb
+func runEOL(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("eol", out)
+	within := fs.Int("within", 0, "Only list images reaching end of life within this many days (0 lists all)")
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: eol takes no arguments", ErrUsage)
+	}
+	items, err := listImages(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	rows := EOLImages(items, *within)
+	if *output == OutputJSON {
+		return writeJSON(out, rows)
+	}
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprint(tw, "IMAGE\tEOL DATE\tDAYS\tCERTIFICATION\tNAMESPACES\n")
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\n", row.Image, orDash(row.EOLDate), *row.DaysUntilEOL,
+			orDash(string(row.CertificationStatus)), orDash(strings.Join(row.Namespaces, ",")))
+	}
+	return tw.Flush()
+}
+
+// runVulnerable implements the vulnerable verb
+func runVulnerable(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("vulnerable", out)
+	severity := fs.String("severity", "important", "Lowest severity listed: critical, important, moderate, or low")
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("%w: vulnerable takes no arguments", ErrUsage)
+	}
+	items, err := listImages(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	rows, err := VulnerableImages(items, *severity)
+	if err != nil {
+		return err
+	}
+	if *output == OutputJSON {
+		return writeJSON(out, rows)
+	}
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprint(tw, "IMAGE\tCRITICAL\tIMPORTANT\tMODERATE\tLOW\tHEALTH\tNAMESPACES\n")
+	for _, row := range rows {
+		v := row.Vulnerabilities
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n", row.Image, v.Critical, v.Important, v.Moderate, v.Low,
+			orDash(row.HealthIndex), orDash(strings.Join(row.Namespaces, ",")))
+	}
+	return tw.Flush()
+}
+
+// runPods implements the pods verb
+func runPods(ctx context.Context, c client.Client, args []string, out io.Writer) error {
+	fs, output := newListFlagSet("pods", out)
+	if err := parseListFlags(fs, output, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("%w: pods requires exactly one image reference", ErrUsage)
+	}
+	cr, err := Resolve(ctx, c, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	refs := slices.Clone(cr.Status.PodReferences)
+	slices.SortFunc(refs, func(a, b securityv1alpha1.PodReference) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Container, b.Container))
+	})
+	if *output == OutputJSON {
+		if refs == nil {
+			refs = []securityv1alpha1.PodReference{}
+		}
+		return writeJSON(out, refs)
+	}
+	tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprint(tw, "NAMESPACE\tPOD\tCONTAINER\tTYPE\tWORKLOAD\n")
+	for _, ref := range refs {
+		workload := ""
+		if ref.WorkloadKind != "" {
+			workload = ref.WorkloadKind + "/" + ref.WorkloadName
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", ref.Namespace, ref.Name, ref.Container,
+			orDash(string(ref.ContainerType)), orDash(workload))
+	}
+	return tw.Flush()
+}
+
+// writeJSON writes v as indented JSON
+func writeJSON(out io.Writer, v any) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// sortedKeys returns the keys of a count map in order
+func sortedKeys[K ~string](m map[K]int) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consoleplugin deploys the OpenShift Console dynamic plugin that adds an
+// Image Certification view to the web console.
+package consoleplugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultName is the name of the ConsolePlugin and its backing workload
+const DefaultName = "imagecertinfo-console-plugin"
+
+// Port is the HTTPS port the plugin assets are served on
+const Port = 9443
+
+// retryInterval is how long to wait before retrying a failed install
+const retryInterval = time.Minute
+
+// ConsolePluginGVK identifies the OpenShift ConsolePlugin resource
+var ConsolePluginGVK = schema.GroupVersionKind{Group: "console.openshift.io", Version: "v1", Kind: "ConsolePlugin"}
+
+// nginxConfig serves the plugin assets over TLS using the service serving certificate
+const nginxConfig = `error_log /dev/stdout info;
+pid /tmp/nginx.pid;
+events {}
+http {
+  access_log         /dev/stdout;
+  include            /etc/nginx/mime.types;
+  default_type       application/octet-stream;
+  keepalive_timeout  65;
+  client_body_temp_path /tmp/client_temp;
+  proxy_temp_path       /tmp/proxy_temp;
+  fastcgi_temp_path     /tmp/fastcgi_temp;
+  uwsgi_temp_path       /tmp/uwsgi_temp;
+  scgi_temp_path        /tmp/scgi_temp;
+  server {
+    listen              %d ssl;
+    listen              [::]:%d ssl;
+    ssl_certificate     /var/cert/tls.crt;
+    ssl_certificate_key /var/cert/tls.key;
+    root                /usr/share/nginx/html;
+  }
+}
+`
+
+// +kubebuilder:rbac:groups=console.openshift.io,resources=consoleplugins,verbs=get;create;update
+
+// Installer creates or updates the console plugin Deployment, Service, ConfigMap,
+// and ConsolePlugin. It runs once on the elected leader at startup.
+type Installer struct {
+	// Client must not be backed by the manager cache, which would otherwise
+	// start cluster-wide informers for Deployments, Services, and ConfigMaps
+	Client client.Client
+	// Namespace is the namespace the plugin workload runs in
+	Namespace string
+	// Name is the name of the plugin and its resources
+	Name string
+	// Image is the container image serving the plugin assets
+	Image string
+}
+
+// Start installs the plugin, retrying until it succeeds or ctx is cancelled
+func (i *Installer) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("console-plugin")
+	for {
+		err := i.Install(ctx)
+		if err == nil {
+			logger.Info("console plugin installed", "name", i.Name, "namespace", i.Namespace)
+			return nil
+		}
+		logger.Error(err, "failed to install console plugin, retrying", "retryInterval", retryInterval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// Install creates or updates all plugin resources
+func (i *Installer) Install(ctx context.Context) error {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       i.Name,
+		"app.kubernetes.io/component":  "console-plugin",
+		"app.kubernetes.io/managed-by": "imagecertinfo-operator",
+	}
+	meta := metav1.ObjectMeta{Name: i.Name, Namespace: i.Namespace}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: meta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, i.Client, configMap, func() error {
+		configMap.Labels = labels
+		configMap.Data = map[string]string{"nginx.conf": fmt.Sprintf(nginxConfig, Port, Port)}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to apply ConfigMap: %w", err)
+	}
+
+	service := &corev1.Service{ObjectMeta: meta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, i.Client, service, func() error {
+		service.Labels = labels
+		if service.Annotations == nil {
+			service.Annotations = map[string]string{}
+		}
+		// OpenShift generates the TLS secret the console requires for plugin backends
+		service.Annotations["service.beta.openshift.io/serving-cert-secret-name"] = i.certSecretName()
+		service.Spec.Selector = labels
+		service.Spec.Ports = []corev1.ServicePort{{
+			Name:       "https",
+			Port:       Port,
+			TargetPort: intstr.FromInt32(Port),
+			Protocol:   corev1.ProtocolTCP,
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to apply Service: %w", err)
+	}
+
+	deployment := &appsv1.Deployment{ObjectMeta: meta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, i.Client, deployment, func() error {
+		deployment.Labels = labels
+		deployment.Spec.Replicas = ptr.To[int32](1)
+		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+		deployment.Spec.Template.Labels = labels
+		deployment.Spec.Template.Spec = i.podSpec()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to apply Deployment: %w", err)
+	}
+
+	plugin := &unstructured.Unstructured{}
+	plugin.SetGroupVersionKind(ConsolePluginGVK)
+	plugin.SetName(i.Name)
+	if _, err := controllerutil.CreateOrUpdate(ctx, i.Client, plugin, func() error {
+		plugin.SetLabels(labels)
+		return unstructured.SetNestedMap(plugin.Object, map[string]any{
+			"displayName": "Image Certification",
+			"backend": map[string]any{
+				"type": "Service",
+				"service": map[string]any{
+					"name":      i.Name,
+					"namespace": i.Namespace,
+					"port":      int64(Port),
+					"basePath":  "/",
+				},
+			},
+		}, "spec")
+	}); err != nil {
+		return fmt.Errorf("failed to apply ConsolePlugin: %w", err)
+	}
+
+	return nil
+}
+
+// certSecretName is the name of the serving certificate secret
+func (i *Installer) certSecretName() string {
+	return i.Name + "-cert"
+}
+
+// podSpec builds the pod template for the nginx server hosting the plugin assets
+func (i *Installer) podSpec() corev1.PodSpec {
+	return corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   ptr.To(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []corev1.Container{{
+			Name:  "plugin",
+			Image: i.Image,
+			Ports: []corev1.ContainerPort{{ContainerPort: Port, Protocol: corev1.ProtocolTCP}},
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10m"),
+					corev1.ResourceMemory: resource.MustParse("50Mi"),
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "plugin-cert", MountPath: "/var/cert", ReadOnly: true},
+				{Name: "nginx-conf", MountPath: "/etc/nginx/nginx.conf", SubPath: "nginx.conf", ReadOnly: true},
+			},
+		}},
+		Volumes: []corev1.Volume{
+			{
+				Name: "plugin-cert",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: i.certSecretName(), DefaultMode: ptr.To[int32](0o420)},
+				},
+			},
+			{
+				Name: "nginx-conf",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: i.Name},
+						DefaultMode:          ptr.To[int32](0o420),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consoleplugin
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNamespace = "imagecertinfo-operator-system"
+
+func TestInstaller_Install(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+
+	installer := &Installer{Client: c, Namespace: testNamespace, Name: DefaultName, Image: "quay.io/example/plugin:v1"}
+	if err := installer.Install(ctx); err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+
+	key := types.NamespacedName{Name: DefaultName, Namespace: testNamespace}
+
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, key, deployment); err != nil {
+		t.Fatalf("expected Deployment: %v", err)
+	}
+	if got := deployment.Spec.Template.Spec.Containers[0].Image; got != installer.Image {
+		t.Errorf("Deployment image = %s, want %s", got, installer.Image)
+	}
+
+	service := &corev1.Service{}
+	if err := c.Get(ctx, key, service); err != nil {
+		t.Fatalf("expected Service: %v", err)
+	}
+	if got := service.Annotations["service.beta.openshift.io/serving-cert-secret-name"]; got != DefaultName+"-cert" {
+		t.Errorf("serving cert annotation = %q", got)
+	}
+
+	if err := c.Get(ctx, key, &corev1.ConfigMap{}); err != nil {
+		t.Fatalf("expected ConfigMap: %v", err)
+	}
+
+	plugin := &unstructured.Unstructured{}
+	plugin.SetGroupVersionKind(ConsolePluginGVK)
+	if err := c.Get(ctx, types.NamespacedName{Name: DefaultName}, plugin); err != nil {
+		t.Fatalf("expected ConsolePlugin: %v", err)
+	}
+	if ns, _, _ := unstructured.NestedString(plugin.Object, "spec", "backend", "service", "namespace"); ns != testNamespace {
+		t.Errorf("ConsolePlugin backend namespace = %q, want %q", ns, testNamespace)
+	}
+
+	// A second install with a new image updates in place
+	installer.Image = "quay.io/example/plugin:v2"
+	if err := installer.Install(ctx); err != nil {
+		t.Fatalf("second Install() error = %v", err)
+	}
+	if err := c.Get(ctx, key, deployment); err != nil {
+		t.Fatalf("expected Deployment: %v", err)
+	}
+	if got := deployment.Spec.Template.Spec.Containers[0].Image; got != installer.Image {
+		t.Errorf("Deployment image after update = %s, want %s", got, installer.Image)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// architectureAliases maps alternative architecture names to the Go names used by node labels
+var architectureAliases = map[string]string{
+	"x86_64":  "amd64",
+	"aarch64": "arm64",
+}
+
+// ArchitectureCoverage compares the CPU architectures of the cluster nodes with the
+// architectures each image supports, recording the gaps in the image status and in the
+// images_missing_architecture metric
+type ArchitectureCoverage struct {
+	client.Client
+	// Interval is how often coverage is recomputed
+	Interval time.Duration
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+// Start recomputes architecture coverage every Interval until ctx is cancelled. It runs
+// only on the elected leader so that replicas do not duplicate writes.
+func (a *ArchitectureCoverage) Start(ctx context.Context) error {
+	ticker := time.NewTicker(a.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Check(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to check image architecture coverage")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check updates MissingArchitectures on every image whose gaps changed
+func (a *ArchitectureCoverage) Check(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("architecture-coverage")
+
+	nodeArchs, err := a.nodeArchitectures(ctx)
+	if err != nil {
+		return err
+	}
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := a.List(ctx, &crList); err != nil {
+		return err
+	}
+
+	missingCounts := make(map[string]int)
+	for i := range crList.Items {
+		cr := &crList.Items[i]
+		missing := missingArchitectures(nodeArchs, cr)
+		for _, arch := range missing {
+			missingCounts[arch]++
+		}
+		if slices.Equal(missing, cr.Status.MissingArchitectures) {
+			continue
+		}
+
+		patch := client.MergeFrom(cr.DeepCopy())
+		cr.Status.MissingArchitectures = missing
+		if err := a.Status().Patch(ctx, cr, patch); client.IgnoreNotFound(err) != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Error(err, "failed to update missing architectures", "name", cr.Name)
+		}
+	}
+
+	metrics.SetImagesMissingArchitecture(nodeArchs, missingCounts)
+	return nil
+}
+
+// nodeArchitectures returns the sorted, distinct CPU architectures of the cluster nodes.
+// Only node metadata is read, which keeps the node cache small on large clusters.
+func (a *ArchitectureCoverage) nodeArchitectures(ctx context.Context) ([]string, error) {
+	var nodes metav1.PartialObjectMetadataList
+	nodes.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+	if err := a.List(ctx, &nodes); err != nil {
+		return nil, err
+	}
+
+	var archs []string
+	for _, node := range nodes.Items {
+		arch := normalizeArchitecture(node.Labels[corev1.LabelArchStable])
+		if arch != "" && !slices.Contains(archs, arch) {
+			archs = append(archs, arch)
+		}
+	}
+	slices.Sort(archs)
+	return archs, nil
+}
+
+// missingArchitectures returns the node architectures the image does not support, or nil
+// when the image's supported architectures are unknown
+func missingArchitectures(nodeArchs []string, cr *securityv1alpha1.ImageCertificationInfo) []string {
+	var archs []string
+	if cr.Status.PyxisData != nil {
+		archs = cr.Status.PyxisData.Architectures
+	}
+	// Images outside Red Hat registries report the platforms of their manifest list
+	if len(archs) == 0 && cr.Status.RegistryData != nil {
+		archs = cr.Status.RegistryData.Architectures
+	}
+	if len(archs) == 0 {
+		return nil
+	}
+
+	supported := make(map[string]bool, len(archs))
+	for _, arch := range archs {
+		supported[normalizeArchitecture(arch)] = true
+	}
+
+	var missing []string
+	for _, arch := range nodeArchs {
+		if !supported[arch] {
+			missing = append(missing, arch)
+		}
+	}
+	return missing
+}
+
+// normalizeArchitecture converts an architecture name to the Go name used by node labels
+func normalizeArchitecture(arch string) string {
+	if alias, ok := architectureAliases[arch]; ok {
+		return alias
+	}
+	return arch
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestArchitectureCoverage_Check(t *testing.T) {
+	ctx := context.Background()
+
+	node := func(name, arch string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelArchStable: arch}}}
+	}
+	image := func(name string, archs ...string) *securityv1alpha1.ImageCertificationInfo {
+		cr := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if archs != nil {
+			cr.Status.PyxisData = &securityv1alpha1.PyxisData{Architectures: archs}
+		}
+		return cr
+	}
+	amd64Only := image("amd64-only", "amd64")
+	multiArch := image("multi-arch", "x86_64", "aarch64")
+	unknown := image("unknown")
+	// Images outside Red Hat registries fall back to the architectures read from their registry
+	quay := image("quay")
+	quay.Status.RegistryData = &securityv1alpha1.RegistryData{Architectures: []string{"amd64"}}
+	// Gaps that were fixed are cleared
+	multiArch.Status.MissingArchitectures = []string{"arm64"}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(node("worker-0", "amd64"), node("worker-1", "amd64"), node("worker-2", "arm64"),
+			amd64Only, multiArch, unknown, quay).
+		WithStatusSubresource(amd64Only, multiArch, unknown, quay).
+		Build()
+	coverage := &ArchitectureCoverage{Client: fakeClient}
+
+	if err := coverage.Check(ctx); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	want := map[string][]string{
+		"amd64-only": {"arm64"},
+		"multi-arch": nil,
+		"unknown":    nil,
+		"quay":       {"arm64"},
+	}
+	for name, missing := range want {
+		var cr securityv1alpha1.ImageCertificationInfo
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, &cr); err != nil {
+			t.Fatalf("Failed to get %s: %v", name, err)
+		}
+		if !slices.Equal(cr.Status.MissingArchitectures, missing) {
+			t.Errorf("%s: MissingArchitectures = %v, want %v", name, cr.Status.MissingArchitectures, missing)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
+)
+
+// Default backoff for retrying images whose certification lookup has not succeeded
+const (
+	DefaultCertificationRetryBaseInterval = time.Minute
+	DefaultCertificationRetryMaxInterval  = 30 * time.Minute
+)
+
+// AnnotationFreshnessGap records on an ImageCertificationInfo the periods during which its
+// certification data could not be refreshed because of a provider outage, as
+// provider=start/end intervals
+const AnnotationFreshnessGap = "security.telco.openshift.io/freshness-gap"
+
+// CertificationRetryReconciler retries the provider lookups of images left in the Error or
+// Unknown certification state, doubling the delay after each failed attempt, so that images
+// recover from a transient API outage within minutes instead of at the next refresh cycle
+type CertificationRetryReconciler struct {
+	client.Client
+	// Pods performs the lookups and decides which images this instance owns
+	Pods *PodReconciler
+	// BaseInterval is the delay before the first retry
+	BaseInterval time.Duration
+	// MaxInterval caps the delay between retries
+	MaxInterval time.Duration
+	// Outages, if set, counts the lookups deferred during provider outages
+	Outages *health.OutageTracker
+
+	// retries tracks the backoff of each image awaiting a retry
+	retries   map[string]*certificationRetry
+	retriesMu sync.Mutex
+}
+
+// certificationRetry is the backoff state of a single image
+type certificationRetry struct {
+	// failures is the number of failed retries
+	failures int
+	// next is when the image is retried
+	next time.Time
+	// settled is set once a lookup succeeded without giving the image a certification status
+	settled bool
+}
+
+// Reconcile retries the lookup of an image in the Error or Unknown state once its backoff
+// has elapsed and requeues it until a lookup succeeds
+func (r *CertificationRetryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := r.Get(ctx, req.NamespacedName, &cr); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forget(req.Name)
+			r.Pods.Journal.Complete(req.Name)
+			r.Outages.Forget(req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !r.Pods.Shard.Owns(cr.Name) {
+		// The journal entry belongs to the shard member that owns the image
+		r.forget(cr.Name)
+		return ctrl.Result{}, nil
+	}
+	if !needsCertificationRetry(&cr) {
+		r.forget(cr.Name)
+		r.Pods.Journal.Complete(cr.Name)
+		r.resolveOutages(ctx, cr.Name)
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	retry := r.retry(cr.Name, now)
+	if retry.settled {
+		return ctrl.Result{}, nil
+	}
+	r.Outages.Defer(cr.Name)
+	if wait := retry.next.Sub(now); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	err := r.Pods.refreshSingleImage(ctx, &cr)
+	if ctx.Err() != nil {
+		return ctrl.Result{}, nil
+	}
+	if err == nil {
+		// The image leaves the retry set when its new status is observed; one that is still
+		// Unknown has no provider verdict and is left to the refresh loop
+		r.settle(cr.Name)
+		r.Pods.Journal.Complete(cr.Name)
+		r.resolveOutages(ctx, cr.Name)
+		return ctrl.Result{}, nil
+	}
+
+	delay := r.fail(cr.Name, time.Now())
+	logger.V(1).Info("certification lookup failed, retrying", "name", cr.Name,
+		"status", cr.Status.CertificationStatus, "retryAfter", delay, "error", err.Error())
+	return ctrl.Result{RequeueAfter: delay}, nil
+}
+
+// resolveOutages records that the lookup of an image is no longer deferred and annotates
+// the image with the freshness gaps of the provider outages that had deferred it. The
+// annotation is best effort; the outage summary does not depend on it.
+func (r *CertificationRetryReconciler) resolveOutages(ctx context.Context, name string) {
+	gaps := r.Outages.Resolve(name)
+	if len(gaps) == 0 {
+		return
+	}
+	intervals := make([]string, len(gaps))
+	for i, gap := range gaps {
+		intervals[i] = fmt.Sprintf("%s=%s/%s", gap.Provider,
+			gap.From.UTC().Format(time.RFC3339), gap.To.UTC().Format(time.RFC3339))
+	}
+	data, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": map[string]string{
+			AnnotationFreshnessGap: strings.Join(intervals, ","),
+		}},
+	})
+	if err == nil {
+		cr := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		err = client.IgnoreNotFound(r.Patch(ctx, cr, client.RawPatch(types.MergePatchType, data)))
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to annotate freshness gap", "name", name)
+	}
+}
+
+// needsCertificationRetry reports whether an image has no certification result yet
+func needsCertificationRetry(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	return cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusError ||
+		cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusUnknown
+}
+
+// retry returns the backoff state of an image, scheduling the first retry one base
+// interval from now so that the enrichment of a newly discovered image can finish first.
+// An image journaled by the previous run resumes its backoff instead, so a lookup
+// interrupted by a restart is retried at once.
+func (r *CertificationRetryReconciler) retry(name string, now time.Time) certificationRetry {
+	r.retriesMu.Lock()
+	defer r.retriesMu.Unlock()
+	if r.retries == nil {
+		r.retries = make(map[string]*certificationRetry)
+	}
+	retry, ok := r.retries[name]
+	if !ok {
+		retry = &certificationRetry{next: now.Add(r.BaseInterval)}
+		if intent, ok := r.Pods.Journal.Restored(name); ok {
+			retry = &certificationRetry{failures: intent.Attempts, next: intent.NextRetry}
+		}
+		r.retries[name] = retry
+	}
+	return *retry
+}
+
+// fail records a failed retry and returns the delay before the next one
+func (r *CertificationRetryReconciler) fail(name string, now time.Time) time.Duration {
+	r.retriesMu.Lock()
+	defer r.retriesMu.Unlock()
+	retry, ok := r.retries[name]
+	if !ok {
+		retry = &certificationRetry{}
+		r.retries[name] = retry
+	}
+	retry.failures++
+	delay := r.backoff(retry.failures)
+	retry.next = now.Add(delay)
+	r.Pods.Journal.Fail(name, retry.failures, retry.next)
+	return delay
+}
+
+// settle stops retrying an image until it is forgotten
+func (r *CertificationRetryReconciler) settle(name string) {
+	r.retriesMu.Lock()
+	defer r.retriesMu.Unlock()
+	if retry, ok := r.retries[name]; ok {
+		retry.settled = true
+	}
+}
+
+// forget drops the backoff state of an image
+func (r *CertificationRetryReconciler) forget(name string) {
+	r.retriesMu.Lock()
+	defer r.retriesMu.Unlock()
+	delete(r.retries, name)
+}
+
+// backoff returns the base interval doubled once per failure, capped at the maximum interval
+func (r *CertificationRetryReconciler) backoff(failures int) time.Duration {
+	delay := r.BaseInterval
+	for range failures {
+		if delay >= r.MaxInterval {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, r.MaxInterval)
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *CertificationRetryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&securityv1alpha1.ImageCertificationInfo{}).
+		Named("certificationretry")
+	if r.Pods.Shard != nil {
+		// Every instance retries the images of its own shard, like the Pod controller
+		b = b.WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)})
+	}
+	return b.Complete(r)
+}
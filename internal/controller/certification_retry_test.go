@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+func TestCertificationRetryReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest: testDigest,
+			Registry:    "registry.redhat.io",
+			Repository:  "ubi8/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusError,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cr).
+		WithStatusSubresource(cr).
+		Build()
+
+	mockPyxis := &MockPyxisClient{Err: errors.New("service unavailable")}
+	outages := health.NewOutageTracker()
+	outages.Observe("pyxis", false)
+	reconciler := &CertificationRetryReconciler{
+		Client:       fakeClient,
+		Pods:         &PodReconciler{Client: fakeClient, Scheme: scheme, PyxisClient: mockPyxis},
+		BaseInterval: time.Minute,
+		MaxInterval:  30 * time.Minute,
+		Outages:      outages,
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testCRName}}
+	reconcileDue := func() reconcile.Result {
+		t.Helper()
+		if retry, ok := reconciler.retries[testCRName]; ok {
+			retry.next = time.Time{}
+		}
+		result, err := reconciler.Reconcile(ctx, req)
+		if err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		return result
+	}
+
+	// The first retry waits one base interval
+	result, err := reconciler.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.RequeueAfter != time.Minute {
+		t.Errorf("first RequeueAfter = %v, want 1m", result.RequeueAfter)
+	}
+
+	// Failed retries back off
+	if result := reconcileDue(); result.RequeueAfter != 2*time.Minute {
+		t.Errorf("RequeueAfter after one failure = %v, want 2m", result.RequeueAfter)
+	}
+	if result := reconcileDue(); result.RequeueAfter != 4*time.Minute {
+		t.Errorf("RequeueAfter after two failures = %v, want 4m", result.RequeueAfter)
+	}
+
+	// Once Pyxis recovers the image is certified and no longer requeued
+	outages.Observe("pyxis", true)
+	mockPyxis.Err = nil
+	mockPyxis.CertData = &pyxis.CertificationData{ProjectID: "ubi8-container", HealthIndex: "A"}
+	if result := reconcileDue(); !result.IsZero() {
+		t.Errorf("result after a successful lookup = %+v, want no requeue", result)
+	}
+	var updated securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &updated); err != nil {
+		t.Fatalf("failed to get ImageCertificationInfo: %v", err)
+	}
+	if updated.Status.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
+		t.Errorf("CertificationStatus = %v, want Certified", updated.Status.CertificationStatus)
+	}
+
+	// The outage deferred the image's lookup until it drained, leaving a freshness gap
+	gap := updated.Annotations[AnnotationFreshnessGap]
+	if !strings.HasPrefix(gap, "pyxis=") || !strings.Contains(gap, "/") {
+		t.Errorf("%s = %q, want a pyxis interval", AnnotationFreshnessGap, gap)
+	}
+	outage := outages.Outages()[0]
+	if outage.DeferredLookups != 1 || outage.PendingLookups != 0 || outage.DrainedAt == nil {
+		t.Errorf("outage = %+v, want one deferred lookup, drained", outage)
+	}
+
+	if result := reconcileDue(); !result.IsZero() {
+		t.Errorf("result for a certified image = %+v, want no requeue", result)
+	}
+	if _, ok := reconciler.retries[testCRName]; ok {
+		t.Error("backoff state kept for a certified image")
+	}
+}
+
+func TestCertificationRetryReconciler_Backoff(t *testing.T) {
+	r := &CertificationRetryReconciler{BaseInterval: time.Minute, MaxInterval: 10 * time.Minute}
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, time.Minute},
+		{1, 2 * time.Minute},
+		{3, 8 * time.Minute},
+		{4, 10 * time.Minute},
+		{100, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := r.backoff(tt.failures); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultClusterReportInterval is the default interval between ClusterCertificationReport rebuilds
+const DefaultClusterReportInterval = 10 * time.Minute
+
+// maxReportedImages bounds the images listed as past end-of-life in the report
+const maxReportedImages = 100
+
+// maxReportedWorkloads bounds the workloads listed for each image in the report
+const maxReportedWorkloads = 20
+
+// ClusterReportBuilder maintains the ClusterCertificationReport, a single cluster-wide
+// summary of every ImageCertificationInfo, so that auditors can read one object
+// instead of listing every image
+type ClusterReportBuilder struct {
+	client.Client
+	// Interval is how often the report is rebuilt
+	Interval time.Duration
+	// Outages, if set, provides the provider outages listed in the report
+	Outages *health.OutageTracker
+}
+
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=clustercertificationreports,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=clustercertificationreports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertpolicies,verbs=get;list;watch
+
+// Start rebuilds the report every Interval until ctx is cancelled. It runs only on the
+// elected leader so that replicas do not duplicate writes.
+func (b *ClusterReportBuilder) Start(ctx context.Context) error {
+	ticker := time.NewTicker(b.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.Rebuild(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to rebuild the cluster certification report")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rebuild recomputes the report from the current ImageCertificationInfos, creating it if needed
+func (b *ClusterReportBuilder) Rebuild(ctx context.Context) error {
+	var report securityv1alpha1.ClusterCertificationReport
+	err := b.Get(ctx, client.ObjectKey{Name: securityv1alpha1.ClusterCertificationReportName}, &report)
+	if apierrors.IsNotFound(err) {
+		report = securityv1alpha1.ClusterCertificationReport{
+			ObjectMeta: metav1.ObjectMeta{Name: securityv1alpha1.ClusterCertificationReportName},
+			Spec:       securityv1alpha1.ClusterCertificationReportSpec{TopImages: securityv1alpha1.DefaultReportTopImages},
+		}
+		err = b.Create(ctx, &report)
+	}
+	if err != nil {
+		return err
+	}
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := b.List(ctx, &crList); err != nil {
+		return err
+	}
+
+	items := filterByOperatingSystem(crList.Items, report.Spec.OperatingSystems)
+	topImages := report.Spec.TopImages
+	if topImages <= 0 {
+		topImages = securityv1alpha1.DefaultReportTopImages
+	}
+	var policyList securityv1alpha1.ImageCertPolicyList
+	if err := b.List(ctx, &policyList); err != nil {
+		return err
+	}
+
+	report.Status = buildClusterReport(items, topImages)
+	compliance := complianceScore(items, violatingImages(policyList.Items))
+	report.Status.Compliance = compliance
+	metrics.SetClusterCompliance(compliance.Score, compliance.Grade, map[string]int{
+		"certification":   compliance.Certification,
+		"vulnerabilities": compliance.Vulnerabilities,
+		"end_of_life":     compliance.EndOfLife,
+		"policy":          compliance.Policy,
+	})
+	report.Status.ProviderOutages = b.Outages.Outages()
+	riskScores := make(map[string]int, len(report.Status.Namespaces))
+	for _, ns := range report.Status.Namespaces {
+		riskScores[ns.Namespace] = ns.RiskScore
+	}
+	metrics.SetNamespaceRiskScores(riskScores)
+	now := metav1.Now()
+	report.Status.LastUpdatedAt = &now
+	return b.Status().Update(ctx, &report)
+}
+
+// filterByOperatingSystem returns the images published for one of the operating systems,
+// or all images when none are given
+func filterByOperatingSystem(items []securityv1alpha1.ImageCertificationInfo,
+	systems []string) []securityv1alpha1.ImageCertificationInfo {
+	if len(systems) == 0 {
+		return items
+	}
+	var filtered []securityv1alpha1.ImageCertificationInfo
+	for i := range items {
+		if slices.ContainsFunc(items[i].Status.OperatingSystems, func(os string) bool {
+			return slices.Contains(systems, os)
+		}) {
+			filtered = append(filtered, items[i])
+		}
+	}
+	return filtered
+}
+
+// buildClusterReport aggregates the images into a report status
+func buildClusterReport(items []securityv1alpha1.ImageCertificationInfo,
+	topImages int) securityv1alpha1.ClusterCertificationReportStatus {
+	status := securityv1alpha1.ClusterCertificationReportStatus{
+		TotalImages:           len(items),
+		ByRegistryType:        make(map[string]int),
+		ByCertificationStatus: make(map[string]int),
+		ByHealthGrade:         make(map[string]int),
+		ByOperatingSystem:     make(map[string]map[string]int),
+		MissingArchitectures:  make(map[string]int),
+	}
+
+	type workloadKey struct{ namespace, kind, name string }
+	affected := make(map[workloadKey]bool)
+	namespaces := make(map[string]*securityv1alpha1.NamespaceCertificationSummary)
+	namespaceWorkloads := make(map[workloadKey]bool)
+	var vulnerable []securityv1alpha1.ReportedImage
+
+	for i := range items {
+		cr := &items[i]
+		certStatus := cr.Status.CertificationStatus
+		if certStatus == "" {
+			certStatus = securityv1alpha1.CertificationStatusPending
+		}
+		status.ByRegistryType[string(cmp.Or(cr.Status.RegistryType, securityv1alpha1.RegistryTypeUnknown))]++
+		status.ByCertificationStatus[string(certStatus)]++
+		systems := cr.Status.OperatingSystems
+		if len(systems) == 0 {
+			systems = []string{osUnknown}
+		}
+		for _, os := range systems {
+			if status.ByOperatingSystem[os] == nil {
+				status.ByOperatingSystem[os] = make(map[string]int)
+			}
+			status.ByOperatingSystem[os][string(certStatus)]++
+		}
+		if cr.Status.PyxisData != nil && cr.Status.PyxisData.HealthIndex != "" {
+			status.ByHealthGrade[cr.Status.PyxisData.HealthIndex]++
+		}
+		for _, arch := range cr.Status.MissingArchitectures {
+			status.MissingArchitectures[arch]++
+		}
+
+		workloads := cr.Status.Workloads
+		if workloads == nil {
+			workloads = summarizeWorkloads(cr.Status.PodReferences)
+		}
+		vulns := vulnerabilitySummary(cr)
+		critical := vulns != nil && vulns.Critical > 0
+		pastEOL := cr.Status.DaysUntilEOL != nil && *cr.Status.DaysUntilEOL < 0
+		isAffected := critical || pastEOL || len(cr.Status.MissingArchitectures) > 0
+		risk := imageRisk(vulns)
+
+		entry := reportedImage(cr, vulns, workloads)
+		if pastEOL {
+			status.ImagesPastEOL = append(status.ImagesPastEOL, entry)
+		}
+		if vulns != nil && (vulns.Critical > 0 || vulns.Important > 0) {
+			vulnerable = append(vulnerable, entry)
+		}
+
+		// Count the image once per namespace that uses it
+		counted := make(map[string]bool)
+		for _, w := range workloads {
+			key := workloadKey{w.Namespace, w.Kind, w.Name}
+			if isAffected {
+				affected[key] = true
+			}
+
+			ns := namespaces[w.Namespace]
+			if ns == nil {
+				ns = &securityv1alpha1.NamespaceCertificationSummary{
+					Namespace:             w.Namespace,
+					ByCertificationStatus: make(map[string]int),
+				}
+				namespaces[w.Namespace] = ns
+			}
+			if !namespaceWorkloads[key] {
+				namespaceWorkloads[key] = true
+				ns.Workloads++
+			}
+			// Each pod running the image adds to the risk, whichever workload it belongs to
+			ns.RiskScore += risk * w.Pods
+			if counted[w.Namespace] {
+				continue
+			}
+			counted[w.Namespace] = true
+			ns.Images++
+			ns.ByCertificationStatus[string(certStatus)]++
+			if critical {
+				ns.ImagesWithCriticalVulnerabilities++
+			}
+			if pastEOL {
+				ns.ImagesPastEOL++
+			}
+		}
+	}
+
+	// Affected workloads are counted once even when several of their images are affected
+	status.AffectedWorkloads = len(affected)
+	for key := range affected {
+		namespaces[key.namespace].AffectedWorkloads++
+	}
+	for _, ns := range namespaces {
+		status.Namespaces = append(status.Namespaces, *ns)
+	}
+	slices.SortFunc(status.Namespaces, func(a, b securityv1alpha1.NamespaceCertificationSummary) int {
+		return cmp.Compare(a.Namespace, b.Namespace)
+	})
+	status.NamespacesByRisk = rankNamespacesByRisk(status.Namespaces)
+
+	slices.SortFunc(status.ImagesPastEOL, func(a, b securityv1alpha1.ReportedImage) int {
+		return cmp.Or(cmp.Compare(*a.DaysUntilEOL, *b.DaysUntilEOL),
+			cmp.Compare(a.ImageCertificationInfo, b.ImageCertificationInfo))
+	})
+	status.ImagesPastEOL = status.ImagesPastEOL[:min(len(status.ImagesPastEOL), maxReportedImages)]
+
+	slices.SortFunc(vulnerable, func(a, b securityv1alpha1.ReportedImage) int {
+		return cmp.Or(cmp.Compare(b.Vulnerabilities.Critical, a.Vulnerabilities.Critical),
+			cmp.Compare(b.Vulnerabilities.Important, a.Vulnerabilities.Important),
+			cmp.Compare(a.ImageCertificationInfo, b.ImageCertificationInfo))
+	})
+	status.MostVulnerableImages = vulnerable[:min(len(vulnerable), topImages)]
+
+	return status
+}
+
+// reportedImage builds the report entry of an image
+func reportedImage(cr *securityv1alpha1.ImageCertificationInfo, vulns *securityv1alpha1.VulnerabilitySummary,
+	workloads []securityv1alpha1.WorkloadReference) securityv1alpha1.ReportedImage {
+	return securityv1alpha1.ReportedImage{
+		ImageCertificationInfo: cr.Name,
+		FullImageReference:     cr.Spec.FullImageReference,
+		CertificationStatus:    cr.Status.CertificationStatus,
+		Vulnerabilities:        vulns,
+		DaysUntilEOL:           cr.Status.DaysUntilEOL,
+		Workloads:              workloads[:min(len(workloads), maxReportedWorkloads)],
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+func TestClusterReportBuilder_Rebuild(t *testing.T) {
+	ctx := context.Background()
+
+	image := func(name string, status securityv1alpha1.CertificationStatus, critical, important int,
+		daysUntilEOL *int, workloads ...securityv1alpha1.WorkloadReference) *securityv1alpha1.ImageCertificationInfo {
+		cr := &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{
+				RegistryType:        securityv1alpha1.RegistryTypeRedHat,
+				CertificationStatus: status,
+				DaysUntilEOL:        daysUntilEOL,
+				Workloads:           workloads,
+				PyxisData: &securityv1alpha1.PyxisData{
+					HealthIndex:     "B",
+					Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: critical, Important: important},
+				},
+			},
+		}
+		return cr
+	}
+	pastEOL := -30
+	frontend := securityv1alpha1.WorkloadReference{Namespace: "shop", Kind: WorkloadKindDeployment, Name: "frontend", Pods: 2}
+	backend := securityv1alpha1.WorkloadReference{Namespace: "shop", Kind: WorkloadKindDeployment, Name: "backend", Pods: 1}
+	batch := securityv1alpha1.WorkloadReference{Namespace: "batch", Kind: WorkloadKindPod, Name: "job-abc", Pods: 1}
+
+	clean := image("clean", securityv1alpha1.CertificationStatusCertified, 0, 0, nil, frontend, batch)
+	critical := image("critical", securityv1alpha1.CertificationStatusCertified, 3, 1, nil, frontend)
+	important := image("important", securityv1alpha1.CertificationStatusCertified, 0, 5, nil, backend)
+	eol := image("eol", securityv1alpha1.CertificationStatusNotCertified, 0, 0, &pastEOL, batch)
+	// Images without Pyxis data have no grade
+	eol.Status.PyxisData = nil
+	clean.Status.OperatingSystems = []string{"linux", "windows"}
+	eol.Status.OperatingSystems = []string{"windows"}
+
+	// Policy violations lower the compliance score
+	policy := &securityv1alpha1.ImageCertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "strict"},
+		Status: securityv1alpha1.ImageCertPolicyStatus{
+			Violations: []securityv1alpha1.PolicyViolation{{ImageCertificationInfo: "important", Rule: "no-important"}},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(clean, critical, important, eol, policy).
+		WithStatusSubresource(&securityv1alpha1.ClusterCertificationReport{}).
+		Build()
+	builder := &ClusterReportBuilder{Client: fakeClient}
+
+	if err := builder.Rebuild(ctx); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+
+	var report securityv1alpha1.ClusterCertificationReport
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: securityv1alpha1.ClusterCertificationReportName}, &report); err != nil {
+		t.Fatalf("failed to get report: %v", err)
+	}
+	status := report.Status
+
+	if status.TotalImages != 4 || status.ByRegistryType["RedHat"] != 4 {
+		t.Errorf("TotalImages = %d, ByRegistryType = %v, want 4 Red Hat images", status.TotalImages, status.ByRegistryType)
+	}
+	if status.ByCertificationStatus["Certified"] != 3 || status.ByCertificationStatus["NotCertified"] != 1 {
+		t.Errorf("ByCertificationStatus = %v", status.ByCertificationStatus)
+	}
+	wantOS := map[string]map[string]int{
+		"linux":   {"Certified": 1},
+		"windows": {"Certified": 1, "NotCertified": 1},
+		"unknown": {"Certified": 2},
+	}
+	if !reflect.DeepEqual(status.ByOperatingSystem, wantOS) {
+		t.Errorf("ByOperatingSystem = %v, want %v", status.ByOperatingSystem, wantOS)
+	}
+	if status.ByHealthGrade["B"] != 3 {
+		t.Errorf("ByHealthGrade = %v, want 3 B grades", status.ByHealthGrade)
+	}
+	if len(status.ImagesPastEOL) != 1 || status.ImagesPastEOL[0].ImageCertificationInfo != "eol" {
+		t.Errorf("ImagesPastEOL = %+v, want eol", status.ImagesPastEOL)
+	}
+	if len(status.MostVulnerableImages) != 2 || status.MostVulnerableImages[0].ImageCertificationInfo != "critical" ||
+		status.MostVulnerableImages[1].ImageCertificationInfo != "important" {
+		t.Errorf("MostVulnerableImages = %+v, want critical then important", status.MostVulnerableImages)
+	}
+	// frontend runs the critical image and job-abc the past-EOL image
+	if status.AffectedWorkloads != 2 {
+		t.Errorf("AffectedWorkloads = %d, want 2", status.AffectedWorkloads)
+	}
+	if status.LastUpdatedAt == nil {
+		t.Error("LastUpdatedAt was not set")
+	}
+	// 0.4*75% certified + 0.3*62.5% without critical vulnerabilities + 0.15*75% supported + 0.15*75% compliant
+	wantCompliance := securityv1alpha1.ComplianceScore{
+		Score: 71, Grade: "C", Certification: 75, Vulnerabilities: 63, EndOfLife: 75, Policy: 75,
+	}
+	if status.Compliance == nil || *status.Compliance != wantCompliance {
+		t.Errorf("Compliance = %+v, want %+v", status.Compliance, wantCompliance)
+	}
+
+	want := map[string]securityv1alpha1.NamespaceCertificationSummary{
+		"batch": {Images: 2, Workloads: 1, ImagesPastEOL: 1, AffectedWorkloads: 1},
+		// 2 frontend pods run 3 critical and 1 important CVEs (70), 1 backend pod 5 important (25)
+		"shop": {Images: 3, Workloads: 2, ImagesWithCriticalVulnerabilities: 1, AffectedWorkloads: 1, RiskScore: 95},
+	}
+	if len(status.Namespaces) != len(want) {
+		t.Fatalf("Namespaces = %+v, want %d entries", status.Namespaces, len(want))
+	}
+	for _, ns := range status.Namespaces {
+		w := want[ns.Namespace]
+		if ns.Images != w.Images || ns.Workloads != w.Workloads || ns.ImagesPastEOL != w.ImagesPastEOL ||
+			ns.ImagesWithCriticalVulnerabilities != w.ImagesWithCriticalVulnerabilities ||
+			ns.AffectedWorkloads != w.AffectedWorkloads || ns.RiskScore != w.RiskScore {
+			t.Errorf("namespace %s = %+v, want %+v", ns.Namespace, ns, w)
+		}
+	}
+	// Namespaces without vulnerabilities are not ranked
+	if !reflect.DeepEqual(status.NamespacesByRisk, []string{"shop"}) {
+		t.Errorf("NamespacesByRisk = %v, want [shop]", status.NamespacesByRisk)
+	}
+	var gauge dto.Metric
+	if err := metrics.NamespaceRiskScore.WithLabelValues("shop").Write(&gauge); err != nil {
+		t.Fatalf("failed to read namespace_risk_score: %v", err)
+	}
+	if got := gauge.GetGauge().GetValue(); got != 95 {
+		t.Errorf("namespace_risk_score{namespace=shop} = %v, want 95", got)
+	}
+
+	// The spec limits the number of vulnerable images listed
+	report.Spec.TopImages = 1
+	if err := fakeClient.Update(ctx, &report); err != nil {
+		t.Fatalf("failed to update report: %v", err)
+	}
+	if err := builder.Rebuild(ctx); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(&report), &report); err != nil {
+		t.Fatalf("failed to get report: %v", err)
+	}
+	if len(report.Status.MostVulnerableImages) != 1 {
+		t.Errorf("MostVulnerableImages = %+v, want 1 entry", report.Status.MostVulnerableImages)
+	}
+
+	// The spec limits the report to images of the given operating systems
+	report.Spec.OperatingSystems = []string{"windows"}
+	if err := fakeClient.Update(ctx, &report); err != nil {
+		t.Fatalf("failed to update report: %v", err)
+	}
+	if err := builder.Rebuild(ctx); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(&report), &report); err != nil {
+		t.Fatalf("failed to get report: %v", err)
+	}
+	if report.Status.TotalImages != 2 || report.Status.ByCertificationStatus["NotCertified"] != 1 {
+		t.Errorf("TotalImages = %d, ByCertificationStatus = %v, want the 2 windows images",
+			report.Status.TotalImages, report.Status.ByCertificationStatus)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Weights of the compliance score components; they sum to 1
+const (
+	complianceWeightCertification   = 0.40
+	complianceWeightVulnerabilities = 0.30
+	complianceWeightEndOfLife       = 0.15
+	complianceWeightPolicy          = 0.15
+)
+
+// complianceGrades maps the lowest score of each letter grade, best first
+var complianceGrades = []struct {
+	min   int
+	grade string
+}{
+	{90, "A"},
+	{80, "B"},
+	{70, "C"},
+	{60, "D"},
+}
+
+// complianceScore rates the images from 0 to 100. violating holds the names of the
+// images that violate at least one ImageCertPolicy. A cluster without images scores 100.
+func complianceScore(items []securityv1alpha1.ImageCertificationInfo, violating map[string]bool) *securityv1alpha1.ComplianceScore {
+	var certified, vulnerabilities, supported, compliant float64
+	for i := range items {
+		cr := &items[i]
+		switch cr.Status.CertificationStatus {
+		case securityv1alpha1.CertificationStatusCertified,
+			securityv1alpha1.CertificationStatusDeprecated,
+			securityv1alpha1.CertificationStatusEOL,
+			securityv1alpha1.CertificationStatusOfficial,
+			securityv1alpha1.CertificationStatusVerified:
+			certified++
+		}
+
+		vulns := vulnerabilitySummary(cr)
+		switch {
+		case vulns != nil && vulns.Critical > 0:
+		case vulns != nil && vulns.Important > 0:
+			vulnerabilities += 0.5
+		default:
+			vulnerabilities++
+		}
+
+		if cr.Status.DaysUntilEOL == nil || *cr.Status.DaysUntilEOL >= 0 {
+			supported++
+		}
+		if !violating[cr.Name] {
+			compliant++
+		}
+	}
+
+	fraction := func(n float64) float64 {
+		if len(items) == 0 {
+			return 1
+		}
+		return n / float64(len(items))
+	}
+	percent := func(f float64) int {
+		return int(math.Round(100 * f))
+	}
+
+	certification := fraction(certified)
+	vulnerability := fraction(vulnerabilities)
+	endOfLife := fraction(supported)
+	policy := fraction(compliant)
+	score := percent(complianceWeightCertification*certification +
+		complianceWeightVulnerabilities*vulnerability +
+		complianceWeightEndOfLife*endOfLife +
+		complianceWeightPolicy*policy)
+
+	return &securityv1alpha1.ComplianceScore{
+		Score:           score,
+		Grade:           complianceGrade(score),
+		Certification:   percent(certification),
+		Vulnerabilities: percent(vulnerability),
+		EndOfLife:       percent(endOfLife),
+		Policy:          percent(policy),
+	}
+}
+
+// complianceGrade converts a compliance score to its letter grade
+func complianceGrade(score int) string {
+	for _, g := range complianceGrades {
+		if score >= g.min {
+			return g.grade
+		}
+	}
+	return "F"
+}
+
+// violatingImages returns the names of the images listed as violating any of the policies
+func violatingImages(policies []securityv1alpha1.ImageCertPolicy) map[string]bool {
+	violating := make(map[string]bool)
+	for i := range policies {
+		for _, v := range policies[i].Status.Violations {
+			violating[v.ImageCertificationInfo] = true
+		}
+	}
+	return violating
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestComplianceScore(t *testing.T) {
+	// A cluster without images has nothing to fault
+	empty := complianceScore(nil, nil)
+	if empty.Score != 100 || empty.Grade != "A" {
+		t.Errorf("complianceScore(nil) = %+v, want 100 A", empty)
+	}
+
+	certified := securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "certified"},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+		},
+	}
+	official := securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "official"},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusOfficial,
+		},
+	}
+	all := complianceScore([]securityv1alpha1.ImageCertificationInfo{certified, official}, nil)
+	if all.Score != 100 || all.Grade != "A" {
+		t.Errorf("complianceScore() = %+v, want 100 A", all)
+	}
+
+	// Half the images violate a policy: 0.85*100% + 0.15*50%
+	violating := violatingImages([]securityv1alpha1.ImageCertPolicy{{
+		Status: securityv1alpha1.ImageCertPolicyStatus{
+			Violations: []securityv1alpha1.PolicyViolation{{ImageCertificationInfo: "official"}},
+		},
+	}})
+	half := complianceScore([]securityv1alpha1.ImageCertificationInfo{certified, official}, violating)
+	if half.Score != 93 || half.Grade != "A" || half.Policy != 50 {
+		t.Errorf("complianceScore() = %+v, want 93 A with 50%% policy compliance", half)
+	}
+}
+
+func TestComplianceGrade(t *testing.T) {
+	tests := map[int]string{100: "A", 90: "A", 89: "B", 80: "B", 79: "C", 70: "C", 69: "D", 60: "D", 59: "F", 0: "F"}
+	for score, want := range tests {
+		if got := complianceGrade(score); got != want {
+			t.Errorf("complianceGrade(%d) = %s, want %s", score, got, want)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultDeprecationScanInterval is the default interval between scans for legacy artifacts
+const DefaultDeprecationScanInterval = time.Hour
+
+// maxDeprecationExamples is the most legacy images named per deprecation in the condition message
+const maxDeprecationExamples = 3
+
+// digestCRName matches the names given by the deprecated image.DigestToCRName scheme
+var digestCRName = regexp.MustCompile(`^sha256-[a-f0-9]{64}$`)
+
+// Deprecation is a naming scheme or field written by earlier versions that may still be
+// found on ImageCertificationInfos in long-lived clusters
+type Deprecation struct {
+	// Name identifies the deprecation in the condition message and metrics
+	Name string
+	// Detect reports whether the image still uses the deprecated scheme or field
+	Detect func(cr *securityv1alpha1.ImageCertificationInfo) bool
+}
+
+// Deprecations are the legacy artifacts the DeprecationReporter looks for
+var Deprecations = []Deprecation{
+	{Name: "digest-cr-name", Detect: func(cr *securityv1alpha1.ImageCertificationInfo) bool {
+		return digestCRName.MatchString(cr.Name)
+	}},
+	{Name: "legacy-cve-annotation", Detect: func(cr *securityv1alpha1.ImageCertificationInfo) bool {
+		_, ok := cr.Annotations[annotationLegacyCVEs]
+		return ok
+	}},
+}
+
+// DeprecationReporter periodically lists the ImageCertificationInfos that still use a
+// deprecated naming scheme or field, reports them in the DeprecatedArtifacts condition of
+// the operator's ImageCertInfoConfig and the deprecated_artifacts gauge, and, with Cleanup,
+// removes the ones that are safe to remove:
+//
+//   - an image named by digest is deleted once an image with the current name tracks the same digest
+//   - a legacy CVE annotation is migrated into the status, like the startup migration does
+//
+// It runs only on the elected leader.
+type DeprecationReporter struct {
+	client.Client
+	// Interval is how often the images are scanned
+	Interval time.Duration
+	// Cleanup removes the legacy artifacts that are safe to remove
+	Cleanup bool
+	// ConfigName and ConfigNamespace identify the ImageCertInfoConfig the condition is set
+	// on. Without them, legacy artifacts are only reported in metrics and logs.
+	ConfigName      string
+	ConfigNamespace string
+}
+
+// Start scans for legacy artifacts every Interval until ctx is cancelled
+func (r *DeprecationReporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Scan(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to scan for deprecated artifacts")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan finds the legacy artifacts, cleans up the safe ones when enabled, and reports the
+// ones that remain
+func (r *DeprecationReporter) Scan(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("deprecations")
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		return err
+	}
+	// Digests tracked by an image with a current name, which supersedes any digest-named one
+	current := make(map[string]bool, len(crList.Items))
+	for i := range crList.Items {
+		if cr := &crList.Items[i]; !digestCRName.MatchString(cr.Name) && cr.Spec.ImageDigest != "" {
+			current[cr.Spec.ImageDigest] = true
+		}
+	}
+
+	remaining := make(map[string][]string, len(Deprecations))
+	for i := range crList.Items {
+		cr := &crList.Items[i]
+		for _, deprecation := range Deprecations {
+			if !deprecation.Detect(cr) {
+				continue
+			}
+			cleaned, err := r.cleanup(ctx, deprecation.Name, cr, current)
+			if err != nil {
+				logger.Error(err, "failed to clean up deprecated artifact", "deprecation", deprecation.Name,
+					"name", cr.Name)
+			}
+			if cleaned {
+				metrics.RecordDeprecatedArtifactCleaned(deprecation.Name)
+				logger.Info("Cleaned up deprecated artifact", "deprecation", deprecation.Name, "name", cr.Name)
+				continue
+			}
+			remaining[deprecation.Name] = append(remaining[deprecation.Name], cr.Name)
+		}
+	}
+
+	counts := make(map[string]int, len(Deprecations))
+	for _, deprecation := range Deprecations {
+		names := remaining[deprecation.Name]
+		counts[deprecation.Name] = len(names)
+		if len(names) > 0 {
+			logger.Info("Deprecated artifacts remain in the cluster", "deprecation", deprecation.Name,
+				"count", len(names), "examples", names[:min(len(names), maxDeprecationExamples)])
+		}
+	}
+	metrics.SetDeprecatedArtifacts(counts)
+	return r.setCondition(ctx, remaining)
+}
+
+// cleanup removes the legacy artifact from the image when Cleanup is enabled and it is safe,
+// and reports whether it did
+func (r *DeprecationReporter) cleanup(ctx context.Context, deprecation string,
+	cr *securityv1alpha1.ImageCertificationInfo, current map[string]bool) (bool, error) {
+	if !r.Cleanup {
+		return false, nil
+	}
+	switch deprecation {
+	case "digest-cr-name":
+		// Without a successor, the image is removed by the stale reference cleanup once
+		// its pods are gone
+		if !current[cr.Spec.ImageDigest] {
+			return false, nil
+		}
+		if err := r.Delete(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		return true, nil
+	case "legacy-cve-annotation":
+		applied, err := (&InventoryMigrator{Client: r.Client}).migrateImage(ctx, cr)
+		return err == nil && len(applied) > 0, client.IgnoreNotFound(err)
+	}
+	return false, nil
+}
+
+// setCondition records the remaining legacy artifacts in the DeprecatedArtifacts condition
+// of the operator's ImageCertInfoConfig, if it exists
+func (r *DeprecationReporter) setCondition(ctx context.Context, remaining map[string][]string) error {
+	if r.ConfigName == "" || r.ConfigNamespace == "" {
+		return nil
+	}
+	condition := deprecationCondition(remaining)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var config securityv1alpha1.ImageCertInfoConfig
+		if err := r.Get(ctx, client.ObjectKey{Namespace: r.ConfigNamespace, Name: r.ConfigName}, &config); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		condition.ObservedGeneration = config.Generation
+		if !meta.SetStatusCondition(&config.Status.Conditions, condition) {
+			return nil
+		}
+		return r.Status().Update(ctx, &config)
+	})
+}
+
+// deprecationCondition summarizes the remaining legacy artifacts, naming a few images of each
+func deprecationCondition(remaining map[string][]string) metav1.Condition {
+	var parts []string
+	total := 0
+	for _, deprecation := range Deprecations {
+		names := remaining[deprecation.Name]
+		if len(names) == 0 {
+			continue
+		}
+		total += len(names)
+		examples := strings.Join(names[:min(len(names), maxDeprecationExamples)], ", ")
+		if len(names) > maxDeprecationExamples {
+			examples += fmt.Sprintf(", %d more", len(names)-maxDeprecationExamples)
+		}
+		parts = append(parts, fmt.Sprintf("%s=%d (%s)", deprecation.Name, len(names), examples))
+	}
+
+	if total == 0 {
+		return metav1.Condition{
+			Type:    securityv1alpha1.ImageCertInfoConfigConditionDeprecatedArtifacts,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(securityv1alpha1.ReasonNoDeprecatedArtifacts),
+			Message: "No images use a deprecated name or field",
+		}
+	}
+	return metav1.Condition{
+		Type:    securityv1alpha1.ImageCertInfoConfigConditionDeprecatedArtifacts,
+		Status:  metav1.ConditionTrue,
+		Reason:  string(securityv1alpha1.ReasonDeprecatedArtifactsFound),
+		Message: fmt.Sprintf("%d images use a deprecated name or field: %s", total, strings.Join(parts, "; ")),
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+func TestDeprecationReporter_Scan(t *testing.T) {
+	ctx := context.Background()
+	orphanDigest := "sha256:" + strings.Repeat("b", 64)
+
+	// A digest-named image superseded by an image with the current name
+	superseded := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: image.DigestToCRName(testDigest)},
+		Spec:       securityv1alpha1.ImageCertificationInfoSpec{ImageDigest: testDigest},
+	}
+	successor := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testCRName,
+			Annotations: map[string]string{annotationLegacyCVEs: "CVE-2024-0001"},
+		},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{ImageDigest: testDigest},
+	}
+	// A digest-named image that nothing supersedes yet
+	orphan := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: image.DigestToCRName(orphanDigest)},
+		Spec:       securityv1alpha1.ImageCertificationInfoSpec{ImageDigest: orphanDigest},
+	}
+	config := &securityv1alpha1.ImageCertInfoConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultOperatorConfigName, Namespace: "operator-system"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(superseded, successor, orphan, config).
+		WithStatusSubresource(successor, config).Build()
+	reporter := &DeprecationReporter{
+		Client:          fakeClient,
+		ConfigName:      config.Name,
+		ConfigNamespace: config.Namespace,
+	}
+	condition := func() *metav1.Condition {
+		t.Helper()
+		var got securityv1alpha1.ImageCertInfoConfig
+		if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(config), &got); err != nil {
+			t.Fatal(err)
+		}
+		return meta.FindStatusCondition(got.Status.Conditions,
+			securityv1alpha1.ImageCertInfoConfigConditionDeprecatedArtifacts)
+	}
+
+	// Without cleanup every legacy artifact is reported
+	if err := reporter.Scan(ctx); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	c := condition()
+	if c == nil || c.Status != metav1.ConditionTrue || !strings.HasPrefix(c.Message, "3 images") ||
+		!strings.Contains(c.Message, "digest-cr-name=2") || !strings.Contains(c.Message, "legacy-cve-annotation=1") {
+		t.Fatalf("DeprecatedArtifacts condition = %+v, want 3 images reported", c)
+	}
+
+	// Cleanup deletes the superseded image and migrates the annotation, but keeps the orphan
+	reporter.Cleanup = true
+	if err := reporter.Scan(ctx); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(superseded), superseded); !apierrors.IsNotFound(err) {
+		t.Errorf("superseded image should be deleted, got %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(orphan), orphan); err != nil {
+		t.Errorf("image without a successor should be kept, got %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(successor), successor); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := successor.Annotations[annotationLegacyCVEs]; ok {
+		t.Error("legacy CVE annotation should be migrated")
+	}
+	want := "1 images use a deprecated name or field: digest-cr-name=1 (" + orphan.Name + ")"
+	if c := condition(); c == nil || c.Message != want {
+		t.Errorf("DeprecatedArtifacts condition = %+v, want only the orphan reported", c)
+	}
+}
+
+func TestDeprecationCondition(t *testing.T) {
+	if c := deprecationCondition(nil); c.Status != metav1.ConditionFalse ||
+		c.Reason != string(securityv1alpha1.ReasonNoDeprecatedArtifacts) {
+		t.Errorf("deprecationCondition(nil) = %+v, want False", c)
+	}
+
+	c := deprecationCondition(map[string][]string{"digest-cr-name": {"a", "b", "c", "d", "e"}})
+	want := "5 images use a deprecated name or field: digest-cr-name=5 (a, b, c, 2 more)"
+	if c.Status != metav1.ConditionTrue || c.Message != want {
+		t.Errorf("deprecationCondition() = %+v, want message %q", c, want)
+	}
+}
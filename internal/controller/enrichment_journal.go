@@ -0,0 +1,263 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultEnrichmentJournalName is the name of the ConfigMap holding the enrichment journal
+const DefaultEnrichmentJournalName = "imagecertinfo-enrichment-journal"
+
+// DefaultEnrichmentJournalFlushInterval is how often the journal is written to its ConfigMap
+const DefaultEnrichmentJournalFlushInterval = 15 * time.Second
+
+// EnrichmentIntent is a certification lookup that has been started for an image but has
+// not produced a verdict yet
+type EnrichmentIntent struct {
+	// Digest is the digest of the image being enriched
+	Digest string `json:"digest"`
+	// Attempts is the number of failed retries
+	Attempts int `json:"attempts"`
+	// NextRetry is when the lookup is retried
+	NextRetry time.Time `json:"nextRetry"`
+}
+
+// EnrichmentJournal records the pending certification lookups of each image in a ConfigMap,
+// keyed by ImageCertificationInfo name, so that a restarted operator resumes them: lookups
+// interrupted by a crash are retried at once instead of after the first retry interval, and
+// failing lookups keep their backoff. Changes are buffered and flushed periodically; each
+// flush only writes the entries this instance changed, so shard members can share the
+// ConfigMap. A nil journal records nothing.
+type EnrichmentJournal struct {
+	client    client.Client
+	namespace string
+	name      string
+	interval  time.Duration
+
+	mu      sync.Mutex
+	intents map[string]EnrichmentIntent
+	// changed holds the names whose entries were set or removed since the last flush
+	changed map[string]bool
+	// restored holds the names of intents loaded from the previous run and not changed since
+	restored map[string]bool
+}
+
+// NewEnrichmentJournal creates a journal persisted in the named ConfigMap. The client
+// should not be backed by the manager cache, which would watch every ConfigMap in the cluster.
+func NewEnrichmentJournal(c client.Client, namespace, name string) *EnrichmentJournal {
+	return &EnrichmentJournal{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+		interval:  DefaultEnrichmentJournalFlushInterval,
+		intents:   make(map[string]EnrichmentIntent),
+		changed:   make(map[string]bool),
+		restored:  make(map[string]bool),
+	}
+}
+
+// Load restores the intents persisted by the previous run and returns how many were restored.
+// Entries that cannot be decoded are dropped.
+func (j *EnrichmentJournal) Load(ctx context.Context) (int, error) {
+	var cm corev1.ConfigMap
+	err := j.client.Get(ctx, client.ObjectKey{Namespace: j.namespace, Name: j.name}, &cm)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for name, data := range cm.Data {
+		var intent EnrichmentIntent
+		if err := json.Unmarshal([]byte(data), &intent); err != nil {
+			j.changed[name] = true
+			continue
+		}
+		j.intents[name] = intent
+		j.restored[name] = true
+	}
+	return len(j.intents), nil
+}
+
+// Begin records that the certification lookup of an image has been started
+func (j *EnrichmentJournal) Begin(name, digest string, now time.Time) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.intents[name] = EnrichmentIntent{Digest: digest, NextRetry: now}
+	j.changed[name] = true
+	delete(j.restored, name)
+}
+
+// Fail records a failed lookup and when it is retried
+func (j *EnrichmentJournal) Fail(name string, attempts int, next time.Time) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	intent := j.intents[name]
+	intent.Attempts = attempts
+	intent.NextRetry = next
+	j.intents[name] = intent
+	j.changed[name] = true
+	delete(j.restored, name)
+}
+
+// Complete removes the intent of an image once its lookup has finished or the image is gone
+func (j *EnrichmentJournal) Complete(name string) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, ok := j.intents[name]; !ok {
+		return
+	}
+	delete(j.intents, name)
+	j.changed[name] = true
+	delete(j.restored, name)
+}
+
+// Restored returns the intent of an image loaded from the previous run, unless the image
+// has been journaled again since
+func (j *EnrichmentJournal) Restored(name string) (EnrichmentIntent, bool) {
+	if j == nil {
+		return EnrichmentIntent{}, false
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if !j.restored[name] {
+		return EnrichmentIntent{}, false
+	}
+	return j.intents[name], true
+}
+
+// NeedLeaderElection returns false so that every shard member journals its own lookups
+func (j *EnrichmentJournal) NeedLeaderElection() bool {
+	return false
+}
+
+// Start flushes the journal every flush interval, and once more on shutdown, until ctx is cancelled
+func (j *EnrichmentJournal) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("enrichment-journal")
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			defer cancel()
+			if err := j.Flush(flushCtx); err != nil {
+				logger.Error(err, "failed to write enrichment journal on shutdown", "name", j.name)
+			}
+			return nil
+		case <-ticker.C:
+			if err := j.Flush(ctx); err != nil {
+				logger.Error(err, "failed to write enrichment journal", "name", j.name)
+			}
+		}
+	}
+}
+
+// Flush writes the entries changed since the last flush to the ConfigMap. A concurrent write
+// by another shard member fails with a conflict and is retried on the next flush.
+func (j *EnrichmentJournal) Flush(ctx context.Context) error {
+	j.mu.Lock()
+	if len(j.changed) == 0 {
+		j.mu.Unlock()
+		return nil
+	}
+	updates := make(map[string]string, len(j.changed))
+	for name := range j.changed {
+		if intent, ok := j.intents[name]; ok {
+			data, err := json.Marshal(intent)
+			if err != nil {
+				j.mu.Unlock()
+				return err
+			}
+			updates[name] = string(data)
+		} else {
+			updates[name] = ""
+		}
+	}
+	j.changed = make(map[string]bool)
+	j.mu.Unlock()
+
+	err := j.write(ctx, updates)
+	if err != nil {
+		// Keep the entries for the next flush unless they changed again in the meantime
+		j.mu.Lock()
+		for name := range updates {
+			j.changed[name] = true
+		}
+		j.mu.Unlock()
+	}
+	return err
+}
+
+// write applies updates to the ConfigMap, removing the entries whose update is empty
+func (j *EnrichmentJournal) write(ctx context.Context, updates map[string]string) error {
+	var cm corev1.ConfigMap
+	err := j.client.Get(ctx, client.ObjectKey{Namespace: j.namespace, Name: j.name}, &cm)
+	if apierrors.IsNotFound(err) {
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      j.name,
+				Namespace: j.namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "imagecertinfo-operator"},
+			},
+		}
+		applyJournalUpdates(&cm, updates)
+		return j.client.Create(ctx, &cm)
+	}
+	if err != nil {
+		return err
+	}
+	applyJournalUpdates(&cm, updates)
+	return j.client.Update(ctx, &cm)
+}
+
+// applyJournalUpdates sets or removes the updated entries of the ConfigMap
+func applyJournalUpdates(cm *corev1.ConfigMap, updates map[string]string) {
+	if cm.Data == nil {
+		cm.Data = make(map[string]string, len(updates))
+	}
+	for name, data := range updates {
+		if data == "" {
+			delete(cm.Data, name)
+		} else {
+			cm.Data[name] = data
+		}
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+func TestEnrichmentJournal_FlushAndLoad(t *testing.T) {
+	ctx := context.Background()
+	// Another shard member has journaled an image of its own
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultEnrichmentJournalName, Namespace: testNamespace},
+		Data:       map[string]string{"other.image": `{"digest":"sha256:other","attempts":2}`},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(existing).Build()
+
+	journal := NewEnrichmentJournal(fakeClient, testNamespace, DefaultEnrichmentJournalName)
+	now := time.Now().Truncate(time.Second)
+	journal.Begin(testCRName, testDigest, now)
+	journal.Begin("done.image", "sha256:done", now)
+	journal.Fail(testCRName, 1, now.Add(time.Minute))
+	journal.Complete("done.image")
+	if err := journal.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	var cm corev1.ConfigMap
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &cm); err != nil {
+		t.Fatal(err)
+	}
+	if len(cm.Data) != 2 || cm.Data["other.image"] == "" || cm.Data[testCRName] == "" {
+		t.Fatalf("journal ConfigMap data = %v, want the other member's entry and %s", cm.Data, testCRName)
+	}
+
+	restarted := NewEnrichmentJournal(fakeClient, testNamespace, DefaultEnrichmentJournalName)
+	restored, err := restarted.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if restored != 2 {
+		t.Errorf("Load() restored %d intents, want 2", restored)
+	}
+	intent, ok := restarted.Restored(testCRName)
+	if !ok {
+		t.Fatal("Restored() found no intent")
+	}
+	if intent.Digest != testDigest || intent.Attempts != 1 || !intent.NextRetry.Equal(now.Add(time.Minute)) {
+		t.Errorf("Restored() = %+v", intent)
+	}
+
+	// An image journaled again is no longer a restored intent
+	restarted.Begin(testCRName, testDigest, now)
+	if _, ok := restarted.Restored(testCRName); ok {
+		t.Error("Restored() returned an intent journaled by this run")
+	}
+}
+
+func TestEnrichmentJournal_Nil(t *testing.T) {
+	var journal *EnrichmentJournal
+	journal.Begin(testCRName, testDigest, time.Now())
+	journal.Fail(testCRName, 1, time.Now())
+	journal.Complete(testCRName)
+	if _, ok := journal.Restored(testCRName); ok {
+		t.Error("nil journal returned an intent")
+	}
+}
+
+func TestCertificationRetryReconciler_ResumesJournal(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	// The previous run crashed after discovering the image, before its lookup finished
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest: testDigest,
+			Registry:    "registry.redhat.io",
+			Repository:  "ubi8/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+		},
+	}
+	journalCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultEnrichmentJournalName, Namespace: testNamespace},
+		Data: map[string]string{
+			testCRName: `{"digest":"` + testDigest + `","attempts":0,"nextRetry":"2026-01-01T00:00:00Z"}`,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cr, journalCM).
+		WithStatusSubresource(cr).
+		Build()
+
+	journal := NewEnrichmentJournal(fakeClient, testNamespace, DefaultEnrichmentJournalName)
+	if _, err := journal.Load(ctx); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	reconciler := &CertificationRetryReconciler{
+		Client: fakeClient,
+		Pods: &PodReconciler{
+			Client:      fakeClient,
+			Scheme:      scheme,
+			PyxisClient: &MockPyxisClient{CertData: &pyxis.CertificationData{HealthIndex: "A"}},
+			Journal:     journal,
+		},
+		BaseInterval: time.Minute,
+		MaxInterval:  30 * time.Minute,
+	}
+
+	// The interrupted lookup is retried at once rather than after the base interval
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testCRName}}
+	result, err := reconciler.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("RequeueAfter = %s, want an immediate retry", result.RequeueAfter)
+	}
+	var updated securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &updated); err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
+		t.Fatalf("CertificationStatus = %s, want Certified", updated.Status.CertificationStatus)
+	}
+
+	// Observing the certified image removes its journal entry
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := journal.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	var cm corev1.ConfigMap
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(journalCM), &cm); err != nil {
+		t.Fatal(err)
+	}
+	if len(cm.Data) != 0 {
+		t.Errorf("journal ConfigMap data = %v, want empty", cm.Data)
+	}
+}
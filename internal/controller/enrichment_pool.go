@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultEnrichmentWorkers is the default number of images enriched concurrently
+const DefaultEnrichmentWorkers = 4
+
+// enrichmentQueueSize bounds the tasks waiting for a worker; reconciles block once it is full
+const enrichmentQueueSize = 1024
+
+// EnrichmentPool runs the provider lookups for newly discovered images on a fixed number
+// of workers. It is started by the manager, so lookups stop when the operator shuts down.
+type EnrichmentPool struct {
+	workers int
+	tasks   chan func(context.Context)
+}
+
+// NewEnrichmentPool returns a pool that runs at most workers tasks at a time
+func NewEnrichmentPool(workers int) *EnrichmentPool {
+	return &EnrichmentPool{
+		workers: max(workers, 1),
+		tasks:   make(chan func(context.Context), enrichmentQueueSize),
+	}
+}
+
+// Start runs the workers until ctx is cancelled. Queued tasks are dropped on shutdown;
+// the refresh loop enriches any image left without data.
+func (p *EnrichmentPool) Start(ctx context.Context) error {
+	log.FromContext(ctx).Info("starting enrichment workers", "workers", p.workers)
+	done := make(chan struct{})
+	for range p.workers {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case task := <-p.tasks:
+					metrics.EnrichmentQueueDepth.Set(float64(len(p.tasks)))
+					metrics.EnrichmentWorkersBusy.Inc()
+					task(ctx)
+					metrics.EnrichmentWorkersBusy.Dec()
+				}
+			}
+		}()
+	}
+	for range p.workers {
+		<-done
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false so that the pool serves the reconciler on every
+// instance, including shard members that are not the leader
+func (p *EnrichmentPool) NeedLeaderElection() bool {
+	return false
+}
+
+// Submit queues a task, waiting while the queue is full. It returns ctx's error if ctx
+// is cancelled first.
+func (p *EnrichmentPool) Submit(ctx context.Context, task func(context.Context)) error {
+	select {
+	case p.tasks <- task:
+		metrics.EnrichmentQueueDepth.Set(float64(len(p.tasks)))
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+func TestEnrichmentPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := NewEnrichmentPool(2)
+	stopped := make(chan struct{})
+	go func() {
+		_ = pool.Start(ctx)
+		close(stopped)
+	}()
+
+	// No more than the configured number of tasks run at once
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	release := make(chan struct{})
+	for range 6 {
+		wg.Add(1)
+		if err := pool.Submit(ctx, func(context.Context) {
+			defer wg.Done()
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			<-release
+			running.Add(-1)
+		}); err != nil {
+			t.Fatalf("Submit() error = %v", err)
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if got := peak.Load(); got != 2 {
+		t.Errorf("peak concurrency = %d, want 2", got)
+	}
+
+	// Workers stop with the manager context and tasks can no longer be queued
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Start() did not return after the context was cancelled")
+	}
+	full := NewEnrichmentPool(1)
+	for range enrichmentQueueSize {
+		_ = full.Submit(context.Background(), func(context.Context) {})
+	}
+	if err := full.Submit(ctx, func(context.Context) {}); err == nil {
+		t.Error("Submit() on a full queue with a cancelled context should fail")
+	}
+}
+
+func TestPodReconciler_EnrichTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool := NewEnrichmentPool(1)
+	go func() { _ = pool.Start(ctx) }()
+	r := &PodReconciler{Enrichment: pool}
+	ref := &image.Reference{FullReference: "registry.access.redhat.com/ubi9/ubi@" + testDigest}
+
+	// The queued lookup is traced as a child of the reconcile that found the image, even
+	// though it runs on a pool worker after the reconcile has returned
+	reconcileCtx, reconcileSpan := tracer.Start(ctx, "PodReconciler.Reconcile")
+	done := make(chan struct{})
+	r.enrich(reconcileCtx, "pyxis", ref, func(context.Context) { close(done) })
+	reconcileSpan.End()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("enrichment task did not run")
+	}
+
+	var enrich sdktrace.ReadOnlySpan
+	for deadline := time.Now().Add(time.Second); enrich == nil && time.Now().Before(deadline); {
+		for _, span := range recorder.Ended() {
+			if span.Name() == "enrich/pyxis" {
+				enrich = span
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if enrich == nil {
+		t.Fatal("no enrich/pyxis span recorded")
+	}
+	if got, want := enrich.Parent().SpanID(), reconcileSpan.SpanContext().SpanID(); got != want {
+		t.Errorf("enrich span parent = %s, want reconcile span %s", got, want)
+	}
+	if got := enrich.Attributes(); len(got) != 1 || got[0].Value.AsString() != ref.FullReference {
+		t.Errorf("enrich span attributes = %v, want image=%s", got, ref.FullReference)
+	}
+}
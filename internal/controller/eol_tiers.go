@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/notify"
+)
+
+// EOLTier is a named end-of-life warning threshold. An image is in the tier when it
+// reaches end-of-life within Days days.
+type EOLTier struct {
+	Name string
+	Days int
+}
+
+// DefaultEOLTiers are the end-of-life warning tiers used when none are configured
+var DefaultEOLTiers = []EOLTier{
+	{Name: "notice", Days: 180},
+	{Name: "warning", Days: 90},
+	{Name: "critical", Days: 30},
+	{Name: "imminent", Days: 7},
+}
+
+// FormatEOLTiers formats tiers in the form accepted by ParseEOLTiers
+func FormatEOLTiers(tiers []EOLTier) string {
+	parts := make([]string, len(tiers))
+	for i, tier := range tiers {
+		parts[i] = fmt.Sprintf("%s=%d", tier.Name, tier.Days)
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseEOLTiers parses comma-separated name=days pairs, e.g. "warning=90,critical=30".
+// The tiers are returned widest first. An empty spec disables EOL warnings.
+func ParseEOLTiers(spec string) ([]EOLTier, error) {
+	tiers := []EOLTier{}
+	for pair := range strings.SplitSeq(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		days, err := strconv.Atoi(strings.TrimSpace(value))
+		if !ok || name == "" || err != nil || days < 0 {
+			return nil, fmt.Errorf("invalid EOL tier %q: expected name=days", pair)
+		}
+		if slices.ContainsFunc(tiers, func(t EOLTier) bool { return t.Name == name || t.Days == days }) {
+			return nil, fmt.Errorf("duplicate EOL tier %q", pair)
+		}
+		tiers = append(tiers, EOLTier{Name: name, Days: days})
+	}
+	slices.SortFunc(tiers, func(a, b EOLTier) int { return cmp.Compare(b.Days, a.Days) })
+	return tiers, nil
+}
+
+// matchEOLTier returns the index of the narrowest tier containing an image that reaches
+// end-of-life in daysUntil days, or -1 when it is in no tier. tiers must be widest first,
+// so a higher index is a more urgent tier.
+func matchEOLTier(tiers []EOLTier, daysUntil int) int {
+	match := -1
+	for i, tier := range tiers {
+		if daysUntil >= 0 && daysUntil <= tier.Days {
+			match = i
+		}
+	}
+	return match
+}
+
+// eolTiers returns the configured end-of-life warning tiers
+func (r *PodReconciler) eolTiers() []EOLTier {
+	if r.EOLTiers == nil {
+		return DefaultEOLTiers
+	}
+	return r.EOLTiers
+}
+
+// emitEOLEvent warns that an image is approaching end-of-life when it has entered a more
+// urgent tier than the one it was last warned about, and records the tier in its status so
+// that repeated checks do not warn again. It must be called before the status is written.
+func (r *PodReconciler) emitEOLEvent(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo) {
+	if isExempt(cr) {
+		// Leave the warned tier alone, so that the image is warned about once its exemption expires
+		return
+	}
+	tiers := r.eolTiers()
+	tier := -1
+	if cr.Status.DaysUntilEOL != nil {
+		tier = matchEOLTier(tiers, *cr.Status.DaysUntilEOL)
+	}
+	if tier < 0 {
+		// Past end-of-life, or out of every tier after the date moved; a later approach warns again
+		cr.Status.EOLWarningTier = ""
+		return
+	}
+	warned := slices.IndexFunc(tiers, func(t EOLTier) bool { return t.Name == cr.Status.EOLWarningTier })
+	cr.Status.EOLWarningTier = tiers[tier].Name
+	if warned >= tier {
+		return
+	}
+
+	msg := fmt.Sprintf("Image reaches EOL in %d days (%s tier, %d days)",
+		*cr.Status.DaysUntilEOL, tiers[tier].Name, tiers[tier].Days)
+	if cr.Status.PyxisData != nil && cr.Status.PyxisData.ReplacedBy != "" {
+		msg += fmt.Sprintf(", replacement: %s", cr.Status.PyxisData.ReplacedBy)
+	}
+	r.warn(ctx, cr, EventReasonEOLApproaching, msg)
+	r.notify(cr, notify.TypeEOLApproaching, msg)
+}
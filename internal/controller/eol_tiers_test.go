@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/tools/record"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestParseEOLTiers(t *testing.T) {
+	tiers, err := ParseEOLTiers("critical=30, notice=180,warning=90")
+	if err != nil {
+		t.Fatalf("ParseEOLTiers() error = %v", err)
+	}
+	want := []EOLTier{{"notice", 180}, {"warning", 90}, {"critical", 30}}
+	if !slices.Equal(tiers, want) {
+		t.Errorf("ParseEOLTiers() = %v, want %v", tiers, want)
+	}
+	if got := FormatEOLTiers(DefaultEOLTiers); got != "notice=180,warning=90,critical=30,imminent=7" {
+		t.Errorf("FormatEOLTiers() = %s", got)
+	}
+
+	// An empty spec disables warnings rather than falling back to the defaults
+	if tiers, err := ParseEOLTiers(""); err != nil || tiers == nil || len(tiers) != 0 {
+		t.Errorf("ParseEOLTiers(\"\") = %v, %v, want an empty list", tiers, err)
+	}
+	for _, spec := range []string{"warning", "warning=soon", "=30", "warning=-1", "warning=90,warning=30", "a=30,b=30"} {
+		if _, err := ParseEOLTiers(spec); err == nil {
+			t.Errorf("ParseEOLTiers(%q) expected an error", spec)
+		}
+	}
+}
+
+func TestMatchEOLTier(t *testing.T) {
+	tests := map[int]int{200: -1, 180: 0, 91: 0, 90: 1, 30: 2, 7: 3, 0: 3, -1: -1}
+	for days, want := range tests {
+		if got := matchEOLTier(DefaultEOLTiers, days); got != want {
+			t.Errorf("matchEOLTier(%d) = %d, want %d", days, got, want)
+		}
+	}
+}
+
+func TestPodReconciler_EmitEOLEvent(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &PodReconciler{Recorder: recorder}
+	days := func(n int) *int { return &n }
+	cr := &securityv1alpha1.ImageCertificationInfo{}
+
+	// First check in a tier warns; repeated checks in the same tier do not
+	cr.Status.DaysUntilEOL = days(80)
+	r.emitEOLEvent(context.Background(), cr)
+	r.emitEOLEvent(context.Background(), cr)
+	cr.Status.DaysUntilEOL = days(79)
+	r.emitEOLEvent(context.Background(), cr)
+	if cr.Status.EOLWarningTier != "warning" {
+		t.Errorf("EOLWarningTier = %q, want warning", cr.Status.EOLWarningTier)
+	}
+	// Escalating to a more urgent tier warns again
+	cr.Status.DaysUntilEOL = days(25)
+	r.emitEOLEvent(context.Background(), cr)
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if len(events) != 2 {
+		t.Fatalf("events = %v, want 2", events)
+	}
+	if !strings.Contains(events[0], "80 days (warning tier") || !strings.Contains(events[1], "25 days (critical tier") {
+		t.Errorf("events = %v, want warning then critical tiers", events)
+	}
+
+	// Disabled tiers never warn
+	r.EOLTiers = []EOLTier{}
+	r.emitEOLEvent(context.Background(), cr)
+	if len(recorder.Events) != 0 {
+		t.Error("expected no event without tiers")
+	}
+	if cr.Status.EOLWarningTier != "" {
+		t.Errorf("EOLWarningTier = %q, want it cleared", cr.Status.EOLWarningTier)
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// LabelExemption is set on an ImageCertificationInfo to the name of the active
+// ImageCertExemption that applies to it. Exempted images are left out of policy
+// violations, the vulnerability and end-of-life metrics, warning events, and notifications.
+const LabelExemption = "security.telco.openshift.io/exemption"
+
+// EventReasonExemptionExpired is emitted on an ImageCertExemption when its expiry date passes
+const EventReasonExemptionExpired = "ExemptionExpired"
+
+// ImageCertExemptionReconciler labels the images each active ImageCertExemption applies to,
+// removes the label once no active exemption applies, and records the matched images and
+// expiry in the exemption status
+type ImageCertExemptionReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertexemptions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertexemptions/status,verbs=get;update;patch
+
+// Reconcile brings the exemption labels of every image up to date and refreshes the
+// status of the requested exemption. An active exemption is requeued at its expiry.
+func (r *ImageCertExemptionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+	now := time.Now()
+	if r.now != nil {
+		now = r.now()
+	}
+
+	var exemptions securityv1alpha1.ImageCertExemptionList
+	if err := r.List(ctx, &exemptions); err != nil {
+		logger.Error(err, "unable to list ImageCertExemptions")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertexemption")
+		return ctrl.Result{}, err
+	}
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		logger.Error(err, "unable to list ImageCertificationInfos")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertexemption")
+		return ctrl.Result{}, err
+	}
+
+	// Any exemption change can move an image between exemptions, so every label is checked
+	if err := r.syncLabels(ctx, exemptions.Items, crList.Items, now); err != nil {
+		logger.Error(err, "unable to update image exemption labels")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertexemption")
+		return ctrl.Result{}, err
+	}
+
+	i := slices.IndexFunc(exemptions.Items, func(e securityv1alpha1.ImageCertExemption) bool {
+		return e.Name == req.Name
+	})
+	if i < 0 {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertexemption")
+		return ctrl.Result{}, nil
+	}
+	exemption := &exemptions.Items[i]
+
+	status := securityv1alpha1.ImageCertExemptionStatus{
+		ObservedGeneration: exemption.Generation,
+		Conditions:         slices.Clone(exemption.Status.Conditions),
+	}
+	for j := range crList.Items {
+		if exemptionMatches(exemption, &crList.Items[j]) {
+			status.MatchedImages++
+		}
+	}
+	active := exemptionActive(exemption, now)
+	condition := metav1.Condition{
+		Type:               securityv1alpha1.ImageCertExemptionConditionActive,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: exemption.Generation,
+		Reason:             string(securityv1alpha1.ReasonExemptionInEffect),
+		Message: fmt.Sprintf("Exempts %d images until %s", status.MatchedImages,
+			exemption.Spec.ExpiresAt.UTC().Format(time.RFC3339)),
+	}
+	if !active {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(securityv1alpha1.ReasonExemptionExpired)
+		condition.Message = fmt.Sprintf("Expired at %s", exemption.Spec.ExpiresAt.UTC().Format(time.RFC3339))
+		if meta.IsStatusConditionTrue(exemption.Status.Conditions, securityv1alpha1.ImageCertExemptionConditionActive) &&
+			r.Recorder != nil {
+			r.Recorder.Event(exemption, corev1.EventTypeNormal, EventReasonExemptionExpired,
+				fmt.Sprintf("Exemption expired; %d images are evaluated normally again", status.MatchedImages))
+			metrics.RecordEvent(corev1.EventTypeNormal, EventReasonExemptionExpired)
+		}
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	if !equality.Semantic.DeepEqual(exemption.Status, status) {
+		exemption.Status = status
+		if err := r.Status().Update(ctx, exemption); err != nil {
+			logger.Error(err, "failed to update ImageCertExemption status")
+			metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertexemption")
+			return ctrl.Result{}, err
+		}
+	}
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertexemption")
+	if active {
+		return ctrl.Result{RequeueAfter: exemption.Spec.ExpiresAt.Sub(now)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// syncLabels sets the exemption label of each image to the first active exemption, by
+// name, that applies to it, and removes the label from images no active exemption applies to
+func (r *ImageCertExemptionReconciler) syncLabels(ctx context.Context, exemptions []securityv1alpha1.ImageCertExemption,
+	items []securityv1alpha1.ImageCertificationInfo, now time.Time) error {
+	slices.SortFunc(exemptions, func(a, b securityv1alpha1.ImageCertExemption) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var errs []error
+	for i := range items {
+		cr := &items[i]
+		want := ""
+		for j := range exemptions {
+			if exemptionActive(&exemptions[j], now) && exemptionMatches(&exemptions[j], cr) {
+				want = exemptions[j].Name
+				break
+			}
+		}
+		if cr.Labels[LabelExemption] == want {
+			continue
+		}
+
+		patch := client.MergeFrom(cr.DeepCopy())
+		if want == "" {
+			delete(cr.Labels, LabelExemption)
+		} else {
+			if cr.Labels == nil {
+				cr.Labels = make(map[string]string)
+			}
+			cr.Labels[LabelExemption] = want
+		}
+		if err := client.IgnoreNotFound(r.Patch(ctx, cr, patch)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", cr.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// exemptionActive reports whether the exemption has not expired as of now
+func exemptionActive(exemption *securityv1alpha1.ImageCertExemption, now time.Time) bool {
+	return now.Before(exemption.Spec.ExpiresAt.Time)
+}
+
+// exemptionMatches reports whether the exemption names the image's digest or repository
+func exemptionMatches(exemption *securityv1alpha1.ImageCertExemption,
+	cr *securityv1alpha1.ImageCertificationInfo) bool {
+	if exemption.Spec.ImageDigest != "" {
+		return cr.Spec.ImageDigest == exemption.Spec.ImageDigest
+	}
+	return exemption.Spec.Repository != "" &&
+		exemption.Spec.Repository == cmp.Or(cr.Spec.Registry, RegistryDockerHub)+"/"+cr.Spec.Repository
+}
+
+// isExempt reports whether an active ImageCertExemption applies to the image
+func isExempt(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	return cr.Labels[LabelExemption] != ""
+}
+
+// requestsForImage enqueues the exemptions that apply to an image and the exemption named
+// by its label, so that a new image is labeled and a stale label is removed
+func (r *ImageCertExemptionReconciler) requestsForImage(ctx context.Context, obj client.Object) []reconcile.Request {
+	cr, ok := obj.(*securityv1alpha1.ImageCertificationInfo)
+	if !ok {
+		return nil
+	}
+	var exemptions securityv1alpha1.ImageCertExemptionList
+	if err := r.List(ctx, &exemptions); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list ImageCertExemptions")
+		return nil
+	}
+
+	var names []string
+	for i := range exemptions.Items {
+		if exemptionMatches(&exemptions.Items[i], cr) {
+			names = append(names, exemptions.Items[i].Name)
+		}
+	}
+	if name := cr.Labels[LabelExemption]; name != "" && !slices.Contains(names, name) {
+		names = append(names, name)
+	}
+	requests := make([]reconcile.Request, 0, len(names))
+	for _, name := range names {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Name: name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ImageCertExemptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&securityv1alpha1.ImageCertExemption{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&securityv1alpha1.ImageCertificationInfo{}, handler.EnqueueRequestsFromMapFunc(r.requestsForImage),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Named("imagecertexemption").
+		Complete(r)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestImageCertExemptionReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	otherDigest := "sha256:" + strings.Repeat("b", 64)
+
+	billing := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "quay.io.example.billing.abc123de"},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        testDigest,
+			FullImageReference: "quay.io/example/billing@" + testDigest,
+			Registry:           "quay.io",
+			Repository:         "example/billing",
+		},
+	}
+	// Labeled by an exemption that no longer exists
+	stale := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName, Labels: map[string]string{LabelExemption: "deleted"}},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        otherDigest,
+			FullImageReference: "registry.redhat.io/ubi8/ubi@" + otherDigest,
+			Registry:           "registry.redhat.io",
+			Repository:         "ubi8/ubi",
+		},
+	}
+	exemption := &securityv1alpha1.ImageCertExemption{
+		ObjectMeta: metav1.ObjectMeta{Name: "billing"},
+		Spec: securityv1alpha1.ImageCertExemptionSpec{
+			Repository:    "quay.io/example/billing",
+			Justification: "accepted",
+			ExpiresAt:     metav1.NewTime(now.Add(time.Hour)),
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(billing, stale, exemption).
+		WithStatusSubresource(exemption).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &ImageCertExemptionReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: recorder,
+		now:      func() time.Time { return now },
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: exemption.Name}}
+
+	result, err := reconciler.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.RequeueAfter != time.Hour {
+		t.Errorf("RequeueAfter = %v, want the time until expiry", result.RequeueAfter)
+	}
+	labels := func() (string, string) {
+		t.Helper()
+		var got securityv1alpha1.ImageCertificationInfo
+		if err := fakeClient.Get(ctx, types.NamespacedName{Name: billing.Name}, &got); err != nil {
+			t.Fatal(err)
+		}
+		var other securityv1alpha1.ImageCertificationInfo
+		if err := fakeClient.Get(ctx, types.NamespacedName{Name: stale.Name}, &other); err != nil {
+			t.Fatal(err)
+		}
+		return got.Labels[LabelExemption], other.Labels[LabelExemption]
+	}
+	if exempted, removed := labels(); exempted != "billing" || removed != "" {
+		t.Errorf("exemption labels = %q, %q, want billing and none", exempted, removed)
+	}
+	var got securityv1alpha1.ImageCertExemption
+	if err := fakeClient.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.MatchedImages != 1 ||
+		!meta.IsStatusConditionTrue(got.Status.Conditions, securityv1alpha1.ImageCertExemptionConditionActive) {
+		t.Errorf("status = %+v, want one matched image and Active", got.Status)
+	}
+
+	// At expiry the label is removed and the expiry is reported once
+	now = now.Add(time.Hour)
+	for range 2 {
+		if result, err := reconciler.Reconcile(ctx, req); err != nil || result.RequeueAfter != 0 {
+			t.Fatalf("Reconcile() = %+v, %v, want no requeue after expiry", result, err)
+		}
+	}
+	if exempted, _ := labels(); exempted != "" {
+		t.Errorf("exemption label = %q after expiry, want none", exempted)
+	}
+	if err := fakeClient.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatal(err)
+	}
+	condition := meta.FindStatusCondition(got.Status.Conditions, securityv1alpha1.ImageCertExemptionConditionActive)
+	if condition == nil || condition.Reason != string(securityv1alpha1.ReasonExemptionExpired) {
+		t.Errorf("Active condition = %+v, want Expired", condition)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("got %d events, want one %s event", len(recorder.Events), EventReasonExemptionExpired)
+	}
+}
+
+func TestExemptionMatches(t *testing.T) {
+	nginx := &securityv1alpha1.ImageCertificationInfo{
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{ImageDigest: testDigest, Repository: "library/nginx"},
+	}
+	tests := []struct {
+		name string
+		spec securityv1alpha1.ImageCertExemptionSpec
+		want bool
+	}{
+		{"digest", securityv1alpha1.ImageCertExemptionSpec{ImageDigest: testDigest}, true},
+		{"other digest", securityv1alpha1.ImageCertExemptionSpec{ImageDigest: "sha256:0"}, false},
+		{"default registry", securityv1alpha1.ImageCertExemptionSpec{Repository: "docker.io/library/nginx"}, true},
+		{"other registry", securityv1alpha1.ImageCertExemptionSpec{Repository: "quay.io/library/nginx"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exemption := &securityv1alpha1.ImageCertExemption{Spec: tt.spec}
+			if got := exemptionMatches(exemption, nginx); got != tt.want {
+				t.Errorf("exemptionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExemptedImages(t *testing.T) {
+	ctx := context.Background()
+	daysUntilEOL := 10
+	exempted := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName, Labels: map[string]string{LabelExemption: "accepted"}},
+		Spec:       securityv1alpha1.ImageCertificationInfoSpec{ImageDigest: testDigest, Registry: "quay.io"},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+			PyxisData: &securityv1alpha1.PyxisData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 3},
+			},
+			DaysUntilEOL: &daysUntilEOL,
+		},
+	}
+
+	// Policies skip the image
+	policy := &securityv1alpha1.ImageCertPolicy{
+		Spec: securityv1alpha1.ImageCertPolicySpec{
+			Rules: []securityv1alpha1.ImageCertPolicyRule{{Name: "no-critical", MaxCriticalVulnerabilities: ptr.To[int32](0)}},
+		},
+	}
+	matcher := &namespaceMatcher{cache: make(map[string]bool)}
+	violations, evaluated, skipped, err := evaluatePolicy(ctx, policy,
+		[]securityv1alpha1.ImageCertificationInfo{*exempted}, matcher)
+	if err != nil || len(violations) != 0 || evaluated != 1 || skipped != 1 {
+		t.Errorf("evaluatePolicy() = %v, %d, %d, %v, want one exempted image", violations, evaluated, skipped, err)
+	}
+	if violations, err := EvaluateImage(ctx, nil, policy, exempted, "default"); err != nil || len(violations) != 0 {
+		t.Errorf("EvaluateImage() = %v, %v, want no violations", violations, err)
+	}
+
+	// The inventory counts it as exempted instead of vulnerable
+	inv := summarizeInventory([]securityv1alpha1.ImageCertificationInfo{*exempted}, DefaultEOLTiers, time.Now())
+	if inv.Exempted != 1 || inv.Vulnerabilities[SeverityCritical] != 0 ||
+		inv.ByStatus[string(securityv1alpha1.CertificationStatusNotCertified)] != 1 {
+		t.Errorf("inventory = %+v, want the image counted as exempted only", inv)
+	}
+
+	// No warnings, and the warned state is left for when the exemption expires
+	recorder := record.NewFakeRecorder(10)
+	r := &PodReconciler{Recorder: recorder}
+	r.emitVulnerabilityEvent(context.Background(), exempted)
+	r.emitEOLEvent(context.Background(), exempted)
+	r.warn(context.Background(), exempted, EventReasonHealthDegraded, "degraded")
+	if len(recorder.Events) != 0 || exempted.Status.WarnedVulnerabilities != nil || exempted.Status.EOLWarningTier != "" {
+		t.Errorf("exempted image got %d events and warned state %+v, %q", len(recorder.Events),
+			exempted.Status.WarnedVulnerabilities, exempted.Status.EOLWarningTier)
+	}
+}
@@ -0,0 +1,443 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/pattern"
+)
+
+// EventReasonPolicyViolation is emitted on an ImageCertificationInfo that starts violating a policy rule
+const EventReasonPolicyViolation = "PolicyViolation"
+
+// ImageCertPolicyReconciler evaluates every ImageCertificationInfo against each ImageCertPolicy
+// and records the violations in the policy status
+type ImageCertPolicyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// Reconcile re-evaluates the requested policy against all tracked images
+func (r *ImageCertPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	var policy securityv1alpha1.ImageCertPolicy
+	if err := r.Get(ctx, req.NamespacedName, &policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.DeletePolicyViolations(req.Name)
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertpolicy")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch ImageCertPolicy")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertpolicy")
+		return ctrl.Result{}, err
+	}
+
+	status := securityv1alpha1.ImageCertPolicyStatus{
+		ObservedGeneration: policy.Generation,
+		Conditions:         slices.Clone(policy.Status.Conditions),
+	}
+
+	var selector labels.Selector
+	if policy.Spec.NamespaceSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+		if err != nil {
+			// Retrying cannot fix an invalid selector; wait for the policy to change
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+				Type:               securityv1alpha1.ImageCertPolicyConditionCompliant,
+				Status:             metav1.ConditionUnknown,
+				ObservedGeneration: policy.Generation,
+				Reason:             string(securityv1alpha1.ReasonInvalidNamespaceSelector),
+				Message:            err.Error(),
+			})
+			return r.updateStatus(ctx, &policy, status, start)
+		}
+	}
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		logger.Error(err, "unable to list ImageCertificationInfos")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertpolicy")
+		return ctrl.Result{}, err
+	}
+
+	matcher := &namespaceMatcher{reader: r.Client, selector: selector, cache: make(map[string]bool)}
+	violations, evaluated, exempted, err := evaluatePolicy(ctx, &policy, crList.Items, matcher)
+	if err != nil {
+		logger.Error(err, "unable to evaluate ImageCertPolicy")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertpolicy")
+		return ctrl.Result{}, err
+	}
+
+	status.EvaluatedImages = evaluated
+	status.ExemptedImages = exempted
+	status.ViolationCount = len(violations)
+	status.Violations = violations[:min(len(violations), securityv1alpha1.MaxPolicyViolations)]
+	// Only violations with a recorded hash are reported, so that none is reported again
+	reported := violations[:min(len(violations), securityv1alpha1.MaxPolicyViolationHashes)]
+	status.ViolationHashes = violationHashes(reported)
+	condition := metav1.Condition{
+		Type:               securityv1alpha1.ImageCertPolicyConditionCompliant,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: policy.Generation,
+		Reason:             string(securityv1alpha1.ReasonNoViolations),
+		Message:            fmt.Sprintf("%d images comply with the policy", evaluated),
+	}
+	if len(violations) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(securityv1alpha1.ReasonViolationsFound)
+		condition.Message = fmt.Sprintf("%d violations found across %d images", len(violations), evaluated)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	metrics.SetPolicyViolations(policy.Name, len(violations))
+
+	added := newViolations(&policy, reported)
+	result, err := r.updateStatus(ctx, &policy, status, start)
+	if err == nil {
+		// Events follow the status write, so a failed write does not report violations twice
+		r.emitViolationEvents(&policy, added, crList.Items)
+	}
+	return result, err
+}
+
+// updateStatus writes the policy status unless it is unchanged
+func (r *ImageCertPolicyReconciler) updateStatus(ctx context.Context, policy *securityv1alpha1.ImageCertPolicy,
+	status securityv1alpha1.ImageCertPolicyStatus, start time.Time) (ctrl.Result, error) {
+	// Skip the write when nothing changed to avoid status churn
+	status.LastEvaluatedAt = policy.Status.LastEvaluatedAt
+	if status.LastEvaluatedAt != nil && equality.Semantic.DeepEqual(policy.Status, status) {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertpolicy")
+		return ctrl.Result{}, nil
+	}
+
+	now := metav1.Now()
+	status.LastEvaluatedAt = &now
+	policy.Status = status
+	if err := r.Status().Update(ctx, policy); err != nil {
+		log.FromContext(ctx).Error(err, "failed to update ImageCertPolicy status")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertpolicy")
+		return ctrl.Result{}, err
+	}
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertpolicy")
+	return ctrl.Result{}, nil
+}
+
+// newViolations returns the violations not yet recorded in the policy status. The previous
+// violations are known from the hashes in the status, which cover more than the listed ones.
+func newViolations(policy *securityv1alpha1.ImageCertPolicy,
+	violations []securityv1alpha1.PolicyViolation) []securityv1alpha1.PolicyViolation {
+	previous := make(map[string]bool, len(policy.Status.ViolationHashes)+len(policy.Status.Violations))
+	for _, hash := range policy.Status.ViolationHashes {
+		previous[hash] = true
+	}
+	// Statuses written before the hashes were recorded only know the listed violations
+	for _, v := range policy.Status.Violations {
+		previous[violationHash(v)] = true
+	}
+
+	var added []securityv1alpha1.PolicyViolation
+	for _, v := range violations {
+		if !previous[violationHash(v)] {
+			added = append(added, v)
+		}
+	}
+	return added
+}
+
+// emitViolationEvents emits an event on each image that newly violates a rule of the policy
+func (r *ImageCertPolicyReconciler) emitViolationEvents(policy *securityv1alpha1.ImageCertPolicy,
+	violations []securityv1alpha1.PolicyViolation, items []securityv1alpha1.ImageCertificationInfo) {
+	if r.Recorder == nil || len(violations) == 0 {
+		return
+	}
+
+	crs := make(map[string]*securityv1alpha1.ImageCertificationInfo, len(items))
+	for i := range items {
+		crs[items[i].Name] = &items[i]
+	}
+
+	for _, v := range violations {
+		cr := crs[v.ImageCertificationInfo]
+		if cr == nil {
+			continue
+		}
+		r.Recorder.Event(cr, corev1.EventTypeWarning, EventReasonPolicyViolation,
+			fmt.Sprintf("Image violates rule %s of ImageCertPolicy %s: %s", v.Rule, policy.Name, v.Message))
+		metrics.RecordEvent(corev1.EventTypeWarning, EventReasonPolicyViolation)
+	}
+}
+
+// violationHash identifies the image and rule of a violation in a short, fixed-size string
+func violationHash(v securityv1alpha1.PolicyViolation) string {
+	h := fnv.New64a()
+	h.Write([]byte(v.ImageCertificationInfo + "/" + v.Rule))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// violationHashes returns the hash of every violation, in the order of the violations
+func violationHashes(violations []securityv1alpha1.PolicyViolation) []string {
+	if len(violations) == 0 {
+		return nil
+	}
+	hashes := make([]string, len(violations))
+	for i, v := range violations {
+		hashes[i] = violationHash(v)
+	}
+	return hashes
+}
+
+// evaluatePolicy checks each image against the policy rules and returns the sorted violations,
+// the number of images the policy applies to, and the number of those it skipped because an
+// ImageCertExemption applies to them
+func evaluatePolicy(ctx context.Context, policy *securityv1alpha1.ImageCertPolicy,
+	items []securityv1alpha1.ImageCertificationInfo, matcher *namespaceMatcher) (
+	[]securityv1alpha1.PolicyViolation, int, int, error) {
+	var violations []securityv1alpha1.PolicyViolation
+	evaluated, exempted := 0, 0
+
+	for i := range items {
+		cr := &items[i]
+		namespaces, applies, err := matcher.matchingNamespaces(ctx, cr.Status.PodReferences)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if !applies {
+			continue
+		}
+		evaluated++
+		if isExempt(cr) {
+			exempted++
+			continue
+		}
+
+		for _, rule := range policy.Spec.Rules {
+			if !registryMatches(rule.Registries, cr.Spec.Registry) {
+				continue
+			}
+			failures := ruleFailures(&rule, cr)
+			if len(failures) == 0 {
+				continue
+			}
+			violations = append(violations, securityv1alpha1.PolicyViolation{
+				ImageCertificationInfo: cr.Name,
+				FullImageReference:     cr.Spec.FullImageReference,
+				Rule:                   rule.Name,
+				Message:                strings.Join(failures, "; "),
+				Namespaces:             namespaces,
+			})
+		}
+	}
+
+	slices.SortFunc(violations, func(a, b securityv1alpha1.PolicyViolation) int {
+		if c := strings.Compare(a.ImageCertificationInfo, b.ImageCertificationInfo); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Rule, b.Rule)
+	})
+	return violations, evaluated, exempted, nil
+}
+
+// EvaluateImage returns the violations of the policy by an image about to run in namespace,
+// without recording them. A policy with a namespace selector only applies when the namespace
+// matches it, so it is skipped for an empty namespace or an invalid selector. An exempted
+// image has no violations.
+func EvaluateImage(ctx context.Context, reader client.Reader, policy *securityv1alpha1.ImageCertPolicy,
+	cr *securityv1alpha1.ImageCertificationInfo, namespace string) ([]securityv1alpha1.PolicyViolation, error) {
+	if isExempt(cr) {
+		return nil, nil
+	}
+	var namespaces []string
+	if namespace != "" {
+		namespaces = []string{namespace}
+	}
+	if policy.Spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+		if err != nil || namespace == "" {
+			return nil, nil
+		}
+		matcher := &namespaceMatcher{reader: reader, selector: selector, cache: make(map[string]bool)}
+		if match, err := matcher.matches(ctx, namespace); err != nil || !match {
+			return nil, err
+		}
+	}
+
+	var violations []securityv1alpha1.PolicyViolation
+	for _, rule := range policy.Spec.Rules {
+		if !registryMatches(rule.Registries, cr.Spec.Registry) {
+			continue
+		}
+		if failures := ruleFailures(&rule, cr); len(failures) > 0 {
+			violations = append(violations, securityv1alpha1.PolicyViolation{
+				ImageCertificationInfo: cr.Name,
+				FullImageReference:     cr.Spec.FullImageReference,
+				Rule:                   rule.Name,
+				Message:                strings.Join(failures, "; "),
+				Namespaces:             namespaces,
+			})
+		}
+	}
+	return violations, nil
+}
+
+// ruleFailures lists every requirement of the rule that the image does not meet
+func ruleFailures(rule *securityv1alpha1.ImageCertPolicyRule, cr *securityv1alpha1.ImageCertificationInfo) []string {
+	var failures []string
+
+	// Images still being looked up are not judged on their certification status
+	status := cr.Status.CertificationStatus
+	if len(rule.AllowedCertificationStatuses) > 0 && status != "" && status != securityv1alpha1.CertificationStatusPending &&
+		!slices.Contains(rule.AllowedCertificationStatuses, status) {
+		failures = append(failures, fmt.Sprintf("certification status %s is not allowed", status))
+	}
+
+	if rule.DisallowEOL && cr.Status.DaysUntilEOL != nil && *cr.Status.DaysUntilEOL < 0 {
+		failures = append(failures, fmt.Sprintf("image is %d days past end of life", -*cr.Status.DaysUntilEOL))
+	}
+
+	var vulns securityv1alpha1.VulnerabilitySummary
+	if summary := vulnerabilitySummary(cr); summary != nil {
+		vulns = *summary
+	}
+	if rule.MaxCriticalVulnerabilities != nil && vulns.Critical > int(*rule.MaxCriticalVulnerabilities) {
+		failures = append(failures, fmt.Sprintf("%d critical vulnerabilities exceed the maximum of %d",
+			vulns.Critical, *rule.MaxCriticalVulnerabilities))
+	}
+	if rule.MaxImportantVulnerabilities != nil && vulns.Important > int(*rule.MaxImportantVulnerabilities) {
+		failures = append(failures, fmt.Sprintf("%d important vulnerabilities exceed the maximum of %d",
+			vulns.Important, *rule.MaxImportantVulnerabilities))
+	}
+
+	return failures
+}
+
+// registryMatches reports whether registry matches one of the patterns. An entry ending
+// in '*' matches by prefix and an empty list matches every registry.
+func registryMatches(patterns []string, registry string) bool {
+	return len(patterns) == 0 || pattern.MatchAny(patterns, registry)
+}
+
+// namespaceMatcher checks pod namespaces against a policy's namespace selector,
+// looking up each namespace at most once per evaluation
+type namespaceMatcher struct {
+	reader   client.Reader
+	selector labels.Selector
+	cache    map[string]bool
+}
+
+// matchingNamespaces returns the sorted namespaces of the pod references that match the
+// selector and whether the policy applies to the image at all. Without a selector the
+// policy applies to every image, including ones no longer used by any pod.
+func (m *namespaceMatcher) matchingNamespaces(ctx context.Context, podRefs []securityv1alpha1.PodReference) ([]string, bool, error) {
+	var namespaces []string
+	for _, podRef := range podRefs {
+		if slices.Contains(namespaces, podRef.Namespace) {
+			continue
+		}
+		match, err := m.matches(ctx, podRef.Namespace)
+		if err != nil {
+			return nil, false, err
+		}
+		if match {
+			namespaces = append(namespaces, podRef.Namespace)
+		}
+	}
+	slices.Sort(namespaces)
+	return namespaces, m.selector == nil || len(namespaces) > 0, nil
+}
+
+// matches reports whether the namespace's labels satisfy the selector
+func (m *namespaceMatcher) matches(ctx context.Context, name string) (bool, error) {
+	if m.selector == nil {
+		return true, nil
+	}
+	if match, ok := m.cache[name]; ok {
+		return match, nil
+	}
+
+	var ns corev1.Namespace
+	if err := m.reader.Get(ctx, client.ObjectKey{Name: name}, &ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		// A deleted namespace no longer matches
+		m.cache[name] = false
+		return false, nil
+	}
+	match := m.selector.Matches(labels.Set(ns.Labels))
+	m.cache[name] = match
+	return match, nil
+}
+
+// requestsForAllPolicies enqueues every ImageCertPolicy, since any image or namespace
+// change may affect the result of any policy
+func (r *ImageCertPolicyReconciler) requestsForAllPolicies(ctx context.Context, _ client.Object) []reconcile.Request {
+	var policies securityv1alpha1.ImageCertPolicyList
+	if err := r.List(ctx, &policies); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list ImageCertPolicies")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(policies.Items))
+	for _, policy := range policies.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Name: policy.Name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ImageCertPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&securityv1alpha1.ImageCertPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&securityv1alpha1.ImageCertificationInfo{}, handler.EnqueueRequestsFromMapFunc(r.requestsForAllPolicies)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.requestsForAllPolicies),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Named("imagecertpolicy").
+		Complete(r)
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestImageCertPolicyReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	prod := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}}
+	dev := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}}
+	uncertified := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        testDigest,
+			FullImageReference: "registry.redhat.io/ubi8/ubi@" + testDigest,
+			Registry:           "registry.redhat.io",
+			Repository:         "ubi8/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+			PyxisData: &securityv1alpha1.PyxisData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 2},
+			},
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: "prod", Name: "pod-1", Container: testContainer},
+				{Namespace: "dev", Name: "pod-2", Container: testContainer},
+			},
+		},
+	}
+	devOnly := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry.redhat.io.ubi9.ubi.abc123de"},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        testDigest,
+			FullImageReference: "registry.redhat.io/ubi9/ubi@" + testDigest,
+			Registry:           "registry.redhat.io",
+			Repository:         "ubi9/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: "dev", Name: "pod-3", Container: testContainer},
+			},
+		},
+	}
+	policy := &securityv1alpha1.ImageCertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-images"},
+		Spec: securityv1alpha1.ImageCertPolicySpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			Rules: []securityv1alpha1.ImageCertPolicyRule{
+				{
+					Name:                         "redhat-certified",
+					Registries:                   []string{"registry.redhat.io"},
+					AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+				},
+				{Name: "no-critical", MaxCriticalVulnerabilities: ptr.To[int32](0)},
+				{Name: "quay-only", Registries: []string{"quay.io"}, DisallowEOL: true},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(prod, dev, uncertified, devOnly, policy).
+		WithStatusSubresource(policy).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &ImageCertPolicyReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: recorder,
+	}
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: policy.Name}}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var got securityv1alpha1.ImageCertPolicy
+	if err := fakeClient.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("Failed to get ImageCertPolicy: %v", err)
+	}
+	// The image used only in the unselected namespace is not evaluated
+	if got.Status.EvaluatedImages != 1 {
+		t.Errorf("EvaluatedImages = %v, want 1", got.Status.EvaluatedImages)
+	}
+	if got.Status.ViolationCount != 2 || len(got.Status.Violations) != 2 {
+		t.Fatalf("Violations = %+v, want 2", got.Status.Violations)
+	}
+	for i, rule := range []string{"no-critical", "redhat-certified"} {
+		v := got.Status.Violations[i]
+		if v.Rule != rule || v.ImageCertificationInfo != testCRName {
+			t.Errorf("Violations[%d] = %s/%s, want %s/%s", i, v.ImageCertificationInfo, v.Rule, testCRName, rule)
+		}
+		if len(v.Namespaces) != 1 || v.Namespaces[0] != "prod" {
+			t.Errorf("Violations[%d].Namespaces = %v, want [prod]", i, v.Namespaces)
+		}
+	}
+	if !meta.IsStatusConditionFalse(got.Status.Conditions, securityv1alpha1.ImageCertPolicyConditionCompliant) {
+		t.Errorf("Compliant condition should be False, got %+v", got.Status.Conditions)
+	}
+	if len(recorder.Events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(recorder.Events))
+	}
+	if event := <-recorder.Events; !strings.Contains(event, EventReasonPolicyViolation) {
+		t.Errorf("unexpected event %q", event)
+	}
+	<-recorder.Events
+
+	// Violations already recorded do not produce new events
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("expected no new events, got %d", len(recorder.Events))
+	}
+}
+
+func TestImageCertPolicyReconciler_EventsBeyondListedViolations(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	uncertified := func(name string) *securityv1alpha1.ImageCertificationInfo {
+		return &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       securityv1alpha1.ImageCertificationInfoSpec{Registry: "registry.redhat.io"},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{
+				CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+			},
+		}
+	}
+	policy := &securityv1alpha1.ImageCertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "certified-only"},
+		Spec: securityv1alpha1.ImageCertPolicySpec{
+			Rules: []securityv1alpha1.ImageCertPolicyRule{{
+				Name:                         "certified",
+				AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+			}},
+		},
+	}
+
+	total := securityv1alpha1.MaxPolicyViolations + 20
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).WithStatusSubresource(policy)
+	for i := range total {
+		builder = builder.WithObjects(uncertified(fmt.Sprintf("image-%03d", i)))
+	}
+	// The first status write conflicts with another writer
+	conflicted := false
+	fakeClient := builder.WithInterceptorFuncs(interceptor.Funcs{
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object,
+			opts ...client.SubResourceUpdateOption) error {
+			if !conflicted {
+				conflicted = true
+				return apierrors.NewConflict(securityv1alpha1.GroupVersion.WithResource("imagecertpolicies").GroupResource(),
+					obj.GetName(), errors.New("object was modified"))
+			}
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+	}).Build()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: policy.Name}}
+	reconcileWithFreshReconciler := func() *record.FakeRecorder {
+		t.Helper()
+		recorder := record.NewFakeRecorder(2 * total)
+		reconciler := &ImageCertPolicyReconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		return recorder
+	}
+
+	// Nothing is reported until the status recording the violations is written
+	recorder := record.NewFakeRecorder(2 * total)
+	reconciler := &ImageCertPolicyReconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+	if _, err := reconciler.Reconcile(ctx, req); !apierrors.IsConflict(err) {
+		t.Fatalf("Reconcile() error = %v, want a conflict", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Fatalf("expected no events after a failed status write, got %d", len(recorder.Events))
+	}
+
+	if recorder := reconcileWithFreshReconciler(); len(recorder.Events) != total {
+		t.Fatalf("expected %d events, got %d", total, len(recorder.Events))
+	}
+	var got securityv1alpha1.ImageCertPolicy
+	if err := fakeClient.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("Failed to get ImageCertPolicy: %v", err)
+	}
+	if len(got.Status.Violations) != securityv1alpha1.MaxPolicyViolations || got.Status.ViolationCount != total {
+		t.Fatalf("Violations = %d, ViolationCount = %d, want %d and %d",
+			len(got.Status.Violations), got.Status.ViolationCount, securityv1alpha1.MaxPolicyViolations, total)
+	}
+
+	// Violations beyond the listed ones are not reported again
+	if recorder := reconcileWithFreshReconciler(); len(recorder.Events) != 0 {
+		t.Errorf("expected no new events, got %d", len(recorder.Events))
+	}
+
+	// Only a new violation past the listed ones is reported
+	if err := fakeClient.Create(ctx, uncertified("zz-new-image")); err != nil {
+		t.Fatalf("Failed to create ImageCertificationInfo: %v", err)
+	}
+	recorder = reconcileWithFreshReconciler()
+	if len(recorder.Events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(recorder.Events))
+	}
+	if event := <-recorder.Events; !strings.Contains(event, EventReasonPolicyViolation) {
+		t.Errorf("unexpected event %q", event)
+	}
+}
+
+func TestImageCertPolicyReconciler_ViolationHashesBounded(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	policy := &securityv1alpha1.ImageCertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "certified-only"},
+		Spec: securityv1alpha1.ImageCertPolicySpec{
+			Rules: []securityv1alpha1.ImageCertPolicyRule{{
+				Name:                         "certified",
+				AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+			}},
+		},
+	}
+	total := securityv1alpha1.MaxPolicyViolationHashes + 10
+	objs := []client.Object{policy}
+	for i := range total {
+		objs = append(objs, &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("image-%05d", i)},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{
+				CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+			},
+		})
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(policy).Build()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: policy.Name}}
+
+	for i, want := range []int{securityv1alpha1.MaxPolicyViolationHashes, 0} {
+		recorder := record.NewFakeRecorder(total)
+		reconciler := &ImageCertPolicyReconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if len(recorder.Events) != want {
+			t.Errorf("reconcile %d: expected %d events, got %d", i, want, len(recorder.Events))
+		}
+	}
+
+	var got securityv1alpha1.ImageCertPolicy
+	if err := fakeClient.Get(ctx, req.NamespacedName, &got); err != nil {
+		t.Fatalf("Failed to get ImageCertPolicy: %v", err)
+	}
+	if got.Status.ViolationCount != total || len(got.Status.ViolationHashes) != securityv1alpha1.MaxPolicyViolationHashes {
+		t.Errorf("ViolationCount = %d, ViolationHashes = %d, want %d and %d", got.Status.ViolationCount,
+			len(got.Status.ViolationHashes), total, securityv1alpha1.MaxPolicyViolationHashes)
+	}
+}
+
+func TestRuleFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   securityv1alpha1.ImageCertPolicyRule
+		status securityv1alpha1.ImageCertificationInfoStatus
+		want   int
+	}{
+		{
+			name: "certified image passes",
+			rule: securityv1alpha1.ImageCertPolicyRule{
+				AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+			},
+			status: securityv1alpha1.ImageCertificationInfoStatus{CertificationStatus: securityv1alpha1.CertificationStatusCertified},
+			want:   0,
+		},
+		{
+			name: "pending image is not judged",
+			rule: securityv1alpha1.ImageCertPolicyRule{
+				AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+			},
+			status: securityv1alpha1.ImageCertificationInfoStatus{CertificationStatus: securityv1alpha1.CertificationStatusPending},
+			want:   0,
+		},
+		{
+			name:   "past EOL",
+			rule:   securityv1alpha1.ImageCertPolicyRule{DisallowEOL: true},
+			status: securityv1alpha1.ImageCertificationInfoStatus{DaysUntilEOL: ptr.To(-3)},
+			want:   1,
+		},
+		{
+			name:   "before EOL",
+			rule:   securityv1alpha1.ImageCertPolicyRule{DisallowEOL: true},
+			status: securityv1alpha1.ImageCertificationInfoStatus{DaysUntilEOL: ptr.To(30)},
+			want:   0,
+		},
+		{
+			name: "every requirement fails",
+			rule: securityv1alpha1.ImageCertPolicyRule{
+				AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+				MaxCriticalVulnerabilities:   ptr.To[int32](0),
+				MaxImportantVulnerabilities:  ptr.To[int32](1),
+			},
+			status: securityv1alpha1.ImageCertificationInfoStatus{
+				CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+				PyxisData: &securityv1alpha1.PyxisData{
+					Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 1, Important: 2},
+				},
+			},
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &securityv1alpha1.ImageCertificationInfo{Status: tt.status}
+			if got := ruleFailures(&tt.rule, cr); len(got) != tt.want {
+				t.Errorf("ruleFailures() = %v, want %d failures", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryMatches(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		registry string
+		want     bool
+	}{
+		{nil, "docker.io", true},
+		{[]string{"registry.redhat.io"}, "registry.redhat.io", true},
+		{[]string{"registry.redhat.io"}, "registry.access.redhat.com", false},
+		{[]string{"registry.*"}, "registry.access.redhat.com", true},
+	}
+
+	for _, tt := range tests {
+		if got := registryMatches(tt.patterns, tt.registry); got != tt.want {
+			t.Errorf("registryMatches(%v, %q) = %v, want %v", tt.patterns, tt.registry, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// ImageUsageReconciler maintains one ImageUsage resource per namespace that projects the
+// cluster-scoped ImageCertificationInfo data for the images used by pods in that namespace
+type ImageUsageReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imageusages,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imageusages/status,verbs=get;update;patch
+
+// Reconcile rebuilds the ImageUsage projection for the requested namespace
+func (r *ImageUsageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		logger.Error(err, "unable to list ImageCertificationInfos")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imageusage")
+		return ctrl.Result{}, err
+	}
+
+	entries := buildImageUsageEntries(crList.Items, req.Namespace)
+	owners := imageUsageOwners(crList.Items, entries)
+
+	var usage securityv1alpha1.ImageUsage
+	err := r.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: securityv1alpha1.ImageUsageName}, &usage)
+	switch {
+	case apierrors.IsNotFound(err):
+		if len(entries) == 0 {
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "imageusage")
+			return ctrl.Result{}, nil
+		}
+		usage = securityv1alpha1.ImageUsage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            securityv1alpha1.ImageUsageName,
+				Namespace:       req.Namespace,
+				OwnerReferences: owners,
+			},
+		}
+		if err := r.Create(ctx, &usage); err != nil {
+			logger.Error(err, "failed to create ImageUsage")
+			metrics.RecordReconcile("error", time.Since(start).Seconds(), "imageusage")
+			return ctrl.Result{}, err
+		}
+	case err != nil:
+		logger.Error(err, "unable to fetch ImageUsage")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imageusage")
+		return ctrl.Result{}, err
+	case len(entries) == 0:
+		// No pods in this namespace use a tracked image anymore
+		if err := r.Delete(ctx, &usage); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "failed to delete ImageUsage")
+			metrics.RecordReconcile("error", time.Since(start).Seconds(), "imageusage")
+			return ctrl.Result{}, err
+		}
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "imageusage")
+		return ctrl.Result{}, nil
+	}
+
+	if !equality.Semantic.DeepEqual(usage.OwnerReferences, owners) {
+		usage.OwnerReferences = owners
+		if err := r.Update(ctx, &usage); err != nil {
+			logger.Error(err, "failed to update ImageUsage owner references")
+			metrics.RecordReconcile("error", time.Since(start).Seconds(), "imageusage")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Skip the write when nothing changed to avoid status churn
+	if usage.Status.LastUpdatedAt != nil && equality.Semantic.DeepEqual(usage.Status.Images, entries) {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "imageusage")
+		return ctrl.Result{}, nil
+	}
+
+	now := metav1.Now()
+	usage.Status = securityv1alpha1.ImageUsageStatus{
+		Images:        entries,
+		ImageCount:    len(entries),
+		LastUpdatedAt: &now,
+	}
+	if err := r.Status().Update(ctx, &usage); err != nil {
+		logger.Error(err, "failed to update ImageUsage status")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imageusage")
+		return ctrl.Result{}, err
+	}
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "imageusage")
+	return ctrl.Result{}, nil
+}
+
+// buildImageUsageEntries projects the ImageCertificationInfos referenced by pods in the given namespace
+func buildImageUsageEntries(items []securityv1alpha1.ImageCertificationInfo, namespace string) []securityv1alpha1.ImageUsageEntry {
+	var entries []securityv1alpha1.ImageUsageEntry
+	for i := range items {
+		cr := &items[i]
+
+		var podRefs []securityv1alpha1.PodReference
+		for _, podRef := range cr.Status.PodReferences {
+			if podRef.Namespace == namespace {
+				podRefs = append(podRefs, podRef)
+			}
+		}
+		if len(podRefs) == 0 {
+			continue
+		}
+
+		entry := securityv1alpha1.ImageUsageEntry{
+			ImageCertificationInfo: cr.Name,
+			FullImageReference:     cr.Spec.FullImageReference,
+			Registry:               cr.Spec.Registry,
+			Repository:             cr.Spec.Repository,
+			Tag:                    cr.Spec.Tag,
+			RegistryType:           cr.Status.RegistryType,
+			CertificationStatus:    cr.Status.CertificationStatus,
+			DaysUntilEOL:           cr.Status.DaysUntilEOL,
+			PodReferences:          podRefs,
+		}
+		if cr.Status.PyxisData != nil {
+			entry.HealthIndex = cr.Status.PyxisData.HealthIndex
+		}
+		entry.Vulnerabilities = vulnerabilitySummary(cr)
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b securityv1alpha1.ImageUsageEntry) int {
+		return strings.Compare(a.ImageCertificationInfo, b.ImageCertificationInfo)
+	})
+	return entries
+}
+
+// imageUsageOwners returns owner references to the ImageCertificationInfos projected by the
+// entries. An object is only garbage collected once all of its owners are gone, so an
+// ImageUsage left behind, for example after the projection is disabled, is deleted when the
+// last image it lists is no longer tracked. Namespace deletion removes it as usual.
+func imageUsageOwners(items []securityv1alpha1.ImageCertificationInfo,
+	entries []securityv1alpha1.ImageUsageEntry) []metav1.OwnerReference {
+	uids := make(map[string]types.UID, len(items))
+	for i := range items {
+		uids[items[i].Name] = items[i].UID
+	}
+
+	var owners []metav1.OwnerReference
+	for _, entry := range entries {
+		uid := uids[entry.ImageCertificationInfo]
+		if uid == "" {
+			continue
+		}
+		owners = append(owners, metav1.OwnerReference{
+			APIVersion: securityv1alpha1.GroupVersion.String(),
+			Kind:       "ImageCertificationInfo",
+			Name:       entry.ImageCertificationInfo,
+			UID:        uid,
+		})
+	}
+	return owners
+}
+
+// imageUsageRequestsForCR maps an ImageCertificationInfo to the ImageUsage of every namespace it references
+func imageUsageRequestsForCR(_ context.Context, obj client.Object) []reconcile.Request {
+	cr, ok := obj.(*securityv1alpha1.ImageCertificationInfo)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var requests []reconcile.Request
+	for _, podRef := range cr.Status.PodReferences {
+		if seen[podRef.Namespace] {
+			continue
+		}
+		seen[podRef.Namespace] = true
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKey{Namespace: podRef.Namespace, Name: securityv1alpha1.ImageUsageName},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ImageUsageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&securityv1alpha1.ImageUsage{}).
+		Watches(&securityv1alpha1.ImageCertificationInfo{}, handler.EnqueueRequestsFromMapFunc(imageUsageRequestsForCR)).
+		Named("imageusage").
+		Complete(r)
+}
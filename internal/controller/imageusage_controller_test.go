@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestImageUsageReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testCRName,
+		},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        testDigest,
+			FullImageReference: "registry.redhat.io/ubi8/ubi@" + testDigest,
+			Registry:           "registry.redhat.io",
+			Repository:         "ubi8/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			RegistryType:        securityv1alpha1.RegistryTypeRedHat,
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			PyxisData: &securityv1alpha1.PyxisData{
+				HealthIndex: "A",
+			},
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: testNamespace, Name: testPodName, Container: testContainer},
+				{Namespace: "other", Name: "other-pod", Container: "other-container"},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cr).
+		WithStatusSubresource(cr, &securityv1alpha1.ImageUsage{}).
+		Build()
+
+	reconciler := &ImageUsageReconciler{
+		Client: fakeClient,
+		Scheme: scheme,
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: securityv1alpha1.ImageUsageName},
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var usage securityv1alpha1.ImageUsage
+	if err := fakeClient.Get(ctx, req.NamespacedName, &usage); err != nil {
+		t.Fatalf("Failed to get ImageUsage: %v", err)
+	}
+	if usage.Status.ImageCount != 1 {
+		t.Fatalf("ImageCount = %v, want 1", usage.Status.ImageCount)
+	}
+	entry := usage.Status.Images[0]
+	if entry.ImageCertificationInfo != testCRName {
+		t.Errorf("ImageCertificationInfo = %v, want %v", entry.ImageCertificationInfo, testCRName)
+	}
+	if entry.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
+		t.Errorf("CertificationStatus = %v, want Certified", entry.CertificationStatus)
+	}
+	if entry.HealthIndex != "A" {
+		t.Errorf("HealthIndex = %v, want A", entry.HealthIndex)
+	}
+	// Only pod references from the requested namespace are projected
+	if len(entry.PodReferences) != 1 || entry.PodReferences[0].Namespace != testNamespace {
+		t.Errorf("PodReferences = %v, want only references in %s", entry.PodReferences, testNamespace)
+	}
+
+	// Once no pods in the namespace use the image, the projection is removed
+	var latest securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &latest); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	latest.Status.PodReferences = latest.Status.PodReferences[1:]
+	if err := fakeClient.Status().Update(ctx, &latest); err != nil {
+		t.Fatalf("Failed to update ImageCertificationInfo: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	err := fakeClient.Get(ctx, req.NamespacedName, &usage)
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("ImageUsage should be deleted, got err = %v", err)
+	}
+}
+
+func TestImageUsageReconciler_OwnerReferences(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	newCR := func(name string, uid types.UID) *securityv1alpha1.ImageCertificationInfo {
+		return &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: uid},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{
+				PodReferences: []securityv1alpha1.PodReference{{Namespace: testNamespace, Name: name + "-pod"}},
+			},
+		}
+	}
+	first, second := newCR("image-a", "uid-a"), newCR("image-b", "uid-b")
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(first, second).
+		WithStatusSubresource(first, &securityv1alpha1.ImageUsage{}).
+		Build()
+	reconciler := &ImageUsageReconciler{Client: fakeClient, Scheme: scheme}
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: securityv1alpha1.ImageUsageName},
+	}
+
+	owners := func() []string {
+		t.Helper()
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		var usage securityv1alpha1.ImageUsage
+		if err := fakeClient.Get(ctx, req.NamespacedName, &usage); err != nil {
+			t.Fatalf("Failed to get ImageUsage: %v", err)
+		}
+		var names []string
+		for _, owner := range usage.OwnerReferences {
+			if owner.Kind != "ImageCertificationInfo" || owner.Controller != nil || owner.BlockOwnerDeletion != nil {
+				t.Errorf("owner reference = %+v, want a plain reference to an ImageCertificationInfo", owner)
+			}
+			names = append(names, owner.Name+"/"+string(owner.UID))
+		}
+		return names
+	}
+
+	// Every projected image owns the ImageUsage
+	if got := owners(); len(got) != 2 || got[0] != "image-a/uid-a" || got[1] != "image-b/uid-b" {
+		t.Errorf("owners = %v, want image-a and image-b", got)
+	}
+
+	// An image that leaves the namespace no longer owns it
+	if err := fakeClient.Delete(ctx, second); err != nil {
+		t.Fatalf("Failed to delete ImageCertificationInfo: %v", err)
+	}
+	if got := owners(); len(got) != 1 || got[0] != "image-a/uid-a" {
+		t.Errorf("owners = %v, want only image-a", got)
+	}
+}
+
+func TestImageUsageRequestsForCR(t *testing.T) {
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: "a", Name: "pod-1"},
+				{Namespace: "a", Name: "pod-2"},
+				{Namespace: "b", Name: "pod-3"},
+			},
+		},
+	}
+
+	requests := imageUsageRequestsForCR(context.Background(), cr)
+	if len(requests) != 2 {
+		t.Fatalf("requests count = %v, want 2", len(requests))
+	}
+	for _, req := range requests {
+		if req.Name != securityv1alpha1.ImageUsageName {
+			t.Errorf("request name = %v, want %v", req.Name, securityv1alpha1.ImageUsageName)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultInventoryMetricsInterval is the default interval for recomputing the inventory gauges
+const DefaultInventoryMetricsInterval = time.Minute
+
+// osUnknown is the operating system reported for images whose platforms are unknown
+const osUnknown = "unknown"
+
+// eolWindowsDays are the windows reported by the images_eol_within_days gauge
+var eolWindowsDays = []int{30, 90, 180}
+
+// Label values that are always reported, so dashboards show zero rather than no data
+var (
+	inventoryStatuses = []securityv1alpha1.CertificationStatus{
+		securityv1alpha1.CertificationStatusCertified,
+		securityv1alpha1.CertificationStatusDeprecated,
+		securityv1alpha1.CertificationStatusEOL,
+		securityv1alpha1.CertificationStatusOfficial,
+		securityv1alpha1.CertificationStatusVerified,
+		securityv1alpha1.CertificationStatusNotCertified,
+		securityv1alpha1.CertificationStatusPending,
+		securityv1alpha1.CertificationStatusUnknown,
+		securityv1alpha1.CertificationStatusError,
+	}
+	inventoryHealthGrades = []string{"A", "B", "C", "D", "E", "F"}
+	inventorySeverities   = []string{SeverityCritical, SeverityImportant, "moderate", "low"}
+)
+
+// InventoryMetrics keeps the image inventory gauges (images_total, images_by_health,
+// vulnerabilities_total, images_eol_within_days, and images_past_eol) current by
+// periodically aggregating every ImageCertificationInfo. Large inventories are aggregated
+// in chunks with a pause in between, so that a collection does not hold a CPU for long.
+type InventoryMetrics struct {
+	client.Client
+	// Interval is how often the gauges are recomputed
+	Interval time.Duration
+	// EOLTiers are the end-of-life warning tiers, widest first (nil uses DefaultEOLTiers)
+	EOLTiers []EOLTier
+	// ChunkSize is the number of images aggregated between pauses (0 uses DefaultInventoryChunkSize)
+	ChunkSize int
+	// ChunkPause is the pause between chunks (0 uses DefaultInventoryChunkPause)
+	ChunkPause time.Duration
+}
+
+const (
+	// DefaultInventoryChunkSize is the default number of images aggregated between pauses
+	DefaultInventoryChunkSize = 500
+	// DefaultInventoryChunkPause is the default pause between chunks of images
+	DefaultInventoryChunkPause = 10 * time.Millisecond
+)
+
+// Start recomputes the inventory gauges every Interval until ctx is cancelled. It runs
+// only on the elected leader so that images are not counted once per replica.
+func (m *InventoryMetrics) Start(ctx context.Context) error {
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+
+	due := time.Now()
+	for {
+		if err := m.Collect(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.FromContext(ctx).Error(err, "failed to collect image inventory metrics")
+		} else {
+			// The ticker drops ticks while a slow collection runs, which shows up as lag
+			metrics.RecordInventoryCollectionLag(time.Since(due).Seconds())
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case due = <-ticker.C:
+		}
+	}
+}
+
+// Collect lists all images and sets the inventory gauges. The gauges are only replaced
+// once every image has been aggregated, so a cancelled collection leaves them unchanged.
+func (m *InventoryMetrics) Collect(ctx context.Context) error {
+	start := time.Now()
+
+	// The images are only read, so the cached copies need not be deep-copied
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := m.List(ctx, &crList, client.UnsafeDisableDeepCopy); err != nil {
+		return err
+	}
+	tiers := m.EOLTiers
+	if tiers == nil {
+		tiers = DefaultEOLTiers
+	}
+	chunkSize := cmp.Or(m.ChunkSize, DefaultInventoryChunkSize)
+	pause := cmp.Or(m.ChunkPause, DefaultInventoryChunkPause)
+
+	now := time.Now()
+	inv := newInventory(tiers)
+	for chunk := range slices.Chunk(crList.Items, chunkSize) {
+		for i := range chunk {
+			addToInventory(&inv, &chunk[i], tiers, now)
+		}
+		if len(chunk) < chunkSize {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pause):
+		}
+	}
+	metrics.SetInventory(inv)
+	metrics.RecordInventoryCollection(len(crList.Items), time.Since(start).Seconds())
+	return nil
+}
+
+// summarizeInventory aggregates the images into an inventory snapshot as of now
+func summarizeInventory(items []securityv1alpha1.ImageCertificationInfo, tiers []EOLTier,
+	now time.Time) metrics.Inventory {
+	inv := newInventory(tiers)
+	for i := range items {
+		addToInventory(&inv, &items[i], tiers, now)
+	}
+	return inv
+}
+
+// newInventory returns an empty inventory that reports zero for every always-reported label value
+func newInventory(tiers []EOLTier) metrics.Inventory {
+	inv := metrics.Inventory{
+		ByStatus:        make(map[string]int, len(inventoryStatuses)),
+		ByOS:            make(map[string]map[string]int),
+		ByHealth:        make(map[string]int, len(inventoryHealthGrades)),
+		Vulnerabilities: make(map[string]int, len(inventorySeverities)),
+		EOLWithinDays:   make(map[int]int, len(eolWindowsDays)),
+		EOLTiers:        make(map[string]int, len(tiers)),
+	}
+	for _, status := range inventoryStatuses {
+		inv.ByStatus[string(status)] = 0
+	}
+	for _, grade := range inventoryHealthGrades {
+		inv.ByHealth[grade] = 0
+	}
+	for _, severity := range inventorySeverities {
+		inv.Vulnerabilities[severity] = 0
+	}
+	for _, days := range eolWindowsDays {
+		inv.EOLWithinDays[days] = 0
+	}
+	for _, tier := range tiers {
+		inv.EOLTiers[tier.Name] = 0
+	}
+	return inv
+}
+
+// addToInventory counts an image in the inventory as of now
+func addToInventory(inv *metrics.Inventory, cr *securityv1alpha1.ImageCertificationInfo, tiers []EOLTier,
+	now time.Time) {
+	status := cr.Status.CertificationStatus
+	if status == "" {
+		status = securityv1alpha1.CertificationStatusPending
+	}
+	inv.ByStatus[string(status)]++
+	systems := cr.Status.OperatingSystems
+	if len(systems) == 0 {
+		systems = []string{osUnknown}
+	}
+	for _, os := range systems {
+		if inv.ByOS[os] == nil {
+			inv.ByOS[os] = make(map[string]int)
+		}
+		inv.ByOS[os][string(status)]++
+	}
+	if isExempt(cr) {
+		// Accepted risks stay in the inventory but out of the gauges that alerts are built on
+		inv.Exempted++
+		return
+	}
+
+	if vulns := vulnerabilitySummary(cr); vulns != nil {
+		inv.Vulnerabilities[SeverityCritical] += vulns.Critical
+		inv.Vulnerabilities[SeverityImportant] += vulns.Important
+		inv.Vulnerabilities["moderate"] += vulns.Moderate
+		inv.Vulnerabilities["low"] += vulns.Low
+	}
+
+	pyxisData := cr.Status.PyxisData
+	if pyxisData == nil {
+		return
+	}
+	if pyxisData.HealthIndex != "" {
+		inv.ByHealth[pyxisData.HealthIndex]++
+	}
+	if pyxisData.EOLDate == nil {
+		return
+	}
+	untilEOL := pyxisData.EOLDate.Sub(now)
+	if untilEOL < 0 {
+		inv.PastEOL++
+		return
+	}
+	for _, days := range eolWindowsDays {
+		if untilEOL <= time.Duration(days)*24*time.Hour {
+			inv.EOLWithinDays[days]++
+		}
+	}
+	if tier := matchEOLTier(tiers, int(untilEOL.Hours()/24)); tier >= 0 {
+		inv.EOLTiers[tiers[tier].Name]++
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestSummarizeInventory(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	eol := func(days int) *metav1.Time {
+		return &metav1.Time{Time: now.Add(time.Duration(days) * 24 * time.Hour)}
+	}
+
+	items := []securityv1alpha1.ImageCertificationInfo{
+		{Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			PyxisData: &securityv1alpha1.PyxisData{
+				HealthIndex:     "A",
+				EOLDate:         eol(20),
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 1, Important: 2, Low: 3},
+			},
+		}},
+		{Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			PyxisData:           &securityv1alpha1.PyxisData{HealthIndex: "C", EOLDate: eol(-5)},
+		}},
+		{Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			PyxisData:           &securityv1alpha1.PyxisData{EOLDate: eol(120)},
+		}},
+		// Quay scans count toward vulnerabilities when there is no Pyxis data
+		{Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+			OperatingSystems:    []string{"linux", "windows"},
+			QuayData: &securityv1alpha1.QuayData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 2, Moderate: 4},
+			},
+		}},
+		// Images not yet enriched are pending
+		{},
+	}
+
+	inv := summarizeInventory(items, DefaultEOLTiers, now)
+
+	wantStatus := map[string]int{"Certified": 3, "Unknown": 1, "Pending": 1, "NotCertified": 0}
+	for status, want := range wantStatus {
+		if got := inv.ByStatus[status]; got != want {
+			t.Errorf("ByStatus[%s] = %d, want %d", status, got, want)
+		}
+	}
+	if len(inv.ByStatus) != len(inventoryStatuses) {
+		t.Errorf("ByStatus has %d statuses, want every status reported", len(inv.ByStatus))
+	}
+	if inv.ByOS["unknown"]["Certified"] != 3 || inv.ByOS["linux"]["Unknown"] != 1 ||
+		inv.ByOS["windows"]["Unknown"] != 1 || inv.ByOS["unknown"]["Pending"] != 1 {
+		t.Errorf("ByOS = %v", inv.ByOS)
+	}
+	if inv.ByHealth["A"] != 1 || inv.ByHealth["C"] != 1 || inv.ByHealth["F"] != 0 || len(inv.ByHealth) != 6 {
+		t.Errorf("ByHealth = %v", inv.ByHealth)
+	}
+	wantVulns := map[string]int{"critical": 3, "important": 2, "moderate": 4, "low": 3}
+	for severity, want := range wantVulns {
+		if got := inv.Vulnerabilities[severity]; got != want {
+			t.Errorf("Vulnerabilities[%s] = %d, want %d", severity, got, want)
+		}
+	}
+	if inv.PastEOL != 1 {
+		t.Errorf("PastEOL = %d, want 1", inv.PastEOL)
+	}
+	wantTiers := map[string]int{"notice": 1, "warning": 0, "critical": 1, "imminent": 0}
+	for tier, want := range wantTiers {
+		if got, ok := inv.EOLTiers[tier]; !ok || got != want {
+			t.Errorf("EOLTiers[%s] = %d, want %d", tier, got, want)
+		}
+	}
+	wantEOL := map[int]int{30: 1, 90: 1, 180: 2}
+	for days, want := range wantEOL {
+		if got := inv.EOLWithinDays[days]; got != want {
+			t.Errorf("EOLWithinDays[%d] = %d, want %d", days, got, want)
+		}
+	}
+}
+
+func TestInventoryMetrics_CollectInChunks(t *testing.T) {
+	builder := fake.NewClientBuilder().WithScheme(newTestScheme())
+	for i := range 5 {
+		builder = builder.WithObjects(&securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("image-%d", i)},
+		})
+	}
+	m := &InventoryMetrics{Client: builder.Build(), ChunkSize: 2, ChunkPause: time.Millisecond}
+
+	if err := m.Collect(context.Background()); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	// A collection cancelled between chunks stops without replacing the gauges
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m.ChunkPause = time.Hour
+	if err := m.Collect(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Collect() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
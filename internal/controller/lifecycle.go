@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultLifecycleEvaluationInterval is the default interval between lifecycle evaluations
+const DefaultLifecycleEvaluationInterval = time.Hour
+
+// ReleaseCategoryDeprecated is the Pyxis release category of deprecated repositories
+const ReleaseCategoryDeprecated = "Deprecated"
+
+// lifecycleStatuses are the certification statuses of images certified by Pyxis
+var lifecycleStatuses = []securityv1alpha1.CertificationStatus{
+	securityv1alpha1.CertificationStatusCertified,
+	securityv1alpha1.CertificationStatusDeprecated,
+	securityv1alpha1.CertificationStatusEOL,
+}
+
+// lifecycleStatus returns the status of an image certified by Pyxis as of now: EOL once
+// its end-of-life date has passed, Deprecated while its release category is Deprecated,
+// and Certified otherwise
+func lifecycleStatus(data *securityv1alpha1.PyxisData, now time.Time) (securityv1alpha1.CertificationStatus,
+	securityv1alpha1.ConditionReason) {
+	switch {
+	case data.EOLDate != nil && !now.Before(data.EOLDate.Time):
+		return securityv1alpha1.CertificationStatusEOL, securityv1alpha1.ReasonPastEndOfLife
+	case data.ReleaseCategory == ReleaseCategoryDeprecated:
+		return securityv1alpha1.CertificationStatusDeprecated, securityv1alpha1.ReasonDeprecatedRelease
+	default:
+		return securityv1alpha1.CertificationStatusCertified, securityv1alpha1.ReasonCertifiedByPyxis
+	}
+}
+
+// applyLifecycle recomputes DaysUntilEOL and the lifecycle status of an image certified by
+// Pyxis as of now, and reports whether either changed. Other images are left unchanged.
+func applyLifecycle(cr *securityv1alpha1.ImageCertificationInfo, now time.Time) bool {
+	data := cr.Status.PyxisData
+	if data == nil || !slices.Contains(lifecycleStatuses, cr.Status.CertificationStatus) {
+		return false
+	}
+
+	var daysUntil *int
+	if data.EOLDate != nil {
+		days := int(data.EOLDate.Sub(now).Hours() / 24)
+		daysUntil = &days
+	}
+	status, reason := lifecycleStatus(data, now)
+	if status == cr.Status.CertificationStatus && reason == cr.Status.ReasonCode &&
+		equalOptionalInt(daysUntil, cr.Status.DaysUntilEOL) {
+		return false
+	}
+	setCertificationStatus(cr, status, reason)
+	cr.Status.DaysUntilEOL = daysUntil
+	return true
+}
+
+// equalOptionalInt reports whether two optional integers are both unset or equal
+func equalOptionalInt(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// LifecycleEvaluator re-evaluates the end-of-life of every image certified by Pyxis on a
+// schedule, so that an image becomes EOL on its end-of-life date and DaysUntilEOL counts
+// down without waiting for the next Pyxis refresh. It also downgrades certification
+// statuses whose provider has not been read within StalenessHorizon, and keeps the
+// images_lifecycle and images_stale metrics current.
+type LifecycleEvaluator struct {
+	// Pods writes the images and emits their events
+	Pods *PodReconciler
+	// Interval is how often the images are evaluated
+	Interval time.Duration
+	// StalenessHorizon is how long after the last successful lookup a certification status
+	// is trusted (zero trusts it indefinitely)
+	StalenessHorizon time.Duration
+}
+
+// Start evaluates the images every Interval until ctx is cancelled. It runs only on the
+// elected leader so that replicas do not duplicate writes.
+func (e *LifecycleEvaluator) Start(ctx context.Context) error {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Evaluate(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to evaluate image lifecycles")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate updates the lifecycle status of every image whose status changed since it was
+// last written, and downgrades the images whose status has gone stale
+func (e *LifecycleEvaluator) Evaluate(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("lifecycle")
+	r := e.Pods
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	counts := map[string]int{
+		string(securityv1alpha1.CertificationStatusEOL):        0,
+		string(securityv1alpha1.CertificationStatusDeprecated): 0,
+	}
+	stale := 0
+	for i := range crList.Items {
+		cr := &crList.Items[i]
+		oldStatus := cr.Status.CertificationStatus
+		changed := applyLifecycle(cr, now)
+		if applyStaleness(cr, e.StalenessHorizon, now) {
+			logger.Info("certification status is stale", "name", cr.Name, "status", oldStatus,
+				"horizon", e.StalenessHorizon)
+			changed = true
+		}
+		if changed {
+			r.emitEOLEvent(ctx, cr)
+			if err := r.Status().Update(ctx, cr); err != nil {
+				logger.Error(err, "failed to update image lifecycle", "name", cr.Name)
+				continue
+			}
+			r.emitChangeEvents(ctx, cr, oldStatus, cr.Status.CertificationStatus, "", "")
+		}
+		if _, ok := counts[string(cr.Status.CertificationStatus)]; ok {
+			counts[string(cr.Status.CertificationStatus)]++
+		}
+		if cr.Status.ReasonCode == securityv1alpha1.ReasonCertificationStale {
+			stale++
+		}
+	}
+	metrics.SetImagesLifecycle(counts)
+	metrics.SetImagesStale(stale)
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestApplyLifecycle(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	certified := func(eol *time.Time, category string) *securityv1alpha1.ImageCertificationInfo {
+		cr := &securityv1alpha1.ImageCertificationInfo{}
+		cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusCertified
+		cr.Status.ReasonCode = securityv1alpha1.ReasonCertifiedByPyxis
+		cr.Status.PyxisData = &securityv1alpha1.PyxisData{ReleaseCategory: category}
+		if eol != nil {
+			cr.Status.PyxisData.EOLDate = &metav1.Time{Time: *eol}
+		}
+		return cr
+	}
+	days := func(n int) *int { return &n }
+	past := now.Add(-time.Hour)
+	future := now.Add(10 * 24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		cr         *securityv1alpha1.ImageCertificationInfo
+		wantStatus securityv1alpha1.CertificationStatus
+		wantReason securityv1alpha1.ConditionReason
+		wantDays   *int
+	}{
+		{"generally available", certified(nil, "Generally Available"),
+			securityv1alpha1.CertificationStatusCertified, securityv1alpha1.ReasonCertifiedByPyxis, nil},
+		{"deprecated release", certified(&future, ReleaseCategoryDeprecated),
+			securityv1alpha1.CertificationStatusDeprecated, securityv1alpha1.ReasonDeprecatedRelease, days(10)},
+		{"past end-of-life", certified(&past, ReleaseCategoryDeprecated),
+			securityv1alpha1.CertificationStatusEOL, securityv1alpha1.ReasonPastEndOfLife, days(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyLifecycle(tt.cr, now)
+			if tt.cr.Status.CertificationStatus != tt.wantStatus || tt.cr.Status.ReasonCode != tt.wantReason {
+				t.Errorf("status = %s/%s, want %s/%s", tt.cr.Status.CertificationStatus, tt.cr.Status.ReasonCode,
+					tt.wantStatus, tt.wantReason)
+			}
+			if !equalOptionalInt(tt.cr.Status.DaysUntilEOL, tt.wantDays) {
+				t.Errorf("DaysUntilEOL = %v, want %v", tt.cr.Status.DaysUntilEOL, tt.wantDays)
+			}
+			// Applying again is a no-op
+			if applyLifecycle(tt.cr, now) {
+				t.Error("applyLifecycle() reported a change on the second call")
+			}
+		})
+	}
+
+	// Images not certified by Pyxis are left alone
+	cr := certified(&past, "")
+	cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusOfficial
+	if applyLifecycle(cr, now) || cr.Status.CertificationStatus != securityv1alpha1.CertificationStatusOfficial {
+		t.Errorf("applyLifecycle() changed a %s image", securityv1alpha1.CertificationStatusOfficial)
+	}
+}
+
+func TestLifecycleEvaluator_Evaluate(t *testing.T) {
+	ctx := context.Background()
+
+	// Certified while its end-of-life date was ahead, which has since passed
+	cr := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: testCRName}}
+	cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusCertified
+	cr.Status.ReasonCode = securityv1alpha1.ReasonCertifiedByPyxis
+	oneDay := 1
+	cr.Status.DaysUntilEOL = &oneDay
+	cr.Status.EOLWarningTier = "imminent"
+	cr.Status.PyxisData = &securityv1alpha1.PyxisData{EOLDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(cr).
+		WithStatusSubresource(cr).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	evaluator := &LifecycleEvaluator{Pods: &PodReconciler{Client: fakeClient, Recorder: recorder}}
+
+	if err := evaluator.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+
+	var got securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &got); err != nil {
+		t.Fatalf("Failed to get CR: %v", err)
+	}
+	if got.Status.CertificationStatus != securityv1alpha1.CertificationStatusEOL {
+		t.Errorf("CertificationStatus = %s, want %s", got.Status.CertificationStatus, securityv1alpha1.CertificationStatusEOL)
+	}
+	if got.Status.ReasonCode != securityv1alpha1.ReasonPastEndOfLife {
+		t.Errorf("ReasonCode = %s, want %s", got.Status.ReasonCode, securityv1alpha1.ReasonPastEndOfLife)
+	}
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if len(events) != 1 || !strings.Contains(events[0], "from Certified to EOL") {
+		t.Errorf("events = %v, want one certification change to EOL", events)
+	}
+
+	// A second evaluation changes nothing
+	if err := evaluator.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("unexpected event on second evaluation: %s", <-recorder.Events)
+	}
+}
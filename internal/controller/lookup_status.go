@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// recordLookupSuccess records a successful certification lookup of an image at now,
+// resetting its consecutive error count
+func recordLookupSuccess(cr *securityv1alpha1.ImageCertificationInfo, now metav1.Time) {
+	cr.Status.LastReconcileAt = &now
+	cr.Status.ConsecutiveErrorCount = 0
+}
+
+// recordLookupFailure records a failed certification lookup of an image at now
+func recordLookupFailure(cr *securityv1alpha1.ImageCertificationInfo, now metav1.Time) {
+	cr.Status.LastReconcileAt = &now
+	cr.Status.LastErrorAt = &now
+	cr.Status.ConsecutiveErrorCount++
+}
+
+// writeLookupFailure records a failed certification lookup in the status of cr, which must
+// be freshly read, so that images that keep failing can be found without the operator logs
+func (r *PodReconciler) writeLookupFailure(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo) {
+	recordLookupFailure(cr, metav1.Now())
+	if err := r.Status().Update(ctx, cr); err != nil {
+		log.FromContext(ctx).Error(err, "failed to record certification lookup failure", "name", cr.Name)
+	}
+}
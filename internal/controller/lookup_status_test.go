@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+func TestPodReconciler_RefreshSingleImage_LookupErrors(t *testing.T) {
+	ctx := context.Background()
+
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest: testDigest,
+			Registry:    "registry.redhat.io",
+			Repository:  "ubi9/ubi",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(cr).
+		WithStatusSubresource(cr).
+		Build()
+	mockPyxis := &MockPyxisClient{Err: errors.New("pyxis unavailable")}
+	r := &PodReconciler{Client: fakeClient, PyxisClient: mockPyxis}
+
+	get := func() securityv1alpha1.ImageCertificationInfoStatus {
+		var got securityv1alpha1.ImageCertificationInfo
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &got); err != nil {
+			t.Fatalf("Failed to get CR: %v", err)
+		}
+		return got.Status
+	}
+
+	// Each failed lookup is counted
+	for range 2 {
+		if err := r.refreshSingleImage(ctx, cr); err == nil {
+			t.Fatal("refreshSingleImage() expected an error")
+		}
+	}
+	status := get()
+	if status.ConsecutiveErrorCount != 2 || status.LastErrorAt == nil || status.LastReconcileAt == nil {
+		t.Errorf("after failures: consecutiveErrorCount = %d, lastErrorAt = %v, lastReconcileAt = %v",
+			status.ConsecutiveErrorCount, status.LastErrorAt, status.LastReconcileAt)
+	}
+
+	// A successful lookup resets the count but keeps when the last error happened
+	mockPyxis.Err = nil
+	mockPyxis.CertData = &pyxis.CertificationData{HealthIndex: "A"}
+	if err := r.refreshSingleImage(ctx, cr); err != nil {
+		t.Fatalf("refreshSingleImage() error = %v", err)
+	}
+	status = get()
+	if status.ConsecutiveErrorCount != 0 || status.LastErrorAt == nil {
+		t.Errorf("after success: consecutiveErrorCount = %d, lastErrorAt = %v",
+			status.ConsecutiveErrorCount, status.LastErrorAt)
+	}
+}
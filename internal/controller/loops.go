@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
+)
+
+// CleanupLoop periodically cleans up stale pod references. It runs only on the elected
+// leader, or on every shard member in shard mode since each cleans up the images it owns.
+type CleanupLoop struct {
+	// Pods cleans up the references and reports the loop heartbeat
+	Pods *PodReconciler
+	// Interval is how often references are cleaned up; the loop follows its changes
+	Interval *TunableInterval
+}
+
+// NeedLeaderElection returns true unless the work is sharded across instances
+func (l *CleanupLoop) NeedLeaderElection() bool {
+	return l.Pods.Shard == nil
+}
+
+// Start cleans up stale pod references every Interval until ctx is cancelled
+func (l *CleanupLoop) Start(ctx context.Context) error {
+	ticker := time.NewTicker(l.Interval.Get())
+	defer ticker.Stop()
+	l.Pods.Heartbeats.Beat(health.LoopCleanup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-l.Interval.Changed():
+			ticker.Reset(l.Interval.Get())
+		case <-ticker.C:
+			if err := l.Pods.CleanupStaleReferences(ctx); err != nil {
+				log.FromContext(ctx).Error(err, "failed to cleanup stale references")
+			}
+			l.Pods.Heartbeats.Beat(health.LoopCleanup)
+		}
+	}
+}
+
+// RefreshLoop periodically refreshes all ImageCertificationInfo resources. It runs only on
+// the elected leader, or on every shard member in shard mode since each refreshes the
+// images it owns.
+type RefreshLoop struct {
+	// Pods refreshes the images and reports the loop heartbeat
+	Pods *PodReconciler
+	// Interval is how often all images are refreshed; the loop follows its changes
+	Interval *TunableInterval
+	// StartupDelay is the longest random delay before the first refresh, which avoids a
+	// thundering herd of lookups when replicas start together (zero starts immediately)
+	StartupDelay time.Duration
+}
+
+// DefaultRefreshStartupDelay is the default longest random delay before the first refresh
+const DefaultRefreshStartupDelay = 5 * time.Minute
+
+// NeedLeaderElection returns true unless the work is sharded across instances
+func (l *RefreshLoop) NeedLeaderElection() bool {
+	return l.Pods.Shard == nil
+}
+
+// Start refreshes the images after a random startup delay and then every Interval until
+// ctx is cancelled
+func (l *RefreshLoop) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("refresh-loop")
+	r := l.Pods
+
+	var startupDelay time.Duration
+	if l.StartupDelay > 0 {
+		startupDelay = time.Duration(rand.Int63n(int64(l.StartupDelay))) //nolint:gosec
+	}
+	logger.Info("refresh loop starting with delay", "delay", startupDelay)
+	r.Heartbeats.Beat(health.LoopRefresh)
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-time.After(startupDelay):
+	}
+
+	ticker := time.NewTicker(l.Interval.Get())
+	defer ticker.Stop()
+	// Images with a refresh interval override may be due between full cycles
+	overrideTicker := time.NewTicker(refreshOverrideCheckInterval)
+	defer overrideTicker.Stop()
+
+	// Run immediately after startup delay
+	if err := r.RefreshAllImages(ctx); err != nil {
+		logger.Error(err, "failed to refresh images")
+	}
+	r.Heartbeats.Beat(health.LoopRefresh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-l.Interval.Changed():
+			ticker.Reset(l.Interval.Get())
+		case <-ticker.C:
+			if err := r.RefreshAllImages(ctx); err != nil {
+				logger.Error(err, "failed to refresh images")
+			}
+			r.Heartbeats.Beat(health.LoopRefresh)
+		case <-overrideTicker.C:
+			if err := r.RefreshOverriddenImages(ctx); err != nil {
+				logger.Error(err, "failed to refresh images with a refresh interval override")
+			}
+		}
+	}
+}
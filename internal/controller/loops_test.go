@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/sharding"
+)
+
+func TestCleanupLoop(t *testing.T) {
+	scheme := newTestScheme()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		Build()
+
+	reconciler := &PodReconciler{
+		Client:     fakeClient,
+		Scheme:     scheme,
+		Heartbeats: health.NewHeartbeats(),
+	}
+	loop := &CleanupLoop{Pods: reconciler, Interval: NewTunableInterval(10 * time.Millisecond)}
+	if !loop.NeedLeaderElection() {
+		t.Error("expected the cleanup loop to require leader election without sharding")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- loop.Start(ctx) }()
+
+	// Let it run a few cleanup cycles
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Start() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cleanup loop did not stop after cancellation")
+	}
+	if _, ok := reconciler.Heartbeats.Last(health.LoopCleanup); !ok {
+		t.Error("expected the cleanup loop to report a heartbeat")
+	}
+}
+
+func TestRefreshLoop(t *testing.T) {
+	scheme := newTestScheme()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		Build()
+
+	reconciler := &PodReconciler{
+		Client:     fakeClient,
+		Scheme:     scheme,
+		Heartbeats: health.NewHeartbeats(),
+	}
+	loop := &RefreshLoop{Pods: reconciler, Interval: NewTunableInterval(1 * time.Hour)}
+	if !loop.NeedLeaderElection() {
+		t.Error("expected the refresh loop to require leader election without sharding")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- loop.Start(ctx) }()
+
+	// Without a startup delay the first refresh runs immediately
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Start() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("refresh loop did not stop after cancellation")
+	}
+	if _, ok := reconciler.Heartbeats.Last(health.LoopRefresh); !ok {
+		t.Error("expected the refresh loop to report a heartbeat")
+	}
+}
+
+func TestLoopsRunOnEveryShardMember(t *testing.T) {
+	reconciler := &PodReconciler{Shard: sharding.NewMembership(nil, testNamespace, "member-a")}
+
+	if (&CleanupLoop{Pods: reconciler}).NeedLeaderElection() {
+		t.Error("expected the cleanup loop to run on every shard member")
+	}
+	if (&RefreshLoop{Pods: reconciler}).NeedLeaderElection() {
+		t.Error("expected the refresh loop to run on every shard member")
+	}
+}
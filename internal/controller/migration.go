@@ -0,0 +1,260 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/version"
+)
+
+// EventReasonMigrationCompleted is emitted on the operator pod once ImageCertificationInfos
+// written by earlier versions have been migrated
+const EventReasonMigrationCompleted = "MigrationCompleted"
+
+// Migration upgrades an ImageCertificationInfo written by an earlier version to what the
+// current version expects
+type Migration struct {
+	// Name identifies the migration in events and metrics
+	Name string
+	// Migrate updates cr in place and reports whether it changed anything. It must be a
+	// no-op on images that are already migrated.
+	Migrate func(cr *securityv1alpha1.ImageCertificationInfo) bool
+}
+
+// Migrations are the migrations applied to every ImageCertificationInfo on startup, in order
+var Migrations = []Migration{
+	{Name: "legacy-cve-annotation", Migrate: migrateLegacyCVEAnnotation},
+	{Name: "first-seen-at", Migrate: migrateFirstSeenAt},
+	{Name: "workload-count", Migrate: migrateWorkloadCount},
+	{Name: "warning-state", Migrate: migrateWarningState},
+}
+
+// InventoryMigrator migrates the ImageCertificationInfos written by earlier versions when
+// the operator starts, so that data survives upgrades without waiting for every image to
+// be reconciled again. It runs once on the elected leader and emits a MigrationCompleted
+// event with the number of images changed by each migration. Images that already match
+// the current schema are not written, so the event is only emitted after an upgrade that
+// needed it.
+type InventoryMigrator struct {
+	client.Client
+	Recorder record.EventRecorder
+	// Reader reads the operator pod the event is recorded on. It should not be backed by
+	// the manager cache, which may not include the operator namespace.
+	Reader client.Reader
+	// Namespace and PodName identify the operator pod. No event is emitted when unset.
+	Namespace string
+	PodName   string
+}
+
+// Start runs the migrations once
+func (m *InventoryMigrator) Start(ctx context.Context) error {
+	if err := m.Migrate(ctx); err != nil {
+		log.FromContext(ctx).Error(err, "failed to migrate ImageCertificationInfos")
+	}
+	return nil
+}
+
+// Migrate applies Migrations to every ImageCertificationInfo and reports the result
+func (m *InventoryMigrator) Migrate(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("migration")
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := m.List(ctx, &crList); err != nil {
+		return err
+	}
+
+	counts := make(map[string]int)
+	migrated, failed := 0, 0
+	for i := range crList.Items {
+		applied, err := m.migrateImage(ctx, &crList.Items[i])
+		if err != nil {
+			logger.Error(err, "failed to migrate image", "name", crList.Items[i].Name)
+			failed++
+			continue
+		}
+		if len(applied) > 0 {
+			migrated++
+		}
+		for _, name := range applied {
+			counts[name]++
+			metrics.RecordImageMigrated(name)
+		}
+	}
+	if migrated == 0 && failed == 0 {
+		return nil
+	}
+
+	msg := migrationSummary(len(crList.Items), migrated, failed, counts)
+	logger.Info(msg, "version", version.Get().Version)
+	m.recordEvent(ctx, msg, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d images could not be migrated", failed, len(crList.Items))
+	}
+	return nil
+}
+
+// migrateImage applies the migrations to cr and writes the changes, returning the names of
+// the migrations that changed it. Conflicting writes are retried against the latest version.
+func (m *InventoryMigrator) migrateImage(ctx context.Context,
+	cr *securityv1alpha1.ImageCertificationInfo) ([]string, error) {
+	var applied []string
+	retrying := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if retrying {
+			if err := m.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+				return err
+			}
+		}
+		retrying = true
+
+		original := cr.DeepCopy()
+		applied = nil
+		for _, migration := range Migrations {
+			if migration.Migrate(cr) {
+				applied = append(applied, migration.Name)
+			}
+		}
+		if len(applied) == 0 {
+			return nil
+		}
+		if !equality.Semantic.DeepEqual(original.Status, cr.Status) {
+			if err := m.Status().Update(ctx, cr.DeepCopy()); err != nil {
+				return err
+			}
+		}
+		if !equality.Semantic.DeepEqual(original.ObjectMeta, cr.ObjectMeta) {
+			return m.Patch(ctx, cr, client.MergeFrom(original))
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// recordEvent emits the MigrationCompleted event on the operator pod
+func (m *InventoryMigrator) recordEvent(ctx context.Context, msg string, failed int) {
+	if m.Recorder == nil || m.Reader == nil || m.Namespace == "" || m.PodName == "" {
+		return
+	}
+	pod := &corev1.Pod{}
+	if err := m.Reader.Get(ctx, client.ObjectKey{Namespace: m.Namespace, Name: m.PodName}, pod); err != nil {
+		log.FromContext(ctx).Error(err, "failed to get the operator pod for the migration event")
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if failed > 0 {
+		eventType = corev1.EventTypeWarning
+	}
+	m.Recorder.Event(pod, eventType, EventReasonMigrationCompleted, msg)
+	metrics.RecordEvent(eventType, EventReasonMigrationCompleted)
+}
+
+// migrationSummary describes the result of a migration run, listing the migrations in order
+func migrationSummary(total, migrated, failed int, counts map[string]int) string {
+	var parts []string
+	for _, migration := range Migrations {
+		if n := counts[migration.Name]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s=%d", migration.Name, n))
+		}
+	}
+	msg := fmt.Sprintf("Migrated %d of %d images to version %s", migrated, total, version.Get().Version)
+	if len(parts) > 0 {
+		msg += " (" + strings.Join(parts, ", ") + ")"
+	}
+	if failed > 0 {
+		msg += fmt.Sprintf(", %d failed", failed)
+	}
+	return msg
+}
+
+// migrateLegacyCVEAnnotation moves the comma-separated CVE annotation written by earlier
+// versions into status.pyxisData.cves when the status does not list any CVEs yet
+func migrateLegacyCVEAnnotation(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	value, ok := cr.Annotations[annotationLegacyCVEs]
+	if !ok {
+		return false
+	}
+	delete(cr.Annotations, annotationLegacyCVEs)
+
+	if cr.Status.PyxisData == nil || len(cr.Status.PyxisData.CVEs) > 0 {
+		return true
+	}
+	var cves []securityv1alpha1.CVE
+	for id := range strings.SplitSeq(value, ",") {
+		id = strings.TrimSpace(id)
+		if id != "" && !slices.ContainsFunc(cves, func(c securityv1alpha1.CVE) bool { return c.ID == id }) {
+			cves = append(cves, securityv1alpha1.CVE{ID: id})
+		}
+	}
+	cr.Status.PyxisData.CVEs = cves
+	cr.Status.PyxisData.TotalCVEs = len(cves)
+	return true
+}
+
+// migrateFirstSeenAt sets status.firstSeenAt on images created before it was recorded, using
+// the creation time of the image as the closest known value
+func migrateFirstSeenAt(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	if cr.Status.FirstSeenAt != nil || cr.CreationTimestamp.IsZero() {
+		return false
+	}
+	created := cr.CreationTimestamp
+	cr.Status.FirstSeenAt = &created
+	return true
+}
+
+// migrateWorkloadCount sets status.workloadCount on images whose workloads were recorded
+// before the count was
+func migrateWorkloadCount(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	if cr.Status.WorkloadCount == len(cr.Status.Workloads) {
+		return false
+	}
+	cr.Status.WorkloadCount = len(cr.Status.Workloads)
+	return true
+}
+
+// migrateWarningState records the end-of-life tier and vulnerability counts of images that
+// earlier versions already warned about, so that the upgrade does not repeat those warnings.
+// Tiers are matched against DefaultEOLTiers.
+func migrateWarningState(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	changed := false
+	if cr.Status.EOLWarningTier == "" && cr.Status.DaysUntilEOL != nil {
+		if tier := matchEOLTier(DefaultEOLTiers, *cr.Status.DaysUntilEOL); tier >= 0 {
+			cr.Status.EOLWarningTier = DefaultEOLTiers[tier].Name
+			changed = true
+		}
+	}
+	if vulns := vulnerabilitySummary(cr); cr.Status.WarnedVulnerabilities == nil && vulns != nil &&
+		(vulns.Critical > 0 || vulns.Important > 0) {
+		cr.Status.WarnedVulnerabilities = &securityv1alpha1.VulnerabilitySummary{
+			Critical: vulns.Critical, Important: vulns.Important,
+		}
+		changed = true
+	}
+	return changed
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestInventoryMigrator_Migrate(t *testing.T) {
+	ctx := context.Background()
+	created := metav1.NewTime(time.Now().Add(-48 * time.Hour).Truncate(time.Second))
+	seen := metav1.NewTime(created.Add(time.Hour))
+	eolDays := 45
+
+	legacy := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "legacy",
+			CreationTimestamp: created,
+			Annotations:       map[string]string{annotationLegacyCVEs: "CVE-2024-0001, CVE-2024-0002,CVE-2024-0001"},
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			PyxisData: &securityv1alpha1.PyxisData{
+				HealthIndex:     "B",
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 1, Important: 3, Low: 9},
+			},
+			DaysUntilEOL: &eolDays,
+			Workloads:    []securityv1alpha1.WorkloadReference{{Namespace: testNamespace, Kind: "Deployment", Name: "web"}},
+		},
+	}
+	current := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "current", CreationTimestamp: created},
+		Status:     securityv1alpha1.ImageCertificationInfoStatus{FirstSeenAt: &seen},
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-0", Namespace: "operator-system"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(legacy, current, pod).WithStatusSubresource(legacy, current).Build()
+	recorder := record.NewFakeRecorder(10)
+	migrator := &InventoryMigrator{
+		Client:    fakeClient,
+		Recorder:  recorder,
+		Reader:    fakeClient,
+		Namespace: pod.Namespace,
+		PodName:   pod.Name,
+	}
+
+	if err := migrator.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	migrated := &securityv1alpha1.ImageCertificationInfo{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "legacy"}, migrated); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if _, ok := migrated.Annotations[annotationLegacyCVEs]; ok {
+		t.Error("legacy CVE annotation was not removed")
+	}
+	cves := migrated.Status.PyxisData.CVEs
+	if len(cves) != 2 || cves[0].ID != "CVE-2024-0001" || cves[1].ID != "CVE-2024-0002" {
+		t.Errorf("CVEs = %+v, want CVE-2024-0001 and CVE-2024-0002", cves)
+	}
+	if migrated.Status.PyxisData.TotalCVEs != 2 {
+		t.Errorf("TotalCVEs = %d, want 2", migrated.Status.PyxisData.TotalCVEs)
+	}
+	if migrated.Status.FirstSeenAt == nil || !migrated.Status.FirstSeenAt.Equal(&created) {
+		t.Errorf("FirstSeenAt = %v, want %v", migrated.Status.FirstSeenAt, created)
+	}
+	if migrated.Status.WorkloadCount != 1 {
+		t.Errorf("WorkloadCount = %d, want 1", migrated.Status.WorkloadCount)
+	}
+	if migrated.Status.EOLWarningTier != "warning" {
+		t.Errorf("EOLWarningTier = %q, want warning", migrated.Status.EOLWarningTier)
+	}
+	if warned := migrated.Status.WarnedVulnerabilities; warned == nil || *warned !=
+		(securityv1alpha1.VulnerabilitySummary{Critical: 1, Important: 3}) {
+		t.Errorf("WarnedVulnerabilities = %+v, want 1 critical and 3 important", warned)
+	}
+
+	unchanged := &securityv1alpha1.ImageCertificationInfo{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "current"}, unchanged); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if unchanged.ResourceVersion != current.ResourceVersion {
+		t.Error("an image that needed no migration was written")
+	}
+
+	select {
+	case event := <-recorder.Events:
+		want := "Normal " + EventReasonMigrationCompleted + " Migrated 1 of 2 images"
+		if !strings.HasPrefix(event, want) {
+			t.Errorf("event = %q, want prefix %q", event, want)
+		}
+		for _, count := range []string{"legacy-cve-annotation=1", "first-seen-at=1", "workload-count=1", "warning-state=1"} {
+			if !strings.Contains(event, count) {
+				t.Errorf("event = %q, want it to contain %q", event, count)
+			}
+		}
+	default:
+		t.Fatal("expected a MigrationCompleted event")
+	}
+
+	// A second run finds nothing to migrate and stays quiet
+	if err := migrator.Migrate(ctx); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("got event %q after the inventory was already migrated", <-recorder.Events)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// MirrorSetKind is an OpenShift resource that declares registry mirrors
+type MirrorSetKind struct {
+	GVK schema.GroupVersionKind
+	// Field is the spec field listing source and mirrors pairs
+	Field string
+}
+
+// MirrorSetKinds are the OpenShift resources read for registry mirrors, in order of precedence
+var MirrorSetKinds = []MirrorSetKind{
+	{
+		GVK:   schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ImageDigestMirrorSet"},
+		Field: "imageDigestMirrors",
+	},
+	{
+		GVK:   schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ImageTagMirrorSet"},
+		Field: "imageTagMirrors",
+	},
+	{
+		GVK:   schema.GroupVersionKind{Group: "operator.openshift.io", Version: "v1alpha1", Kind: "ImageContentSourcePolicy"},
+		Field: "repositoryDigestMirrors",
+	},
+}
+
+// mirrorSetRequest is the single request all mirror set changes are coalesced into
+var mirrorSetRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "mirror-sets"}}
+
+// MirrorSetReconciler keeps a MirrorMap in sync with the cluster's ImageDigestMirrorSets,
+// ImageTagMirrorSets, and ImageContentSourcePolicies, so images pulled through an
+// OpenShift mirror are tracked under their canonical registry
+type MirrorSetReconciler struct {
+	client.Client
+	// Mirrors is updated with the mappings read from the cluster
+	Mirrors *image.MirrorMap
+	// Static mappings, e.g. from --image-mirrors, take precedence over the cluster's
+	Static map[string]string
+	// Kinds are the mirror set resources served by the cluster
+	Kinds []MirrorSetKind
+}
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=imagedigestmirrorsets;imagetagmirrorsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.openshift.io,resources=imagecontentsourcepolicies,verbs=get;list;watch
+
+// Reconcile rebuilds the mirror map from all mirror set resources
+func (r *MirrorSetReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	mirrors := make(map[string]string)
+	for _, kind := range r.Kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(kind.GVK.GroupVersion().WithKind(kind.GVK.Kind + "List"))
+		if err := r.List(ctx, list); err != nil {
+			logger.Error(err, "unable to list mirror sets", "kind", kind.GVK.Kind)
+			metrics.RecordReconcile("error", time.Since(start).Seconds(), "mirrorsets")
+			return ctrl.Result{}, err
+		}
+		for _, item := range list.Items {
+			addMirrorSetMappings(mirrors, &item, kind.Field)
+		}
+	}
+	maps.Copy(mirrors, r.Static)
+
+	r.Mirrors.Set(mirrors)
+	logger.V(1).Info("updated registry mirrors from mirror sets", "mirrors", len(mirrors))
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "mirrorsets")
+	return ctrl.Result{}, nil
+}
+
+// addMirrorSetMappings adds the mirror to source mappings of a mirror set. A mirror already
+// mapped by an earlier resource keeps its source.
+func addMirrorSetMappings(mirrors map[string]string, obj *unstructured.Unstructured, field string) {
+	entries, _, _ := unstructured.NestedSlice(obj.Object, "spec", field)
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		source, _, _ := unstructured.NestedString(fields, "source")
+		locations, _, _ := unstructured.NestedStringSlice(fields, "mirrors")
+		if source == "" {
+			continue
+		}
+		for _, mirror := range locations {
+			if _, exists := mirrors[mirror]; !exists && mirror != source {
+				mirrors[mirror] = source
+			}
+		}
+	}
+}
+
+// ServedMirrorSetKinds returns the mirror set resources the cluster serves
+func ServedMirrorSetKinds(mapper meta.RESTMapper) []MirrorSetKind {
+	var served []MirrorSetKind
+	for _, kind := range MirrorSetKinds {
+		if _, err := mapper.RESTMapping(kind.GVK.GroupKind(), kind.GVK.Version); err == nil {
+			served = append(served, kind)
+		}
+	}
+	return served
+}
+
+// SetupWithManager watches every served mirror set kind. It runs on every replica, since
+// each replica resolves the images it reconciles.
+func (r *MirrorSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("mirrorsets").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)})
+	for _, kind := range r.Kinds {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(kind.GVK)
+		b = b.Watches(obj, handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+			return []reconcile.Request{mirrorSetRequest}
+		}))
+	}
+	return b.Complete(r)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// newMirrorSet builds a mirror set resource with a single source and its mirrors
+func newMirrorSet(kind MirrorSetKind, name, source string, mirrors ...any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			kind.Field: []any{map[string]any{"source": source, "mirrors": mirrors}},
+		},
+	}}
+	obj.SetGroupVersionKind(kind.GVK)
+	obj.SetName(name)
+	return obj
+}
+
+func TestMirrorSetReconciler_Reconcile(t *testing.T) {
+	idms, itms, icsp := MirrorSetKinds[0], MirrorSetKinds[1], MirrorSetKinds[2]
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, kind := range MirrorSetKinds {
+		mapper.Add(kind.GVK, meta.RESTScopeRoot)
+	}
+
+	objects := []client.Object{
+		newMirrorSet(idms, "redhat", "registry.redhat.io", "mirror.example.com/rh"),
+		newMirrorSet(itms, "quay", "quay.io/openshift", "mirror.example.com/ocp"),
+		// The digest mirror set takes precedence for a mirror both declare
+		newMirrorSet(icsp, "legacy", "registry.access.redhat.com", "mirror.example.com/rh", "legacy.example.com"),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithRESTMapper(mapper).
+		WithObjects(objects...).Build()
+
+	mirrors := image.NewMirrorMap(nil)
+	r := &MirrorSetReconciler{
+		Client:  fakeClient,
+		Mirrors: mirrors,
+		Static:  map[string]string{"legacy.example.com": "registry.redhat.io"},
+		Kinds:   ServedMirrorSetKinds(mapper),
+	}
+	if _, err := r.Reconcile(context.Background(), mirrorSetRequest); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	tests := []struct {
+		registry, repository string
+		wantRegistry         string
+		wantRepository       string
+	}{
+		{"mirror.example.com", "rh/ubi9/ubi", "registry.redhat.io", "ubi9/ubi"},
+		{"mirror.example.com", "ocp/release", "quay.io", "openshift/release"},
+		{"legacy.example.com", "ubi8/ubi", "registry.redhat.io", "ubi8/ubi"},
+	}
+	for _, tt := range tests {
+		registry, repository, ok := mirrors.Source(tt.registry, tt.repository)
+		if !ok || registry != tt.wantRegistry || repository != tt.wantRepository {
+			t.Errorf("Source(%s, %s) = %s, %s, %v; want %s, %s", tt.registry, tt.repository,
+				registry, repository, ok, tt.wantRegistry, tt.wantRepository)
+		}
+	}
+	if mirrors.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", mirrors.Len())
+	}
+}
+
+func TestServedMirrorSetKinds(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(MirrorSetKinds[0].GVK, meta.RESTScopeRoot)
+
+	served := ServedMirrorSetKinds(mapper)
+	if len(served) != 1 || served[0].GVK.Kind != "ImageDigestMirrorSet" {
+		t.Errorf("ServedMirrorSetKinds() = %+v, want only ImageDigestMirrorSet", served)
+	}
+}
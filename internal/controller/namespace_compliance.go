@@ -0,0 +1,165 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// LabelNonCompliantImages is set on namespaces to the number of images their pods run
+// that violate at least one ImageCertPolicy
+const LabelNonCompliantImages = "security.telco.openshift.io/non-compliant-images"
+
+// NamespaceComplianceLabeler labels every namespace running images that violate an
+// ImageCertPolicy with the number of such images, so that namespace dashboards and
+// chargeback reports can show risk without reading ImageCertificationInfos. The label is
+// removed from namespaces that no longer run violating images.
+type NamespaceComplianceLabeler struct {
+	client.Client
+	// Interval is how often the labels are recomputed
+	Interval time.Duration
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=patch
+
+// Start recomputes the namespace labels every Interval until ctx is cancelled. It runs
+// only on the elected leader so that replicas do not duplicate writes.
+func (l *NamespaceComplianceLabeler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(l.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.Label(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to label namespaces with policy violations")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Label evaluates every ImageCertPolicy and patches each namespace whose count of
+// violating images differs from its label
+func (l *NamespaceComplianceLabeler) Label(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("namespace-compliance")
+
+	var policyList securityv1alpha1.ImageCertPolicyList
+	if err := l.List(ctx, &policyList); err != nil {
+		return err
+	}
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := l.List(ctx, &crList); err != nil {
+		return err
+	}
+	counts, err := nonCompliantImageCounts(ctx, l.Client, policyList.Items, crList.Items)
+	if err != nil {
+		return err
+	}
+
+	var nsList corev1.NamespaceList
+	if err := l.List(ctx, &nsList); err != nil {
+		return err
+	}
+	for i := range nsList.Items {
+		ns := &nsList.Items[i]
+		current, labeled := ns.Labels[LabelNonCompliantImages]
+		count := counts[ns.Name]
+		if (count == 0 && !labeled) || (count > 0 && current == strconv.Itoa(count)) {
+			continue
+		}
+		if err := l.patch(ctx, ns.Name, count); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Error(err, "failed to label namespace", "namespace", ns.Name)
+		}
+	}
+	return nil
+}
+
+// nonCompliantImageCounts returns, for each namespace, the number of distinct images its
+// pods run that violate a rule of any policy whose namespace selector matches it. Policies
+// with an invalid namespace selector are skipped, as they are by ImageCertPolicyReconciler.
+func nonCompliantImageCounts(ctx context.Context, reader client.Reader, policies []securityv1alpha1.ImageCertPolicy,
+	items []securityv1alpha1.ImageCertificationInfo) (map[string]int, error) {
+	images := make(map[string]map[string]bool)
+	for i := range policies {
+		matcher := &namespaceMatcher{reader: reader, cache: make(map[string]bool)}
+		if policies[i].Spec.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(policies[i].Spec.NamespaceSelector)
+			if err != nil {
+				continue
+			}
+			matcher.selector = selector
+		}
+
+		violations, _, _, err := evaluatePolicy(ctx, &policies[i], items, matcher)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range violations {
+			for _, ns := range v.Namespaces {
+				if images[ns] == nil {
+					images[ns] = make(map[string]bool)
+				}
+				images[ns][v.ImageCertificationInfo] = true
+			}
+		}
+	}
+
+	counts := make(map[string]int, len(images))
+	for ns, violating := range images {
+		counts[ns] = len(violating)
+	}
+	return counts, nil
+}
+
+// patch sets the label on a namespace to count, or removes it when count is zero
+func (l *NamespaceComplianceLabeler) patch(ctx context.Context, name string, count int) error {
+	// A null value in a merge patch removes the label
+	var value any
+	if count > 0 {
+		value = strconv.Itoa(count)
+	}
+	data, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"labels": map[string]any{LabelNonCompliantImages: value}},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = l.Patch(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, client.RawPatch(types.MergePatchType, data))
+	if apierrors.IsNotFound(err) {
+		// The namespace was deleted; nothing to label
+		return nil
+	}
+	return err
+}
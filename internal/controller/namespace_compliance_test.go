@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestNamespaceComplianceLabeler_Label(t *testing.T) {
+	ctx := context.Background()
+
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	image := func(name string, status securityv1alpha1.CertificationStatus, namespaces ...string) *securityv1alpha1.ImageCertificationInfo {
+		cr := &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     securityv1alpha1.ImageCertificationInfoStatus{CertificationStatus: status},
+		}
+		for _, ns := range namespaces {
+			cr.Status.PodReferences = append(cr.Status.PodReferences,
+				securityv1alpha1.PodReference{Namespace: ns, Name: name + "-pod", Container: testContainer})
+		}
+		return cr
+	}
+	certifiedOnly := []securityv1alpha1.ImageCertPolicyRule{{
+		Name:                         "certified",
+		AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+	}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		namespace("payments", map[string]string{"tier": "prod"}),
+		namespace("batch", nil),
+		namespace("web", map[string]string{LabelNonCompliantImages: "4"}),
+		namespace("dev", nil),
+		image("uncertified-a", securityv1alpha1.CertificationStatusNotCertified, "payments", "batch"),
+		image("uncertified-b", securityv1alpha1.CertificationStatusNotCertified, "payments"),
+		image("certified", securityv1alpha1.CertificationStatusCertified, "web"),
+		image("errored", securityv1alpha1.CertificationStatusError, "dev"),
+		&securityv1alpha1.ImageCertPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "all"},
+			Spec:       securityv1alpha1.ImageCertPolicySpec{Rules: certifiedOnly},
+		},
+		&securityv1alpha1.ImageCertPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+			Spec: securityv1alpha1.ImageCertPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "prod"}},
+				Rules:             certifiedOnly,
+			},
+		},
+	).Build()
+
+	labeler := &NamespaceComplianceLabeler{Client: fakeClient}
+	if err := labeler.Label(ctx); err != nil {
+		t.Fatalf("Label() error = %v", err)
+	}
+
+	// Images violating several policies are counted once per namespace
+	want := map[string]string{"payments": "2", "batch": "1", "web": "", "dev": "1"}
+	for name, wantLabel := range want {
+		var ns corev1.Namespace
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, &ns); err != nil {
+			t.Fatalf("Get(%s) error = %v", name, err)
+		}
+		if got := ns.Labels[LabelNonCompliantImages]; got != wantLabel {
+			t.Errorf("namespace %s label = %q, want %q", name, got, wantLabel)
+		}
+	}
+	var payments corev1.Namespace
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "payments"}, &payments); err != nil {
+		t.Fatalf("Get(payments) error = %v", err)
+	}
+	if payments.Labels["tier"] != "prod" {
+		t.Errorf("existing labels were not preserved: %v", payments.Labels)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/pattern"
+)
+
+// NamespaceFilter selects the namespaces whose pods are tracked. Namespace patterns
+// ending in '*' match by prefix, e.g. "openshift-*".
+type NamespaceFilter struct {
+	// Reader reads namespace labels when Selector is set
+	Reader client.Reader
+	// Include lists the namespaces to track (empty tracks all namespaces)
+	Include []string
+	// Exclude lists namespaces that are never tracked; it takes precedence over Include
+	Exclude []string
+	// Selector restricts tracking to namespaces whose labels match (nil matches all)
+	Selector labels.Selector
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// ParseNamespaceList splits a comma-separated list of namespace patterns, dropping empty entries
+func ParseNamespaceList(list string) []string {
+	var patterns []string
+	for entry := range strings.SplitSeq(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			patterns = append(patterns, entry)
+		}
+	}
+	return patterns
+}
+
+// Allows reports whether pods in the namespace are tracked. A nil filter allows every
+// namespace. When the namespace labels cannot be read for a reason other than the
+// namespace being gone, the namespace is allowed so that images are not missed.
+func (f *NamespaceFilter) Allows(ctx context.Context, namespace string) bool {
+	if f == nil {
+		return true
+	}
+	if pattern.MatchAny(f.Exclude, namespace) {
+		return false
+	}
+	if len(f.Include) > 0 && !pattern.MatchAny(f.Include, namespace) {
+		return false
+	}
+	if f.Selector == nil || f.Selector.Empty() {
+		return true
+	}
+
+	var ns corev1.Namespace
+	if err := f.Reader.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		log.FromContext(ctx).Error(err, "failed to read namespace labels, tracking its pods", "namespace", namespace)
+		return true
+	}
+	return f.Selector.Matches(labels.Set(ns.Labels))
+}
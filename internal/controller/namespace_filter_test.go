@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestParseNamespaceList(t *testing.T) {
+	got := ParseNamespaceList(" kube-*, ,openshift-*,default ")
+	if want := []string{"kube-*", "openshift-*", "default"}; !slices.Equal(got, want) {
+		t.Errorf("ParseNamespaceList() = %v, want %v", got, want)
+	}
+	if got := ParseNamespaceList(""); got != nil {
+		t.Errorf("ParseNamespaceList(\"\") = %v, want nil", got)
+	}
+}
+
+func TestNamespaceFilter_Allows(t *testing.T) {
+	ctx := context.Background()
+	namespace := func(name string, nsLabels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nsLabels}}
+	}
+	reader := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(namespace("shop", map[string]string{"tier": "application"}),
+			namespace("shop-test", nil), namespace("kube-system", nil)).
+		Build()
+	selector, err := labels.Parse("tier=application")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		filter    *NamespaceFilter
+		namespace string
+		want      bool
+	}{
+		{"nil filter", nil, "kube-system", true},
+		{"excluded by prefix", &NamespaceFilter{Exclude: []string{"kube-*"}}, "kube-system", false},
+		{"not excluded", &NamespaceFilter{Exclude: []string{"kube-*"}}, "shop", true},
+		{"included", &NamespaceFilter{Include: []string{"shop*"}}, "shop-test", true},
+		{"not included", &NamespaceFilter{Include: []string{"shop"}}, "shop-test", false},
+		{"exclude wins", &NamespaceFilter{Include: []string{"shop*"}, Exclude: []string{"shop-test"}}, "shop-test", false},
+		{"selector matches", &NamespaceFilter{Reader: reader, Selector: selector}, "shop", true},
+		{"selector does not match", &NamespaceFilter{Reader: reader, Selector: selector}, "shop-test", false},
+		{"namespace gone", &NamespaceFilter{Reader: reader, Selector: selector}, "deleted", false},
+		{"empty selector", &NamespaceFilter{Selector: labels.Everything()}, "shop-test", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Allows(ctx, tt.namespace); got != tt.want {
+				t.Errorf("Allows(%s) = %v, want %v", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodReconciler_CleanupStaleReferences_ExcludedNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	pod := func(namespace string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace}}
+	}
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: "shop", Name: "app", Container: "app"},
+				{Namespace: "kube-system", Name: "app", Container: "app"},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(cr, pod("shop"), pod("kube-system")).
+		WithStatusSubresource(cr).
+		Build()
+
+	// References recorded before the namespace was excluded are dropped
+	reconciler := &PodReconciler{
+		Client:     fakeClient,
+		Namespaces: &NamespaceFilter{Exclude: []string{"kube-*"}},
+	}
+	if err := reconciler.CleanupStaleReferences(ctx); err != nil {
+		t.Fatalf("CleanupStaleReferences() error = %v", err)
+	}
+
+	var updated securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &updated); err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Status.PodReferences) != 1 || updated.Status.PodReferences[0].Namespace != "shop" {
+		t.Errorf("PodReferences = %+v, want only the shop reference", updated.Status.PodReferences)
+	}
+}
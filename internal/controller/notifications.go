@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/notify"
+)
+
+// maxNotifiedCVEs is the most new CVE IDs named in a single notification
+const maxNotifiedCVEs = 5
+
+// notify queues a notification about an image, if notifications are enabled and no
+// ImageCertExemption applies to it
+func (r *PodReconciler) notify(cr *securityv1alpha1.ImageCertificationInfo, notificationType notify.Type, msg string) {
+	if isExempt(cr) {
+		return
+	}
+	r.Notifier.Notify(notify.Notification{
+		Type:    notificationType,
+		Name:    cr.Name,
+		Image:   cr.Spec.FullImageReference,
+		Message: msg,
+	})
+}
+
+// notifyCriticalCVEs reports critical vulnerabilities found by a refresh, naming the CVEs
+// that were not tracked before it
+func (r *PodReconciler) notifyCriticalCVEs(cr *securityv1alpha1.ImageCertificationInfo, oldCritical, newCritical int,
+	oldTracked []securityv1alpha1.TrackedCVE) {
+	if newCritical <= oldCritical {
+		return
+	}
+	msg := fmt.Sprintf("Critical vulnerabilities increased from %d to %d", oldCritical, newCritical)
+	if ids := newCriticalCVEs(oldTracked, cr.Status.TrackedCVEs); len(ids) > 0 {
+		if len(ids) > maxNotifiedCVEs {
+			ids = append(ids[:maxNotifiedCVEs], fmt.Sprintf("%d more", len(ids)-maxNotifiedCVEs))
+		}
+		msg += ": " + strings.Join(ids, ", ")
+	}
+	r.notify(cr, notify.TypeCriticalCVEs, msg)
+}
+
+// newCriticalCVEs returns the IDs of the critical CVEs in after that are not in before
+func newCriticalCVEs(before, after []securityv1alpha1.TrackedCVE) []string {
+	var ids []string
+	for _, cve := range after {
+		if cve.Severity != SeverityCritical {
+			continue
+		}
+		if !slices.ContainsFunc(before, func(old securityv1alpha1.TrackedCVE) bool { return old.ID == cve.ID }) {
+			ids = append(ids, cve.ID)
+		}
+	}
+	return ids
+}
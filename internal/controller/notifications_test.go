@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/notify"
+)
+
+// recordingSink collects the notifications it is sent
+type recordingSink struct {
+	notifications []notify.Notification
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Send(_ context.Context, notifications []notify.Notification) error {
+	s.notifications = append(s.notifications, notifications...)
+	return nil
+}
+
+func TestPodReconciler_Notifications(t *testing.T) {
+	sink := &recordingSink{}
+	r := &PodReconciler{Notifier: notify.NewNotifier([]notify.Sink{sink})}
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec:       securityv1alpha1.ImageCertificationInfoSpec{FullImageReference: "registry.redhat.io/ubi8/ubi@" + testDigest},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			TrackedCVEs: []securityv1alpha1.TrackedCVE{
+				{ID: "CVE-2026-0001", Severity: SeverityCritical},
+				{ID: "CVE-2026-0002", Severity: SeverityCritical},
+				{ID: "CVE-2026-0003", Severity: SeverityImportant},
+			},
+		},
+	}
+	oldTracked := []securityv1alpha1.TrackedCVE{{ID: "CVE-2026-0001", Severity: SeverityCritical}}
+
+	// The first lookup result is not a change worth notifying about
+	r.emitChangeEvents(context.Background(), cr,
+		securityv1alpha1.CertificationStatusUnknown, securityv1alpha1.CertificationStatusCertified, "", "")
+	r.emitChangeEvents(context.Background(), cr,
+		securityv1alpha1.CertificationStatusCertified, securityv1alpha1.CertificationStatusNotCertified, "A", "C")
+	r.notifyCriticalCVEs(cr, 1, 2, oldTracked)
+	// No new critical CVEs
+	r.notifyCriticalCVEs(cr, 2, 2, cr.Status.TrackedCVEs)
+	r.Notifier.Flush(context.Background())
+
+	types := make([]notify.Type, len(sink.notifications))
+	for i, n := range sink.notifications {
+		types[i] = n.Type
+	}
+	want := []notify.Type{notify.TypeCertificationChanged, notify.TypeHealthDegraded, notify.TypeCriticalCVEs}
+	if len(types) != len(want) {
+		t.Fatalf("notification types = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Errorf("notification types = %v, want %v", types, want)
+			break
+		}
+	}
+	cves := sink.notifications[len(sink.notifications)-1]
+	if !strings.HasSuffix(cves.Message, ": CVE-2026-0002") || cves.Image != cr.Spec.FullImageReference {
+		t.Errorf("critical CVE notification = %+v, want only the new CVE named", cves)
+	}
+
+	// Notifications are optional
+	(&PodReconciler{}).notify(cr, notify.TypeEOLApproaching, "Image reaches EOL in 7 days")
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultObjectSizeWarningBytes is the default serialized size above which an
+// ImageCertificationInfo is reported as approaching the etcd request size limit
+// (1.5MiB by default)
+const DefaultObjectSizeWarningBytes = 1 << 20
+
+// EventReasonObjectSizeWarning is emitted when an ImageCertificationInfo grows past the
+// object size warning threshold
+const EventReasonObjectSizeWarning = "ObjectSizeWarning"
+
+// ObjectSizeClient wraps a client so that every ImageCertificationInfo status written
+// through it records its serialized size in status.objectSizeBytes and the
+// object_size_bytes metric. A Warning event is emitted when an object first grows past
+// WarningBytes, before etcd starts rejecting its writes.
+type ObjectSizeClient struct {
+	client.Client
+	// WarningBytes is the size above which objects are reported. Zero disables warnings.
+	WarningBytes int64
+	Recorder     record.EventRecorder
+}
+
+// Status returns a status writer that records the size of ImageCertificationInfos
+func (c *ObjectSizeClient) Status() client.SubResourceWriter {
+	return &objectSizeStatusWriter{SubResourceWriter: c.Client.Status(), sizes: c}
+}
+
+type objectSizeStatusWriter struct {
+	client.SubResourceWriter
+	sizes *ObjectSizeClient
+}
+
+func (w *objectSizeStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	w.sizes.record(ctx, obj)
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (w *objectSizeStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	w.sizes.record(ctx, obj)
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// record sets the serialized size of obj in its status and warns when the size crosses
+// the warning threshold. Objects other than ImageCertificationInfos are ignored.
+func (c *ObjectSizeClient) record(ctx context.Context, obj client.Object) {
+	cr, ok := obj.(*securityv1alpha1.ImageCertificationInfo)
+	if !ok {
+		return
+	}
+	previous := cr.Status.ObjectSizeBytes
+	size, err := objectSize(cr)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to compute object size", "name", cr.Name)
+		return
+	}
+	cr.Status.ObjectSizeBytes = size
+
+	over := c.WarningBytes > 0 && size >= c.WarningBytes
+	metrics.RecordObjectSize(size, over)
+	if !over || previous >= c.WarningBytes {
+		return
+	}
+	msg := fmt.Sprintf("Object size %d bytes exceeds the warning threshold of %d bytes", size, c.WarningBytes)
+	log.FromContext(ctx).Info("ImageCertificationInfo is approaching the etcd object size limit",
+		"name", cr.Name, "sizeBytes", size, "warningBytes", c.WarningBytes)
+	if c.Recorder != nil {
+		c.Recorder.Event(cr, corev1.EventTypeWarning, EventReasonObjectSizeWarning, msg)
+		metrics.RecordEvent(corev1.EventTypeWarning, EventReasonObjectSizeWarning)
+	}
+}
+
+// objectSize returns the JSON-serialized size of cr including the size field itself, so
+// that the recorded value matches what is stored
+func objectSize(cr *securityv1alpha1.ImageCertificationInfo) (int64, error) {
+	sized := cr.DeepCopy()
+	sized.Status.ObjectSizeBytes = 0
+	data, err := json.Marshal(sized)
+	if err != nil {
+		return 0, err
+	}
+	size := int64(len(data))
+	// Account for the objectSizeBytes field, whose own digits can carry the total over
+	// a power of ten
+	for {
+		sized.Status.ObjectSizeBytes = size
+		data, err = json.Marshal(sized)
+		if err != nil {
+			return 0, err
+		}
+		if int64(len(data)) == size {
+			return size, nil
+		}
+		size = int64(len(data))
+	}
+}
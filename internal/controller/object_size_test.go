@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestObjectSizeClient_StatusUpdate(t *testing.T) {
+	ctx := context.Background()
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec:       securityv1alpha1.ImageCertificationInfoSpec{ImageDigest: testDigest},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(cr).WithStatusSubresource(cr).Build()
+	recorder := record.NewFakeRecorder(10)
+	sizes := &ObjectSizeClient{Client: fakeClient, WarningBytes: 2048, Recorder: recorder}
+
+	grow := func(pods int) {
+		t.Helper()
+		current := &securityv1alpha1.ImageCertificationInfo{}
+		if err := sizes.Get(ctx, client.ObjectKey{Name: testCRName}, current); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		current.Status.PodReferences = nil
+		for i := range pods {
+			current.Status.PodReferences = append(current.Status.PodReferences, securityv1alpha1.PodReference{
+				Namespace: testNamespace, Name: fmt.Sprintf("%s-%d", testPodName, i), Container: testContainer,
+			})
+		}
+		if err := sizes.Status().Update(ctx, current); err != nil {
+			t.Fatalf("Status().Update() error = %v", err)
+		}
+		stored := &securityv1alpha1.ImageCertificationInfo{}
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, stored); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		data, err := json.Marshal(stored)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		// The write bumps the resourceVersion, so the stored object may differ by a few bytes
+		if diff := int64(len(data)) - stored.Status.ObjectSizeBytes; diff < 0 || diff > 8 {
+			t.Errorf("ObjectSizeBytes = %d, stored object is %d bytes", stored.Status.ObjectSizeBytes, len(data))
+		}
+	}
+
+	// Small objects do not warn
+	grow(1)
+	if len(recorder.Events) != 0 {
+		t.Errorf("got event %q for an object below the threshold", <-recorder.Events)
+	}
+
+	// Crossing the threshold warns once
+	grow(40)
+	select {
+	case event := <-recorder.Events:
+		if want := "Warning " + EventReasonObjectSizeWarning; !strings.HasPrefix(event, want) {
+			t.Errorf("event = %q, want prefix %q", event, want)
+		}
+	default:
+		t.Fatal("expected an ObjectSizeWarning event")
+	}
+	grow(50)
+	if len(recorder.Events) != 0 {
+		t.Errorf("got event %q for an object already above the threshold", <-recorder.Events)
+	}
+}
+
+func TestObjectSize_IncludesSizeField(t *testing.T) {
+	// Sizes just below a power of ten gain a digit once the size field is included
+	for pods := range 30 {
+		cr := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: testCRName}}
+		for i := range pods {
+			cr.Status.PodReferences = append(cr.Status.PodReferences,
+				securityv1alpha1.PodReference{Namespace: testNamespace, Name: fmt.Sprint(i), Container: testContainer})
+		}
+		size, err := objectSize(cr)
+		if err != nil {
+			t.Fatalf("objectSize() error = %v", err)
+		}
+		cr.Status.ObjectSizeBytes = size
+		data, _ := json.Marshal(cr)
+		if int64(len(data)) != size {
+			t.Errorf("pods=%d: objectSize() = %d, serialized size = %d", pods, size, len(data))
+		}
+	}
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/startup"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// DefaultOperatorConfigName is the name of the ImageCertInfoConfig the operator watches
+const DefaultOperatorConfigName = "imagecertinfo-config"
+
+// TunableInterval is a loop interval that can change while the loop runs
+type TunableInterval struct {
+	mu      sync.Mutex
+	value   time.Duration
+	changed chan struct{}
+}
+
+// NewTunableInterval creates an interval with the given initial value
+func NewTunableInterval(value time.Duration) *TunableInterval {
+	return &TunableInterval{value: value, changed: make(chan struct{})}
+}
+
+// Get returns the current interval
+func (t *TunableInterval) Get() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.value
+}
+
+// Set changes the interval and wakes loops waiting on Changed
+func (t *TunableInterval) Set(value time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if value == t.value {
+		return
+	}
+	t.value = value
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// Changed returns a channel that is closed the next time the interval changes
+func (t *TunableInterval) Changed() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.changed
+}
+
+// ProviderTuner is a provider client whose cache TTL and rate limit can change at runtime
+type ProviderTuner interface {
+	SetTTL(ttl time.Duration)
+	SetRateLimit(rps float64, burst int)
+}
+
+// ProviderDefaults are the provider settings given by command-line flags
+type ProviderDefaults struct {
+	CacheTTL  time.Duration
+	RateLimit float64
+	RateBurst int
+}
+
+// OperatorSettings are the settings an ImageCertInfoConfig can change
+type OperatorSettings struct {
+	Pyxis           ProviderDefaults
+	DockerHub       ProviderDefaults
+	RefreshInterval time.Duration
+	CleanupInterval time.Duration
+	// Registries override the built-in registry classification
+	Registries []image.RegistryRule
+}
+
+// OperatorConfigReconciler applies the operator's ImageCertInfoConfig to the running provider
+// clients and loops. It runs on every replica, since each replica has its own clients.
+type OperatorConfigReconciler struct {
+	client.Client
+	// Name and Namespace identify the watched ImageCertInfoConfig
+	Name      string
+	Namespace string
+	// Defaults are the flag values used for settings the config leaves unset
+	Defaults OperatorSettings
+
+	// Pyxis and DockerHub are tuned when set
+	Pyxis     ProviderTuner
+	DockerHub ProviderTuner
+	// RefreshInterval and CleanupInterval drive the refresh and cleanup loops
+	RefreshInterval *TunableInterval
+	CleanupInterval *TunableInterval
+	// Registries receives the registry classification rules when set
+	Registries *image.RegistryClassifier
+}
+
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertinfoconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security.telco.openshift.io,resources=imagecertinfoconfigs/status,verbs=get;update;patch
+
+// Reconcile applies the config, or the flag defaults when it does not exist
+func (r *OperatorConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	var config securityv1alpha1.ImageCertInfoConfig
+	if err := r.Get(ctx, req.NamespacedName, &config); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("no ImageCertInfoConfig found, using flag settings")
+			r.apply(r.Defaults)
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertinfoconfig")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch ImageCertInfoConfig")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertinfoconfig")
+		return ctrl.Result{}, err
+	}
+
+	status := securityv1alpha1.ImageCertInfoConfigStatus{
+		ObservedGeneration: config.Generation,
+		Conditions:         slices.Clone(config.Status.Conditions),
+	}
+	condition := metav1.Condition{
+		Type:               securityv1alpha1.ImageCertInfoConfigConditionApplied,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: config.Generation,
+		Reason:             string(securityv1alpha1.ReasonApplied),
+		Message:            "Settings are in effect",
+	}
+
+	settings, err := mergeOperatorSettings(r.Defaults, &config.Spec)
+	if err != nil {
+		// Keep running with the previous settings until the config is fixed
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(securityv1alpha1.ReasonInvalidSettings)
+		condition.Message = err.Error()
+	} else {
+		r.apply(settings)
+		logger.Info("applied ImageCertInfoConfig", "settings", settings)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	if equality.Semantic.DeepEqual(config.Status, status) {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertinfoconfig")
+		return ctrl.Result{}, nil
+	}
+	config.Status = status
+	if err := r.Status().Update(ctx, &config); err != nil {
+		logger.Error(err, "failed to update ImageCertInfoConfig status")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "imagecertinfoconfig")
+		return ctrl.Result{}, err
+	}
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "imagecertinfoconfig")
+	return ctrl.Result{}, nil
+}
+
+// apply hands the settings to the provider clients and loops
+func (r *OperatorConfigReconciler) apply(settings OperatorSettings) {
+	if r.Pyxis != nil {
+		r.Pyxis.SetTTL(settings.Pyxis.CacheTTL)
+		r.Pyxis.SetRateLimit(settings.Pyxis.RateLimit, settings.Pyxis.RateBurst)
+	}
+	if r.DockerHub != nil {
+		r.DockerHub.SetTTL(settings.DockerHub.CacheTTL)
+		r.DockerHub.SetRateLimit(settings.DockerHub.RateLimit, settings.DockerHub.RateBurst)
+	}
+	if r.RefreshInterval != nil {
+		r.RefreshInterval.Set(settings.RefreshInterval)
+	}
+	if r.CleanupInterval != nil {
+		r.CleanupInterval.Set(settings.CleanupInterval)
+	}
+	if r.Registries != nil {
+		r.Registries.Set(settings.Registries)
+	}
+}
+
+// mergeOperatorSettings overlays the settings set in spec on the defaults and validates the result
+func mergeOperatorSettings(defaults OperatorSettings, spec *securityv1alpha1.ImageCertInfoConfigSpec) (OperatorSettings, error) {
+	settings := defaults
+	var errs []error
+	mergeProviderSettings(&settings.Pyxis, spec.Pyxis, "pyxis", &errs)
+	mergeProviderSettings(&settings.DockerHub, spec.DockerHub, "dockerHub", &errs)
+	if spec.RefreshInterval != nil {
+		settings.RefreshInterval = spec.RefreshInterval.Duration
+		if settings.RefreshInterval <= 0 {
+			errs = append(errs, fmt.Errorf("refreshInterval must be positive, got %s", settings.RefreshInterval))
+		}
+	}
+	if spec.CleanupInterval != nil {
+		settings.CleanupInterval = spec.CleanupInterval.Duration
+		if settings.CleanupInterval <= 0 {
+			errs = append(errs, fmt.Errorf("cleanupInterval must be positive, got %s", settings.CleanupInterval))
+		}
+	}
+	if spec.RegistryClassification != nil {
+		settings.Registries = mergeRegistryClassification(spec.RegistryClassification, &errs)
+	}
+	if settings.RefreshInterval > 0 && settings.RefreshInterval < settings.Pyxis.CacheTTL {
+		errs = append(errs, fmt.Errorf("refreshInterval (%s) is shorter than the Pyxis cache TTL (%s), so refreshes "+
+			"would only return cached data", settings.RefreshInterval, settings.Pyxis.CacheTTL))
+	}
+	return settings, errors.Join(errs...)
+}
+
+// mergeProviderSettings overlays the provider settings set in spec on settings
+func mergeProviderSettings(settings *ProviderDefaults, spec *securityv1alpha1.ProviderSettings, field string, errs *[]error) {
+	if spec == nil {
+		return
+	}
+	if spec.CacheTTL != nil {
+		settings.CacheTTL = spec.CacheTTL.Duration
+		if settings.CacheTTL < startup.MinCacheTTL {
+			*errs = append(*errs, fmt.Errorf("%s.cacheTTL must be at least %s, got %s", field, startup.MinCacheTTL,
+				settings.CacheTTL))
+		}
+	}
+	if spec.RateLimit != nil {
+		settings.RateLimit = spec.RateLimit.AsApproximateFloat64()
+		if settings.RateLimit <= 0 {
+			*errs = append(*errs, fmt.Errorf("%s.rateLimit must be positive, got %s", field, spec.RateLimit))
+		}
+	}
+	if spec.RateBurst != nil {
+		settings.RateBurst = int(*spec.RateBurst)
+	}
+}
+
+// mergeRegistryClassification converts the registry classification rules in spec
+func mergeRegistryClassification(spec []securityv1alpha1.RegistryClassificationRule, errs *[]error) []image.RegistryRule {
+	rules := make([]image.RegistryRule, 0, len(spec))
+	for i, rule := range spec {
+		if err := image.ValidateRegistryPattern(rule.Registry); err != nil {
+			*errs = append(*errs, fmt.Errorf("registryClassification[%d]: %w", i, err))
+			continue
+		}
+		rules = append(rules, image.RegistryRule{
+			Pattern:       rule.Registry,
+			Type:          rule.Type,
+			PyxisEligible: ptr.Deref(rule.PyxisEligible, rule.Type == securityv1alpha1.RegistryTypeRedHat),
+		})
+	}
+	return rules
+}
+
+// SetupWithManager watches only the configured ImageCertInfoConfig
+func (r *OperatorConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&securityv1alpha1.ImageCertInfoConfig{}, builder.WithPredicates(
+			predicate.GenerationChangedPredicate{},
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetNamespace() == r.Namespace && obj.GetName() == r.Name
+			}))).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Named("imagecertinfoconfig").
+		Complete(r)
+}
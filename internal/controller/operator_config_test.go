@@ -0,0 +1,211 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// recordingTuner records the settings applied to a provider client
+type recordingTuner struct {
+	ttl   time.Duration
+	rps   float64
+	burst int
+}
+
+func (t *recordingTuner) SetTTL(ttl time.Duration) { t.ttl = ttl }
+
+func (t *recordingTuner) SetRateLimit(rps float64, burst int) { t.rps, t.burst = rps, burst }
+
+func TestOperatorConfigReconciler_Reconcile(t *testing.T) {
+	defaults := OperatorSettings{
+		Pyxis:           ProviderDefaults{CacheTTL: time.Hour, RateLimit: 10, RateBurst: 20},
+		DockerHub:       ProviderDefaults{CacheTTL: 24 * time.Hour, RateLimit: 5, RateBurst: 10},
+		RefreshInterval: 24 * time.Hour,
+		CleanupInterval: 5 * time.Minute,
+	}
+
+	tests := []struct {
+		name          string
+		spec          *securityv1alpha1.ImageCertInfoConfigSpec
+		wantApplied   metav1.ConditionStatus
+		wantPyxis     recordingTuner
+		wantDockerHub recordingTuner
+		wantRefresh   time.Duration
+		wantCleanup   time.Duration
+	}{
+		{
+			name:          "no config uses flags",
+			wantPyxis:     recordingTuner{ttl: time.Hour, rps: 10, burst: 20},
+			wantDockerHub: recordingTuner{ttl: 24 * time.Hour, rps: 5, burst: 10},
+			wantRefresh:   24 * time.Hour,
+			wantCleanup:   5 * time.Minute,
+		},
+		{
+			name: "set fields override flags",
+			spec: &securityv1alpha1.ImageCertInfoConfigSpec{
+				Pyxis: &securityv1alpha1.ProviderSettings{
+					CacheTTL:  &metav1.Duration{Duration: 2 * time.Hour},
+					RateLimit: ptr.To(resource.MustParse("500m")),
+				},
+				DockerHub:       &securityv1alpha1.ProviderSettings{RateBurst: ptr.To[int32](3)},
+				CleanupInterval: &metav1.Duration{Duration: time.Minute},
+			},
+			wantApplied:   metav1.ConditionTrue,
+			wantPyxis:     recordingTuner{ttl: 2 * time.Hour, rps: 0.5, burst: 20},
+			wantDockerHub: recordingTuner{ttl: 24 * time.Hour, rps: 5, burst: 3},
+			wantRefresh:   24 * time.Hour,
+			wantCleanup:   time.Minute,
+		},
+		{
+			name: "invalid config is not applied",
+			spec: &securityv1alpha1.ImageCertInfoConfigSpec{
+				Pyxis:           &securityv1alpha1.ProviderSettings{CacheTTL: &metav1.Duration{Duration: time.Second}},
+				RefreshInterval: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			wantApplied: metav1.ConditionFalse,
+			wantRefresh: 12 * time.Hour,
+			wantCleanup: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			builder := fake.NewClientBuilder().WithScheme(newTestScheme()).
+				WithStatusSubresource(&securityv1alpha1.ImageCertInfoConfig{})
+			if tt.spec != nil {
+				builder = builder.WithObjects(&securityv1alpha1.ImageCertInfoConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: DefaultOperatorConfigName, Namespace: "operator", Generation: 1},
+					Spec:       *tt.spec,
+				})
+			}
+			fakeClient := builder.Build()
+
+			pyxisTuner, dockerHubTuner := &recordingTuner{}, &recordingTuner{}
+			r := &OperatorConfigReconciler{
+				Client:          fakeClient,
+				Name:            DefaultOperatorConfigName,
+				Namespace:       "operator",
+				Defaults:        defaults,
+				Pyxis:           pyxisTuner,
+				DockerHub:       dockerHubTuner,
+				RefreshInterval: NewTunableInterval(12 * time.Hour),
+				CleanupInterval: NewTunableInterval(time.Hour),
+			}
+
+			key := types.NamespacedName{Namespace: "operator", Name: DefaultOperatorConfigName}
+			if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if *pyxisTuner != tt.wantPyxis {
+				t.Errorf("Pyxis settings = %+v, want %+v", *pyxisTuner, tt.wantPyxis)
+			}
+			if *dockerHubTuner != tt.wantDockerHub {
+				t.Errorf("Docker Hub settings = %+v, want %+v", *dockerHubTuner, tt.wantDockerHub)
+			}
+			if got := r.RefreshInterval.Get(); got != tt.wantRefresh {
+				t.Errorf("refresh interval = %v, want %v", got, tt.wantRefresh)
+			}
+			if got := r.CleanupInterval.Get(); got != tt.wantCleanup {
+				t.Errorf("cleanup interval = %v, want %v", got, tt.wantCleanup)
+			}
+
+			if tt.spec == nil {
+				return
+			}
+			var config securityv1alpha1.ImageCertInfoConfig
+			if err := fakeClient.Get(ctx, key, &config); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			applied := meta.FindStatusCondition(config.Status.Conditions, securityv1alpha1.ImageCertInfoConfigConditionApplied)
+			if applied == nil || applied.Status != tt.wantApplied {
+				t.Errorf("Applied condition = %+v, want status %s", applied, tt.wantApplied)
+			}
+			if config.Status.ObservedGeneration != 1 {
+				t.Errorf("ObservedGeneration = %d, want 1", config.Status.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestMergeOperatorSettings_RegistryClassification(t *testing.T) {
+	spec := &securityv1alpha1.ImageCertInfoConfigSpec{
+		RegistryClassification: []securityv1alpha1.RegistryClassificationRule{
+			{Registry: "mirror.example.com", Type: securityv1alpha1.RegistryTypeRedHat},
+			{Registry: "*.partners.example.com", Type: securityv1alpha1.RegistryTypePartner},
+			{Registry: "rh-cache.example.com", Type: securityv1alpha1.RegistryTypeRedHat, PyxisEligible: ptr.To(false)},
+		},
+	}
+	settings, err := mergeOperatorSettings(OperatorSettings{}, spec)
+	if err != nil {
+		t.Fatalf("mergeOperatorSettings() error = %v", err)
+	}
+
+	classifier := image.NewRegistryClassifier(settings.Registries)
+	if !classifier.PyxisEligible("mirror.example.com") {
+		t.Error("RedHat rules should be Pyxis eligible by default")
+	}
+	if classifier.PyxisEligible("rh-cache.example.com") {
+		t.Error("pyxisEligible: false should override the RedHat default")
+	}
+	if got := classifier.Classify("acme.partners.example.com"); got != securityv1alpha1.RegistryTypePartner {
+		t.Errorf("Classify() = %s, want Partner", got)
+	}
+
+	spec.RegistryClassification = append(spec.RegistryClassification,
+		securityv1alpha1.RegistryClassificationRule{Registry: "mirror.example.com/rh", Type: securityv1alpha1.RegistryTypeRedHat})
+	if _, err := mergeOperatorSettings(OperatorSettings{}, spec); err == nil {
+		t.Error("mergeOperatorSettings() expected an error for a registry pattern with a path")
+	}
+}
+
+func TestTunableInterval_Changed(t *testing.T) {
+	interval := NewTunableInterval(time.Minute)
+	changed := interval.Changed()
+
+	interval.Set(time.Minute)
+	select {
+	case <-changed:
+		t.Fatal("Changed() closed when the interval was set to its current value")
+	default:
+	}
+
+	interval.Set(time.Hour)
+	select {
+	case <-changed:
+	default:
+		t.Fatal("Changed() not closed after the interval changed")
+	}
+	if got := interval.Get(); got != time.Hour {
+		t.Errorf("Get() = %v, want 1h", got)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// OCI standard image labels that identify who publishes an image
+const (
+	labelOCIVendor   = "org.opencontainers.image.vendor"
+	labelOCIVersion  = "org.opencontainers.image.version"
+	labelOCIRevision = "org.opencontainers.image.revision"
+	labelOCISource   = "org.opencontainers.image.source"
+)
+
+// imageOwnership reads the ownership of an image from its labels, preferring the OCI
+// standard labels over the older vendor, version, and vcs-ref labels. It returns nil
+// when the labels identify nothing.
+func imageOwnership(labels map[string]string) *securityv1alpha1.ImageOwnership {
+	ownership := &securityv1alpha1.ImageOwnership{
+		Vendor:    firstLabel(labels, labelOCIVendor, "vendor"),
+		Version:   firstLabel(labels, labelOCIVersion, "version"),
+		Revision:  firstLabel(labels, labelOCIRevision, "vcs-ref"),
+		SourceURL: firstLabel(labels, labelOCISource),
+	}
+	if *ownership == (securityv1alpha1.ImageOwnership{}) {
+		return nil
+	}
+	return ownership
+}
+
+// firstLabel returns the value of the first of the named labels that is set
+func firstLabel(labels map[string]string, names ...string) string {
+	for _, name := range names {
+		if value := labels[name]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestImageOwnership(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   *securityv1alpha1.ImageOwnership
+	}{
+		{
+			name: "OCI labels",
+			labels: map[string]string{
+				labelOCIVendor:   "Example Corp",
+				labelOCIVersion:  "1.4.2",
+				labelOCIRevision: "0123abc",
+				labelOCISource:   "https://github.com/example/app",
+			},
+			want: &securityv1alpha1.ImageOwnership{
+				Vendor: "Example Corp", Version: "1.4.2", Revision: "0123abc", SourceURL: "https://github.com/example/app",
+			},
+		},
+		{
+			name: "OCI labels take precedence over older labels",
+			labels: map[string]string{
+				labelOCIVendor: "Example Corp",
+				"vendor":       "Example",
+				"version":      "9.4",
+				"vcs-ref":      "fedcba9",
+			},
+			want: &securityv1alpha1.ImageOwnership{Vendor: "Example Corp", Version: "9.4", Revision: "fedcba9"},
+		},
+		{
+			name:   "no ownership labels",
+			labels: map[string]string{"maintainer": "someone"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := imageOwnership(tt.labels)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("imageOwnership() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
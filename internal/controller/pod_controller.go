@@ -17,28 +17,51 @@ limitations under the License.
 package controller
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
-	"math/rand"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/errorbudget"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/health"
 	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/sharding"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/dockerhub"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/notify"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/quay"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
 )
 
+// tracer records the spans of reconciles, enrichment, and refreshes
+var tracer = otel.Tracer("github.com/sebrandon1/imagecertinfo-operator/internal/controller")
+
 // Event reasons for Kubernetes events
 const (
 	EventReasonImageDiscovered      = "ImageDiscovered"
@@ -46,20 +69,114 @@ const (
 	EventReasonVulnerabilitiesFound = "VulnerabilitiesFound"
 	EventReasonEOLApproaching       = "EOLApproaching"
 	EventReasonHealthDegraded       = "HealthDegraded"
+	EventReasonOrphanDeleted        = "OrphanDeleted"
+	EventReasonRefreshCycleSummary  = "RefreshCycleSummary"
 )
 
 // Registry constants
 const (
 	RegistryDockerHub = "docker.io"
+	RegistryQuay      = "quay.io"
 )
 
+// AnnotationRefreshInterval overrides the refresh interval of a single ImageCertificationInfo,
+// e.g. "6h" to re-check a critical production image more often than the cluster-wide default
+const AnnotationRefreshInterval = "security.telco.openshift.io/refresh-interval"
+
+// MinRefreshInterval is the shortest per-image refresh interval; shorter overrides are raised to it
+const MinRefreshInterval = 15 * time.Minute
+
+// refreshOverrideCheckInterval is how often images with a refresh interval override are checked
+const refreshOverrideCheckInterval = 5 * time.Minute
+
+// DefaultEnrichmentTimeout is the default deadline for enriching a single image
+const DefaultEnrichmentTimeout = 2 * time.Minute
+
+// CVE severities tracked for vulnerability aging
+const (
+	SeverityCritical  = "critical"
+	SeverityImportant = "important"
+)
+
+// DefaultMaxCVEs is the default number of CVEs listed in an image's status
+const DefaultMaxCVEs = 500
+
+// annotationLegacyCVEs is the comma-separated CVE annotation written by earlier versions,
+// removed from images as their CVEs are recorded in status
+const annotationLegacyCVEs = "security.telco.openshift.io/cves"
+
+// severityRank orders CVE severities from most to least severe
+var severityRank = map[string]int{SeverityCritical: 0, SeverityImportant: 1, "moderate": 2, "low": 3}
+
 // PodReconciler reconciles a Pod object and creates/updates ImageCertificationInfo resources
 type PodReconciler struct {
 	client.Client
 	Scheme          *runtime.Scheme
 	PyxisClient     pyxis.Client
 	DockerHubClient dockerhub.Client
-	Recorder        record.EventRecorder
+	// RegistryClient reads basic metadata for images in other registries (nil disables it)
+	RegistryClient registry.Client
+	// QuayClient reads security scans of quay.io images (nil disables it)
+	QuayClient quay.Client
+	// SBOMClient discovers the SBOMs attached to images through the OCI referrers API (nil disables it)
+	SBOMClient registry.SBOMClient
+	// PullSecrets supplies credentials for reading private images with RegistryClient and
+	// SBOMClient (nil reads them anonymously)
+	PullSecrets *PullSecrets
+	// PyxisBaseURL is recorded as the source of Pyxis data (pyxis.DefaultBaseURL if empty)
+	PyxisBaseURL string
+	Recorder     record.EventRecorder
+	// Heartbeats receives liveness signals from the background loops for readiness checks
+	Heartbeats *health.Heartbeats
+	// ExcludedContainerTypes lists container categories that are skipped during discovery
+	ExcludedContainerTypes map[securityv1alpha1.ContainerType]bool
+	// Enrichment runs provider lookups for newly discovered images (nil runs them inline)
+	Enrichment *EnrichmentPool
+	// Journal persists pending certification lookups so that a restarted operator resumes
+	// them (nil disables it)
+	Journal *EnrichmentJournal
+	// EOLTiers are the end-of-life warning tiers, widest first (nil uses DefaultEOLTiers)
+	EOLTiers []EOLTier
+	// EnrichmentTimeout bounds all external API calls made for a single image (0 disables the deadline)
+	EnrichmentTimeout time.Duration
+	// Shard restricts processing to the images this instance owns (nil processes all images)
+	Shard *sharding.Membership
+	// OrphanTTL is how long an image may go unused by any pod before its
+	// ImageCertificationInfo is deleted (0 keeps them forever)
+	OrphanTTL time.Duration
+	// Namespaces restricts discovery to the pods of selected namespaces (nil tracks all namespaces)
+	Namespaces *NamespaceFilter
+	// Mirrors maps mirror registries back to the registries they mirror (nil uses only
+	// the container status image to detect mirrored pulls)
+	Mirrors *image.MirrorMap
+	// Registries classifies registries and selects those looked up in Pyxis (nil uses the
+	// built-in classification)
+	Registries *image.RegistryClassifier
+	// MaxCVEs caps the CVEs listed in an image's status, keeping the most severe (0 lists all)
+	MaxCVEs int
+	// Notifier sends chat and webhook notifications about image changes (nil disables them)
+	Notifier *notify.Notifier
+	// RefreshSummary replaces the per-image warning events of each full refresh cycle with a
+	// single summary event (nil emits an event per image)
+	RefreshSummary *RefreshSummarizer
+	// MaxConcurrentReconciles is the number of pods reconciled concurrently (0 reconciles one at a time)
+	MaxConcurrentReconciles int
+	// Workqueue tunes the retry backoff of the pod workqueue (nil uses the controller-runtime defaults)
+	Workqueue *WorkqueueSettings
+	// TrackUnpulledImages discovers the images of containers that cannot pull them from the
+	// pod spec, so that pods stuck in ImagePullBackOff are covered too
+	TrackUnpulledImages bool
+	// TagResolver resolves the tags of unpulled images to digests (nil tracks them by tag)
+	TagResolver registry.TagResolver
+
+	// rebalanceRequests and rebalanceEvents carry shard rebalances to the rebalance loop and
+	// the pods it re-queues to the controller (nil without sharding)
+	rebalanceRequests chan struct{}
+	rebalanceEvents   chan event.GenericEvent
+
+	// refreshedAt records when each image was last refreshed, for images without a durable check time
+	refreshedAt   map[string]time.Time
+	refreshedAtMu sync.Mutex
 }
 
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
@@ -72,13 +189,21 @@ type PodReconciler struct {
 // Reconcile watches Pods and creates/updates ImageCertificationInfo resources for each unique image
 func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	start := time.Now()
+	ctx, span := tracer.Start(ctx, "PodReconciler.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace), attribute.String("k8s.pod.name", req.Name)))
+	defer span.End()
 	logger := log.FromContext(ctx)
 
 	// Fetch the Pod
 	var pod corev1.Pod
 	if err := r.Get(ctx, req.NamespacedName, &pod); err != nil {
 		if apierrors.IsNotFound(err) {
-			// Pod was deleted - we handle cleanup via owner references or periodic reconciliation
+			// Pod was deleted; the cleanup loop catches any deletion missed here
+			if err := r.removeDeletedPodReferences(ctx, req.NamespacedName); err != nil {
+				logger.Error(err, "failed to remove references of deleted pod")
+				metrics.RecordReconcile("error", time.Since(start).Seconds(), "pod")
+				return ctrl.Result{}, err
+			}
 			metrics.RecordReconcile("success", time.Since(start).Seconds(), "pod")
 			return ctrl.Result{}, nil
 		}
@@ -93,38 +218,37 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, nil
 	}
 
-	// Process all container statuses (including init containers)
-	allStatuses := append(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses...)
+	// retry records a write lost to a concurrent reconcile touching the same image
+	var retry error
+	for _, discovered := range r.discoverImages(ctx, &pod) {
+		ref, crName, podRef := discovered.ref, discovered.crName, discovered.podRef
 
-	for _, containerStatus := range allStatuses {
-		if containerStatus.ImageID == "" {
-			continue
-		}
-
-		// Parse the image ID
-		ref, err := image.ParseImageID(containerStatus.ImageID)
-		if err != nil {
-			logger.V(1).Info("failed to parse imageID", "imageID", containerStatus.ImageID, "error", err)
+		// In shard mode another instance handles images it owns
+		if !r.Shard.Owns(crName) {
 			continue
 		}
 
-		// Generate CR name from image reference (human-readable)
-		crName := image.ReferenceToCRName(ref)
-
-		// Create pod reference
-		podRef := securityv1alpha1.PodReference{
-			Namespace: pod.Namespace,
-			Name:      pod.Name,
-			Container: containerStatus.Name,
-		}
-
 		// Try to get existing ImageCertificationInfo
 		var existingCR securityv1alpha1.ImageCertificationInfo
-		err = r.Get(ctx, client.ObjectKey{Name: crName}, &existingCR)
+		err := r.Get(ctx, client.ObjectKey{Name: crName}, &existingCR)
 
 		if apierrors.IsNotFound(err) {
 			// Create new ImageCertificationInfo
-			if err := r.createImageCertificationInfo(ctx, ref, crName, podRef); err != nil {
+			cr := r.newImageCertificationInfo(ref, crName, metav1.Now())
+			setPodReferences(cr, []securityv1alpha1.PodReference{podRef})
+			if discovered.static {
+				setImageSource(cr, ImageSourceStatic)
+			}
+			if discovered.unpulled {
+				setCertificationStatus(cr, securityv1alpha1.CertificationStatusPending,
+					securityv1alpha1.ReasonImagePullFailed)
+			}
+			if err := r.createImageCertificationInfo(ctx, ref, cr); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					// A concurrent reconcile of another pod created it first; retry to add this pod
+					retry = err
+					continue
+				}
 				logger.Error(err, "failed to create ImageCertificationInfo", "name", crName)
 				continue
 			}
@@ -133,34 +257,176 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 			logger.Error(err, "failed to get ImageCertificationInfo", "name", crName)
 			continue
 		} else {
+			// Images first seen in an ordinary pod are also labeled once a static pod uses them
+			if discovered.static {
+				if err := r.labelImageSource(ctx, &existingCR, ImageSourceStatic); err != nil {
+					logger.Error(err, "failed to label ImageCertificationInfo", "name", crName)
+					continue
+				}
+			}
 			// Update existing CR with new pod reference
 			if err := r.updatePodReferences(ctx, &existingCR, podRef); err != nil {
+				if apierrors.IsConflict(err) {
+					// Another reconcile updated the image first; retry with the fresh version
+					retry = err
+					continue
+				}
 				logger.Error(err, "failed to update ImageCertificationInfo", "name", crName)
 				continue
 			}
 		}
 	}
 
+	if retry != nil {
+		// Requeue with the workqueue backoff so the pod's references are not lost
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "pod")
+		return ctrl.Result{}, retry
+	}
 	metrics.RecordReconcile("success", time.Since(start).Seconds(), "pod")
 	return ctrl.Result{}, nil
 }
 
-// createImageCertificationInfo creates a new ImageCertificationInfo resource
-func (r *PodReconciler) createImageCertificationInfo(ctx context.Context, ref *image.Reference, crName string, podRef securityv1alpha1.PodReference) error {
-	now := metav1.Now()
-	registryType := image.ClassifyRegistry(ref.Registry)
+// discoveredImage is an image used by a container of a pod
+type discoveredImage struct {
+	ref    *image.Reference
+	crName string
+	podRef securityv1alpha1.PodReference
+	// static is set for the containers of the mirror pod of a static pod
+	static bool
+	// unpulled is set for containers that could not pull the image, which is taken from the pod spec
+	unpulled bool
+}
 
-	cr := &securityv1alpha1.ImageCertificationInfo{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: crName,
-		},
-		Spec: securityv1alpha1.ImageCertificationInfoSpec{
-			ImageDigest:        ref.Digest,
-			FullImageReference: ref.FullReference,
-			Registry:           ref.Registry,
-			Repository:         ref.Repository,
-			Tag:                ref.Tag,
-		},
+// discoverImages returns the images used by the pod's containers in every category not
+// excluded by policy, resolving images pulled through a mirror to their source registry
+func (r *PodReconciler) discoverImages(ctx context.Context, pod *corev1.Pod) []discoveredImage {
+	logger := log.FromContext(ctx)
+	workloadKind, workloadName := podWorkload(pod)
+	static := isMirrorPod(pod)
+
+	var images []discoveredImage
+	for _, container := range r.classifyContainers(pod) {
+		containerStatus := container.status
+		var ref *image.Reference
+		unpulled := false
+		switch {
+		case containerStatus.ImageID != "":
+			var err error
+			if ref, err = r.containerImage(pod, containerStatus); err != nil {
+				logger.V(1).Info("failed to parse imageID", "imageID", containerStatus.ImageID, "error", err)
+				continue
+			}
+			if r.TrackUnpulledImages {
+				if err := r.releaseProvisionalImage(ctx, pod, containerStatus.Name); err != nil {
+					logger.Error(err, "failed to release provisional image", "container", containerStatus.Name)
+				}
+			}
+		case r.TrackUnpulledImages && pullFailed(containerStatus):
+			if ref = r.unpulledImage(ctx, pod, containerStatus.Name); ref == nil {
+				continue
+			}
+			unpulled = true
+		default:
+			continue
+		}
+
+		images = append(images, discoveredImage{
+			ref: ref,
+			// Generate CR name from image reference (human-readable)
+			crName: image.ReferenceToCRName(ref),
+			podRef: securityv1alpha1.PodReference{
+				Namespace:     pod.Namespace,
+				Name:          pod.Name,
+				Container:     containerStatus.Name,
+				ContainerType: container.containerType,
+				WorkloadKind:  workloadKind,
+				WorkloadName:  workloadName,
+			},
+			static:   static,
+			unpulled: unpulled,
+		})
+	}
+	return images
+}
+
+// containerImage parses the image a container runs from its status. Runtimes that report the
+// imageID as a bare digest get the repository from the status image or the pod spec. Images
+// pulled through a mirror are tracked under the registry they were requested from.
+func (r *PodReconciler) containerImage(pod *corev1.Pod, status corev1.ContainerStatus) (*image.Reference, error) {
+	ref, err := image.ParseContainerImage(status.ImageID, status.Image, specImage(pod, status.Name))
+	if err != nil {
+		return nil, err
+	}
+	return image.ResolveSource(ref, status.Image, r.Mirrors), nil
+}
+
+// specImage returns the image of the named container in the pod spec
+func specImage(pod *corev1.Pod, name string) string {
+	for _, container := range slices.Concat(pod.Spec.Containers, pod.Spec.InitContainers) {
+		if container.Name == name {
+			return container.Image
+		}
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		if container.Name == name {
+			return container.Image
+		}
+	}
+	return ""
+}
+
+// classifiedContainer pairs a container status with its category
+type classifiedContainer struct {
+	status        corev1.ContainerStatus
+	containerType securityv1alpha1.ContainerType
+}
+
+// classifyContainers returns the pod's container statuses labeled by category,
+// omitting any category excluded by policy
+func (r *PodReconciler) classifyContainers(pod *corev1.Pod) []classifiedContainer {
+	var containers []classifiedContainer
+	add := func(status corev1.ContainerStatus, containerType securityv1alpha1.ContainerType) {
+		if !r.ExcludedContainerTypes[containerType] {
+			containers = append(containers, classifiedContainer{status: status, containerType: containerType})
+		}
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		add(status, securityv1alpha1.ContainerTypeApp)
+	}
+
+	// Init containers with restartPolicy Always are native sidecars
+	sidecars := make(map[string]bool)
+	for _, c := range pod.Spec.InitContainers {
+		if c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			sidecars[c.Name] = true
+		}
+	}
+	for _, status := range pod.Status.InitContainerStatuses {
+		if sidecars[status.Name] {
+			add(status, securityv1alpha1.ContainerTypeSidecar)
+		} else {
+			add(status, securityv1alpha1.ContainerTypeInit)
+		}
+	}
+
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		add(status, securityv1alpha1.ContainerTypeEphemeral)
+	}
+	return containers
+}
+
+// createImageCertificationInfo creates the ImageCertificationInfo resource cr for a newly
+// discovered image and starts its enrichment
+func (r *PodReconciler) createImageCertificationInfo(ctx context.Context, ref *image.Reference,
+	cr *securityv1alpha1.ImageCertificationInfo) error {
+	status := cr.Status
+
+	if r.Shard != nil {
+		if cr.Labels == nil {
+			cr.Labels = make(map[string]string)
+		}
+		cr.Labels[sharding.LabelShard] = r.Shard.ID()
 	}
 
 	// Create the resource
@@ -169,25 +435,7 @@ func (r *PodReconciler) createImageCertificationInfo(ctx context.Context, ref *i
 	}
 
 	// Update status
-	cr.Status = securityv1alpha1.ImageCertificationInfoStatus{
-		RegistryType:        registryType,
-		CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
-		PodReferences:       []securityv1alpha1.PodReference{podRef},
-		FirstSeenAt:         &now,
-		LastSeenAt:          &now,
-	}
-
-	// Set initial conditions
-	cr.Status.Conditions = []metav1.Condition{
-		{
-			Type:               "Available",
-			Status:             metav1.ConditionTrue,
-			LastTransitionTime: now,
-			Reason:             "ImageDiscovered",
-			Message:            "Image has been discovered in the cluster",
-		},
-	}
-
+	cr.Status = status
 	if err := r.Status().Update(ctx, cr); err != nil {
 		return err
 	}
@@ -200,41 +448,146 @@ func (r *PodReconciler) createImageCertificationInfo(ctx context.Context, ref *i
 		metrics.RecordEvent(corev1.EventTypeNormal, EventReasonImageDiscovered)
 	}
 
+	// Images tracked by tag have no digest to look up until a pod pulls them
+	if ref.Digest == "" {
+		return nil
+	}
+
+	// Enrichment runs on the worker pool so that busy clusters do not fan out one
+	// goroutine per new image and provider
+	name := cr.Name
+	pods := cr.Status.PodReferences
+
+	// Journal the lookups that decide the certification status until they complete
+	certifies := (r.PyxisClient != nil && r.Registries.PyxisEligible(ref.Registry)) ||
+		(r.DockerHubClient != nil && ref.Registry == RegistryDockerHub)
+	if certifies {
+		r.Journal.Begin(name, ref.Digest, time.Now())
+	}
+
 	// If Pyxis client is available and this is a Red Hat registry, check certification
-	if r.PyxisClient != nil && image.IsRedHatRegistry(ref.Registry) {
-		go r.checkPyxisCertification(context.Background(), cr.Name, ref)
+	if r.PyxisClient != nil && r.Registries.PyxisEligible(ref.Registry) {
+		r.enrich(ctx, "pyxis", ref, func(ctx context.Context) { r.checkPyxisCertification(ctx, name, ref) })
 	}
 
 	// If Docker Hub client is available and this is docker.io, enrich with Docker Hub data
 	if r.DockerHubClient != nil && ref.Registry == RegistryDockerHub {
-		go r.checkDockerHubData(context.Background(), cr.Name, ref)
+		r.enrich(ctx, "dockerhub", ref, func(ctx context.Context) { r.checkDockerHubData(ctx, name, ref) })
+	}
+
+	// If Quay client is available and this is quay.io, enrich with its security scan
+	if r.QuayClient != nil && ref.Registry == RegistryQuay {
+		r.enrich(ctx, "quay", ref, func(ctx context.Context) { r.checkQuayScan(ctx, name, ref) })
+	}
+
+	// Other registries get basic metadata from the image itself
+	if r.inspectsRegistry(ref.Registry) {
+		r.enrich(ctx, "registry", ref, func(ctx context.Context) {
+			r.checkRegistryMetadata(r.withPullSecrets(ctx, ref.Registry, pods), name, ref)
+		})
+	}
+
+	// Any registry may have SBOMs attached to its images
+	if r.SBOMClient != nil {
+		r.enrich(ctx, "sbom", ref, func(ctx context.Context) {
+			r.checkSBOM(r.withPullSecrets(ctx, ref.Registry, pods), name, ref)
+		})
 	}
 
 	return nil
 }
 
+// newImageCertificationInfo returns the resource tracking an image discovered at now, without
+// references to the pods or nodes using it
+func (r *PodReconciler) newImageCertificationInfo(ref *image.Reference, crName string, now metav1.Time) *securityv1alpha1.ImageCertificationInfo {
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crName,
+		},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        ref.Digest,
+			FullImageReference: ref.FullReference,
+			Registry:           ref.Registry,
+			Repository:         ref.Repository,
+			Tag:                ref.Tag,
+			ObservedRegistry:   ref.ObservedRegistry,
+			ObservedRepository: ref.ObservedRepository,
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			RegistryType:        r.Registries.Classify(ref.Registry),
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+			ReasonCode:          securityv1alpha1.ReasonImageDiscovered,
+			FirstSeenAt:         &now,
+			LastSeenAt:          &now,
+			Conditions: []metav1.Condition{
+				{
+					Type:               securityv1alpha1.ImageCertificationInfoConditionAvailable,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: now,
+					Reason:             string(securityv1alpha1.ReasonImageDiscovered),
+					Message:            "Image has been discovered in the cluster",
+				},
+			},
+		},
+	}
+	return cr
+}
+
+// enrich queues a provider lookup for a newly discovered image on the enrichment pool, or
+// runs it inline when there is no pool. A lookup that cannot be queued before ctx is
+// cancelled is left to the refresh loop. The lookup is traced as a child of the reconcile
+// that discovered the image, so the time it waited in the queue shows in the trace.
+func (r *PodReconciler) enrich(ctx context.Context, provider string, ref *image.Reference, task func(context.Context)) {
+	parent := trace.SpanContextFromContext(ctx)
+	traced := func(taskCtx context.Context) {
+		taskCtx, span := tracer.Start(trace.ContextWithSpanContext(taskCtx, parent), "enrich/"+provider,
+			trace.WithAttributes(attribute.String("image", ref.FullReference)))
+		defer span.End()
+		task(taskCtx)
+	}
+	if r.Enrichment == nil {
+		traced(ctx)
+		return
+	}
+	if err := r.Enrichment.Submit(ctx, traced); err != nil {
+		log.FromContext(ctx).V(1).Info("enrichment not queued", "error", err)
+	}
+}
+
 // updatePodReferences updates the pod references in an existing ImageCertificationInfo
 func (r *PodReconciler) updatePodReferences(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo, podRef securityv1alpha1.PodReference) error {
 	now := metav1.Now()
 
 	// Check if this pod reference already exists
-	for _, existing := range cr.Status.PodReferences {
+	for i, existing := range cr.Status.PodReferences {
 		if existing.Namespace == podRef.Namespace &&
 			existing.Name == podRef.Name &&
 			existing.Container == podRef.Container {
-			// Already tracked, just update LastSeenAt
+			// Already tracked, just update LastSeenAt and backfill the container type and workload
+			cr.Status.PodReferences[i] = podRef
+			setPodReferences(cr, cr.Status.PodReferences)
 			cr.Status.LastSeenAt = &now
 			return r.Status().Update(ctx, cr)
 		}
 	}
 
-	// Add new pod reference
-	cr.Status.PodReferences = append(cr.Status.PodReferences, podRef)
+	// Add new pod reference; the image is in use again
+	setPodReferences(cr, append(cr.Status.PodReferences, podRef))
 	cr.Status.LastSeenAt = &now
+	cr.Status.OrphanedAt = nil
 
 	return r.Status().Update(ctx, cr)
 }
 
+// enrichmentContext derives the context used for the external API calls of a single image,
+// applying the per-image enrichment deadline
+func (r *PodReconciler) enrichmentContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.EnrichmentTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, r.EnrichmentTimeout)
+}
+
 // checkPyxisCertification queries the Pyxis API for certification data
 func (r *PodReconciler) checkPyxisCertification(ctx context.Context, crName string, ref *image.Reference) {
 	logger := log.FromContext(ctx).WithValues("crName", crName)
@@ -243,8 +596,21 @@ func (r *PodReconciler) checkPyxisCertification(ctx context.Context, crName stri
 		return
 	}
 
-	// Query Pyxis
-	certData, err := r.PyxisClient.GetImageCertification(ctx, ref.Registry, ref.Repository, ref.Digest)
+	// Query Pyxis within the enrichment deadline
+	callCtx, cancel := r.enrichmentContext(ctx)
+	certData, err := r.PyxisClient.GetImageCertification(callCtx, ref.Registry, ref.Repository, ref.Digest)
+	cancel()
+
+	// Nothing to record if the operator is shutting down
+	if ctx.Err() != nil {
+		return
+	}
+
+	// Leave the status untouched while Pyxis is disabled; the refresh loop retries later
+	if errors.Is(err, errorbudget.ErrDisabled) {
+		logger.V(1).Info("skipping Pyxis check while provider is disabled")
+		return
+	}
 
 	// Fetch the latest version of the CR
 	var cr securityv1alpha1.ImageCertificationInfo
@@ -258,7 +624,8 @@ func (r *PodReconciler) checkPyxisCertification(ctx context.Context, crName stri
 
 	if err != nil {
 		logger.Error(err, "failed to query Pyxis API")
-		cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusError
+		setCertificationStatus(&cr, securityv1alpha1.CertificationStatusError, securityv1alpha1.ReasonPyxisQueryFailed)
+		recordLookupFailure(&cr, now)
 		updateErr := r.Status().Update(ctx, &cr)
 		if updateErr != nil {
 			logger.Error(updateErr, "failed to update status after Pyxis error")
@@ -266,47 +633,31 @@ func (r *PodReconciler) checkPyxisCertification(ctx context.Context, crName stri
 		return
 	}
 
+	recordLookupSuccess(&cr, now)
 	if certData == nil {
 		// No certification data found
-		cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusNotCertified
+		setCertificationStatus(&cr, securityv1alpha1.CertificationStatusNotCertified, securityv1alpha1.ReasonNotFoundInPyxis)
+		recordDataSource(&cr, securityv1alpha1.DataSourcePyxis, r.pyxisSource(),
+			[]string{fieldCertificationStatus}, now)
 	} else {
 		// Update with certification data using shared method
 		r.updateCRWithPyxisData(&cr, certData)
 
-		// Emit event if EOL approaching (within 90 days)
-		if cr.Status.DaysUntilEOL != nil {
-			daysUntil := *cr.Status.DaysUntilEOL
-			if daysUntil >= 0 && daysUntil <= 90 && r.Recorder != nil {
-				msg := fmt.Sprintf("Image reaches EOL in %d days", daysUntil)
-				if certData.ReplacedBy != "" {
-					msg += fmt.Sprintf(", replacement: %s", certData.ReplacedBy)
-				}
-				r.Recorder.Event(&cr, corev1.EventTypeWarning, EventReasonEOLApproaching, msg)
-				metrics.RecordEvent(corev1.EventTypeWarning, EventReasonEOLApproaching)
-			}
-		}
-
-		// Emit event if vulnerabilities found
-		if certData.Vulnerabilities != nil &&
-			(certData.Vulnerabilities.Critical > 0 || certData.Vulnerabilities.Important > 0) &&
-			r.Recorder != nil {
-			r.Recorder.Event(&cr, corev1.EventTypeWarning, EventReasonVulnerabilitiesFound,
-				fmt.Sprintf("Found %d critical, %d important vulnerabilities",
-					certData.Vulnerabilities.Critical, certData.Vulnerabilities.Important))
-			metrics.RecordEvent(corev1.EventTypeWarning, EventReasonVulnerabilitiesFound)
-		}
+		// Emit events if EOL is approaching or vulnerabilities were found
+		r.emitEOLEvent(ctx, &cr)
+		r.emitVulnerabilityEvent(ctx, &cr)
 	}
 
 	// Update status first
 	if err := r.Status().Update(ctx, &cr); err != nil {
 		logger.Error(err, "failed to update ImageCertificationInfo with Pyxis data")
+	} else {
+		r.Journal.Complete(crName)
 	}
 
-	// Update CVE annotations separately (after status update)
-	if certData != nil && len(certData.CVEs) > 0 {
-		if updateErr := r.updateCVEAnnotations(ctx, crName, certData.CVEs); updateErr != nil {
-			logger.Error(updateErr, "failed to update CVE annotations")
-		}
+	// CVEs now live in status, so drop the annotation earlier versions wrote
+	if err := r.removeLegacyCVEAnnotation(ctx, &cr); err != nil {
+		logger.Error(err, "failed to remove legacy CVE annotation")
 	}
 }
 
@@ -323,8 +674,15 @@ func (r *PodReconciler) checkDockerHubData(ctx context.Context, crName string, r
 	// For user images: bitnami/redis -> namespace=bitnami, repo=redis
 	namespace, repo := parseDockerHubRepo(ref.Repository)
 
-	// Query Docker Hub
-	repoInfo, err := r.DockerHubClient.GetRepositoryInfo(ctx, namespace, repo)
+	// Query Docker Hub within the enrichment deadline
+	callCtx, cancel := r.enrichmentContext(ctx)
+	repoInfo, err := r.DockerHubClient.GetRepositoryInfo(callCtx, namespace, repo)
+	cancel()
+
+	// Nothing to record if the operator is shutting down
+	if ctx.Err() != nil {
+		return
+	}
 
 	// Fetch the latest version of the CR
 	var cr securityv1alpha1.ImageCertificationInfo
@@ -335,20 +693,27 @@ func (r *PodReconciler) checkDockerHubData(ctx context.Context, crName string, r
 
 	if err != nil {
 		logger.Error(err, "failed to query Docker Hub API")
+		if !errors.Is(err, errorbudget.ErrDisabled) {
+			r.writeLookupFailure(ctx, &cr)
+		}
 		return
 	}
 
 	if repoInfo == nil {
 		// No data found
+		r.Journal.Complete(crName)
 		return
 	}
 
 	// Update CR with Docker Hub data
+	recordLookupSuccess(&cr, metav1.Now())
 	r.updateCRWithDockerHubData(&cr, repoInfo)
 
 	// Update status
 	if err := r.Status().Update(ctx, &cr); err != nil {
 		logger.Error(err, "failed to update ImageCertificationInfo with Docker Hub data")
+	} else {
+		r.Journal.Complete(crName)
 	}
 }
 
@@ -365,6 +730,7 @@ func parseDockerHubRepo(repository string) (namespace, repo string) {
 // updateCRWithDockerHubData updates a CR's status with data from Docker Hub
 func (r *PodReconciler) updateCRWithDockerHubData(cr *securityv1alpha1.ImageCertificationInfo, repoInfo *dockerhub.RepositoryInfo) {
 	daysSinceUpdate := dockerhub.CalculateDaysSince(repoInfo.LastUpdated)
+	now := metav1.Now()
 
 	cr.Status.DockerHubData = &securityv1alpha1.DockerHubData{
 		IsOfficialImage:     repoInfo.IsOfficial,
@@ -374,28 +740,95 @@ func (r *PodReconciler) updateCRWithDockerHubData(cr *securityv1alpha1.ImageCert
 		LastUpdated:         &metav1.Time{Time: repoInfo.LastUpdated},
 		DaysSinceUpdate:     &daysSinceUpdate,
 		PullCountFormatted:  dockerhub.FormatPullCount(repoInfo.PullCount),
+		Description:         repoInfo.Description,
+		Source:              dockerhub.DefaultBaseURL,
+		SyncedAt:            &now,
 	}
+	fields := []string{fieldDockerHubData}
 
 	// Update certification status based on Docker Hub trust level
 	if repoInfo.IsOfficial {
-		cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusOfficial
+		setCertificationStatus(cr, securityv1alpha1.CertificationStatusOfficial, securityv1alpha1.ReasonDockerOfficialImage)
+		fields = append(fields, fieldCertificationStatus)
 	} else if repoInfo.IsVerifiedPublisher {
-		cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusVerified
+		setCertificationStatus(cr, securityv1alpha1.CertificationStatusVerified, securityv1alpha1.ReasonDockerVerifiedPublisher)
+		fields = append(fields, fieldCertificationStatus)
 	} else if cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusUnknown {
 		// Only update to NotCertified if currently Unknown
-		cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusNotCertified
+		setCertificationStatus(cr, securityv1alpha1.CertificationStatusNotCertified,
+			securityv1alpha1.ReasonNoDockerHubTrustProgram)
+		fields = append(fields, fieldCertificationStatus)
 	}
+	recordDataSource(cr, securityv1alpha1.DataSourceDockerHub, dockerhub.DefaultBaseURL, fields, now)
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&corev1.Pod{}).
-		Named("pod").
-		Complete(r)
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &securityv1alpha1.ImageCertificationInfo{},
+		IndexPodReference, podReferenceKeys); err != nil {
+		return err
+	}
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				// Drop pods in filtered namespaces before they are queued
+				return r.Namespaces.Allows(context.Background(), obj.GetNamespace())
+			}),
+			// Other updates do not change the images a pod runs. Deletions remove the pod's
+			// references.
+			predicate.Funcs{UpdateFunc: podImagesChanged},
+		)).
+		Named("pod")
+	opts := controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}
+	if r.Workqueue != nil {
+		opts.RateLimiter = r.Workqueue.RateLimiter()
+	}
+	if r.Shard != nil {
+		// Every instance processes its own shard, not only the leader. Rebalances run outside
+		// the membership loop and queue the pods through the controller's workqueue.
+		opts.NeedLeaderElection = ptr.To(false)
+		r.enableRebalance()
+		b = b.WatchesRawSource(source.Channel(r.rebalanceEvents, &handler.EnqueueRequestForObject{}))
+		if err := mgr.Add(&rebalanceLoop{pods: r}); err != nil {
+			return err
+		}
+		r.Shard.OnChange(r.requestRebalance)
+	}
+	return b.WithOptions(opts).Complete(r)
+}
+
+// podImagesChanged passes pod updates that change the imageID of any container, so that
+// status churn such as condition and resource version bumps is not reconciled. Periodic
+// resyncs, which repeat the same resource version, also pass so that pods in namespaces
+// newly allowed by the namespace filter are picked up.
+func podImagesChanged(e event.UpdateEvent) bool {
+	oldPod, okOld := e.ObjectOld.(*corev1.Pod)
+	newPod, okNew := e.ObjectNew.(*corev1.Pod)
+	if !okOld || !okNew {
+		return false
+	}
+	return oldPod.ResourceVersion == newPod.ResourceVersion ||
+		!slices.Equal(podImageIDs(oldPod), podImageIDs(newPod))
+}
+
+// podImageIDs lists the name and imageID of every container status of the pod that has
+// an imageID, and the containers that failed to pull their image. Containers still pulling
+// their image have neither and are not discovered yet.
+func podImageIDs(pod *corev1.Pod) []string {
+	var ids []string
+	for _, status := range slices.Concat(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses,
+		pod.Status.EphemeralContainerStatuses) {
+		if status.ImageID != "" {
+			ids = append(ids, status.Name+"="+status.ImageID)
+		} else if pullFailed(status) {
+			ids = append(ids, status.Name+"=pull-failed")
+		}
+	}
+	return ids
 }
 
-// CleanupStaleReferences removes pod references for pods that no longer exist
+// CleanupStaleReferences removes pod references for pods that no longer exist and, when
+// OrphanTTL is set, deletes ImageCertificationInfos no pod has used for longer than the TTL.
 // This should be called periodically
 func (r *PodReconciler) CleanupStaleReferences(ctx context.Context) error {
 	logger := log.FromContext(ctx)
@@ -406,99 +839,131 @@ func (r *PodReconciler) CleanupStaleReferences(ctx context.Context) error {
 		return err
 	}
 
+	now := time.Now()
+	var livePods map[types.NamespacedName]bool
 	for i := range crList.Items {
 		cr := &crList.Items[i]
-		var validRefs []securityv1alpha1.PodReference
+		if !r.Shard.Owns(cr.Name) {
+			continue
+		}
 
+		// Without pod references there is nothing to check, only the orphan TTL to apply.
+		// Images reported by control-plane nodes are in use by static pods.
+		if len(cr.Status.PodReferences) == 0 {
+			if len(cr.Status.NodeReferences) == 0 {
+				r.collectOrphan(ctx, cr, now)
+			}
+			continue
+		}
+
+		// Pods are looked up in one listing shared by all images rather than one read per reference
+		if livePods == nil {
+			var err error
+			if livePods, err = r.livePods(ctx); err != nil {
+				return err
+			}
+		}
+
+		var validRefs []securityv1alpha1.PodReference
 		for _, podRef := range cr.Status.PodReferences {
-			// Check if pod still exists
-			var pod corev1.Pod
-			err := r.Get(ctx, client.ObjectKey{
-				Namespace: podRef.Namespace,
-				Name:      podRef.Name,
-			}, &pod)
-
-			if err == nil {
-				// Pod exists, keep the reference
-				validRefs = append(validRefs, podRef)
-			} else if !apierrors.IsNotFound(err) {
-				// Error other than not found, keep the reference to be safe
+			// Pods in namespaces that are no longer tracked are dropped like deleted pods
+			if !r.Namespaces.Allows(ctx, podRef.Namespace) {
+				continue
+			}
+			if livePods[types.NamespacedName{Namespace: podRef.Namespace, Name: podRef.Name}] {
 				validRefs = append(validRefs, podRef)
-				logger.Error(err, "error checking pod existence", "namespace", podRef.Namespace, "name", podRef.Name)
 			}
-			// If not found, the reference is stale and won't be kept
 		}
 
 		if len(validRefs) != len(cr.Status.PodReferences) {
-			cr.Status.PodReferences = validRefs
+			setPodReferences(cr, validRefs)
+			if len(validRefs) == 0 && len(cr.Status.NodeReferences) == 0 {
+				cr.Status.OrphanedAt = &metav1.Time{Time: now}
+			}
 			if err := r.Status().Update(ctx, cr); err != nil {
 				logger.Error(err, "failed to update stale references", "name", cr.Name)
 			}
 		}
 	}
 
+	// Reuse the CR list to keep vulnerability aging metrics current
+	metrics.SetCVEAges(cveAgesBySeverity(crList.Items, time.Now()))
+
 	return nil
 }
 
-// StartCleanupLoop starts a goroutine that periodically cleans up stale pod references
-func (r *PodReconciler) StartCleanupLoop(ctx context.Context, interval time.Duration) {
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				if err := r.CleanupStaleReferences(ctx); err != nil {
-					log.FromContext(ctx).Error(err, "failed to cleanup stale references")
-				}
-			}
-		}
-	}()
+// livePods returns the keys of all existing pods. The pods are listed from the informer
+// cache without copying them, so the listing costs no API server requests.
+func (r *PodReconciler) livePods(ctx context.Context) (map[types.NamespacedName]bool, error) {
+	var podList corev1.PodList
+	if err := r.List(ctx, &podList, client.UnsafeDisableDeepCopy); err != nil {
+		return nil, err
+	}
+	pods := make(map[types.NamespacedName]bool, len(podList.Items))
+	for i := range podList.Items {
+		pods[types.NamespacedName{Namespace: podList.Items[i].Namespace, Name: podList.Items[i].Name}] = true
+	}
+	return pods, nil
 }
 
-// StartRefreshLoop starts a goroutine that periodically refreshes all ImageCertificationInfo resources
-func (r *PodReconciler) StartRefreshLoop(ctx context.Context, interval time.Duration) {
-	go func() {
-		logger := log.FromContext(ctx).WithName("refresh-loop")
+// collectOrphan records when an image without pod references was first found orphaned and
+// deletes its ImageCertificationInfo once it has stayed orphaned for longer than OrphanTTL
+func (r *PodReconciler) collectOrphan(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo, now time.Time) {
+	logger := log.FromContext(ctx)
 
-		// Random startup delay (0-5 minutes) to avoid thundering herd
-		startupDelay := time.Duration(rand.Int63n(int64(5 * time.Minute))) //nolint:gosec
-		logger.Info("refresh loop starting with delay", "delay", startupDelay)
-		select {
-		case <-ctx.Done():
-			return
-		case <-time.After(startupDelay):
+	// Resources orphaned before the timestamp existed start their TTL now
+	if cr.Status.OrphanedAt == nil {
+		cr.Status.OrphanedAt = &metav1.Time{Time: now}
+		if err := r.Status().Update(ctx, cr); err != nil {
+			logger.Error(err, "failed to mark image as orphaned", "name", cr.Name)
 		}
+		return
+	}
 
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
+	orphanedFor := now.Sub(cr.Status.OrphanedAt.Time)
+	if r.OrphanTTL <= 0 || orphanedFor < r.OrphanTTL {
+		return
+	}
 
-		// Run immediately after startup delay
-		if err := r.RefreshAllImages(ctx); err != nil {
-			logger.Error(err, "failed to refresh images")
+	// The precondition fails if a pod started using the image since the list
+	err := r.Delete(ctx, cr, client.Preconditions{ResourceVersion: &cr.ResourceVersion})
+	if err != nil {
+		if !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			logger.Error(err, "failed to delete orphaned image", "name", cr.Name)
 		}
+		return
+	}
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				if err := r.RefreshAllImages(ctx); err != nil {
-					logger.Error(err, "failed to refresh images")
-				}
-			}
-		}
-	}()
+	logger.Info("deleted orphaned image", "name", cr.Name, "orphanedFor", orphanedFor.Round(time.Second))
+	metrics.OrphanedImagesDeleted.Inc()
+	if r.Recorder != nil {
+		r.Recorder.Event(cr, corev1.EventTypeNormal, EventReasonOrphanDeleted,
+			fmt.Sprintf("Deleted image %s after no pod used it for %s", cr.Spec.FullImageReference,
+				orphanedFor.Round(time.Minute)))
+		metrics.RecordEvent(corev1.EventTypeNormal, EventReasonOrphanDeleted)
+	}
 }
 
-// RefreshAllImages refreshes certification data for all Red Hat registry images
+// RefreshAllImages refreshes certification data for all Red Hat registry images.
+// Images with a refresh interval override are only refreshed when that interval has elapsed.
 func (r *PodReconciler) RefreshAllImages(ctx context.Context) error {
+	return r.refreshImages(ctx, false)
+}
+
+// RefreshOverriddenImages refreshes the images with a refresh interval override whose
+// interval has elapsed since their last refresh
+func (r *PodReconciler) RefreshOverriddenImages(ctx context.Context) error {
+	return r.refreshImages(ctx, true)
+}
+
+// refreshImages refreshes all eligible images, or only those with a refresh interval override
+func (r *PodReconciler) refreshImages(ctx context.Context, overriddenOnly bool) error {
 	logger := log.FromContext(ctx).WithName("refresh")
 	start := time.Now()
 
+	// Refresh lookups yield rate limiter budget to newly discovered images
+	ctx = pyxis.WithPriority(ctx, pyxis.PriorityBatch)
+
 	// List all ImageCertificationInfo resources
 	var crList securityv1alpha1.ImageCertificationInfoList
 	if err := r.List(ctx, &crList); err != nil {
@@ -507,34 +972,70 @@ func (r *PodReconciler) RefreshAllImages(ctx context.Context) error {
 
 	refreshed := 0
 	skipped := 0
-	errors := 0
+	failed := 0
+
+	var cycle *refreshCycle
+	if !overriddenOnly {
+		r.pruneRefreshTimes(crList.Items)
+		if r.RefreshSummary != nil {
+			ctx, cycle = withRefreshCycle(ctx)
+		}
+	}
 
 	for i := range crList.Items {
 		cr := &crList.Items[i]
+		if !r.Shard.Owns(cr.Name) {
+			continue
+		}
+		override, overridden := refreshIntervalOverride(ctx, cr)
+		if overriddenOnly && !overridden {
+			continue
+		}
 
 		// Determine which API to use based on registry
-		isRedHatRegistry := image.IsRedHatRegistry(cr.Spec.Registry)
+		isPyxisEligible := r.Registries.PyxisEligible(cr.Spec.Registry)
 		isDockerHub := cr.Spec.Registry == RegistryDockerHub
+		isQuay := cr.Spec.Registry == RegistryQuay && r.QuayClient != nil
+		// Registry metadata is read by digest and never changes, so it is only retried until it succeeds
+		needsRegistryData := r.inspectsRegistry(cr.Spec.Registry) && registryDataMissing(cr)
+		needsSBOM := r.needsSBOMCheck(cr)
 
 		// Skip if no enrichment is possible
-		if !isRedHatRegistry && !isDockerHub {
+		if !isPyxisEligible && !isDockerHub && !isQuay && !needsRegistryData && !needsSBOM {
 			skipped++
 			continue
 		}
 
-		// Skip if checked within the last hour (staggering)
-		if cr.Status.LastPyxisCheckAt != nil && isRedHatRegistry {
+		if overridden {
+			// Skip until the image's own interval has elapsed
+			if time.Since(r.lastRefresh(cr)) < override {
+				skipped++
+				continue
+			}
+		} else if cr.Status.LastPyxisCheckAt != nil && isPyxisEligible {
+			// Skip if checked within the last hour (staggering)
 			if time.Since(cr.Status.LastPyxisCheckAt.Time) < time.Hour {
 				skipped++
 				continue
 			}
+		} else if isQuay && cr.Status.QuayData != nil && cr.Status.QuayData.LastScanAt != nil {
+			// Skip if a completed scan was read within the last hour
+			if time.Since(cr.Status.QuayData.LastScanAt.Time) < time.Hour {
+				skipped++
+				continue
+			}
 		}
 
 		// Refresh single image with delay between requests (staggering)
-		if err := r.refreshSingleImage(ctx, cr); err != nil {
+		if err := r.refreshSingleImage(ctx, cr); errors.Is(err, errorbudget.ErrDisabled) {
+			// Provider is disabled by its error budget guard; keep the existing data
+			skipped++
+		} else if err != nil {
 			logger.Error(err, "failed to refresh image", "name", cr.Name)
-			errors++
+			r.recordRefresh(cr.Name, time.Now())
+			failed++
 		} else {
+			r.recordRefresh(cr.Name, time.Now())
 			refreshed++
 		}
 
@@ -547,22 +1048,95 @@ func (r *PodReconciler) RefreshAllImages(ctx context.Context) error {
 	}
 
 	duration := time.Since(start)
+	if overriddenOnly {
+		if refreshed > 0 || failed > 0 {
+			logger.Info("refreshed images with a refresh interval override",
+				"duration", duration, "refreshed", refreshed, "errors", failed)
+		}
+		return nil
+	}
 	metrics.RecordRefreshCycle(duration.Seconds())
+	if cycle != nil {
+		r.RefreshSummary.publish(ctx, cycle, time.Now())
+	}
 
 	logger.Info("refresh cycle completed",
 		"duration", duration,
 		"refreshed", refreshed,
 		"skipped", skipped,
-		"errors", errors,
+		"errors", failed,
 		"total", len(crList.Items))
 
 	return nil
 }
 
+// refreshIntervalOverride returns the refresh interval set by the image's annotation, raised to
+// MinRefreshInterval, and whether a valid override is present
+func refreshIntervalOverride(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo) (time.Duration, bool) {
+	value, ok := cr.Annotations[AnnotationRefreshInterval]
+	if !ok {
+		return 0, false
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		log.FromContext(ctx).V(1).Info("ignoring invalid refresh interval annotation",
+			"name", cr.Name, "value", value)
+		return 0, false
+	}
+	return max(interval, MinRefreshInterval), true
+}
+
+// lastRefresh returns when the image was last refreshed by this instance or, for Red Hat
+// images, last checked against Pyxis, whichever is later
+func (r *PodReconciler) lastRefresh(cr *securityv1alpha1.ImageCertificationInfo) time.Time {
+	r.refreshedAtMu.Lock()
+	last := r.refreshedAt[cr.Name]
+	r.refreshedAtMu.Unlock()
+
+	if cr.Status.LastPyxisCheckAt != nil && cr.Status.LastPyxisCheckAt.After(last) {
+		last = cr.Status.LastPyxisCheckAt.Time
+	}
+	return last
+}
+
+// recordRefresh remembers when an image was refreshed
+func (r *PodReconciler) recordRefresh(name string, at time.Time) {
+	r.refreshedAtMu.Lock()
+	defer r.refreshedAtMu.Unlock()
+	if r.refreshedAt == nil {
+		r.refreshedAt = make(map[string]time.Time)
+	}
+	r.refreshedAt[name] = at
+}
+
+// pruneRefreshTimes forgets the refresh times of images that no longer exist
+func (r *PodReconciler) pruneRefreshTimes(items []securityv1alpha1.ImageCertificationInfo) {
+	names := make(map[string]bool, len(items))
+	for i := range items {
+		names[items[i].Name] = true
+	}
+
+	r.refreshedAtMu.Lock()
+	defer r.refreshedAtMu.Unlock()
+	for name := range r.refreshedAt {
+		if !names[name] {
+			delete(r.refreshedAt, name)
+		}
+	}
+}
+
 // refreshSingleImage refreshes certification data for a single ImageCertificationInfo
 func (r *PodReconciler) refreshSingleImage(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo) error {
+	ctx, span := tracer.Start(ctx, "PodReconciler.refreshImage",
+		trace.WithAttributes(attribute.String("image", cr.Spec.FullImageReference)))
+	defer span.End()
 	logger := log.FromContext(ctx).WithValues("crName", cr.Name)
 
+	// Images tracked by tag have no digest to look up
+	if cr.Spec.ImageDigest == "" {
+		return nil
+	}
+
 	// Re-fetch CR to get latest version (avoid conflicts)
 	var latestCR securityv1alpha1.ImageCertificationInfo
 	if err := r.Get(ctx, client.ObjectKey{Name: cr.Name}, &latestCR); err != nil {
@@ -572,93 +1146,165 @@ func (r *PodReconciler) refreshSingleImage(ctx context.Context, cr *securityv1al
 	// Store old values for change detection
 	oldCertStatus := latestCR.Status.CertificationStatus
 	var oldHealthIndex string
-	var oldCriticalVulns, oldImportantVulns int
+	var oldCriticalVulns int
 	if latestCR.Status.PyxisData != nil {
 		oldHealthIndex = latestCR.Status.PyxisData.HealthIndex
-		if latestCR.Status.PyxisData.Vulnerabilities != nil {
-			oldCriticalVulns = latestCR.Status.PyxisData.Vulnerabilities.Critical
-			oldImportantVulns = latestCR.Status.PyxisData.Vulnerabilities.Important
-		}
 	}
+	if vulns := vulnerabilitySummary(&latestCR); vulns != nil {
+		oldCriticalVulns = vulns.Critical
+	}
+	oldTrackedCVEs := latestCR.Status.TrackedCVEs
 
-	// Track CVEs for annotation updates (only relevant for Pyxis)
-	var cves []string
+	// External API calls share the per-image enrichment deadline; status writes use the parent context
+	callCtx, cancel := r.enrichmentContext(ctx)
+	defer cancel()
 
 	// Refresh based on registry type
-	if image.IsRedHatRegistry(cr.Spec.Registry) && r.PyxisClient != nil {
+	if r.Registries.PyxisEligible(cr.Spec.Registry) && r.PyxisClient != nil {
 		// Query Pyxis for Red Hat registry images
-		certData, err := r.PyxisClient.GetImageCertification(ctx, cr.Spec.Registry, cr.Spec.Repository, cr.Spec.ImageDigest)
+		certData, err := r.PyxisClient.GetImageCertification(callCtx, cr.Spec.Registry, cr.Spec.Repository, cr.Spec.ImageDigest)
 		if err != nil {
-			logger.Error(err, "failed to query Pyxis API during refresh")
+			if !errors.Is(err, errorbudget.ErrDisabled) {
+				logger.Error(err, "failed to query Pyxis API during refresh")
+				r.writeLookupFailure(ctx, &latestCR)
+			}
 			return err
 		}
 
-		now := metav1.Now()
-		latestCR.Status.LastPyxisCheckAt = &now
-
-		if certData == nil {
-			latestCR.Status.CertificationStatus = securityv1alpha1.CertificationStatusNotCertified
-		} else {
-			r.updateCRWithPyxisData(&latestCR, certData)
-			cves = certData.CVEs
-		}
+		recordLookupSuccess(&latestCR, metav1.Now())
+		r.recordPyxisResult(&latestCR, certData)
 	} else if cr.Spec.Registry == RegistryDockerHub && r.DockerHubClient != nil {
 		// Query Docker Hub for docker.io images
 		namespace, repo := parseDockerHubRepo(cr.Spec.Repository)
-		repoInfo, err := r.DockerHubClient.GetRepositoryInfo(ctx, namespace, repo)
+		repoInfo, err := r.DockerHubClient.GetRepositoryInfo(callCtx, namespace, repo)
 		if err != nil {
-			logger.Error(err, "failed to query Docker Hub API during refresh")
+			if !errors.Is(err, errorbudget.ErrDisabled) {
+				logger.Error(err, "failed to query Docker Hub API during refresh")
+				r.writeLookupFailure(ctx, &latestCR)
+			}
 			return err
 		}
 
+		recordLookupSuccess(&latestCR, metav1.Now())
 		if repoInfo != nil {
 			r.updateCRWithDockerHubData(&latestCR, repoInfo)
 		}
-	} else {
+	} else if cr.Spec.Registry == RegistryQuay && r.QuayClient != nil {
+		// Query Quay for the security scan of quay.io images
+		scan, err := r.QuayClient.GetSecurityScan(callCtx, cr.Spec.Repository, cr.Spec.ImageDigest)
+		if err != nil {
+			if !errors.Is(err, errorbudget.ErrDisabled) {
+				logger.Error(err, "failed to query Quay API during refresh")
+				r.writeLookupFailure(ctx, &latestCR)
+			}
+			return err
+		}
+
+		recordLookupSuccess(&latestCR, metav1.Now())
+		if scan != nil {
+			updateCRWithQuayData(&latestCR, scan, time.Now())
+		}
+	} else if !r.inspectsRegistry(cr.Spec.Registry) && !r.needsSBOMCheck(&latestCR) {
 		// No client available for this registry
 		return nil
 	}
 
+	// Private images are read with the pull secrets of the pods using them
+	needsRegistryData := r.inspectsRegistry(cr.Spec.Registry) && registryDataMissing(&latestCR)
+	if needsRegistryData || r.needsSBOMCheck(&latestCR) {
+		callCtx = r.withPullSecrets(callCtx, cr.Spec.Registry, latestCR.Status.PodReferences)
+	}
+
+	// Registry metadata is read by digest and never changes, so it is only read until it succeeds
+	if needsRegistryData {
+		metadata, err := r.RegistryClient.GetImageMetadata(callCtx, cr.Spec.Registry, cr.Spec.Repository, cr.Spec.ImageDigest)
+		if err != nil {
+			logger.V(1).Info("failed to read image metadata from registry during refresh", "error", err.Error())
+			return err
+		}
+		if metadata != nil {
+			updateCRWithRegistryData(&latestCR, metadata)
+		}
+	}
+
+	if r.needsSBOMCheck(&latestCR) {
+		sbom, err := r.SBOMClient.GetSBOM(callCtx, cr.Spec.Registry, cr.Spec.Repository, cr.Spec.ImageDigest)
+		if err != nil {
+			// The data read from the other providers is still recorded
+			logger.V(1).Info("failed to discover SBOM during refresh", "error", err.Error())
+		} else if sbom != nil {
+			updateCRWithSBOMData(&latestCR, sbom, time.Now())
+		}
+	}
+
+	// Warnings record what they reported in the status, so they are emitted before it is written
+	r.emitEOLEvent(ctx, &latestCR)
+	r.emitVulnerabilityEvent(ctx, &latestCR)
+
 	if err := r.Status().Update(ctx, &latestCR); err != nil {
 		logger.Error(err, "failed to update ImageCertificationInfo during refresh")
 		return err
 	}
 
-	// Update CVE annotations if available
-	if len(cves) > 0 {
-		if err := r.updateCVEAnnotations(ctx, latestCR.Name, cves); err != nil {
-			logger.Error(err, "failed to update CVE annotations during refresh")
-		}
+	if err := r.removeLegacyCVEAnnotation(ctx, &latestCR); err != nil {
+		logger.Error(err, "failed to remove legacy CVE annotation during refresh")
 	}
 
 	metrics.RecordImageRefreshed()
 
 	// Emit change events
 	var newHealthIndex string
-	var newCriticalVulns, newImportantVulns int
+	var newCriticalVulns int
 	if latestCR.Status.PyxisData != nil {
 		newHealthIndex = latestCR.Status.PyxisData.HealthIndex
-		if latestCR.Status.PyxisData.Vulnerabilities != nil {
-			newCriticalVulns = latestCR.Status.PyxisData.Vulnerabilities.Critical
-			newImportantVulns = latestCR.Status.PyxisData.Vulnerabilities.Important
-		}
+	}
+	if vulns := vulnerabilitySummary(&latestCR); vulns != nil {
+		newCriticalVulns = vulns.Critical
 	}
 
-	r.emitChangeEvents(&latestCR, oldCertStatus, latestCR.Status.CertificationStatus,
-		oldHealthIndex, newHealthIndex,
-		oldCriticalVulns, oldImportantVulns, newCriticalVulns, newImportantVulns)
+	r.emitChangeEvents(ctx, &latestCR, oldCertStatus, latestCR.Status.CertificationStatus, oldHealthIndex, newHealthIndex)
+	r.notifyCriticalCVEs(&latestCR, oldCriticalVulns, newCriticalVulns, oldTrackedCVEs)
 
 	return nil
 }
 
+// setCertificationStatus sets the certification status of an image together with its reason code
+func setCertificationStatus(cr *securityv1alpha1.ImageCertificationInfo, status securityv1alpha1.CertificationStatus,
+	reason securityv1alpha1.ConditionReason) {
+	cr.Status.CertificationStatus = status
+	cr.Status.ReasonCode = reason
+}
+
+// recordPyxisResult records the outcome of a successful Pyxis lookup, where a nil certData
+// means Pyxis has no data for the image
+func (r *PodReconciler) recordPyxisResult(cr *securityv1alpha1.ImageCertificationInfo, certData *pyxis.CertificationData) {
+	now := metav1.Now()
+	cr.Status.LastPyxisCheckAt = &now
+
+	if certData == nil {
+		setCertificationStatus(cr, securityv1alpha1.CertificationStatusNotCertified, securityv1alpha1.ReasonNotFoundInPyxis)
+		recordDataSource(cr, securityv1alpha1.DataSourcePyxis, r.pyxisSource(), []string{fieldCertificationStatus}, now)
+		return
+	}
+	r.updateCRWithPyxisData(cr, certData)
+}
+
 // updateCRWithPyxisData updates a CR's status with data from Pyxis
 func (r *PodReconciler) updateCRWithPyxisData(cr *securityv1alpha1.ImageCertificationInfo, certData *pyxis.CertificationData) {
-	cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusCertified
+	now := metav1.Now()
+	setCertificationStatus(cr, securityv1alpha1.CertificationStatusCertified, securityv1alpha1.ReasonCertifiedByPyxis)
+	previous := cr.Status.PyxisData
 	cr.Status.PyxisData = &securityv1alpha1.PyxisData{
 		ProjectID:   certData.ProjectID,
 		Publisher:   certData.Publisher,
 		HealthIndex: certData.HealthIndex,
 		CatalogURL:  certData.CatalogURL,
+		Source:      r.pyxisSource(),
+		SyncedAt:    &now,
+	}
+	fields := []string{fieldCertificationStatus, fieldPyxisData}
+	if !certData.VulnerabilitiesUnavailable {
+		fields = append(fields, fieldTrackedCVEs)
 	}
 
 	// Parse and set PublishedAt timestamp
@@ -703,66 +1349,244 @@ func (r *PodReconciler) updateCRWithPyxisData(cr *securityv1alpha1.ImageCertific
 	cr.Status.PyxisData.UncompressedSizeBytes = certData.UncompressedSizeBytes
 	cr.Status.PyxisData.LayerCount = certData.LayerCount
 	cr.Status.PyxisData.BuildDate = certData.BuildDate
-	cr.Status.PyxisData.AdvisoryIDs = certData.AdvisoryIDs
+	if !certData.VulnerabilitiesUnavailable {
+		cr.Status.PyxisData.AdvisoryIDs = certData.AdvisoryIDs
+		cr.Status.PyxisData.CVEs, cr.Status.PyxisData.CVEsTruncated = cveList(certData, r.MaxCVEs)
+		cr.Status.PyxisData.TotalCVEs = len(certData.CVEs)
+	} else if previous != nil {
+		// A failed vulnerabilities lookup is not an image without CVEs; keep what was known
+		cr.Status.PyxisData.AdvisoryIDs = previous.AdvisoryIDs
+		cr.Status.PyxisData.CVEs, cr.Status.PyxisData.CVEsTruncated = previous.CVEs, previous.CVEsTruncated
+		cr.Status.PyxisData.TotalCVEs = previous.TotalCVEs
+	}
+	cr.Status.PyxisData.PartnerCertification = partnerCertification(certData.PartnerCertification)
+
+	// Attribute the image from its labels
+	if ownership := imageOwnership(certData.Labels); ownership != nil {
+		cr.Status.Ownership = ownership
+		fields = append(fields, fieldOwnership)
+	}
 
 	// Compute ImageAge if PublishedAt is available
 	if cr.Status.PyxisData.PublishedAt != nil {
 		age := time.Since(cr.Status.PyxisData.PublishedAt.Time)
 		cr.Status.ImageAge = formatDuration(age)
+		fields = append(fields, fieldImageAge)
 	}
 
-	// Compute DaysUntilEOL if EOLDate is available
+	// Compute DaysUntilEOL and whether the image is past end-of-life or deprecated
+	applyLifecycle(cr, now.Time)
 	if cr.Status.PyxisData.EOLDate != nil {
-		daysUntil := int(time.Until(cr.Status.PyxisData.EOLDate.Time).Hours() / 24)
-		cr.Status.DaysUntilEOL = &daysUntil
+		fields = append(fields, fieldDaysUntilEOL)
 	}
+
+	// Track how long critical/important CVEs have been present. A failed vulnerabilities
+	// lookup would drop every CVE and restart its age, so the tracked CVEs are kept instead.
+	if !certData.VulnerabilitiesUnavailable {
+		updateTrackedCVEs(cr, certData.CVESeverities, certData.CVEFixes, now.Time)
+	}
+	recordDataSource(cr, securityv1alpha1.DataSourcePyxis, r.pyxisSource(), fields, now)
 }
 
-// updateCVEAnnotations updates the CVE annotation on a CR
-func (r *PodReconciler) updateCVEAnnotations(ctx context.Context, crName string, cves []string) error {
-	var cr securityv1alpha1.ImageCertificationInfo
-	if err := r.Get(ctx, client.ObjectKey{Name: crName}, &cr); err != nil {
-		return err
+// partnerCertification converts a partner certification project to its status form
+func partnerCertification(partner *pyxis.PartnerCertification) *securityv1alpha1.PartnerCertification {
+	if partner == nil {
+		return nil
 	}
-	if cr.Annotations == nil {
-		cr.Annotations = make(map[string]string)
+	return &securityv1alpha1.PartnerCertification{
+		ProjectID:            partner.ProjectID,
+		ProjectName:          partner.ProjectName,
+		Level:                partner.Level,
+		Status:               partner.Status,
+		SupportedOCPVersions: partner.SupportedOCPVersions,
+		Badges:               partner.Badges,
+		CNF:                  partner.CNF,
 	}
-	cr.Annotations["security.telco.openshift.io/cves"] = strings.Join(cves, ",")
-	return r.Update(ctx, &cr)
 }
 
-// emitChangeEvents emits Kubernetes events when certification status, health, or vulnerabilities change
-func (r *PodReconciler) emitChangeEvents(cr *securityv1alpha1.ImageCertificationInfo,
-	oldCertStatus, newCertStatus securityv1alpha1.CertificationStatus,
-	oldHealth, newHealth string,
-	oldCritical, oldImportant, newCritical, newImportant int) {
+// updateTrackedCVEs records first-observed timestamps and known fixes for critical and important CVEs.
+// Existing timestamps are preserved, CVEs that no longer affect the image are dropped,
+// and MaxCVEAgeDays is recomputed from the oldest remaining CVE.
+func updateTrackedCVEs(cr *securityv1alpha1.ImageCertificationInfo, severities map[string]string,
+	fixes map[string]pyxis.CVEFix, now time.Time) {
+	firstObserved := make(map[string]metav1.Time, len(cr.Status.TrackedCVEs))
+	for _, tracked := range cr.Status.TrackedCVEs {
+		firstObserved[tracked.ID] = tracked.FirstObservedAt
+	}
 
-	if r.Recorder == nil {
-		return
+	var tracked []securityv1alpha1.TrackedCVE
+	for id, severity := range severities {
+		if severity != SeverityCritical && severity != SeverityImportant {
+			continue
+		}
+		observedAt, ok := firstObserved[id]
+		if !ok {
+			observedAt = metav1.NewTime(now)
+		}
+		tracked = append(tracked, securityv1alpha1.TrackedCVE{
+			ID:              id,
+			Severity:        severity,
+			FirstObservedAt: observedAt,
+			AdvisoryID:      fixes[id].AdvisoryID,
+			FixedIn:         fixes[id].FixedIn,
+		})
+	}
+	slices.SortFunc(tracked, func(a, b securityv1alpha1.TrackedCVE) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	cr.Status.TrackedCVEs = tracked
+	cr.Status.MaxCVEAgeDays = nil
+	for _, cve := range tracked {
+		ageDays := int(now.Sub(cve.FirstObservedAt.Time).Hours() / 24)
+		if cr.Status.MaxCVEAgeDays == nil || ageDays > *cr.Status.MaxCVEAgeDays {
+			cr.Status.MaxCVEAgeDays = &ageDays
+		}
 	}
+}
+
+// cveAgesBySeverity collects the current age in days of every tracked CVE across running images, grouped by
+// severity. Orphaned images awaiting deletion and exempt images, whose risk has been accepted, are left out.
+func cveAgesBySeverity(items []securityv1alpha1.ImageCertificationInfo, now time.Time) map[string][]float64 {
+	ages := map[string][]float64{
+		SeverityCritical:  nil,
+		SeverityImportant: nil,
+	}
+	for i := range items {
+		running := len(items[i].Status.PodReferences) > 0 || len(items[i].Status.NodeReferences) > 0
+		if !running || isExempt(&items[i]) {
+			continue
+		}
+		for _, cve := range items[i].Status.TrackedCVEs {
+			ages[cve.Severity] = append(ages[cve.Severity], now.Sub(cve.FirstObservedAt.Time).Hours()/24)
+		}
+	}
+	return ages
+}
+
+// cveList returns the CVEs of an image, most severe first, keeping at most max of them
+// (0 keeps all), and whether any were left out
+func cveList(certData *pyxis.CertificationData, max int) ([]securityv1alpha1.CVE, bool) {
+	cves := make([]securityv1alpha1.CVE, 0, len(certData.CVEs))
+	seen := make(map[string]bool, len(certData.CVEs))
+	for _, id := range certData.CVEs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		cves = append(cves, securityv1alpha1.CVE{
+			ID:         id,
+			Severity:   certData.CVESeverities[id],
+			AdvisoryID: certData.CVEAdvisories[id],
+		})
+	}
+	slices.SortFunc(cves, func(a, b securityv1alpha1.CVE) int {
+		if c := cmp.Compare(cveSeverityRank(a.Severity), cveSeverityRank(b.Severity)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if len(cves) == 0 {
+		return nil, false
+	}
+	if max > 0 && len(cves) > max {
+		return cves[:max], true
+	}
+	return cves, false
+}
+
+// cveSeverityRank orders a CVE severity, with unknown severities last
+func cveSeverityRank(severity string) int {
+	if rank, ok := severityRank[severity]; ok {
+		return rank
+	}
+	return len(severityRank)
+}
+
+// removeLegacyCVEAnnotation drops the CVE annotation written by earlier versions from a CR
+func (r *PodReconciler) removeLegacyCVEAnnotation(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo) error {
+	if _, ok := cr.Annotations[annotationLegacyCVEs]; !ok {
+		return nil
+	}
+	patch := client.MergeFrom(cr.DeepCopy())
+	delete(cr.Annotations, annotationLegacyCVEs)
+	return r.Patch(ctx, cr, patch)
+}
+
+// emitChangeEvents emits Kubernetes events when certification status or health change
+func (r *PodReconciler) emitChangeEvents(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo,
+	oldCertStatus, newCertStatus securityv1alpha1.CertificationStatus,
+	oldHealth, newHealth string) {
 
 	// Certification status changed
 	if oldCertStatus != newCertStatus && oldCertStatus != "" {
 		msg := fmt.Sprintf("Certification status changed from %s to %s", oldCertStatus, newCertStatus)
-		r.Recorder.Event(cr, corev1.EventTypeWarning, EventReasonCertificationChanged, msg)
-		metrics.RecordEvent(corev1.EventTypeWarning, EventReasonCertificationChanged)
+		r.warn(ctx, cr, EventReasonCertificationChanged, msg)
 		metrics.RecordCertificationStatusChange(string(oldCertStatus), string(newCertStatus))
+		// The first result for an image, or recovery from a lookup failure, is not news
+		if oldCertStatus != securityv1alpha1.CertificationStatusUnknown &&
+			oldCertStatus != securityv1alpha1.CertificationStatusError {
+			r.notify(cr, notify.TypeCertificationChanged, msg)
+		}
 	}
 
 	// Health grade degraded
 	if oldHealth != "" && newHealth != "" && isHealthDegraded(oldHealth, newHealth) {
 		msg := fmt.Sprintf("Health grade degraded from %s to %s", oldHealth, newHealth)
-		r.Recorder.Event(cr, corev1.EventTypeWarning, EventReasonHealthDegraded, msg)
-		metrics.RecordEvent(corev1.EventTypeWarning, EventReasonHealthDegraded)
+		r.warn(ctx, cr, EventReasonHealthDegraded, msg)
+		r.notify(cr, notify.TypeHealthDegraded, msg)
 	}
+}
 
-	// New critical/important vulnerabilities
-	if newCritical > oldCritical || newImportant > oldImportant {
-		msg := fmt.Sprintf("Vulnerabilities increased: critical %d→%d, important %d→%d",
-			oldCritical, newCritical, oldImportant, newImportant)
-		r.Recorder.Event(cr, corev1.EventTypeWarning, EventReasonVulnerabilitiesFound, msg)
-		metrics.RecordEvent(corev1.EventTypeWarning, EventReasonVulnerabilitiesFound)
+// emitVulnerabilityEvent warns about the critical and important vulnerabilities of an image
+// when they first appear or increase since it was last warned, and records the warned counts
+// in its status so that repeated checks do not warn again. It must be called before the
+// status is written.
+func (r *PodReconciler) emitVulnerabilityEvent(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo) {
+	if isExempt(cr) {
+		// Leave the warned counts alone, so that the image is warned about once its exemption expires
+		return
+	}
+	var critical, important int
+	if vulns := vulnerabilitySummary(cr); vulns != nil {
+		critical, important = vulns.Critical, vulns.Important
+	}
+	warned := cr.Status.WarnedVulnerabilities
+	if critical == 0 && important == 0 {
+		// A later reappearance warns again
+		cr.Status.WarnedVulnerabilities = nil
+		return
+	}
+	cr.Status.WarnedVulnerabilities = &securityv1alpha1.VulnerabilitySummary{Critical: critical, Important: important}
+
+	switch {
+	case warned == nil:
+		r.warn(ctx, cr, EventReasonVulnerabilitiesFound,
+			fmt.Sprintf("Found %d critical, %d important vulnerabilities", critical, important))
+	case critical > warned.Critical || important > warned.Important:
+		r.warn(ctx, cr, EventReasonVulnerabilitiesFound,
+			fmt.Sprintf("Vulnerabilities increased: critical %d→%d, important %d→%d",
+				warned.Critical, critical, warned.Important, important))
+	}
+}
+
+// warn records a warning event on the image, if an event recorder is configured and no
+// ImageCertExemption applies to it. During a summarized refresh cycle the warning is counted
+// in the cycle summary instead.
+func (r *PodReconciler) warn(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo, reason, msg string) {
+	if isExempt(cr) {
+		return
+	}
+	if cycle := refreshCycleFrom(ctx); cycle != nil {
+		cycle.add(cr, reason)
+		return
+	}
+	if r.Recorder == nil {
+		return
 	}
+	r.Recorder.Event(cr, corev1.EventTypeWarning, reason, msg)
+	metrics.RecordEvent(corev1.EventTypeWarning, reason)
 }
 
 // isHealthDegraded compares health grades and returns true if the new grade is worse
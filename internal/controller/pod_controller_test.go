@@ -18,19 +18,30 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/sharding"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/dockerhub"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
 	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
 )
 
@@ -240,8 +251,22 @@ func TestPodReconciler_Reconcile_DeletedPod(t *testing.T) {
 	ctx := context.Background()
 	scheme := newTestScheme()
 
+	// An image referenced by the deleted pod and by another pod
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: testNamespace, Name: "deleted-pod", Container: "app"},
+				{Namespace: testNamespace, Name: "deleted-pod", Container: "sidecar"},
+				{Namespace: testNamespace, Name: "live-pod", Container: "app"},
+			},
+		},
+	}
 	fakeClient := fake.NewClientBuilder().
 		WithScheme(scheme).
+		WithObjects(cr).
+		WithStatusSubresource(cr).
+		WithIndex(&securityv1alpha1.ImageCertificationInfo{}, IndexPodReference, podReferenceKeys).
 		Build()
 
 	reconciler := &PodReconciler{
@@ -264,6 +289,18 @@ func TestPodReconciler_Reconcile_DeletedPod(t *testing.T) {
 	if result.RequeueAfter != 0 {
 		t.Error("Reconcile() returned RequeueAfter != 0, want 0")
 	}
+
+	// The deleted pod's references are removed without waiting for the cleanup loop
+	var updated securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: testCRName}, &updated); err != nil {
+		t.Fatalf("failed to get ImageCertificationInfo: %v", err)
+	}
+	if refs := updated.Status.PodReferences; len(refs) != 1 || refs[0].Name != "live-pod" {
+		t.Errorf("pod references = %+v, want only live-pod", refs)
+	}
+	if updated.Status.OrphanedAt != nil {
+		t.Error("an image still used by a pod should not be orphaned")
+	}
 }
 
 func TestPodReconciler_Reconcile_PodNotRunning(t *testing.T) {
@@ -331,12 +368,209 @@ func (m *MockPyxisClient) IsHealthy(ctx context.Context) bool {
 	return m.Healthy
 }
 
+func TestPodReconciler_Reconcile_MirroredImage(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	// The runtime pulled the image from a mirror, so the imageID names the mirror
+	// while the container status image keeps the reference from the pod spec
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: testContainer, Image: "registry.redhat.io/ubi8/ubi:latest"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				Image:   "registry.redhat.io/ubi8/ubi:latest",
+				ImageID: "mirror.example.com:5000/rh/ubi8/ubi@" + testDigest,
+			}},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(testPod).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+		Build()
+
+	reconciler := &PodReconciler{Client: fakeClient, Scheme: scheme}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testPodName, Namespace: testNamespace}}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// The image is tracked under its canonical source
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	if cr.Spec.Registry != "registry.redhat.io" || cr.Spec.Repository != "ubi8/ubi" {
+		t.Errorf("source = %s/%s, want registry.redhat.io/ubi8/ubi", cr.Spec.Registry, cr.Spec.Repository)
+	}
+	if cr.Spec.FullImageReference != "registry.redhat.io/ubi8/ubi@"+testDigest {
+		t.Errorf("FullImageReference = %s", cr.Spec.FullImageReference)
+	}
+	if cr.Spec.ObservedRegistry != "mirror.example.com:5000" || cr.Spec.ObservedRepository != "rh/ubi8/ubi" {
+		t.Errorf("observed = %s/%s, want mirror.example.com:5000/rh/ubi8/ubi",
+			cr.Spec.ObservedRegistry, cr.Spec.ObservedRepository)
+	}
+	if cr.Status.RegistryType != securityv1alpha1.RegistryTypeRedHat {
+		t.Errorf("RegistryType = %v, want %v", cr.Status.RegistryType, securityv1alpha1.RegistryTypeRedHat)
+	}
+}
+
+func TestPodReconciler_Reconcile_DigestOnlyImageID(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	// containerd reports a bare digest as the imageID, and here also as the status image,
+	// so only the pod spec names the repository
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: testContainer, Image: "registry.redhat.io/ubi8/ubi:8.9"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				Image:   testDigest,
+				ImageID: testDigest,
+			}},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(testPod).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+		Build()
+
+	reconciler := &PodReconciler{Client: fakeClient, Scheme: scheme}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testPodName, Namespace: testNamespace}}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	if cr.Spec.ImageDigest != testDigest || cr.Spec.Tag != "8.9" {
+		t.Errorf("spec = %+v, want the digest from the imageID and the tag from the pod spec", cr.Spec)
+	}
+}
+
+func TestPodReconciler_Reconcile_ConcurrentCreate(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: testContainer, Image: "registry.redhat.io/ubi8/ubi:latest"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				ImageID: "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest,
+			}},
+		},
+	}
+
+	// Another worker creates the image between this reconcile's Get and Create
+	raced := false
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(testPod).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if _, ok := obj.(*securityv1alpha1.ImageCertificationInfo); ok && !raced {
+					raced = true
+					other := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: obj.GetName()}}
+					if err := c.Create(ctx, other); err != nil {
+						return err
+					}
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	reconciler := &PodReconciler{Client: fakeClient, Scheme: scheme}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testPodName, Namespace: testNamespace}}
+
+	if _, err := reconciler.Reconcile(ctx, req); !apierrors.IsAlreadyExists(err) {
+		t.Fatalf("Reconcile() error = %v, want AlreadyExists so the pod is requeued", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("requeued Reconcile() error = %v", err)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	if len(cr.Status.PodReferences) != 1 || cr.Status.PodReferences[0].Name != testPodName {
+		t.Errorf("PodReferences = %+v, want the requeued pod", cr.Status.PodReferences)
+	}
+}
+
 func TestPodReconciler_SetupWithManager(t *testing.T) {
 	// This test requires a real cluster config, so we skip it in unit tests.
 	// Integration tests using envtest will cover this functionality.
 	t.Skip("Skipping test - requires kubeconfig or envtest setup")
 }
 
+func TestPodImagesChanged(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace, ResourceVersion: "1"},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: testContainer}},
+		},
+	}
+	bump := func(mutate func(*corev1.Pod)) *corev1.Pod {
+		updated := pod.DeepCopy()
+		updated.ResourceVersion = "2"
+		mutate(updated)
+		return updated
+	}
+
+	tests := []struct {
+		name string
+		new  *corev1.Pod
+		want bool
+	}{
+		{name: "resync", new: pod.DeepCopy(), want: true},
+		{name: "condition churn", new: bump(func(p *corev1.Pod) {
+			p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}), want: false},
+		{name: "container started", new: bump(func(p *corev1.Pod) {
+			p.Status.ContainerStatuses[0].ImageID = "registry.redhat.io/ubi8/ubi@" + testDigest
+		}), want: true},
+		{name: "init container started", new: bump(func(p *corev1.Pod) {
+			p.Status.InitContainerStatuses = []corev1.ContainerStatus{{Name: "init", ImageID: "docker.io/busybox@" + testDigest}}
+		}), want: true},
+		{name: "ephemeral container pulling", new: bump(func(p *corev1.Pod) {
+			p.Status.EphemeralContainerStatuses = []corev1.ContainerStatus{{Name: "debug"}}
+		}), want: false},
+		{name: "image pull failed", new: bump(func(p *corev1.Pod) {
+			p.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}
+		}), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podImagesChanged(event.UpdateEvent{ObjectOld: pod, ObjectNew: tt.new}); got != tt.want {
+				t.Errorf("podImagesChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestPodReconciler_CleanupStaleReferences(t *testing.T) {
 	ctx := context.Background()
 	scheme := newTestScheme()
@@ -384,10 +618,26 @@ func TestPodReconciler_CleanupStaleReferences(t *testing.T) {
 		},
 	}
 
+	// Pods are checked against a single listing rather than read one by one
+	var podGets, podLists int
 	fakeClient := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(existingCR, existingPod).
 		WithStatusSubresource(existingCR).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*corev1.Pod); ok {
+					podGets++
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*corev1.PodList); ok {
+					podLists++
+				}
+				return c.List(ctx, list, opts...)
+			},
+		}).
 		Build()
 
 	reconciler := &PodReconciler{
@@ -399,6 +649,9 @@ func TestPodReconciler_CleanupStaleReferences(t *testing.T) {
 	if err := reconciler.CleanupStaleReferences(ctx); err != nil {
 		t.Fatalf("CleanupStaleReferences() error = %v", err)
 	}
+	if podGets != 0 || podLists != 1 {
+		t.Errorf("pod reads = %d gets and %d lists, want a single list", podGets, podLists)
+	}
 
 	// Verify stale reference was removed
 	var cr securityv1alpha1.ImageCertificationInfo
@@ -414,31 +667,84 @@ func TestPodReconciler_CleanupStaleReferences(t *testing.T) {
 	}
 }
 
-func TestPodReconciler_StartCleanupLoop(t *testing.T) {
+func TestPodReconciler_CleanupStaleReferences_OrphanTTL(t *testing.T) {
+	ctx := context.Background()
 	scheme := newTestScheme()
 
+	spec := securityv1alpha1.ImageCertificationInfoSpec{
+		ImageDigest:        testDigest,
+		FullImageReference: "registry.redhat.io/ubi8/ubi@" + testDigest,
+		Registry:           "registry.redhat.io",
+		Repository:         "ubi8/ubi",
+	}
+	expired := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	orphanedCR := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphaned"},
+		Spec:       spec,
+		Status:     securityv1alpha1.ImageCertificationInfoStatus{OrphanedAt: &expired},
+	}
+	unmarkedCR := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "unmarked"},
+		Spec:       spec,
+	}
+	stalePodCR := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "stale-pod"},
+		Spec:       spec,
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: testNamespace, Name: "deleted-pod", Container: testContainer},
+			},
+		},
+	}
+
 	fakeClient := fake.NewClientBuilder().
 		WithScheme(scheme).
+		WithObjects(orphanedCR, unmarkedCR, stalePodCR).
+		WithStatusSubresource(orphanedCR, unmarkedCR, stalePodCR).
 		Build()
-
+	recorder := record.NewFakeRecorder(10)
 	reconciler := &PodReconciler{
-		Client: fakeClient,
-		Scheme: scheme,
+		Client:    fakeClient,
+		Scheme:    scheme,
+		Recorder:  recorder,
+		OrphanTTL: time.Hour,
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-
-	// Start cleanup loop with short interval
-	reconciler.StartCleanupLoop(ctx, 100*time.Millisecond)
+	if err := reconciler.CleanupStaleReferences(ctx); err != nil {
+		t.Fatalf("CleanupStaleReferences() error = %v", err)
+	}
 
-	// Let it run briefly
-	time.Sleep(150 * time.Millisecond)
+	// Orphaned for longer than the TTL: deleted with an event
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "orphaned"}, &cr); !apierrors.IsNotFound(err) {
+		t.Errorf("orphaned CR should be deleted, got err = %v", err)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("expected 1 event, got %d", len(recorder.Events))
+	}
 
-	// Cancel context to stop the loop
-	cancel()
+	// Orphaned now: marked and kept until the TTL expires
+	for _, name := range []string{"unmarked", "stale-pod"} {
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, &cr); err != nil {
+			t.Fatalf("Failed to get %s: %v", name, err)
+		}
+		if cr.Status.OrphanedAt == nil {
+			t.Errorf("%s: OrphanedAt should be set", name)
+		}
+	}
 
-	// Give time for goroutine to exit
-	time.Sleep(50 * time.Millisecond)
+	// A pod using the image again clears the mark
+	if err := reconciler.updatePodReferences(ctx, &cr, securityv1alpha1.PodReference{
+		Namespace: testNamespace, Name: testPodName, Container: testContainer,
+	}); err != nil {
+		t.Fatalf("updatePodReferences() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "stale-pod"}, &cr); err != nil {
+		t.Fatalf("Failed to get stale-pod: %v", err)
+	}
+	if cr.Status.OrphanedAt != nil {
+		t.Errorf("OrphanedAt = %v, want nil once a pod uses the image", cr.Status.OrphanedAt)
+	}
 }
 
 func TestPodReconciler_RefreshAllImages(t *testing.T) {
@@ -572,6 +878,104 @@ func TestPodReconciler_RefreshAllImages(t *testing.T) {
 	}
 }
 
+func TestRefreshIntervalOverride(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		want       time.Duration
+		wantExists bool
+	}{
+		{name: "no annotation"},
+		{name: "valid interval", value: "6h", want: 6 * time.Hour, wantExists: true},
+		{name: "raised to minimum", value: "1m", want: MinRefreshInterval, wantExists: true},
+		{name: "unparseable", value: "daily"},
+		{name: "negative", value: "-1h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: testCRName}}
+			if tt.value != "" {
+				cr.Annotations = map[string]string{AnnotationRefreshInterval: tt.value}
+			}
+			got, ok := refreshIntervalOverride(context.Background(), cr)
+			if got != tt.want || ok != tt.wantExists {
+				t.Errorf("refreshIntervalOverride() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestPodReconciler_RefreshOverriddenImages(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	newCR := func(name, interval string, checkedAgo time.Duration) *securityv1alpha1.ImageCertificationInfo {
+		checkedAt := metav1.NewTime(time.Now().Add(-checkedAgo))
+		cr := &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: securityv1alpha1.ImageCertificationInfoSpec{
+				ImageDigest:        testDigest,
+				FullImageReference: "registry.redhat.io/ubi9/ubi@" + testDigest,
+				Registry:           "registry.redhat.io",
+				Repository:         "ubi9/ubi",
+			},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{
+				RegistryType:        securityv1alpha1.RegistryTypeRedHat,
+				CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+				LastPyxisCheckAt:    &checkedAt,
+			},
+		}
+		if interval != "" {
+			cr.Annotations = map[string]string{AnnotationRefreshInterval: interval}
+		}
+		return cr
+	}
+	// Due under its own 15m interval, although the default would skip it for an hour
+	due := newCR("due", "15m", 20*time.Minute)
+	// Not yet due under its own 6h interval, although the default would refresh it
+	notDue := newCR("not-due", "6h", 2*time.Hour)
+	// No override: left to the full refresh cycle
+	plain := newCR("plain", "", 2*time.Hour)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(due, notDue, plain).
+		WithStatusSubresource(due, notDue, plain).
+		Build()
+
+	reconciler := &PodReconciler{
+		Client:      fakeClient,
+		Scheme:      scheme,
+		PyxisClient: &MockPyxisClient{CertData: &pyxis.CertificationData{ProjectID: "ubi9-ubi"}, Healthy: true},
+	}
+
+	if err := reconciler.RefreshOverriddenImages(ctx); err != nil {
+		t.Fatalf("RefreshOverriddenImages() error = %v", err)
+	}
+	assertRefreshed := func(name string, want bool) {
+		t.Helper()
+		var cr securityv1alpha1.ImageCertificationInfo
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, &cr); err != nil {
+			t.Fatalf("Failed to get %s: %v", name, err)
+		}
+		got := cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusCertified
+		if got != want {
+			t.Errorf("%s refreshed = %v, want %v", name, got, want)
+		}
+	}
+	assertRefreshed("due", true)
+	assertRefreshed("not-due", false)
+	assertRefreshed("plain", false)
+
+	// The full cycle honors the override too and refreshes the image without one
+	if err := reconciler.RefreshAllImages(ctx); err != nil {
+		t.Fatalf("RefreshAllImages() error = %v", err)
+	}
+	assertRefreshed("not-due", false)
+	assertRefreshed("plain", true)
+}
+
 func TestPodReconciler_RefreshSingleImage(t *testing.T) {
 	ctx := context.Background()
 	scheme := newTestScheme()
@@ -579,7 +983,8 @@ func TestPodReconciler_RefreshSingleImage(t *testing.T) {
 	now := metav1.Now()
 	cr := &securityv1alpha1.ImageCertificationInfo{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: testCRName,
+			Name:        testCRName,
+			Annotations: map[string]string{annotationLegacyCVEs: "CVE-2024-0001"},
 		},
 		Spec: securityv1alpha1.ImageCertificationInfoSpec{
 			ImageDigest:        testDigest,
@@ -612,6 +1017,12 @@ func TestPodReconciler_RefreshSingleImage(t *testing.T) {
 				Moderate:  5,
 				Low:       10,
 			},
+			CVEs:          []string{"CVE-2024-0002", "CVE-2024-0001"},
+			CVESeverities: map[string]string{"CVE-2024-0001": "low", "CVE-2024-0002": SeverityCritical},
+			CVEAdvisories: map[string]string{"CVE-2024-0002": "RHSA-2024:0002"},
+			PartnerCertification: &pyxis.PartnerCertification{
+				ProjectID: "5f0c1a2b", Level: "Vendor Validated", SupportedOCPVersions: []string{"4.15"}, CNF: true,
+			},
 		},
 		Healthy: true,
 	}
@@ -637,6 +1048,9 @@ func TestPodReconciler_RefreshSingleImage(t *testing.T) {
 	if updatedCR.Status.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
 		t.Errorf("CertificationStatus = %v, want Certified", updatedCR.Status.CertificationStatus)
 	}
+	if updatedCR.Status.ReasonCode != securityv1alpha1.ReasonCertifiedByPyxis {
+		t.Errorf("ReasonCode = %v, want %v", updatedCR.Status.ReasonCode, securityv1alpha1.ReasonCertifiedByPyxis)
+	}
 
 	if updatedCR.Status.PyxisData == nil {
 		t.Fatal("PyxisData should not be nil")
@@ -657,6 +1071,22 @@ func TestPodReconciler_RefreshSingleImage(t *testing.T) {
 	if updatedCR.Status.PyxisData.Vulnerabilities.Critical != 1 {
 		t.Errorf("Critical vulnerabilities = %v, want 1", updatedCR.Status.PyxisData.Vulnerabilities.Critical)
 	}
+
+	wantCVEs := []securityv1alpha1.CVE{
+		{ID: "CVE-2024-0002", Severity: SeverityCritical, AdvisoryID: "RHSA-2024:0002"},
+		{ID: "CVE-2024-0001", Severity: "low"},
+	}
+	if !slices.Equal(updatedCR.Status.PyxisData.CVEs, wantCVEs) || updatedCR.Status.PyxisData.TotalCVEs != 2 {
+		t.Errorf("CVEs = %+v (total %d), want %+v", updatedCR.Status.PyxisData.CVEs,
+			updatedCR.Status.PyxisData.TotalCVEs, wantCVEs)
+	}
+	if _, ok := updatedCR.Annotations[annotationLegacyCVEs]; ok {
+		t.Error("legacy CVE annotation was not removed")
+	}
+	if partner := updatedCR.Status.PyxisData.PartnerCertification; partner == nil || partner.Level != "Vendor Validated" ||
+		!partner.CNF || !slices.Equal(partner.SupportedOCPVersions, []string{"4.15"}) {
+		t.Errorf("PartnerCertification = %+v, want the partner certification project", partner)
+	}
 }
 
 func TestPodReconciler_RefreshSingleImage_NotCertified(t *testing.T) {
@@ -715,6 +1145,58 @@ func TestPodReconciler_RefreshSingleImage_NotCertified(t *testing.T) {
 	if updatedCR.Status.CertificationStatus != securityv1alpha1.CertificationStatusNotCertified {
 		t.Errorf("CertificationStatus = %v, want NotCertified", updatedCR.Status.CertificationStatus)
 	}
+	if updatedCR.Status.ReasonCode != securityv1alpha1.ReasonNotFoundInPyxis {
+		t.Errorf("ReasonCode = %v, want %v", updatedCR.Status.ReasonCode, securityv1alpha1.ReasonNotFoundInPyxis)
+	}
+}
+
+func TestPodReconciler_RefreshSingleImage_ClassifiedRegistry(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	// A corporate mirror of registry.redhat.io is only looked up in Pyxis once classified
+	now := metav1.Now()
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        testDigest,
+			FullImageReference: "rh.mirror.corp.example.com/ubi8/ubi@" + testDigest,
+			Registry:           "rh.mirror.corp.example.com",
+			Repository:         "ubi8/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+			FirstSeenAt:         &now,
+			LastSeenAt:          &now,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cr).
+		WithStatusSubresource(cr).
+		Build()
+
+	reconciler := &PodReconciler{
+		Client:      fakeClient,
+		Scheme:      scheme,
+		PyxisClient: &MockPyxisClient{CertData: &pyxis.CertificationData{ProjectID: "ubi8-container"}, Healthy: true},
+		Registries: image.NewRegistryClassifier([]image.RegistryRule{
+			{Pattern: "*.mirror.corp.example.com", Type: securityv1alpha1.RegistryTypeRedHat, PyxisEligible: true},
+		}),
+	}
+
+	if err := reconciler.refreshSingleImage(ctx, cr); err != nil {
+		t.Fatalf("refreshSingleImage() error = %v", err)
+	}
+
+	var updatedCR securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &updatedCR); err != nil {
+		t.Fatalf("Failed to get refreshed ImageCertificationInfo: %v", err)
+	}
+	if updatedCR.Status.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
+		t.Errorf("CertificationStatus = %v, want Certified", updatedCR.Status.CertificationStatus)
+	}
 }
 
 func TestIsHealthDegraded(t *testing.T) {
@@ -746,30 +1228,486 @@ func TestIsHealthDegraded(t *testing.T) {
 	}
 }
 
-func TestPodReconciler_StartRefreshLoop(t *testing.T) {
+func TestUpdateTrackedCVEs(t *testing.T) {
+	now := time.Now()
+	tenDaysAgo := metav1.NewTime(now.Add(-10 * 24 * time.Hour))
+
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			TrackedCVEs: []securityv1alpha1.TrackedCVE{
+				{ID: "CVE-2024-0001", Severity: SeverityCritical, FirstObservedAt: tenDaysAgo},
+				{ID: "CVE-2024-0002", Severity: SeverityImportant, FirstObservedAt: tenDaysAgo},
+			},
+		},
+	}
+
+	// CVE-2024-0001 is still present, CVE-2024-0002 was fixed, CVE-2024-0003 is new,
+	// and CVE-2024-0004 is moderate so it is not tracked
+	updateTrackedCVEs(cr, map[string]string{
+		"CVE-2024-0001": SeverityCritical,
+		"CVE-2024-0003": SeverityImportant,
+		"CVE-2024-0004": "moderate",
+	}, map[string]pyxis.CVEFix{
+		"CVE-2024-0001": {AdvisoryID: "RHSA-2024:0001", FixedIn: "registry.redhat.io/ubi9/ubi:9.4-1214"},
+	}, now)
+
+	if len(cr.Status.TrackedCVEs) != 2 {
+		t.Fatalf("TrackedCVEs count = %v, want 2", len(cr.Status.TrackedCVEs))
+	}
+	if cr.Status.TrackedCVEs[0].ID != "CVE-2024-0001" {
+		t.Errorf("TrackedCVEs[0].ID = %v, want CVE-2024-0001", cr.Status.TrackedCVEs[0].ID)
+	}
+	if !cr.Status.TrackedCVEs[0].FirstObservedAt.Equal(&tenDaysAgo) {
+		t.Errorf("FirstObservedAt should be preserved for existing CVE, got %v", cr.Status.TrackedCVEs[0].FirstObservedAt)
+	}
+	if cr.Status.TrackedCVEs[0].FixedIn != "registry.redhat.io/ubi9/ubi:9.4-1214" ||
+		cr.Status.TrackedCVEs[0].AdvisoryID != "RHSA-2024:0001" {
+		t.Errorf("TrackedCVEs[0] fix = %v %v, want RHSA-2024:0001 registry.redhat.io/ubi9/ubi:9.4-1214",
+			cr.Status.TrackedCVEs[0].AdvisoryID, cr.Status.TrackedCVEs[0].FixedIn)
+	}
+	if cr.Status.TrackedCVEs[1].ID != "CVE-2024-0003" {
+		t.Errorf("TrackedCVEs[1].ID = %v, want CVE-2024-0003", cr.Status.TrackedCVEs[1].ID)
+	}
+	if cr.Status.TrackedCVEs[1].FixedIn != "" {
+		t.Errorf("TrackedCVEs[1].FixedIn = %v, want empty for a CVE without a fix", cr.Status.TrackedCVEs[1].FixedIn)
+	}
+	if cr.Status.MaxCVEAgeDays == nil || *cr.Status.MaxCVEAgeDays != 10 {
+		t.Errorf("MaxCVEAgeDays = %v, want 10", cr.Status.MaxCVEAgeDays)
+	}
+
+	// All CVEs fixed clears the aging fields
+	updateTrackedCVEs(cr, nil, nil, now)
+	if len(cr.Status.TrackedCVEs) != 0 {
+		t.Errorf("TrackedCVEs count = %v, want 0", len(cr.Status.TrackedCVEs))
+	}
+	if cr.Status.MaxCVEAgeDays != nil {
+		t.Errorf("MaxCVEAgeDays = %v, want nil", *cr.Status.MaxCVEAgeDays)
+	}
+}
+
+func TestUpdateCRWithPyxisData_VulnerabilitiesUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/images":
+			_ = json.NewEncoder(w).Encode(pyxis.PyxisPagedResponse{Data: []pyxis.PyxisImageResponse{{
+				ID:           "test-id",
+				Repositories: []pyxis.PyxisImageRepository{{Registry: "registry.redhat.io", Repository: "ubi8/ubi"}},
+			}}})
+		case "/images/id/test-id/vulnerabilities":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	certData, err := pyxis.NewHTTPClient(pyxis.WithBaseURL(server.URL)).
+		GetImageCertification(context.Background(), "registry.redhat.io", "ubi8/ubi", testDigest)
+	if err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	if !certData.VulnerabilitiesUnavailable {
+		t.Fatal("VulnerabilitiesUnavailable = false, want true after a failed vulnerabilities request")
+	}
+
+	tenDaysAgo := metav1.NewTime(time.Now().Add(-10 * 24 * time.Hour))
+	maxAge := 10
+	tracked := []securityv1alpha1.TrackedCVE{
+		{ID: "CVE-2024-0001", Severity: SeverityCritical, FirstObservedAt: tenDaysAgo, AdvisoryID: "RHSA-2024:0001"},
+		{ID: "CVE-2024-0002", Severity: SeverityImportant, FirstObservedAt: tenDaysAgo},
+	}
+	cves := []securityv1alpha1.CVE{
+		{ID: "CVE-2024-0001", Severity: SeverityCritical, AdvisoryID: "RHSA-2024:0001"},
+		{ID: "CVE-2024-0002", Severity: SeverityImportant},
+	}
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			PyxisData: &securityv1alpha1.PyxisData{
+				CVEs:        slices.Clone(cves),
+				TotalCVEs:   2,
+				AdvisoryIDs: []string{"RHSA-2024:0001"},
+			},
+			TrackedCVEs:   slices.Clone(tracked),
+			MaxCVEAgeDays: &maxAge,
+		},
+	}
+
+	(&PodReconciler{}).updateCRWithPyxisData(cr, certData)
+
+	if !slices.EqualFunc(cr.Status.TrackedCVEs, tracked, func(a, b securityv1alpha1.TrackedCVE) bool {
+		return a.ID == b.ID && a.AdvisoryID == b.AdvisoryID && a.FirstObservedAt.Equal(&b.FirstObservedAt)
+	}) {
+		t.Errorf("TrackedCVEs = %+v, want unchanged %+v", cr.Status.TrackedCVEs, tracked)
+	}
+	if cr.Status.MaxCVEAgeDays == nil || *cr.Status.MaxCVEAgeDays != 10 {
+		t.Errorf("MaxCVEAgeDays = %v, want 10", cr.Status.MaxCVEAgeDays)
+	}
+	if pyxisData := cr.Status.PyxisData; !slices.Equal(pyxisData.CVEs, cves) || pyxisData.TotalCVEs != 2 ||
+		!slices.Equal(pyxisData.AdvisoryIDs, []string{"RHSA-2024:0001"}) {
+		t.Errorf("PyxisData CVEs = %+v, TotalCVEs = %d, AdvisoryIDs = %v, want the previous values",
+			pyxisData.CVEs, pyxisData.TotalCVEs, pyxisData.AdvisoryIDs)
+	}
+}
+
+func TestCVEAgesBySeverity(t *testing.T) {
+	now := time.Now()
+	tenDaysAgo := metav1.NewTime(now.Add(-10 * 24 * time.Hour))
+	image := func(name string, labels map[string]string, podRefs ...securityv1alpha1.PodReference,
+	) securityv1alpha1.ImageCertificationInfo {
+		return securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{
+				PodReferences: podRefs,
+				TrackedCVEs: []securityv1alpha1.TrackedCVE{
+					{ID: "CVE-2024-0001", Severity: SeverityCritical, FirstObservedAt: tenDaysAgo},
+				},
+			},
+		}
+	}
+	podRef := securityv1alpha1.PodReference{Namespace: testNamespace, Name: testPodName, Container: testContainer}
+
+	ages := cveAgesBySeverity([]securityv1alpha1.ImageCertificationInfo{
+		image("running", nil, podRef),
+		image("exempt", map[string]string{LabelExemption: "accepted-risk"}, podRef),
+		image("orphaned", nil),
+	}, now)
+
+	if len(ages[SeverityCritical]) != 1 || ages[SeverityCritical][0] != 10 {
+		t.Errorf("critical ages = %v, want [10] for the running image only", ages[SeverityCritical])
+	}
+	if len(ages[SeverityImportant]) != 0 {
+		t.Errorf("important ages = %v, want none", ages[SeverityImportant])
+	}
+}
+
+func TestCVEList(t *testing.T) {
+	certData := &pyxis.CertificationData{
+		CVEs: []string{"CVE-2024-0005", "CVE-2024-0004", "CVE-2024-0003", "CVE-2024-0002", "CVE-2024-0001",
+			"CVE-2024-0002"},
+		CVESeverities: map[string]string{
+			"CVE-2024-0001": "low",
+			"CVE-2024-0002": "moderate",
+			"CVE-2024-0003": SeverityImportant,
+			"CVE-2024-0004": SeverityCritical,
+		},
+	}
+
+	cves, truncated := cveList(certData, 0)
+	var ids []string
+	for _, cve := range cves {
+		ids = append(ids, cve.ID)
+	}
+	// Most severe first, unknown severity last, duplicates dropped
+	want := []string{"CVE-2024-0004", "CVE-2024-0003", "CVE-2024-0002", "CVE-2024-0001", "CVE-2024-0005"}
+	if !slices.Equal(ids, want) || truncated {
+		t.Errorf("cveList() = %v, %v; want %v, false", ids, truncated, want)
+	}
+
+	cves, truncated = cveList(certData, 2)
+	if len(cves) != 2 || cves[0].ID != "CVE-2024-0004" || cves[1].ID != "CVE-2024-0003" || !truncated {
+		t.Errorf("cveList() with a cap of 2 = %+v, %v; want the critical and important CVEs, truncated", cves, truncated)
+	}
+
+	if cves, truncated := cveList(&pyxis.CertificationData{}, 2); cves != nil || truncated {
+		t.Errorf("cveList() without CVEs = %+v, %v; want nil, false", cves, truncated)
+	}
+}
+
+func TestPodReconciler_ClassifyContainers(t *testing.T) {
+	always := corev1.ContainerRestartPolicyAlways
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "setup"},
+				{Name: "proxy", RestartPolicy: &always},
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses:          []corev1.ContainerStatus{{Name: "app"}},
+			InitContainerStatuses:      []corev1.ContainerStatus{{Name: "setup"}, {Name: "proxy"}},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{{Name: "debugger"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		excluded map[securityv1alpha1.ContainerType]bool
+		want     map[string]securityv1alpha1.ContainerType
+	}{
+		{
+			name: "all categories included",
+			want: map[string]securityv1alpha1.ContainerType{
+				"app":      securityv1alpha1.ContainerTypeApp,
+				"setup":    securityv1alpha1.ContainerTypeInit,
+				"proxy":    securityv1alpha1.ContainerTypeSidecar,
+				"debugger": securityv1alpha1.ContainerTypeEphemeral,
+			},
+		},
+		{
+			name: "only app containers",
+			excluded: map[securityv1alpha1.ContainerType]bool{
+				securityv1alpha1.ContainerTypeInit:      true,
+				securityv1alpha1.ContainerTypeSidecar:   true,
+				securityv1alpha1.ContainerTypeEphemeral: true,
+			},
+			want: map[string]securityv1alpha1.ContainerType{
+				"app": securityv1alpha1.ContainerTypeApp,
+			},
+		},
+		{
+			name: "sidecars kept while init containers excluded",
+			excluded: map[securityv1alpha1.ContainerType]bool{
+				securityv1alpha1.ContainerTypeInit: true,
+			},
+			want: map[string]securityv1alpha1.ContainerType{
+				"app":      securityv1alpha1.ContainerTypeApp,
+				"proxy":    securityv1alpha1.ContainerTypeSidecar,
+				"debugger": securityv1alpha1.ContainerTypeEphemeral,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PodReconciler{ExcludedContainerTypes: tt.excluded}
+			got := make(map[string]securityv1alpha1.ContainerType)
+			for _, c := range r.classifyContainers(pod) {
+				got[c.status.Name] = c.containerType
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("classifyContainers() returned %v, want %v", got, tt.want)
+			}
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("container %q type = %q, want %q", name, got[name], want)
+				}
+			}
+		})
+	}
+}
+
+// blockingPyxisClient blocks every lookup until its context is done
+type blockingPyxisClient struct{}
+
+func (b *blockingPyxisClient) GetImageCertification(ctx context.Context, registry, repository, digest string) (*pyxis.CertificationData, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (b *blockingPyxisClient) IsHealthy(ctx context.Context) bool {
+	return true
+}
+
+func TestPodReconciler_CheckPyxisCertification_Deadline(t *testing.T) {
+	ref := &image.Reference{Registry: "registry.redhat.io", Repository: "ubi8/ubi", Digest: testDigest}
+
+	tests := []struct {
+		name         string
+		cancelParent bool
+		wantStatus   securityv1alpha1.CertificationStatus
+	}{
+		{
+			name:       "enrichment deadline records error",
+			wantStatus: securityv1alpha1.CertificationStatusError,
+		},
+		{
+			name:         "shutdown leaves status untouched",
+			cancelParent: true,
+			wantStatus:   securityv1alpha1.CertificationStatusPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &securityv1alpha1.ImageCertificationInfo{
+				ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+				Status: securityv1alpha1.ImageCertificationInfoStatus{
+					CertificationStatus: securityv1alpha1.CertificationStatusPending,
+				},
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(newTestScheme()).
+				WithObjects(cr).
+				WithStatusSubresource(cr).
+				Build()
+
+			r := &PodReconciler{
+				Client:            fakeClient,
+				Scheme:            newTestScheme(),
+				PyxisClient:       &blockingPyxisClient{},
+				EnrichmentTimeout: 20 * time.Millisecond,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancelParent {
+				cancel()
+			}
+			defer cancel()
+
+			done := make(chan struct{})
+			go func() {
+				r.checkPyxisCertification(ctx, testCRName, ref)
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("checkPyxisCertification did not honor the enrichment deadline")
+			}
+
+			var updated securityv1alpha1.ImageCertificationInfo
+			if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: testCRName}, &updated); err != nil {
+				t.Fatalf("failed to get CR: %v", err)
+			}
+			if updated.Status.CertificationStatus != tt.wantStatus {
+				t.Errorf("CertificationStatus = %s, want %s", updated.Status.CertificationStatus, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestPodReconciler_UpdateCRWithDockerHubData(t *testing.T) {
+	r := &PodReconciler{}
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+		},
+	}
+
+	r.updateCRWithDockerHubData(cr, &dockerhub.RepositoryInfo{
+		Namespace:   "library",
+		Name:        "nginx",
+		IsOfficial:  true,
+		PullCount:   12_700_000_000,
+		StarCount:   20000,
+		LastUpdated: time.Now().Add(-48 * time.Hour),
+		Description: "Official build of Nginx.",
+	})
+
+	data := cr.Status.DockerHubData
+	if data == nil {
+		t.Fatal("expected DockerHubData to be set")
+	}
+	if cr.Status.CertificationStatus != securityv1alpha1.CertificationStatusOfficial ||
+		cr.Status.ReasonCode != securityv1alpha1.ReasonDockerOfficialImage {
+		t.Errorf("CertificationStatus = %s (%s), want Official (%s)", cr.Status.CertificationStatus,
+			cr.Status.ReasonCode, securityv1alpha1.ReasonDockerOfficialImage)
+	}
+	if data.Description != "Official build of Nginx." || data.PullCountFormatted != "12.7B" || data.StarCount != 20000 {
+		t.Errorf("unexpected DockerHubData: %+v", data)
+	}
+	if data.DaysSinceUpdate == nil || *data.DaysSinceUpdate != 2 {
+		t.Errorf("DaysSinceUpdate = %v, want 2", data.DaysSinceUpdate)
+	}
+}
+
+func TestPodReconciler_Reconcile_Sharded(t *testing.T) {
+	ctx := context.Background()
 	scheme := newTestScheme()
 
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				ImageID: "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest,
+			}},
+		},
+	}
 	fakeClient := fake.NewClientBuilder().
 		WithScheme(scheme).
+		WithObjects(testPod).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
 		Build()
 
-	reconciler := &PodReconciler{
-		Client: fakeClient,
-		Scheme: scheme,
+	// Two instances share the fake cluster; sync both twice so each sees the other
+	members := []*sharding.Membership{
+		sharding.NewMembership(fakeClient, testNamespace, "replica-a"),
+		sharding.NewMembership(fakeClient, testNamespace, "replica-b"),
+	}
+	for range 2 {
+		for _, m := range members {
+			if err := m.Sync(ctx); err != nil {
+				t.Fatalf("Sync() error = %v", err)
+			}
+		}
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	var owner *sharding.Membership
+	for _, m := range members {
+		if m.Owns(testCRName) {
+			owner = m
+		}
+	}
+	if owner == nil {
+		t.Fatal("expected exactly one replica to own the image")
+	}
 
-	// Start refresh loop - note: it has a random startup delay (0-5 min)
-	// so we can't easily test the actual refresh, just that it starts and stops
-	reconciler.StartRefreshLoop(ctx, 1*time.Hour)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testPodName, Namespace: testNamespace}}
+	for _, m := range members {
+		if m == owner {
+			continue
+		}
+		if _, err := (&PodReconciler{Client: fakeClient, Scheme: scheme, Shard: m}).Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		var cr securityv1alpha1.ImageCertificationInfo
+		if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &cr); err == nil {
+			t.Fatal("non-owning replica created the ImageCertificationInfo")
+		}
+	}
 
-	// Give some time for the goroutine to start
-	time.Sleep(50 * time.Millisecond)
+	if _, err := (&PodReconciler{Client: fakeClient, Scheme: scheme, Shard: owner}).Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &cr); err != nil {
+		t.Fatalf("owning replica did not create the ImageCertificationInfo: %v", err)
+	}
+	if cr.Labels[sharding.LabelShard] != owner.ID() {
+		t.Errorf("shard label = %q, want %q", cr.Labels[sharding.LabelShard], owner.ID())
+	}
+}
 
-	// Cancel context to stop the loop
-	cancel()
+func TestPodReconciler_EmitVulnerabilityEvent(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &PodReconciler{Recorder: recorder}
+	cr := &securityv1alpha1.ImageCertificationInfo{}
+	setVulns := func(critical, important int) {
+		cr.Status.PyxisData = &securityv1alpha1.PyxisData{
+			Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: critical, Important: important},
+		}
+	}
 
-	// Give time for goroutine to exit
-	time.Sleep(50 * time.Millisecond)
+	// The first finding warns and repeated checks with the same counts do not
+	setVulns(1, 2)
+	r.emitVulnerabilityEvent(context.Background(), cr)
+	r.emitVulnerabilityEvent(context.Background(), cr)
+	// Fewer vulnerabilities do not warn, but an increase from there does
+	setVulns(0, 2)
+	r.emitVulnerabilityEvent(context.Background(), cr)
+	setVulns(1, 2)
+	r.emitVulnerabilityEvent(context.Background(), cr)
+	// Vulnerabilities that reappear after being fixed warn again
+	setVulns(0, 0)
+	r.emitVulnerabilityEvent(context.Background(), cr)
+	if cr.Status.WarnedVulnerabilities != nil {
+		t.Errorf("WarnedVulnerabilities = %+v, want nil once fixed", cr.Status.WarnedVulnerabilities)
+	}
+	setVulns(0, 1)
+	r.emitVulnerabilityEvent(context.Background(), cr)
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	want := []string{
+		"Warning VulnerabilitiesFound Found 1 critical, 2 important vulnerabilities",
+		"Warning VulnerabilitiesFound Vulnerabilities increased: critical 0→1, important 2→2",
+		"Warning VulnerabilitiesFound Found 0 critical, 1 important vulnerabilities",
+	}
+	if !slices.Equal(events, want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
 }
@@ -0,0 +1,195 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// Labels set on pods by the PodLabelReconciler
+const (
+	// LabelPodCertificationStatus is Certified, NotCertified, or Pending
+	LabelPodCertificationStatus = "imagecertinfo.telco.openshift.io/status"
+	// LabelPodHealth is the worst health grade (A-F) among the pod's images
+	LabelPodHealth = "imagecertinfo.telco.openshift.io/health"
+)
+
+// Values of the LabelPodCertificationStatus label
+const (
+	PodStatusCertified    = "Certified"
+	PodStatusNotCertified = "NotCertified"
+	PodStatusPending      = "Pending"
+)
+
+// PodLabelReconciler labels pods with the certification status and worst health grade of
+// their images, so that network policies, dashboards, and label selectors can key on
+// certification posture. Images count as certified under the same rules as the readiness gate.
+type PodLabelReconciler struct {
+	client.Client
+	// Pods decides which containers are tracked and how mirrored images are resolved
+	Pods *PodReconciler
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=patch
+
+// Reconcile updates the certification labels of the requested pod
+func (r *PodLabelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	var pod corev1.Pod
+	if err := r.Get(ctx, req.NamespacedName, &pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "podlabels")
+			return ctrl.Result{}, nil
+		}
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "podlabels")
+		return ctrl.Result{}, err
+	}
+	if !pod.DeletionTimestamp.IsZero() {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "podlabels")
+		return ctrl.Result{}, nil
+	}
+
+	status, health, err := r.evaluate(ctx, &pod)
+	if err != nil {
+		logger.Error(err, "unable to evaluate image certification for pod labels")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "podlabels")
+		return ctrl.Result{}, err
+	}
+	if pod.Labels[LabelPodCertificationStatus] == status && pod.Labels[LabelPodHealth] == health {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "podlabels")
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(pod.DeepCopy())
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string)
+	}
+	pod.Labels[LabelPodCertificationStatus] = status
+	if health == "" {
+		delete(pod.Labels, LabelPodHealth)
+	} else {
+		pod.Labels[LabelPodHealth] = health
+	}
+	if err := r.Patch(ctx, &pod, patch); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "podlabels")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "failed to patch pod certification labels")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "podlabels")
+		return ctrl.Result{}, err
+	}
+	logger.V(1).Info("updated pod certification labels", "status", status, "health", health)
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "podlabels")
+	return ctrl.Result{}, nil
+}
+
+// evaluate returns the certification status label of a pod, NotCertified if any tracked
+// container's image is known not to be certified and otherwise Pending until every image
+// is certified, and the worst health grade among its images, empty if none has a grade
+func (r *PodLabelReconciler) evaluate(ctx context.Context, pod *corev1.Pod) (string, string, error) {
+	containers := r.Pods.classifyContainers(pod)
+	status := PodStatusCertified
+	if len(containers) == 0 {
+		status = PodStatusPending
+	}
+	health := ""
+	for _, container := range containers {
+		if container.status.ImageID == "" {
+			status = worsePodStatus(status, PodStatusPending)
+			continue
+		}
+		ref, err := r.Pods.containerImage(pod, container.status)
+		if err != nil {
+			status = PodStatusNotCertified
+			continue
+		}
+
+		var cr securityv1alpha1.ImageCertificationInfo
+		if err := r.Get(ctx, client.ObjectKey{Name: image.ReferenceToCRName(ref)}, &cr); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return "", "", err
+			}
+			status = worsePodStatus(status, PodStatusPending)
+			continue
+		}
+		switch certStatus := cr.Status.CertificationStatus; {
+		case slices.Contains(lifecycleStatuses, certStatus):
+			// Deprecated and EOL images are still certified by Red Hat
+		case certStatus == "" || certStatus == securityv1alpha1.CertificationStatusPending ||
+			certStatus == securityv1alpha1.CertificationStatusUnknown ||
+			certStatus == securityv1alpha1.CertificationStatusError:
+			status = worsePodStatus(status, PodStatusPending)
+		default:
+			status = PodStatusNotCertified
+		}
+		if cr.Status.PyxisData != nil {
+			if grade := cr.Status.PyxisData.HealthIndex; grade != "" && (health == "" || isHealthDegraded(health, grade)) {
+				health = grade
+			}
+		}
+	}
+	return status, health, nil
+}
+
+// worsePodStatus returns the more concerning of two pod certification statuses
+func worsePodStatus(a, b string) string {
+	rank := map[string]int{PodStatusCertified: 0, PodStatusPending: 1, PodStatusNotCertified: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *PodLabelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return r.Pods.Namespaces.Allows(context.Background(), obj.GetNamespace())
+			}),
+			// Relabel when the pod runs other images or its labels were changed by someone else
+			predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return podImagesChanged(e) || predicate.LabelChangedPredicate{}.Update(e)
+				},
+				DeleteFunc: func(event.DeleteEvent) bool { return false },
+			},
+		)).
+		Watches(&securityv1alpha1.ImageCertificationInfo{}, handler.EnqueueRequestsFromMapFunc(readinessGateRequestsForCR)).
+		Named("podlabels").
+		Complete(r)
+}
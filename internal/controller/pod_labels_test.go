@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestPodLabelReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testPodName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app": "web", LabelPodHealth: "A"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: testContainer, Image: "registry.redhat.io/ubi8/ubi:latest"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				Image:   "registry.redhat.io/ubi8/ubi:latest",
+				ImageID: "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest,
+			}},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build()
+
+	reconciler := &PodLabelReconciler{Client: fakeClient, Pods: &PodReconciler{Client: fakeClient}}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testPodName}}
+	labels := func() map[string]string {
+		t.Helper()
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		var updated corev1.Pod
+		if err := fakeClient.Get(ctx, req.NamespacedName, &updated); err != nil {
+			t.Fatalf("failed to get pod: %v", err)
+		}
+		if updated.Labels["app"] != "web" {
+			t.Errorf("pod labels = %v, want other labels kept", updated.Labels)
+		}
+		return updated.Labels
+	}
+
+	// An image that has not been discovered yet is pending and has no health grade
+	got := labels()
+	if got[LabelPodCertificationStatus] != PodStatusPending {
+		t.Errorf("status label before discovery = %q, want %s", got[LabelPodCertificationStatus], PodStatusPending)
+	}
+	if _, ok := got[LabelPodHealth]; ok {
+		t.Errorf("health label = %q, want it removed when no image has a grade", got[LabelPodHealth])
+	}
+
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusEOL,
+			PyxisData:           &securityv1alpha1.PyxisData{HealthIndex: "C"},
+		},
+	}
+	if err := fakeClient.Create(ctx, cr); err != nil {
+		t.Fatalf("failed to create ImageCertificationInfo: %v", err)
+	}
+	got = labels()
+	if got[LabelPodCertificationStatus] != PodStatusCertified || got[LabelPodHealth] != "C" {
+		t.Errorf("labels for an EOL image = %v, want Certified with health C", got)
+	}
+
+	cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusNotCertified
+	if err := fakeClient.Update(ctx, cr); err != nil {
+		t.Fatalf("failed to update ImageCertificationInfo: %v", err)
+	}
+	if got = labels(); got[LabelPodCertificationStatus] != PodStatusNotCertified {
+		t.Errorf("status label for an uncertified image = %q, want %s",
+			got[LabelPodCertificationStatus], PodStatusNotCertified)
+	}
+}
+
+func TestWorsePodStatus(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{PodStatusCertified, PodStatusPending, PodStatusPending},
+		{PodStatusNotCertified, PodStatusPending, PodStatusNotCertified},
+		{PodStatusPending, PodStatusCertified, PodStatusPending},
+	}
+	for _, tt := range tests {
+		if got := worsePodStatus(tt.a, tt.b); got != tt.want {
+			t.Errorf("worsePodStatus(%s, %s) = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
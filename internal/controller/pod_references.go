@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// IndexPodReference indexes ImageCertificationInfos by the namespace/name of each pod that
+// references them, so that the images a pod uses are found without listing every image
+const IndexPodReference = "status.podReferences"
+
+// podReferenceKeys returns the index keys of the pods referencing an ImageCertificationInfo
+func podReferenceKeys(obj client.Object) []string {
+	cr, ok := obj.(*securityv1alpha1.ImageCertificationInfo)
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(cr.Status.PodReferences))
+	for _, ref := range cr.Status.PodReferences {
+		key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}.String()
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// removeDeletedPodReferences drops a deleted pod from the images it referenced, found through
+// IndexPodReference, so that its references do not linger until the next cleanup. An image
+// left without references starts its orphan TTL, as in CleanupStaleReferences.
+func (r *PodReconciler) removeDeletedPodReferences(ctx context.Context, pod types.NamespacedName) error {
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList, client.MatchingFields{IndexPodReference: pod.String()}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range crList.Items {
+		cr := &crList.Items[i]
+		if !r.Shard.Owns(cr.Name) {
+			continue
+		}
+		refs := slices.DeleteFunc(slices.Clone(cr.Status.PodReferences), func(ref securityv1alpha1.PodReference) bool {
+			return ref.Namespace == pod.Namespace && ref.Name == pod.Name
+		})
+		if len(refs) == len(cr.Status.PodReferences) {
+			continue
+		}
+		setPodReferences(cr, refs)
+		if len(refs) == 0 && len(cr.Status.NodeReferences) == 0 {
+			cr.Status.OrphanedAt = &metav1.Time{Time: now}
+		}
+		// A conflict requeues the pod, which finds the image again if it still references the pod
+		if err := r.Status().Update(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
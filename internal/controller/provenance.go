@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+// Status fields recorded in DataSources
+const (
+	fieldCertificationStatus = "certificationStatus"
+	fieldPyxisData           = "pyxisData"
+	fieldDockerHubData       = "dockerHubData"
+	fieldQuayData            = "quayData"
+	fieldRegistryData        = "registryData"
+	fieldDaysUntilEOL        = "daysUntilEol"
+	fieldImageAge            = "imageAge"
+	fieldTrackedCVEs         = "trackedCves"
+	fieldOwnership           = "ownership"
+	fieldSBOM                = "sbom"
+)
+
+// recordDataSource records that a provider was read at now and populated the given
+// status fields, replacing any earlier entry for the provider. Entries are kept sorted
+// by name so that unchanged provenance does not reorder the status.
+func recordDataSource(cr *securityv1alpha1.ImageCertificationInfo, name, source string, fields []string, now metav1.Time) {
+	entry := securityv1alpha1.DataSource{Name: name, Source: source, Fields: fields, SyncedAt: now}
+	i := slices.IndexFunc(cr.Status.DataSources, func(ds securityv1alpha1.DataSource) bool {
+		return ds.Name == name
+	})
+	if i >= 0 {
+		cr.Status.DataSources[i] = entry
+		return
+	}
+	cr.Status.DataSources = append(cr.Status.DataSources, entry)
+	slices.SortFunc(cr.Status.DataSources, func(a, b securityv1alpha1.DataSource) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+// pyxisSource returns the Pyxis API URL recorded as the source of Pyxis data
+func (r *PodReconciler) pyxisSource() string {
+	return cmp.Or(r.PyxisBaseURL, pyxis.DefaultBaseURL)
+}
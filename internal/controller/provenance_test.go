@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/dockerhub"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/quay"
+)
+
+func TestRecordDataSource(t *testing.T) {
+	cr := &securityv1alpha1.ImageCertificationInfo{}
+	first := metav1.NewTime(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(first.Add(time.Hour))
+
+	recordDataSource(cr, securityv1alpha1.DataSourceQuay, quay.DefaultBaseURL, []string{fieldQuayData}, first)
+	recordDataSource(cr, securityv1alpha1.DataSourcePyxis, pyxis.DefaultBaseURL, []string{fieldCertificationStatus}, first)
+	recordDataSource(cr, securityv1alpha1.DataSourceQuay, quay.DefaultBaseURL,
+		[]string{fieldQuayData, fieldTrackedCVEs}, later)
+
+	sources := cr.Status.DataSources
+	if len(sources) != 2 || sources[0].Name != "Pyxis" || sources[1].Name != "Quay" {
+		t.Fatalf("DataSources = %+v, want Pyxis then Quay", sources)
+	}
+	if !sources[1].SyncedAt.Equal(&later) || !slices.Equal(sources[1].Fields, []string{"quayData", "trackedCves"}) {
+		t.Errorf("Quay entry = %+v, want the later sync", sources[1])
+	}
+}
+
+func TestProviderDataProvenance(t *testing.T) {
+	r := &PodReconciler{PyxisBaseURL: "https://pyxis.example.com/v1"}
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+		},
+	}
+
+	r.updateCRWithPyxisData(cr, &pyxis.CertificationData{
+		EOLDate: time.Now().Add(30 * 24 * time.Hour).Format(time.RFC3339),
+	})
+	r.updateCRWithDockerHubData(cr, &dockerhub.RepositoryInfo{Namespace: "library", Name: "nginx"})
+
+	if data := cr.Status.PyxisData; data.Source != "https://pyxis.example.com/v1" || data.SyncedAt == nil {
+		t.Errorf("PyxisData source = %q, syncedAt = %v", data.Source, data.SyncedAt)
+	}
+	if data := cr.Status.DockerHubData; data.Source != dockerhub.DefaultBaseURL || data.SyncedAt == nil {
+		t.Errorf("DockerHubData source = %q, syncedAt = %v", data.Source, data.SyncedAt)
+	}
+
+	want := map[string][]string{
+		"Pyxis":     {"certificationStatus", "pyxisData", "trackedCves", "daysUntilEol"},
+		"DockerHub": {"dockerHubData"},
+	}
+	if len(cr.Status.DataSources) != len(want) {
+		t.Fatalf("DataSources = %+v", cr.Status.DataSources)
+	}
+	for _, ds := range cr.Status.DataSources {
+		if !slices.Equal(ds.Fields, want[ds.Name]) {
+			t.Errorf("%s fields = %v, want %v", ds.Name, ds.Fields, want[ds.Name])
+		}
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// Event reasons of provider error budget transitions
+const (
+	EventReasonProviderDisabled = "ProviderDisabled"
+	EventReasonProviderEnabled  = "ProviderEnabled"
+)
+
+// providerStateChanges is the number of guard transitions queued before Start writes them
+const providerStateChanges = 32
+
+// providerStateChange is a transition reported by an error budget guard
+type providerStateChange struct {
+	provider string
+	disabled bool
+	reason   string
+}
+
+// ProviderDegradationReporter reports the providers disabled by their error budget guard in
+// the ProviderDegraded condition of the operator's ImageCertInfoConfig, with a Warning event
+// on the config when a provider is disabled and a Normal event when it is re-enabled. Guards
+// report transitions from the lookup that trips them, so StateChanged only queues them and
+// Start writes them. It runs on every replica, since each has its own guards.
+type ProviderDegradationReporter struct {
+	client.Client
+	Recorder record.EventRecorder
+	// ConfigName and ConfigNamespace identify the ImageCertInfoConfig the condition and events
+	// are recorded on. Without them, transitions are only reported in metrics and logs.
+	ConfigName      string
+	ConfigNamespace string
+
+	changes  chan providerStateChange
+	disabled map[string]string
+}
+
+// NewProviderDegradationReporter returns a reporter recording on the given ImageCertInfoConfig
+func NewProviderDegradationReporter(c client.Client, recorder record.EventRecorder,
+	configName, configNamespace string) *ProviderDegradationReporter {
+	return &ProviderDegradationReporter{
+		Client:          c,
+		Recorder:        recorder,
+		ConfigName:      configName,
+		ConfigNamespace: configNamespace,
+		changes:         make(chan providerStateChange, providerStateChanges),
+		disabled:        make(map[string]string),
+	}
+}
+
+// NeedLeaderElection returns false, since every replica reports its own guards
+func (r *ProviderDegradationReporter) NeedLeaderElection() bool {
+	return false
+}
+
+// StateChanged queues a guard transition. It matches errorbudget.StateChangeFunc and never
+// blocks the lookup that reports it; a transition is dropped if the queue is full.
+func (r *ProviderDegradationReporter) StateChanged(provider string, disabled bool, reason string) {
+	metrics.SetProviderDisabled(provider, disabled)
+	select {
+	case r.changes <- providerStateChange{provider: provider, disabled: disabled, reason: reason}:
+	default:
+	}
+}
+
+// Start records the queued transitions until ctx is cancelled
+func (r *ProviderDegradationReporter) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change := <-r.changes:
+			if err := r.record(ctx, change); err != nil {
+				log.FromContext(ctx).Error(err, "failed to record provider state change",
+					"provider", change.provider)
+			}
+		}
+	}
+}
+
+// record updates the ProviderDegraded condition after a transition and emits its event
+func (r *ProviderDegradationReporter) record(ctx context.Context, change providerStateChange) error {
+	logger := log.FromContext(ctx)
+	if change.disabled {
+		logger.Info("Provider disabled by error budget guard", "provider", change.provider, "reason", change.reason)
+		r.disabled[change.provider] = change.reason
+	} else {
+		logger.Info("Provider re-enabled", "provider", change.provider, "reason", change.reason)
+		delete(r.disabled, change.provider)
+	}
+	if r.ConfigName == "" || r.ConfigNamespace == "" {
+		return nil
+	}
+
+	condition := providerDegradedCondition(r.disabled)
+	var config securityv1alpha1.ImageCertInfoConfig
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.Get(ctx, client.ObjectKey{Namespace: r.ConfigNamespace, Name: r.ConfigName}, &config); err != nil {
+			return err
+		}
+		condition.ObservedGeneration = config.Generation
+		if !meta.SetStatusCondition(&config.Status.Conditions, condition) {
+			return nil
+		}
+		return r.Status().Update(ctx, &config)
+	})
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if r.Recorder == nil {
+		return nil
+	}
+	eventType, reason := corev1.EventTypeNormal, EventReasonProviderEnabled
+	msg := fmt.Sprintf("Provider %s re-enabled: %s", change.provider, change.reason)
+	if change.disabled {
+		eventType, reason = corev1.EventTypeWarning, EventReasonProviderDisabled
+		msg = fmt.Sprintf("Provider %s disabled by its error budget guard: %s", change.provider, change.reason)
+	}
+	r.Recorder.Event(&config, eventType, reason, msg)
+	metrics.RecordEvent(eventType, reason)
+	return nil
+}
+
+// providerDegradedCondition lists the disabled providers and the reasons they were disabled
+func providerDegradedCondition(disabled map[string]string) metav1.Condition {
+	if len(disabled) == 0 {
+		return metav1.Condition{
+			Type:    securityv1alpha1.ImageCertInfoConfigConditionProviderDegraded,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(securityv1alpha1.ReasonProvidersEnabled),
+			Message: "No provider is disabled by its error budget guard",
+		}
+	}
+	parts := make([]string, 0, len(disabled))
+	for provider, reason := range disabled {
+		parts = append(parts, fmt.Sprintf("%s (%s)", provider, cmp.Or(reason, "error budget exhausted")))
+	}
+	slices.Sort(parts)
+	return metav1.Condition{
+		Type:    securityv1alpha1.ImageCertInfoConfigConditionProviderDegraded,
+		Status:  metav1.ConditionTrue,
+		Reason:  string(securityv1alpha1.ReasonProviderDisabled),
+		Message: "Disabled by the error budget guard: " + strings.Join(parts, "; "),
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestProviderDegradationReporter(t *testing.T) {
+	ctx := context.Background()
+	config := &securityv1alpha1.ImageCertInfoConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultOperatorConfigName, Namespace: "operator-system"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(config).WithStatusSubresource(config).Build()
+	recorder := record.NewFakeRecorder(10)
+	reporter := NewProviderDegradationReporter(fakeClient, recorder, config.Name, config.Namespace)
+
+	transition := func(provider string, disabled bool, reason string) *metav1.Condition {
+		t.Helper()
+		reporter.StateChanged(provider, disabled, reason)
+		if err := reporter.record(ctx, <-reporter.changes); err != nil {
+			t.Fatalf("record() error = %v", err)
+		}
+		var updated securityv1alpha1.ImageCertInfoConfig
+		if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(config), &updated); err != nil {
+			t.Fatal(err)
+		}
+		condition := meta.FindStatusCondition(updated.Status.Conditions,
+			securityv1alpha1.ImageCertInfoConfigConditionProviderDegraded)
+		if condition == nil {
+			t.Fatal("ProviderDegraded condition not set")
+		}
+		return condition
+	}
+	event := func() string {
+		t.Helper()
+		select {
+		case e := <-recorder.Events:
+			return e
+		default:
+			t.Fatal("no event recorded")
+			return ""
+		}
+	}
+
+	condition := transition("pyxis", true, "error rate 80% over 10m0s")
+	if condition.Status != metav1.ConditionTrue || condition.Reason != string(securityv1alpha1.ReasonProviderDisabled) ||
+		!strings.Contains(condition.Message, "pyxis (error rate 80% over 10m0s)") {
+		t.Errorf("condition after disabling = %+v, want True naming pyxis", condition)
+	}
+	if e := event(); !strings.HasPrefix(e, "Warning "+EventReasonProviderDisabled) {
+		t.Errorf("event = %q, want a %s warning", e, EventReasonProviderDisabled)
+	}
+
+	condition = transition("pyxis", false, "trial request succeeded")
+	if condition.Status != metav1.ConditionFalse || condition.Reason != string(securityv1alpha1.ReasonProvidersEnabled) {
+		t.Errorf("condition after re-enabling = %+v, want False", condition)
+	}
+	if e := event(); !strings.HasPrefix(e, "Normal "+EventReasonProviderEnabled) {
+		t.Errorf("event = %q, want a %s event", e, EventReasonProviderEnabled)
+	}
+}
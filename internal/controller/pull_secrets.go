@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/secrets"
+)
+
+// DefaultGlobalPullSecret is the OpenShift global pull secret
+const DefaultGlobalPullSecret = "openshift-config/pull-secret"
+
+// maxPullSecretPods bounds the pods whose pull secrets are read for one image
+const maxPullSecretPods = 10
+
+// PullSecrets finds the credentials for reading an image from its registry in the pull
+// secrets of the pods using it and in the cluster's global pull secret
+type PullSecrets struct {
+	// Pods reads the pods using an image
+	Pods client.Reader
+	// Secrets reads the pull secrets. It must not be backed by the manager cache, which
+	// would otherwise start a cluster-wide Secret informer.
+	Secrets client.Reader
+	// Global is the cluster-wide pull secret, tried after the pods' pull secrets (nil to skip)
+	Global *types.NamespacedName
+}
+
+// Credentials returns the distinct credentials for the registry from the pull secrets of
+// the referenced pods, then from the global pull secret. Secrets that cannot be read are
+// skipped.
+func (p *PullSecrets) Credentials(ctx context.Context, reg string,
+	pods []securityv1alpha1.PodReference) []registry.Credential {
+	logger := log.FromContext(ctx).WithValues("registry", reg)
+
+	var names []types.NamespacedName
+	for _, podRef := range pods[:min(len(pods), maxPullSecretPods)] {
+		var pod corev1.Pod
+		if err := p.Pods.Get(ctx, client.ObjectKey{Namespace: podRef.Namespace, Name: podRef.Name}, &pod); err != nil {
+			continue
+		}
+		for _, secret := range pod.Spec.ImagePullSecrets {
+			name := types.NamespacedName{Namespace: pod.Namespace, Name: secret.Name}
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	if p.Global != nil {
+		names = append(names, *p.Global)
+	}
+
+	reader := secrets.NewSecretReader(p.Secrets)
+	var creds []registry.Credential
+	for _, name := range names {
+		config, err := reader.ReadDockerConfig(ctx, name.Namespace, name.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.V(1).Info("skipping unreadable pull secret", "secret", name.String(), "error", err.Error())
+			}
+			continue
+		}
+		auth, ok := config.Lookup(reg)
+		cred := registry.Credential{Username: auth.Username, Password: auth.Password}
+		if ok && !slices.Contains(creds, cred) {
+			creds = append(creds, cred)
+		}
+	}
+	return creds
+}
+
+// withPullSecrets attaches the credentials for the registry from the pull secrets of the
+// referenced pods to ctx, unless pull secrets are not used
+func (r *PodReconciler) withPullSecrets(ctx context.Context, reg string,
+	pods []securityv1alpha1.PodReference) context.Context {
+	if r.PullSecrets == nil {
+		return ctx
+	}
+	return registry.WithCredentials(ctx, r.PullSecrets.Credentials(ctx, reg, pods)...)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
+)
+
+func pullSecret(namespace, name, auths string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{` + auths + `}}`)},
+	}
+}
+
+func TestPullSecrets_Credentials(t *testing.T) {
+	ctx := context.Background()
+	objects := []client.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: testNamespace},
+			Spec: corev1.PodSpec{ImagePullSecrets: []corev1.LocalObjectReference{
+				{Name: "quay-robot"}, {Name: "other"}, {Name: "absent"},
+			}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-2", Namespace: testNamespace},
+			Spec:       corev1.PodSpec{ImagePullSecrets: []corev1.LocalObjectReference{{Name: "quay-robot"}}},
+		},
+		pullSecret(testNamespace, "quay-robot", `"quay.io":{"username":"robot","password":"one"}`),
+		pullSecret(testNamespace, "other", `"registry.example.com":{"username":"user","password":"two"}`),
+		pullSecret("openshift-config", "pull-secret",
+			`"quay.io":{"username":"cluster","password":"three"},"https://index.docker.io/v1/":{"username":"hub","password":"four"}`),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(objects...).Build()
+	pullSecrets := &PullSecrets{
+		Pods:    fakeClient,
+		Secrets: fakeClient,
+		Global:  &types.NamespacedName{Namespace: "openshift-config", Name: "pull-secret"},
+	}
+	pods := []securityv1alpha1.PodReference{
+		{Namespace: testNamespace, Name: "app-1"},
+		{Namespace: testNamespace, Name: "app-2"},
+		{Namespace: testNamespace, Name: "deleted"},
+	}
+
+	tests := []struct {
+		registry string
+		want     []registry.Credential
+	}{
+		// The pods' pull secrets come before the global pull secret, each read once
+		{registry: "quay.io", want: []registry.Credential{
+			{Username: "robot", Password: "one"}, {Username: "cluster", Password: "three"},
+		}},
+		{registry: "docker.io", want: []registry.Credential{{Username: "hub", Password: "four"}}},
+		{registry: "registry.redhat.io", want: nil},
+	}
+	for _, tt := range tests {
+		got := pullSecrets.Credentials(ctx, tt.registry, pods)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Credentials(%s) = %+v, want %+v", tt.registry, got, tt.want)
+		}
+	}
+
+	// Without a global pull secret only the pods' pull secrets are used
+	pullSecrets.Global = nil
+	got := pullSecrets.Credentials(ctx, "quay.io", pods)
+	if want := []registry.Credential{{Username: "robot", Password: "one"}}; !slices.Equal(got, want) {
+		t.Errorf("Credentials(quay.io) without a global pull secret = %+v, want %+v", got, want)
+	}
+}
+
+func TestPodReconciler_WithPullSecrets(t *testing.T) {
+	ctx := context.Background()
+	reconciler := &PodReconciler{}
+	if got := reconciler.withPullSecrets(ctx, "quay.io", nil); got != ctx {
+		t.Error("withPullSecrets() without PullSecrets should return ctx unchanged")
+	}
+}
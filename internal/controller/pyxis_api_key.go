@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/secrets"
+)
+
+// APIKeySetter is a provider client whose API key can be replaced at runtime
+type APIKeySetter interface {
+	SetAPIKey(apiKey string)
+}
+
+// PyxisAPIKeyReconciler watches the Secret holding the Pyxis API key and hands the current
+// key to the Pyxis client, so a rotated key takes effect without a restart
+type PyxisAPIKeyReconciler struct {
+	client.Client
+	// Secret is the Secret key holding the API key
+	Secret secrets.KeyRef
+	// Pyxis receives the API key
+	Pyxis APIKeySetter
+}
+
+// Reconcile reads the API key from the Secret and applies it. When the Secret or key is
+// removed the previous key stays in use, since dropping it would silently downgrade to
+// unauthenticated access.
+func (r *PyxisAPIKeyReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	apiKey, err := secrets.NewSecretReader(r.Client).ReadAPIKey(ctx, r.Secret.Namespace, r.Secret.Name, r.Secret.Key)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Pyxis API key Secret not found, keeping the current key", "secret", r.Secret.String())
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "pyxisapikey")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "unable to read Pyxis API key, keeping the current key")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "pyxisapikey")
+		return ctrl.Result{}, err
+	}
+
+	r.Pyxis.SetAPIKey(apiKey)
+	logger.V(1).Info("applied Pyxis API key from Secret", "secret", r.Secret.String())
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "pyxisapikey")
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager watches only the configured Secret. The manager's cache must be restricted
+// to that Secret, as the operator is only allowed to read it by name.
+func (r *PyxisAPIKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Secret{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetNamespace() == r.Secret.Namespace && obj.GetName() == r.Secret.Name
+			}))).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Named("pyxisapikey").
+		Complete(r)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/secrets"
+)
+
+// fakeAPIKeySetter records the last API key it was given
+type fakeAPIKeySetter struct {
+	apiKey string
+}
+
+func (f *fakeAPIKeySetter) SetAPIKey(apiKey string) {
+	f.apiKey = apiKey
+}
+
+func TestPyxisAPIKeyReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pyxis-api-key", Namespace: testNamespace},
+		Data:       map[string][]byte{"api-key": []byte("first")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(secret).Build()
+	setter := &fakeAPIKeySetter{}
+	reconciler := &PyxisAPIKeyReconciler{
+		Client: fakeClient,
+		Secret: secrets.KeyRef{Namespace: testNamespace, Name: "pyxis-api-key", Key: "api-key"},
+		Pyxis:  setter,
+	}
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)}
+
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if setter.apiKey != "first" {
+		t.Errorf("API key = %q, want first", setter.apiKey)
+	}
+
+	// A rotated key is applied
+	secret.Data["api-key"] = []byte("rotated")
+	if err := fakeClient.Update(ctx, secret); err != nil {
+		t.Fatalf("failed to update secret: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if setter.apiKey != "rotated" {
+		t.Errorf("API key = %q, want rotated", setter.apiKey)
+	}
+
+	// Deleting the Secret keeps the current key
+	if err := fakeClient.Delete(ctx, secret); err != nil {
+		t.Fatalf("failed to delete secret: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if setter.apiKey != "rotated" {
+		t.Errorf("API key after deletion = %q, want rotated kept", setter.apiKey)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/errorbudget"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/quay"
+)
+
+// checkQuayScan queries the Quay API for the security scan of a quay.io image
+func (r *PodReconciler) checkQuayScan(ctx context.Context, crName string, ref *image.Reference) {
+	logger := log.FromContext(ctx).WithValues("crName", crName)
+
+	callCtx, cancel := r.enrichmentContext(ctx)
+	scan, err := r.QuayClient.GetSecurityScan(callCtx, ref.Repository, ref.Digest)
+	cancel()
+
+	// Nothing to record if the operator is shutting down
+	if ctx.Err() != nil {
+		return
+	}
+	if errors.Is(err, errorbudget.ErrDisabled) {
+		return
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := r.Get(ctx, client.ObjectKey{Name: crName}, &cr); err != nil {
+		logger.Error(err, "failed to get ImageCertificationInfo for Quay update")
+		return
+	}
+
+	if err != nil {
+		logger.Error(err, "failed to query Quay API")
+		r.writeLookupFailure(ctx, &cr)
+		return
+	}
+	if scan == nil {
+		// Unknown to Quay or in a private repository
+		return
+	}
+
+	recordLookupSuccess(&cr, metav1.Now())
+	updateCRWithQuayData(&cr, scan, time.Now())
+
+	r.emitVulnerabilityEvent(ctx, &cr)
+
+	if err := r.Status().Update(ctx, &cr); err != nil {
+		logger.Error(err, "failed to update ImageCertificationInfo with Quay data")
+	}
+}
+
+// updateCRWithQuayData updates a CR's status with a Quay security scan. A scan that is
+// still queued keeps the counts of the previous completed scan.
+func updateCRWithQuayData(cr *securityv1alpha1.ImageCertificationInfo, scan *quay.ScanResult, now time.Time) {
+	if cr.Status.QuayData == nil {
+		cr.Status.QuayData = &securityv1alpha1.QuayData{}
+	}
+	cr.Status.QuayData.ScanStatus = scan.Status
+	cr.Status.QuayData.Source = quay.DefaultBaseURL
+	cr.Status.QuayData.SyncedAt = &metav1.Time{Time: now}
+	fields := []string{fieldQuayData}
+	if scan.Status != quay.ScanStatusScanned {
+		recordDataSource(cr, securityv1alpha1.DataSourceQuay, quay.DefaultBaseURL, fields, metav1.Time{Time: now})
+		return
+	}
+
+	cr.Status.QuayData.Vulnerabilities = &securityv1alpha1.VulnerabilitySummary{
+		Critical:  scan.Vulnerabilities.Critical,
+		Important: scan.Vulnerabilities.Important,
+		Moderate:  scan.Vulnerabilities.Moderate,
+		Low:       scan.Vulnerabilities.Low,
+	}
+	cr.Status.QuayData.FixableCount = scan.FixableCount
+	cr.Status.QuayData.LastScanAt = &metav1.Time{Time: now}
+
+	// Quay images have no Pyxis data, so their CVEs are aged from the Quay scan
+	if cr.Status.PyxisData == nil {
+		updateTrackedCVEs(cr, scan.CVESeverities, nil, now)
+		fields = append(fields, fieldTrackedCVEs)
+	}
+	recordDataSource(cr, securityv1alpha1.DataSourceQuay, quay.DefaultBaseURL, fields, metav1.Time{Time: now})
+}
+
+// vulnerabilitySummary returns the vulnerability counts of an image from Pyxis, or from
+// Quay for quay.io images, or nil if neither has scanned it
+func vulnerabilitySummary(cr *securityv1alpha1.ImageCertificationInfo) *securityv1alpha1.VulnerabilitySummary {
+	if cr.Status.PyxisData != nil && cr.Status.PyxisData.Vulnerabilities != nil {
+		return cr.Status.PyxisData.Vulnerabilities
+	}
+	if cr.Status.QuayData != nil {
+		return cr.Status.QuayData.Vulnerabilities
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/quay"
+)
+
+type MockQuayClient struct {
+	Scan    *quay.ScanResult
+	Err     error
+	Healthy bool
+}
+
+func (m *MockQuayClient) GetSecurityScan(ctx context.Context, repository, digest string) (*quay.ScanResult, error) {
+	return m.Scan, m.Err
+}
+
+func (m *MockQuayClient) IsHealthy(ctx context.Context) bool {
+	return m.Healthy
+}
+
+func TestUpdateCRWithQuayData(t *testing.T) {
+	now := time.Now()
+	cr := &securityv1alpha1.ImageCertificationInfo{}
+
+	updateCRWithQuayData(cr, &quay.ScanResult{
+		Status:          quay.ScanStatusScanned,
+		Vulnerabilities: quay.VulnerabilityCounts{Critical: 1, Important: 2, Moderate: 3},
+		FixableCount:    4,
+		CVESeverities:   map[string]string{"CVE-2024-0001": "critical"},
+	}, now)
+
+	data := cr.Status.QuayData
+	if data == nil || data.ScanStatus != quay.ScanStatusScanned || data.FixableCount != 4 {
+		t.Fatalf("QuayData = %+v, want a scanned result", data)
+	}
+	if vulns := vulnerabilitySummary(cr); vulns == nil || vulns.Critical != 1 || vulns.Important != 2 || vulns.Moderate != 3 {
+		t.Errorf("vulnerabilitySummary() = %+v, want the Quay counts", vulns)
+	}
+	if data.LastScanAt == nil || !data.LastScanAt.Time.Equal(now) {
+		t.Errorf("LastScanAt = %v, want %v", data.LastScanAt, now)
+	}
+	if len(cr.Status.TrackedCVEs) != 1 || cr.Status.TrackedCVEs[0].ID != "CVE-2024-0001" {
+		t.Errorf("TrackedCVEs = %+v, want CVE-2024-0001", cr.Status.TrackedCVEs)
+	}
+
+	// A rescan in progress keeps the previous counts
+	updateCRWithQuayData(cr, &quay.ScanResult{Status: quay.ScanStatusQueued}, now.Add(time.Hour))
+	if cr.Status.QuayData.ScanStatus != quay.ScanStatusQueued || cr.Status.QuayData.Vulnerabilities.Critical != 1 ||
+		!cr.Status.QuayData.LastScanAt.Time.Equal(now) {
+		t.Errorf("QuayData = %+v, want queued with the previous scan", cr.Status.QuayData)
+	}
+}
+
+func TestPodReconciler_RefreshQuayScan(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	quayCR := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "quay.io.org.app.abc12345"},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest: "sha256:abc12345",
+			Registry:    RegistryQuay,
+			Repository:  "org/app",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(quayCR).
+		WithStatusSubresource(quayCR).
+		Build()
+
+	reconciler := &PodReconciler{
+		Client: fakeClient,
+		Scheme: scheme,
+		QuayClient: &MockQuayClient{Scan: &quay.ScanResult{
+			Status:          quay.ScanStatusScanned,
+			Vulnerabilities: quay.VulnerabilityCounts{Important: 2},
+		}},
+	}
+
+	if err := reconciler.RefreshAllImages(ctx); err != nil {
+		t.Fatalf("RefreshAllImages() error = %v", err)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: quayCR.Name}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	if cr.Status.QuayData == nil || cr.Status.QuayData.Vulnerabilities == nil ||
+		cr.Status.QuayData.Vulnerabilities.Important != 2 {
+		t.Errorf("QuayData = %+v, want 2 important vulnerabilities", cr.Status.QuayData)
+	}
+}
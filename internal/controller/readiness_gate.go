@@ -0,0 +1,221 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// ConditionImagesCertified is the pod condition set by the readiness gate controller. Pods that
+// list it in spec.readinessGates only become Ready once all of their images are certified.
+const ConditionImagesCertified corev1.PodConditionType = "security.telco.openshift.io/images-certified"
+
+// Reasons of the ConditionImagesCertified pod condition, from the condition reason catalog
+const (
+	ReasonImagesCertified   = string(securityv1alpha1.ReasonImagesCertified)
+	ReasonImageNotCertified = string(securityv1alpha1.ReasonImageNotCertified)
+	ReasonImagePending      = string(securityv1alpha1.ReasonImagePending)
+)
+
+// ReadinessGateReconciler maintains the ConditionImagesCertified condition of pods that declare
+// it as a readiness gate, giving soft enforcement of certification without denying admission
+type ReadinessGateReconciler struct {
+	client.Client
+	// Pods decides which containers are tracked and how mirrored images are resolved
+	Pods *PodReconciler
+}
+
+// +kubebuilder:rbac:groups="",resources=pods/status,verbs=get;patch
+
+// Reconcile sets the certification condition of the requested pod
+func (r *ReadinessGateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	var pod corev1.Pod
+	if err := r.Get(ctx, req.NamespacedName, &pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "readinessgate")
+			return ctrl.Result{}, nil
+		}
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "readinessgate")
+		return ctrl.Result{}, err
+	}
+	if !hasCertificationGate(&pod) {
+		metrics.RecordReconcile("success", time.Since(start).Seconds(), "readinessgate")
+		return ctrl.Result{}, nil
+	}
+
+	status, reason, message, err := r.evaluate(ctx, &pod)
+	if err != nil {
+		logger.Error(err, "unable to evaluate image certification for readiness gate")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "readinessgate")
+		return ctrl.Result{}, err
+	}
+
+	for _, existing := range pod.Status.Conditions {
+		if existing.Type == ConditionImagesCertified && existing.Status == status &&
+			existing.Reason == reason && existing.Message == message {
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "readinessgate")
+			return ctrl.Result{}, nil
+		}
+	}
+
+	// A strategic merge patch only touches this condition, leaving the kubelet's conditions alone
+	patch := client.StrategicMergeFrom(pod.DeepCopy())
+	setPodCondition(&pod, corev1.PodCondition{
+		Type:               ConditionImagesCertified,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	if err := r.Status().Patch(ctx, &pod, patch); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.RecordReconcile("success", time.Since(start).Seconds(), "readinessgate")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "failed to patch pod readiness gate condition")
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "readinessgate")
+		return ctrl.Result{}, err
+	}
+	logger.V(1).Info("updated readiness gate condition", "status", status, "reason", reason)
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "readinessgate")
+	return ctrl.Result{}, nil
+}
+
+// evaluate returns the certification condition of a pod: true once every tracked container's
+// image is certified, and false with the first offending image otherwise
+func (r *ReadinessGateReconciler) evaluate(ctx context.Context, pod *corev1.Pod) (
+	corev1.ConditionStatus, string, string, error) {
+	containers := r.Pods.classifyContainers(pod)
+	if len(containers) == 0 {
+		return corev1.ConditionFalse, ReasonImagePending, "Waiting for containers to start", nil
+	}
+
+	var uncertified []string
+	for _, container := range containers {
+		if container.status.ImageID == "" {
+			return corev1.ConditionFalse, ReasonImagePending,
+				fmt.Sprintf("Waiting for the image of container %s to be pulled", container.status.Name), nil
+		}
+		ref, err := r.Pods.containerImage(pod, container.status)
+		if err != nil {
+			uncertified = append(uncertified, fmt.Sprintf("%s (unrecognized image)", container.status.Name))
+			continue
+		}
+
+		var cr securityv1alpha1.ImageCertificationInfo
+		err = r.Get(ctx, client.ObjectKey{Name: image.ReferenceToCRName(ref)}, &cr)
+		if apierrors.IsNotFound(err) {
+			return corev1.ConditionFalse, ReasonImagePending,
+				fmt.Sprintf("Waiting for the image of container %s to be discovered", container.status.Name), nil
+		} else if err != nil {
+			return "", "", "", err
+		}
+		// Deprecated and EOL images are still certified by Red Hat
+		if !slices.Contains(lifecycleStatuses, cr.Status.CertificationStatus) {
+			status := cr.Status.CertificationStatus
+			if status == "" {
+				status = securityv1alpha1.CertificationStatusUnknown
+			}
+			uncertified = append(uncertified, fmt.Sprintf("%s (%s)", container.status.Name, status))
+		}
+	}
+
+	if len(uncertified) > 0 {
+		slices.Sort(uncertified)
+		return corev1.ConditionFalse, ReasonImageNotCertified,
+			"Containers with uncertified images: " + strings.Join(uncertified, ", "), nil
+	}
+	return corev1.ConditionTrue, ReasonImagesCertified, "All container images are certified", nil
+}
+
+// hasCertificationGate reports whether a pod lists ConditionImagesCertified as a readiness gate
+func hasCertificationGate(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Spec.ReadinessGates, func(gate corev1.PodReadinessGate) bool {
+		return gate.ConditionType == ConditionImagesCertified
+	})
+}
+
+// setPodCondition adds or replaces a pod condition, keeping the transition time when the
+// status does not change
+func setPodCondition(pod *corev1.Pod, condition corev1.PodCondition) {
+	for i, existing := range pod.Status.Conditions {
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		pod.Status.Conditions[i] = condition
+		return
+	}
+	pod.Status.Conditions = append(pod.Status.Conditions, condition)
+}
+
+// readinessGateRequestsForCR maps an ImageCertificationInfo to the pods using its image
+func readinessGateRequestsForCR(_ context.Context, obj client.Object) []reconcile.Request {
+	cr, ok := obj.(*securityv1alpha1.ImageCertificationInfo)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[client.ObjectKey]bool)
+	var requests []reconcile.Request
+	for _, podRef := range cr.Status.PodReferences {
+		key := client.ObjectKey{Namespace: podRef.Namespace, Name: podRef.Name}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ReadinessGateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			pod, ok := obj.(*corev1.Pod)
+			return ok && hasCertificationGate(pod)
+		}))).
+		Watches(&securityv1alpha1.ImageCertificationInfo{}, handler.EnqueueRequestsFromMapFunc(readinessGateRequestsForCR)).
+		Named("readinessgate").
+		Complete(r)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestReadinessGateReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Containers:     []corev1.Container{{Name: testContainer, Image: "registry.redhat.io/ubi8/ubi:latest"}},
+			ReadinessGates: []corev1.PodReadinessGate{{ConditionType: ConditionImagesCertified}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.ContainersReady, Status: corev1.ConditionTrue},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				Image:   "registry.redhat.io/ubi8/ubi:latest",
+				ImageID: "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest,
+			}},
+		},
+	}
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusUnknown,
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: testNamespace, Name: testPodName, Container: testContainer},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pod, cr).
+		WithStatusSubresource(pod, cr).
+		Build()
+
+	reconciler := &ReadinessGateReconciler{Client: fakeClient, Pods: &PodReconciler{Client: fakeClient}}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testPodName}}
+	condition := func() *corev1.PodCondition {
+		t.Helper()
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		var updated corev1.Pod
+		if err := fakeClient.Get(ctx, req.NamespacedName, &updated); err != nil {
+			t.Fatalf("failed to get pod: %v", err)
+		}
+		if len(updated.Status.Conditions) != 2 {
+			t.Errorf("pod conditions = %+v, want the kubelet condition kept", updated.Status.Conditions)
+		}
+		for i := range updated.Status.Conditions {
+			if updated.Status.Conditions[i].Type == ConditionImagesCertified {
+				return &updated.Status.Conditions[i]
+			}
+		}
+		t.Fatal("readiness gate condition not set")
+		return nil
+	}
+
+	got := condition()
+	if got.Status != corev1.ConditionFalse || got.Reason != ReasonImageNotCertified {
+		t.Errorf("condition for an uncertified image = %+v, want False with reason %s", got, ReasonImageNotCertified)
+	}
+
+	cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusCertified
+	if err := fakeClient.Status().Update(ctx, cr); err != nil {
+		t.Fatalf("failed to update ImageCertificationInfo: %v", err)
+	}
+	got = condition()
+	if got.Status != corev1.ConditionTrue || got.Reason != ReasonImagesCertified {
+		t.Errorf("condition for a certified image = %+v, want True with reason %s", got, ReasonImagesCertified)
+	}
+
+	if requests := readinessGateRequestsForCR(ctx, cr); len(requests) != 1 || requests[0] != req {
+		t.Errorf("readinessGateRequestsForCR() = %v, want %v", requests, req)
+	}
+}
+
+func TestReadinessGateReconciler_ImagePending(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			ReadinessGates: []corev1.PodReadinessGate{{ConditionType: ConditionImagesCertified}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    testContainer,
+				ImageID: "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest,
+			}},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).WithStatusSubresource(pod).Build()
+
+	reconciler := &ReadinessGateReconciler{Client: fakeClient, Pods: &PodReconciler{Client: fakeClient}}
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pod)}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var updated corev1.Pod
+	if err := fakeClient.Get(ctx, req.NamespacedName, &updated); err != nil {
+		t.Fatalf("failed to get pod: %v", err)
+	}
+	if len(updated.Status.Conditions) != 1 || updated.Status.Conditions[0].Reason != ReasonImagePending ||
+		updated.Status.Conditions[0].Status != corev1.ConditionFalse {
+		t.Errorf("conditions = %+v, want a False %s condition", updated.Status.Conditions, ReasonImagePending)
+	}
+}
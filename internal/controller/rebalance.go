@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/sharding"
+)
+
+// enableRebalance creates the channels that carry shard rebalances
+func (r *PodReconciler) enableRebalance() {
+	r.rebalanceRequests = make(chan struct{}, 1)
+	r.rebalanceEvents = make(chan event.GenericEvent)
+}
+
+// requestRebalance asks the rebalance loop to rebalance after a shard membership change. It
+// is called from the membership sync, so it never blocks lease renewal; a rebalance that is
+// already pending covers the change.
+func (r *PodReconciler) requestRebalance(context.Context) {
+	select {
+	case r.rebalanceRequests <- struct{}{}:
+	default:
+	}
+}
+
+// rebalanceLoop runs the requested rebalances on every shard member
+type rebalanceLoop struct {
+	pods *PodReconciler
+}
+
+// NeedLeaderElection returns false so that every shard member rebalances its own shard
+func (l *rebalanceLoop) NeedLeaderElection() bool {
+	return false
+}
+
+// Start rebalances after each requested membership change until ctx is cancelled
+func (l *rebalanceLoop) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-l.pods.rebalanceRequests:
+			l.pods.Rebalance(ctx)
+		}
+	}
+}
+
+// Rebalance runs after shard membership changes. It labels the ImageCertificationInfos this
+// instance now owns and queues all pods for reconciliation so that images without a resource
+// yet are picked up by their new owner.
+func (r *PodReconciler) Rebalance(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("rebalance")
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		logger.Error(err, "unable to list ImageCertificationInfos")
+		return
+	}
+	claimed := 0
+	for i := range crList.Items {
+		cr := &crList.Items[i]
+		if !r.Shard.Owns(cr.Name) || cr.Labels[sharding.LabelShard] == r.Shard.ID() {
+			continue
+		}
+		patch := client.MergeFrom(cr.DeepCopy())
+		if cr.Labels == nil {
+			cr.Labels = make(map[string]string)
+		}
+		cr.Labels[sharding.LabelShard] = r.Shard.ID()
+		if err := r.Patch(ctx, cr, patch); err != nil {
+			logger.Error(err, "failed to claim ImageCertificationInfo", "name", cr.Name)
+			continue
+		}
+		claimed++
+	}
+
+	// Only the keys are queued, so the cached pods are not copied
+	var podList corev1.PodList
+	if err := r.List(ctx, &podList, client.UnsafeDisableDeepCopy); err != nil {
+		logger.Error(err, "unable to list pods")
+		return
+	}
+	for i := range podList.Items {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: podList.Items[i].Namespace,
+			Name:      podList.Items[i].Name,
+		}}
+		select {
+		case r.rebalanceEvents <- event.GenericEvent{Object: pod}:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	logger.Info("shard rebalanced", "claimed", claimed, "pods", len(podList.Items))
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/sharding"
+)
+
+func TestPodReconciler_RebalanceOutsideMembershipSync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const podCount = 2000
+	objects := make([]client.Object, 0, podCount)
+	for i := range podCount {
+		objects = append(objects, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i), Namespace: testNamespace},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:    testContainer,
+					ImageID: "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest,
+				}},
+			},
+		})
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(objects...).Build()
+
+	membership := sharding.NewMembership(fakeClient, testNamespace, "replica-a")
+	r := &PodReconciler{Client: fakeClient, Scheme: fakeClient.Scheme(), Shard: membership}
+	r.enableRebalance()
+	membership.OnChange(r.requestRebalance)
+
+	// The membership sync only requests a rebalance, however many pods there are
+	synced := make(chan error, 1)
+	go func() { synced <- membership.Sync(ctx) }()
+	select {
+	case err := <-synced:
+		if err != nil {
+			t.Fatalf("Sync() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Sync() did not return promptly")
+	}
+	if len(r.rebalanceRequests) != 1 {
+		t.Fatal("Sync() should request a rebalance")
+	}
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := fakeClient.List(ctx, &crList); err != nil || len(crList.Items) != 0 {
+		t.Fatalf("images = %d, %v, want no pod reconciled during the sync", len(crList.Items), err)
+	}
+
+	// The rebalance loop queues every pod for the controller
+	go func() { _ = (&rebalanceLoop{pods: r}).Start(ctx) }()
+	queued := make(map[string]bool)
+	for len(queued) < podCount {
+		select {
+		case e := <-r.rebalanceEvents:
+			queued[e.Object.GetName()] = true
+		case <-time.After(5 * time.Second):
+			t.Fatalf("queued %d pods, want %d", len(queued), podCount)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultRefreshSummaryOffenders is the number of images listed in a refresh cycle summary
+const DefaultRefreshSummaryOffenders = 5
+
+// RefreshCycleSummary counts the images warned about during a refresh cycle
+type RefreshCycleSummary struct {
+	// CompletedAt is when the refresh cycle completed
+	CompletedAt metav1.Time `json:"completedAt"`
+	// NewlyVulnerable is the number of images whose critical or important vulnerabilities
+	// appeared or increased
+	NewlyVulnerable int `json:"newlyVulnerable"`
+	// HealthDegraded is the number of images whose health grade got worse
+	HealthDegraded int `json:"healthDegraded"`
+	// EOLApproaching is the number of images that entered a more urgent end-of-life tier
+	EOLApproaching int `json:"eolApproaching"`
+	// CertificationChanged is the number of images whose certification status changed
+	CertificationChanged int `json:"certificationChanged"`
+	// TopOffenders are the images with the most warnings, most vulnerable first
+	TopOffenders []RefreshCycleOffender `json:"topOffenders,omitempty"`
+}
+
+// RefreshCycleOffender is an image warned about during a refresh cycle
+type RefreshCycleOffender struct {
+	// Image is the full image reference
+	Image string `json:"image"`
+	// Warnings are the reasons of the events the image would have had
+	Warnings []string `json:"warnings"`
+	// Critical and Important are the image's vulnerability counts
+	Critical  int `json:"critical,omitempty"`
+	Important int `json:"important,omitempty"`
+}
+
+// empty reports whether no image was warned about
+func (s *RefreshCycleSummary) empty() bool {
+	return s.NewlyVulnerable == 0 && s.HealthDegraded == 0 && s.EOLApproaching == 0 && s.CertificationChanged == 0
+}
+
+// message describes the summary in an event
+func (s *RefreshCycleSummary) message() string {
+	msg := fmt.Sprintf("Refresh cycle warnings: %d newly vulnerable, %d health degraded, %d approaching EOL, "+
+		"%d certification changed", s.NewlyVulnerable, s.HealthDegraded, s.EOLApproaching, s.CertificationChanged)
+	offenders := make([]string, len(s.TopOffenders))
+	for i, offender := range s.TopOffenders {
+		offenders[i] = fmt.Sprintf("%s (%s)", offender.Image, strings.Join(offender.Warnings, ", "))
+	}
+	if len(offenders) > 0 {
+		msg += "; top offenders: " + strings.Join(offenders, "; ")
+	}
+	return msg
+}
+
+// refreshCycle collects the warnings raised while a refresh cycle runs
+type refreshCycle struct {
+	mu     sync.Mutex
+	images map[string]*RefreshCycleOffender
+	counts map[string]int
+}
+
+type refreshCycleKey struct{}
+
+// withRefreshCycle returns a context whose warnings are collected by a new refresh cycle
+func withRefreshCycle(ctx context.Context) (context.Context, *refreshCycle) {
+	cycle := &refreshCycle{images: make(map[string]*RefreshCycleOffender), counts: make(map[string]int)}
+	return context.WithValue(ctx, refreshCycleKey{}, cycle), cycle
+}
+
+// refreshCycleFrom returns the refresh cycle collecting the warnings of ctx, or nil
+func refreshCycleFrom(ctx context.Context) *refreshCycle {
+	cycle, _ := ctx.Value(refreshCycleKey{}).(*refreshCycle)
+	return cycle
+}
+
+// add records a warning about an image, counting each reason once per image
+func (c *refreshCycle) add(cr *securityv1alpha1.ImageCertificationInfo, reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	offender, ok := c.images[cr.Name]
+	if !ok {
+		offender = &RefreshCycleOffender{Image: cmp.Or(cr.Spec.FullImageReference, cr.Name)}
+		c.images[cr.Name] = offender
+	}
+	if vulns := vulnerabilitySummary(cr); vulns != nil {
+		offender.Critical, offender.Important = vulns.Critical, vulns.Important
+	}
+	if !slices.Contains(offender.Warnings, reason) {
+		offender.Warnings = append(offender.Warnings, reason)
+		c.counts[reason]++
+	}
+}
+
+// summary returns the counts of the cycle and its top offenders, ranked by the number of
+// warnings and then by their critical and important vulnerabilities
+func (c *refreshCycle) summary(top int, completedAt time.Time) *RefreshCycleSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	summary := &RefreshCycleSummary{
+		CompletedAt:          metav1.NewTime(completedAt),
+		NewlyVulnerable:      c.counts[EventReasonVulnerabilitiesFound],
+		HealthDegraded:       c.counts[EventReasonHealthDegraded],
+		EOLApproaching:       c.counts[EventReasonEOLApproaching],
+		CertificationChanged: c.counts[EventReasonCertificationChanged],
+	}
+	offenders := make([]RefreshCycleOffender, 0, len(c.images))
+	for _, offender := range c.images {
+		offenders = append(offenders, *offender)
+	}
+	slices.SortFunc(offenders, func(a, b RefreshCycleOffender) int {
+		return cmp.Or(
+			cmp.Compare(len(b.Warnings), len(a.Warnings)),
+			cmp.Compare(b.Critical, a.Critical),
+			cmp.Compare(b.Important, a.Important),
+			cmp.Compare(a.Image, b.Image),
+		)
+	})
+	if len(offenders) > top {
+		offenders = offenders[:top]
+	}
+	summary.TopOffenders = offenders
+	return summary
+}
+
+// RefreshSummarizer emits a single RefreshCycleSummary event on the operator pod for each
+// full refresh cycle in place of the per-image warning events, which on large clusters can
+// flood the event stream after a Pyxis data update. The last summary is served on /statusz.
+type RefreshSummarizer struct {
+	Recorder record.EventRecorder
+	// Reader reads the operator pod the event is recorded on. It should not be backed by
+	// the manager cache, which may not include the operator namespace.
+	Reader client.Reader
+	// Namespace and PodName identify the operator pod. No event is emitted when unset.
+	Namespace string
+	PodName   string
+	// TopOffenders is the number of images listed in each summary
+	// (DefaultRefreshSummaryOffenders if 0)
+	TopOffenders int
+
+	mu   sync.Mutex
+	last *RefreshCycleSummary
+}
+
+// Last returns the summary of the last completed refresh cycle, or nil before the first one
+// or when s is nil
+func (s *RefreshSummarizer) Last() *RefreshCycleSummary {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+// publish records the summary of a completed refresh cycle and emits its event, unless no
+// image was warned about
+func (s *RefreshSummarizer) publish(ctx context.Context, cycle *refreshCycle, completedAt time.Time) {
+	summary := cycle.summary(cmp.Or(s.TopOffenders, DefaultRefreshSummaryOffenders), completedAt)
+	s.mu.Lock()
+	s.last = summary
+	s.mu.Unlock()
+
+	if summary.empty() {
+		return
+	}
+	logger := log.FromContext(ctx)
+	logger.Info("refresh cycle summary", "newlyVulnerable", summary.NewlyVulnerable,
+		"healthDegraded", summary.HealthDegraded, "eolApproaching", summary.EOLApproaching,
+		"certificationChanged", summary.CertificationChanged)
+	if s.Recorder == nil || s.Reader == nil || s.Namespace == "" || s.PodName == "" {
+		return
+	}
+	pod := &corev1.Pod{}
+	if err := s.Reader.Get(ctx, client.ObjectKey{Namespace: s.Namespace, Name: s.PodName}, pod); err != nil {
+		logger.Error(err, "failed to get the operator pod for the refresh cycle summary event")
+		return
+	}
+	s.Recorder.Event(pod, corev1.EventTypeWarning, EventReasonRefreshCycleSummary, summary.message())
+	metrics.RecordEvent(corev1.EventTypeWarning, EventReasonRefreshCycleSummary)
+}
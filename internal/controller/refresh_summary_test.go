@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestRefreshSummarizer(t *testing.T) {
+	operatorPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: "operator-system"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(operatorPod).Build()
+	recorder := record.NewFakeRecorder(10)
+	summarizer := &RefreshSummarizer{
+		Recorder:     recorder,
+		Reader:       fakeClient,
+		Namespace:    operatorPod.Namespace,
+		PodName:      operatorPod.Name,
+		TopOffenders: 1,
+	}
+	r := &PodReconciler{Recorder: recorder, RefreshSummary: summarizer}
+	if summarizer.Last() != nil || (*RefreshSummarizer)(nil).Last() != nil {
+		t.Fatal("Last() should be nil before the first refresh cycle")
+	}
+
+	image := func(name string, critical int) *securityv1alpha1.ImageCertificationInfo {
+		return &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       securityv1alpha1.ImageCertificationInfoSpec{FullImageReference: "quay.io/example/" + name},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{PyxisData: &securityv1alpha1.PyxisData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: critical},
+			}},
+		}
+	}
+	ctx, cycle := withRefreshCycle(context.Background())
+	app, db := image("app", 1), image("db", 4)
+	r.warn(ctx, app, EventReasonVulnerabilitiesFound, "found")
+	r.warn(ctx, app, EventReasonVulnerabilitiesFound, "increased")
+	r.warn(ctx, db, EventReasonVulnerabilitiesFound, "found")
+	r.warn(ctx, db, EventReasonHealthDegraded, "degraded")
+	if len(recorder.Events) != 0 {
+		t.Fatalf("recorded %d events during the refresh cycle, want them summarized", len(recorder.Events))
+	}
+
+	summarizer.publish(ctx, cycle, time.Now())
+	summary := summarizer.Last()
+	if summary == nil || summary.NewlyVulnerable != 2 || summary.HealthDegraded != 1 || summary.EOLApproaching != 0 {
+		t.Fatalf("summary = %+v, want 2 newly vulnerable and 1 degraded image", summary)
+	}
+	if len(summary.TopOffenders) != 1 || summary.TopOffenders[0].Image != "quay.io/example/db" ||
+		summary.TopOffenders[0].Critical != 4 {
+		t.Errorf("top offenders = %+v, want the db image", summary.TopOffenders)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, EventReasonRefreshCycleSummary) || !strings.Contains(event, "2 newly vulnerable") {
+			t.Errorf("event = %q, want the refresh cycle summary", event)
+		}
+	default:
+		t.Fatal("no refresh cycle summary event")
+	}
+
+	// A cycle without warnings is recorded but not announced
+	_, quiet := withRefreshCycle(context.Background())
+	summarizer.publish(ctx, quiet, time.Now())
+	if summary := summarizer.Last(); summary == nil || !summary.empty() {
+		t.Errorf("summary = %+v, want the empty cycle", summary)
+	}
+	if len(recorder.Events) != 0 {
+		t.Error("a cycle without warnings should not emit an event")
+	}
+
+	// Outside a refresh cycle warnings are still emitted per image
+	r.warn(context.Background(), app, EventReasonHealthDegraded, "degraded")
+	if len(recorder.Events) != 1 {
+		t.Error("a warning outside a refresh cycle should be emitted")
+	}
+}
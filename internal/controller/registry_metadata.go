@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
+)
+
+// inspectsRegistry reports whether images from the registry are enriched by reading
+// their manifest and config directly. Red Hat registries and Docker Hub have richer
+// sources of their own.
+func (r *PodReconciler) inspectsRegistry(reg string) bool {
+	return r.RegistryClient != nil && !r.Registries.PyxisEligible(reg) && reg != RegistryDockerHub
+}
+
+// registryDataMissing reports whether an image's registry metadata has not been read yet.
+// Metadata read by earlier versions, which did not record platforms, is read again.
+func registryDataMissing(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	return cr.Status.RegistryData == nil || cr.Status.RegistryData.Platforms == nil
+}
+
+// checkRegistryMetadata reads an image's manifest and config from its registry and records them on the CR
+func (r *PodReconciler) checkRegistryMetadata(ctx context.Context, crName string, ref *image.Reference) {
+	logger := log.FromContext(ctx).WithValues("crName", crName)
+
+	callCtx, cancel := r.enrichmentContext(ctx)
+	metadata, err := r.RegistryClient.GetImageMetadata(callCtx, ref.Registry, ref.Repository, ref.Digest)
+	cancel()
+
+	// Nothing to record if the operator is shutting down
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		// Private registries commonly reject anonymous pulls; the refresh loop retries
+		logger.V(1).Info("failed to read image metadata from registry", "registry", ref.Registry, "error", err.Error())
+		return
+	}
+	if metadata == nil {
+		return
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := r.Get(ctx, client.ObjectKey{Name: crName}, &cr); err != nil {
+		logger.Error(err, "failed to get ImageCertificationInfo for registry metadata update")
+		return
+	}
+
+	updateCRWithRegistryData(&cr, metadata)
+	if err := r.Status().Update(ctx, &cr); err != nil {
+		logger.Error(err, "failed to update ImageCertificationInfo with registry metadata")
+	}
+}
+
+// updateCRWithRegistryData updates a CR's status with metadata read from the image's registry
+func updateCRWithRegistryData(cr *securityv1alpha1.ImageCertificationInfo, metadata *registry.ImageMetadata) {
+	now := metav1.Now()
+	data := &securityv1alpha1.RegistryData{
+		Architectures:       metadata.Architectures,
+		Platforms:           imagePlatforms(metadata.Platforms),
+		LayerCount:          metadata.LayerCount,
+		CompressedSizeBytes: metadata.CompressedSizeBytes,
+		Source:              cr.Spec.Registry,
+		SyncedAt:            &now,
+	}
+	if len(metadata.Labels) > 0 {
+		data.Labels = maps.Clone(metadata.Labels)
+	}
+	if !metadata.Created.IsZero() {
+		data.Created = &metav1.Time{Time: metadata.Created}
+	}
+	cr.Status.RegistryData = data
+	cr.Status.OperatingSystems = operatingSystems(data.Platforms)
+	fields := []string{fieldRegistryData}
+	if ownership := imageOwnership(metadata.Labels); ownership != nil {
+		cr.Status.Ownership = ownership
+		fields = append(fields, fieldOwnership)
+	}
+	recordDataSource(cr, securityv1alpha1.DataSourceRegistry, cr.Spec.Registry, fields, now)
+}
+
+// imagePlatforms converts the platforms read from a registry to their status form
+func imagePlatforms(platforms []registry.Platform) []securityv1alpha1.ImagePlatform {
+	if len(platforms) == 0 {
+		return nil
+	}
+	result := make([]securityv1alpha1.ImagePlatform, len(platforms))
+	for i, p := range platforms {
+		result[i] = securityv1alpha1.ImagePlatform{OS: p.OS, Architecture: p.Architecture, Variant: p.Variant}
+	}
+	return result
+}
+
+// operatingSystems returns the sorted, distinct operating systems of the platforms
+func operatingSystems(platforms []securityv1alpha1.ImagePlatform) []string {
+	var systems []string
+	for _, p := range platforms {
+		if !slices.Contains(systems, p.OS) {
+			systems = append(systems, p.OS)
+		}
+	}
+	slices.Sort(systems)
+	return systems
+}
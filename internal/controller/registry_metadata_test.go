@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
+)
+
+type MockRegistryClient struct {
+	Metadata *registry.ImageMetadata
+	Err      error
+	Calls    int
+}
+
+func (m *MockRegistryClient) GetImageMetadata(ctx context.Context, reg, repository, digest string) (*registry.ImageMetadata, error) {
+	m.Calls++
+	return m.Metadata, m.Err
+}
+
+func TestPodReconciler_RefreshRegistryMetadata(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	newCR := func(name, reg string) *securityv1alpha1.ImageCertificationInfo {
+		return &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: securityv1alpha1.ImageCertificationInfoSpec{
+				ImageDigest: "sha256:abc12345",
+				Registry:    reg,
+				Repository:  "org/app",
+			},
+		}
+	}
+	quayCR := newCR("quay.io.org.app.abc12345", "quay.io")
+	// Images that already have registry data are not read again
+	doneCR := newCR("ghcr.io.org.app.abc12345", "ghcr.io")
+	doneCR.Status.RegistryData = &securityv1alpha1.RegistryData{
+		LayerCount: 3,
+		Platforms:  []securityv1alpha1.ImagePlatform{{OS: "linux", Architecture: "amd64"}},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(quayCR, doneCR).
+		WithStatusSubresource(quayCR, doneCR).
+		Build()
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockRegistry := &MockRegistryClient{Metadata: &registry.ImageMetadata{
+		Architectures: []string{"amd64", "arm64"},
+		Platforms: []registry.Platform{
+			{OS: "linux", Architecture: "amd64"},
+			{OS: "windows", Architecture: "amd64"},
+			{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		},
+		Labels:     map[string]string{"org.opencontainers.image.vendor": "Example"},
+		LayerCount: 5,
+		Created:    created,
+	}}
+	reconciler := &PodReconciler{
+		Client:         fakeClient,
+		Scheme:         scheme,
+		RegistryClient: mockRegistry,
+	}
+
+	if err := reconciler.RefreshAllImages(ctx); err != nil {
+		t.Fatalf("RefreshAllImages() error = %v", err)
+	}
+	if mockRegistry.Calls != 1 {
+		t.Errorf("registry client called %d times, want 1", mockRegistry.Calls)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: quayCR.Name}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	data := cr.Status.RegistryData
+	if data == nil {
+		t.Fatal("RegistryData was not recorded")
+	}
+	if !slices.Equal(data.Architectures, []string{"amd64", "arm64"}) || data.LayerCount != 5 ||
+		data.Labels["org.opencontainers.image.vendor"] != "Example" {
+		t.Errorf("RegistryData = %+v, want the registry metadata", data)
+	}
+	if data.Created == nil || !data.Created.Time.Equal(created) {
+		t.Errorf("RegistryData.Created = %v, want %v", data.Created, created)
+	}
+	if len(data.Platforms) != 3 || data.Platforms[2].Variant != "v8" {
+		t.Errorf("RegistryData.Platforms = %+v, want the registry platforms", data.Platforms)
+	}
+	if !slices.Equal(cr.Status.OperatingSystems, []string{"linux", "windows"}) {
+		t.Errorf("OperatingSystems = %v, want [linux windows]", cr.Status.OperatingSystems)
+	}
+	if cr.Status.Ownership == nil || cr.Status.Ownership.Vendor != "Example" {
+		t.Errorf("Ownership = %+v, want the vendor from the OCI labels", cr.Status.Ownership)
+	}
+}
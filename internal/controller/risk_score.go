@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"slices"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Weight of each vulnerability in a namespace risk score, by severity
+const (
+	riskWeightCritical  = 10
+	riskWeightImportant = 5
+	riskWeightModerate  = 2
+	riskWeightLow       = 1
+)
+
+// maxRiskNamespaces bounds the namespaces ranked by risk in the report
+const maxRiskNamespaces = 20
+
+// imageRisk returns the severity-weighted vulnerability count of an image, or 0 if it has
+// not been scanned
+func imageRisk(vulns *securityv1alpha1.VulnerabilitySummary) int {
+	if vulns == nil {
+		return 0
+	}
+	return vulns.Critical*riskWeightCritical + vulns.Important*riskWeightImportant +
+		vulns.Moderate*riskWeightModerate + vulns.Low*riskWeightLow
+}
+
+// rankNamespacesByRisk returns the namespaces with a risk score above zero, highest first
+func rankNamespacesByRisk(namespaces []securityv1alpha1.NamespaceCertificationSummary) []string {
+	var risky []securityv1alpha1.NamespaceCertificationSummary
+	for _, ns := range namespaces {
+		if ns.RiskScore > 0 {
+			risky = append(risky, ns)
+		}
+	}
+	slices.SortFunc(risky, func(a, b securityv1alpha1.NamespaceCertificationSummary) int {
+		return cmp.Or(cmp.Compare(b.RiskScore, a.RiskScore), cmp.Compare(a.Namespace, b.Namespace))
+	})
+	names := make([]string, 0, min(len(risky), maxRiskNamespaces))
+	for _, ns := range risky[:min(len(risky), maxRiskNamespaces)] {
+		names = append(names, ns.Namespace)
+	}
+	return names
+}
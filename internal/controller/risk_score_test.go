@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestImageRisk(t *testing.T) {
+	if got := imageRisk(nil); got != 0 {
+		t.Errorf("imageRisk(nil) = %d, want 0", got)
+	}
+	vulns := &securityv1alpha1.VulnerabilitySummary{Critical: 1, Important: 2, Moderate: 3, Low: 4}
+	if got := imageRisk(vulns); got != 10+10+6+4 {
+		t.Errorf("imageRisk() = %d, want 30", got)
+	}
+}
+
+func TestRankNamespacesByRisk(t *testing.T) {
+	namespaces := []securityv1alpha1.NamespaceCertificationSummary{
+		{Namespace: "a", RiskScore: 5},
+		{Namespace: "b", RiskScore: 50},
+		{Namespace: "c"},
+		{Namespace: "d", RiskScore: 5},
+	}
+	if got := rankNamespacesByRisk(namespaces); !slices.Equal(got, []string{"b", "a", "d"}) {
+		t.Errorf("rankNamespacesByRisk() = %v, want [b a d]", got)
+	}
+
+	namespaces = nil
+	for i := range maxRiskNamespaces + 5 {
+		namespaces = append(namespaces, securityv1alpha1.NamespaceCertificationSummary{
+			Namespace: fmt.Sprintf("ns-%02d", i), RiskScore: i + 1,
+		})
+	}
+	got := rankNamespacesByRisk(namespaces)
+	if len(got) != maxRiskNamespaces || got[0] != fmt.Sprintf("ns-%02d", maxRiskNamespaces+4) {
+		t.Errorf("rankNamespacesByRisk() = %v, want the %d riskiest namespaces", got, maxRiskNamespaces)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
+)
+
+// sbomRecheckInterval is how often an image without an SBOM is checked again, since an
+// SBOM can be attached to an image after it is pushed
+const sbomRecheckInterval = 24 * time.Hour
+
+// needsSBOMCheck reports whether the image's registry should be checked for an SBOM. An
+// attached SBOM is found once; an image without one is checked again after sbomRecheckInterval.
+func (r *PodReconciler) needsSBOMCheck(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	if r.SBOMClient == nil {
+		return false
+	}
+	sbom := cr.Status.SBOM
+	if sbom == nil || sbom.SyncedAt == nil {
+		return true
+	}
+	return !sbom.Present && time.Since(sbom.SyncedAt.Time) >= sbomRecheckInterval
+}
+
+// checkSBOM looks up an SBOM attached to the image and records it on the CR
+func (r *PodReconciler) checkSBOM(ctx context.Context, crName string, ref *image.Reference) {
+	logger := log.FromContext(ctx).WithValues("crName", crName)
+
+	callCtx, cancel := r.enrichmentContext(ctx)
+	sbom, err := r.SBOMClient.GetSBOM(callCtx, ref.Registry, ref.Repository, ref.Digest)
+	cancel()
+
+	// Nothing to record if the operator is shutting down
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		// Not every registry serves referrers; the refresh loop retries
+		logger.V(1).Info("failed to discover SBOM", "registry", ref.Registry, "error", err.Error())
+		return
+	}
+	if sbom == nil {
+		return
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := r.Get(ctx, client.ObjectKey{Name: crName}, &cr); err != nil {
+		logger.Error(err, "failed to get ImageCertificationInfo for SBOM update")
+		return
+	}
+
+	updateCRWithSBOMData(&cr, sbom, time.Now())
+	if err := r.Status().Update(ctx, &cr); err != nil {
+		logger.Error(err, "failed to update ImageCertificationInfo with SBOM data")
+	}
+}
+
+// updateCRWithSBOMData records the SBOM discovered for an image on its CR
+func updateCRWithSBOMData(cr *securityv1alpha1.ImageCertificationInfo, sbom *registry.SBOM, now time.Time) {
+	synced := metav1.Time{Time: now}
+	data := &securityv1alpha1.SBOMData{
+		Present:      sbom.Present,
+		Format:       sbom.Format,
+		ArtifactType: sbom.ArtifactType,
+		Digest:       sbom.Digest,
+		Source:       cr.Spec.Registry,
+		SyncedAt:     &synced,
+	}
+	if sbom.Summary != nil {
+		packages := sbom.Summary.PackageCount
+		data.PackageCount = &packages
+		data.TopLicenses = slices.Clone(sbom.Summary.TopLicenses)
+	}
+	cr.Status.SBOM = data
+	recordDataSource(cr, securityv1alpha1.DataSourceReferrers, cr.Spec.Registry, []string{fieldSBOM}, synced)
+}
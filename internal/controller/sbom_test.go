@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/registry"
+)
+
+type MockSBOMClient struct {
+	SBOM  *registry.SBOM
+	Err   error
+	Calls []string
+}
+
+func (m *MockSBOMClient) GetSBOM(ctx context.Context, reg, repository, digest string) (*registry.SBOM, error) {
+	m.Calls = append(m.Calls, reg+"/"+repository)
+	return m.SBOM, m.Err
+}
+
+func TestPodReconciler_RefreshSBOM(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	newCR := func(reg, repository string, sbom *securityv1alpha1.SBOMData) *securityv1alpha1.ImageCertificationInfo {
+		return &securityv1alpha1.ImageCertificationInfo{
+			ObjectMeta: metav1.ObjectMeta{Name: reg + "." + repository},
+			Spec: securityv1alpha1.ImageCertificationInfoSpec{
+				ImageDigest: "sha256:abc12345",
+				Registry:    reg,
+				Repository:  repository,
+			},
+			Status: securityv1alpha1.ImageCertificationInfoStatus{SBOM: sbom},
+		}
+	}
+	recently := metav1.NewTime(time.Now().Add(-time.Hour))
+	longAgo := metav1.NewTime(time.Now().Add(-2 * sbomRecheckInterval))
+	crs := []*securityv1alpha1.ImageCertificationInfo{
+		newCR("ghcr.io", "org.new", nil),
+		// An image found without an SBOM long ago is checked again
+		newCR("ghcr.io", "org.stale", &securityv1alpha1.SBOMData{SyncedAt: &longAgo}),
+		// Recently checked images and images with an SBOM are not
+		newCR("ghcr.io", "org.recent", &securityv1alpha1.SBOMData{SyncedAt: &recently}),
+		newCR("ghcr.io", "org.found", &securityv1alpha1.SBOMData{Present: true, SyncedAt: &longAgo}),
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, cr := range crs {
+		builder = builder.WithObjects(cr).WithStatusSubresource(cr)
+	}
+	fakeClient := builder.Build()
+
+	mockSBOM := &MockSBOMClient{SBOM: &registry.SBOM{
+		Present:      true,
+		Format:       registry.SBOMFormatSPDX,
+		ArtifactType: "application/spdx+json",
+		Digest:       "sha256:sbom",
+		Summary:      &registry.SBOMSummary{PackageCount: 42, TopLicenses: []string{"MIT"}},
+	}}
+	reconciler := &PodReconciler{
+		Client:     fakeClient,
+		Scheme:     scheme,
+		SBOMClient: mockSBOM,
+	}
+
+	if err := reconciler.RefreshAllImages(ctx); err != nil {
+		t.Fatalf("RefreshAllImages() error = %v", err)
+	}
+	slices.Sort(mockSBOM.Calls)
+	if want := []string{"ghcr.io/org.new", "ghcr.io/org.stale"}; !slices.Equal(mockSBOM.Calls, want) {
+		t.Errorf("SBOM client called for %v, want %v", mockSBOM.Calls, want)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "ghcr.io.org.new"}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	sbom := cr.Status.SBOM
+	if sbom == nil || !sbom.Present || sbom.Format != registry.SBOMFormatSPDX || sbom.Digest != "sha256:sbom" {
+		t.Fatalf("SBOM = %+v, want the discovered SPDX SBOM", sbom)
+	}
+	if sbom.PackageCount == nil || *sbom.PackageCount != 42 || !slices.Equal(sbom.TopLicenses, []string{"MIT"}) {
+		t.Errorf("SBOM summary = %v, %v, want 42 packages licensed MIT", sbom.PackageCount, sbom.TopLicenses)
+	}
+	if !slices.ContainsFunc(cr.Status.DataSources, func(ds securityv1alpha1.DataSource) bool {
+		return ds.Name == securityv1alpha1.DataSourceReferrers && slices.Equal(ds.Fields, []string{fieldSBOM})
+	}) {
+		t.Errorf("DataSources = %+v, want a Referrers entry", cr.Status.DataSources)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// ScanPods discovers the images used by running and pending pods and enriches them from
+// Pyxis and Docker Hub as the operator would, but in memory: no ImageCertificationInfo
+// resources are read or written, so it works where the CRDs are not installed. Images are
+// returned sorted by name. A failed Pyxis lookup leaves the image in the Error status.
+func (r *PodReconciler) ScanPods(ctx context.Context, pods []corev1.Pod) []securityv1alpha1.ImageCertificationInfo {
+	now := metav1.Now()
+	images := make(map[string]*securityv1alpha1.ImageCertificationInfo)
+	refs := make(map[string]*image.Reference)
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, discovered := range r.discoverImages(ctx, pod) {
+			cr, ok := images[discovered.crName]
+			if !ok {
+				cr = r.newImageCertificationInfo(discovered.ref, discovered.crName, now)
+				setPodReferences(cr, []securityv1alpha1.PodReference{discovered.podRef})
+				if discovered.static {
+					setImageSource(cr, ImageSourceStatic)
+				}
+				images[discovered.crName] = cr
+				refs[discovered.crName] = discovered.ref
+				continue
+			}
+			if !slices.Contains(cr.Status.PodReferences, discovered.podRef) {
+				setPodReferences(cr, append(cr.Status.PodReferences, discovered.podRef))
+			}
+		}
+	}
+
+	result := make([]securityv1alpha1.ImageCertificationInfo, 0, len(images))
+	for _, name := range slices.Sorted(maps.Keys(images)) {
+		// Images left when the scan is cancelled are reported unenriched
+		if ctx.Err() == nil {
+			r.scanImage(ctx, images[name], refs[name])
+		}
+		result = append(result, *images[name])
+	}
+	return result
+}
+
+// scanImage enriches an image found by ScanPods from the provider for its registry
+func (r *PodReconciler) scanImage(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo, ref *image.Reference) {
+	logger := log.FromContext(ctx).WithValues("image", cr.Name)
+	callCtx, cancel := r.enrichmentContext(ctx)
+	defer cancel()
+
+	switch {
+	case r.PyxisClient != nil && r.Registries.PyxisEligible(ref.Registry):
+		certData, err := r.PyxisClient.GetImageCertification(callCtx, ref.Registry, ref.Repository, ref.Digest)
+		if err != nil {
+			logger.Error(err, "failed to query Pyxis API")
+			now := metav1.Now()
+			cr.Status.LastPyxisCheckAt = &now
+			setCertificationStatus(cr, securityv1alpha1.CertificationStatusError, securityv1alpha1.ReasonPyxisQueryFailed)
+			return
+		}
+		r.recordPyxisResult(cr, certData)
+	case r.DockerHubClient != nil && ref.Registry == RegistryDockerHub:
+		namespace, repo := parseDockerHubRepo(ref.Repository)
+		repoInfo, err := r.DockerHubClient.GetRepositoryInfo(callCtx, namespace, repo)
+		if err != nil {
+			logger.Error(err, "failed to query Docker Hub API")
+			return
+		}
+		if repoInfo != nil {
+			r.updateCRWithDockerHubData(cr, repoInfo)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis"
+)
+
+func newScanTestPod(name string, phase corev1.PodPhase, imageID string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: testContainer}},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: testContainer, ImageID: imageID},
+			},
+		},
+	}
+}
+
+func TestPodReconciler_ScanPods(t *testing.T) {
+	ubi := "docker-pullable://registry.redhat.io/ubi8/ubi@" + testDigest
+	pods := []corev1.Pod{
+		newScanTestPod("app-b", corev1.PodRunning, ubi),
+		newScanTestPod("app-a", corev1.PodPending, ubi),
+		newScanTestPod("done", corev1.PodSucceeded, ubi),
+		newScanTestPod("other", corev1.PodRunning, "quay.io/example/app@"+testDigest),
+	}
+
+	reconciler := &PodReconciler{
+		PyxisClient: &MockPyxisClient{CertData: &pyxis.CertificationData{HealthIndex: "A"}},
+	}
+	images := reconciler.ScanPods(context.Background(), pods)
+
+	if len(images) != 2 {
+		t.Fatalf("ScanPods() returned %d images, want 2", len(images))
+	}
+	if images[0].Name != "quay.io.example.app.abc123de" || images[1].Name != testCRName {
+		t.Fatalf("ScanPods() names = %s, %s, want sorted by name", images[0].Name, images[1].Name)
+	}
+
+	quay := images[0]
+	if quay.Status.CertificationStatus != securityv1alpha1.CertificationStatusUnknown {
+		t.Errorf("quay.io CertificationStatus = %s, want Unknown", quay.Status.CertificationStatus)
+	}
+
+	ubiCR := images[1]
+	if ubiCR.Status.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
+		t.Errorf("CertificationStatus = %s, want Certified", ubiCR.Status.CertificationStatus)
+	}
+	if ubiCR.Status.PyxisData == nil || ubiCR.Status.PyxisData.HealthIndex != "A" {
+		t.Errorf("PyxisData = %+v, want health index A", ubiCR.Status.PyxisData)
+	}
+	if len(ubiCR.Status.PodReferences) != 2 {
+		t.Fatalf("PodReferences = %+v, want the running and pending pods", ubiCR.Status.PodReferences)
+	}
+	for _, ref := range ubiCR.Status.PodReferences {
+		if ref.Name == "done" {
+			t.Error("PodReferences includes a completed pod")
+		}
+	}
+}
+
+func TestPodReconciler_ScanPods_PyxisError(t *testing.T) {
+	pods := []corev1.Pod{
+		newScanTestPod("app", corev1.PodRunning, "registry.redhat.io/ubi8/ubi@"+testDigest),
+	}
+
+	reconciler := &PodReconciler{
+		PyxisClient: &MockPyxisClient{Err: errors.New("pyxis unavailable")},
+	}
+	images := reconciler.ScanPods(context.Background(), pods)
+
+	if len(images) != 1 {
+		t.Fatalf("ScanPods() returned %d images, want 1", len(images))
+	}
+	if images[0].Status.CertificationStatus != securityv1alpha1.CertificationStatusError {
+		t.Errorf("CertificationStatus = %s, want Error", images[0].Status.CertificationStatus)
+	}
+	if images[0].Status.ReasonCode != securityv1alpha1.ReasonPyxisQueryFailed {
+		t.Errorf("ReasonCode = %s, want %s", images[0].Status.ReasonCode, securityv1alpha1.ReasonPyxisQueryFailed)
+	}
+}
+
+func TestPodReconciler_ScanPods_Cancelled(t *testing.T) {
+	pods := []corev1.Pod{
+		newScanTestPod("app", corev1.PodRunning, "registry.redhat.io/ubi8/ubi@"+testDigest),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reconciler := &PodReconciler{
+		PyxisClient: &MockPyxisClient{CertData: &pyxis.CertificationData{HealthIndex: "A"}},
+	}
+	images := reconciler.ScanPods(ctx, pods)
+
+	if len(images) != 1 {
+		t.Fatalf("ScanPods() returned %d images, want 1", len(images))
+	}
+	if images[0].Status.PyxisData != nil {
+		t.Error("ScanPods() enriched an image after cancellation")
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"time"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// DefaultStalenessHorizon is the default time after the last successful lookup at which a
+// certification status is no longer trusted
+const DefaultStalenessHorizon = 7 * 24 * time.Hour
+
+// statusProviders maps the reasons of provider verdicts to the provider that gave them
+var statusProviders = map[securityv1alpha1.ConditionReason]string{
+	securityv1alpha1.ReasonCertifiedByPyxis:        securityv1alpha1.DataSourcePyxis,
+	securityv1alpha1.ReasonNotFoundInPyxis:         securityv1alpha1.DataSourcePyxis,
+	securityv1alpha1.ReasonPastEndOfLife:           securityv1alpha1.DataSourcePyxis,
+	securityv1alpha1.ReasonDeprecatedRelease:       securityv1alpha1.DataSourcePyxis,
+	securityv1alpha1.ReasonDockerOfficialImage:     securityv1alpha1.DataSourceDockerHub,
+	securityv1alpha1.ReasonDockerVerifiedPublisher: securityv1alpha1.DataSourceDockerHub,
+	securityv1alpha1.ReasonNoDockerHubTrustProgram: securityv1alpha1.DataSourceDockerHub,
+}
+
+// applyStaleness downgrades the certification status of an image to Unknown when the
+// provider that determined it has not been read successfully within horizon of now, and
+// reports whether it did. The provider data is kept, so the last known verdict can still
+// be inspected, and the next successful lookup restores the status. Images without a
+// recorded read of their provider are left unchanged, as is every image when horizon is zero.
+func applyStaleness(cr *securityv1alpha1.ImageCertificationInfo, horizon time.Duration, now time.Time) bool {
+	provider, ok := statusProviders[cr.Status.ReasonCode]
+	if horizon <= 0 || !ok {
+		return false
+	}
+	i := slices.IndexFunc(cr.Status.DataSources, func(ds securityv1alpha1.DataSource) bool {
+		return ds.Name == provider
+	})
+	if i < 0 || now.Sub(cr.Status.DataSources[i].SyncedAt.Time) < horizon {
+		return false
+	}
+	setCertificationStatus(cr, securityv1alpha1.CertificationStatusUnknown, securityv1alpha1.ReasonCertificationStale)
+	return true
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestApplyStaleness(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	horizon := 7 * 24 * time.Hour
+	image := func(status securityv1alpha1.CertificationStatus, reason securityv1alpha1.ConditionReason,
+		provider string, syncedAgo time.Duration) *securityv1alpha1.ImageCertificationInfo {
+		cr := &securityv1alpha1.ImageCertificationInfo{}
+		cr.Status.CertificationStatus = status
+		cr.Status.ReasonCode = reason
+		if provider != "" {
+			cr.Status.DataSources = []securityv1alpha1.DataSource{
+				{Name: provider, SyncedAt: metav1.NewTime(now.Add(-syncedAgo))},
+			}
+		}
+		return cr
+	}
+
+	tests := []struct {
+		name      string
+		cr        *securityv1alpha1.ImageCertificationInfo
+		horizon   time.Duration
+		wantStale bool
+	}{
+		{"certified recently", image(securityv1alpha1.CertificationStatusCertified,
+			securityv1alpha1.ReasonCertifiedByPyxis, securityv1alpha1.DataSourcePyxis, 24*time.Hour), horizon, false},
+		{"certified long ago", image(securityv1alpha1.CertificationStatusCertified,
+			securityv1alpha1.ReasonCertifiedByPyxis, securityv1alpha1.DataSourcePyxis, 8*24*time.Hour), horizon, true},
+		{"official long ago", image(securityv1alpha1.CertificationStatusOfficial,
+			securityv1alpha1.ReasonDockerOfficialImage, securityv1alpha1.DataSourceDockerHub, 30*24*time.Hour), horizon, true},
+		{"only another provider read", image(securityv1alpha1.CertificationStatusOfficial,
+			securityv1alpha1.ReasonDockerOfficialImage, securityv1alpha1.DataSourcePyxis, 30*24*time.Hour), horizon, false},
+		{"no recorded read", image(securityv1alpha1.CertificationStatusCertified,
+			securityv1alpha1.ReasonCertifiedByPyxis, "", 0), horizon, false},
+		{"lookup failed", image(securityv1alpha1.CertificationStatusError,
+			securityv1alpha1.ReasonPyxisQueryFailed, securityv1alpha1.DataSourcePyxis, 30*24*time.Hour), horizon, false},
+		{"disabled", image(securityv1alpha1.CertificationStatusCertified,
+			securityv1alpha1.ReasonCertifiedByPyxis, securityv1alpha1.DataSourcePyxis, 30*24*time.Hour), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldStatus := tt.cr.Status.CertificationStatus
+			if got := applyStaleness(tt.cr, tt.horizon, now); got != tt.wantStale {
+				t.Errorf("applyStaleness() = %v, want %v", got, tt.wantStale)
+			}
+			wantStatus, wantReason := oldStatus, tt.cr.Status.ReasonCode
+			if tt.wantStale {
+				wantStatus, wantReason = securityv1alpha1.CertificationStatusUnknown,
+					securityv1alpha1.ReasonCertificationStale
+			}
+			if tt.cr.Status.CertificationStatus != wantStatus || tt.cr.Status.ReasonCode != wantReason {
+				t.Errorf("status = %s/%s, want %s/%s", tt.cr.Status.CertificationStatus, tt.cr.Status.ReasonCode,
+					wantStatus, wantReason)
+			}
+			// Applying again is a no-op
+			if applyStaleness(tt.cr, tt.horizon, now) {
+				t.Error("applyStaleness() reported a change on the second call")
+			}
+		})
+	}
+}
+
+func TestLifecycleEvaluator_EvaluateStaleness(t *testing.T) {
+	ctx := context.Background()
+
+	// Certified by a Pyxis lookup ten days ago that has not succeeded since
+	cr := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: testCRName}}
+	cr.Status.CertificationStatus = securityv1alpha1.CertificationStatusCertified
+	cr.Status.ReasonCode = securityv1alpha1.ReasonCertifiedByPyxis
+	cr.Status.PyxisData = &securityv1alpha1.PyxisData{HealthIndex: "A"}
+	cr.Status.DataSources = []securityv1alpha1.DataSource{
+		{Name: securityv1alpha1.DataSourcePyxis, SyncedAt: metav1.NewTime(time.Now().Add(-10 * 24 * time.Hour))},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(cr).
+		WithStatusSubresource(cr).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	evaluator := &LifecycleEvaluator{
+		Pods:             &PodReconciler{Client: fakeClient, Recorder: recorder},
+		StalenessHorizon: DefaultStalenessHorizon,
+	}
+
+	if err := evaluator.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+
+	var got securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &got); err != nil {
+		t.Fatalf("Failed to get CR: %v", err)
+	}
+	if got.Status.CertificationStatus != securityv1alpha1.CertificationStatusUnknown ||
+		got.Status.ReasonCode != securityv1alpha1.ReasonCertificationStale {
+		t.Errorf("status = %s/%s, want %s/%s", got.Status.CertificationStatus, got.Status.ReasonCode,
+			securityv1alpha1.CertificationStatusUnknown, securityv1alpha1.ReasonCertificationStale)
+	}
+	if got.Status.PyxisData == nil || got.Status.PyxisData.HealthIndex != "A" {
+		t.Errorf("PyxisData = %+v, want the last known Pyxis data kept", got.Status.PyxisData)
+	}
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if len(events) != 1 || !strings.Contains(events[0], "from Certified to Unknown") {
+		t.Errorf("events = %v, want one certification change to Unknown", events)
+	}
+
+	// A second evaluation changes nothing
+	if err := evaluator.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("unexpected event on second evaluation: %s", <-recorder.Events)
+	}
+}
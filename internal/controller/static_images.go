@@ -0,0 +1,266 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// LabelImageSource marks ImageCertificationInfos discovered outside ordinary pods
+const LabelImageSource = "security.telco.openshift.io/source"
+
+// ImageSourceStatic is the LabelImageSource value of images run by static pods, found
+// through their mirror pods or the status of control-plane nodes
+const ImageSourceStatic = "static"
+
+// annotationMirrorPod is set by the kubelet on the mirror pods of static pods
+const annotationMirrorPod = "kubernetes.io/config.mirror"
+
+// controlPlaneNodeLabels mark the nodes running the static control-plane pods
+var controlPlaneNodeLabels = []string{
+	"node-role.kubernetes.io/control-plane",
+	"node-role.kubernetes.io/master",
+}
+
+// isMirrorPod reports whether pod is the API server mirror of a static pod
+func isMirrorPod(pod *corev1.Pod) bool {
+	_, ok := pod.Annotations[annotationMirrorPod]
+	return ok
+}
+
+// isControlPlaneNode reports whether node carries a control-plane role label
+func isControlPlaneNode(node *corev1.Node) bool {
+	return slices.ContainsFunc(controlPlaneNodeLabels, func(label string) bool {
+		_, ok := node.Labels[label]
+		return ok
+	})
+}
+
+// setImageSource labels cr with the source it was discovered from
+func setImageSource(cr *securityv1alpha1.ImageCertificationInfo, source string) {
+	if cr.Labels == nil {
+		cr.Labels = make(map[string]string)
+	}
+	cr.Labels[LabelImageSource] = source
+}
+
+// labelImageSource adds the source label to an existing ImageCertificationInfo unless it has it
+func (r *PodReconciler) labelImageSource(ctx context.Context, cr *securityv1alpha1.ImageCertificationInfo, source string) error {
+	if cr.Labels[LabelImageSource] == source {
+		return nil
+	}
+	status := cr.Status
+	setImageSource(cr, source)
+	if err := r.Update(ctx, cr); err != nil {
+		return err
+	}
+	// The update returns the stored status, so keep any pending status changes
+	cr.Status = status
+	return nil
+}
+
+// nodeImages returns the images a node reports in its status, keyed by ImageCertificationInfo
+// name. Only images listed by digest can be tracked; the first tagged name of an image is used
+// to resolve mirrored pulls, like the container status image of a pod.
+func (r *PodReconciler) nodeImages(ctx context.Context, node *corev1.Node) map[string]*image.Reference {
+	logger := log.FromContext(ctx)
+	images := make(map[string]*image.Reference)
+	for _, nodeImage := range node.Status.Images {
+		var digestName, taggedName string
+		for _, name := range nodeImage.Names {
+			if strings.Contains(name, "@sha256:") {
+				if digestName == "" {
+					digestName = name
+				}
+			} else if taggedName == "" {
+				taggedName = name
+			}
+		}
+		if digestName == "" {
+			continue
+		}
+
+		ref, err := image.ParseImageID(digestName)
+		if err != nil {
+			logger.V(1).Info("failed to parse node image", "node", node.Name, "image", digestName, "error", err)
+			continue
+		}
+		ref = image.ResolveSource(ref, taggedName, r.Mirrors)
+		images[image.ReferenceToCRName(ref)] = ref
+	}
+	return images
+}
+
+// NodeImageReconciler tracks the images of static pods from the status of control-plane nodes.
+// Static pods are only visible through mirror pods, which some distributions name unusually or
+// recreate often, so the images a control-plane node reports that none of its ordinary pods
+// use are also recorded from the node. Such images are labeled source=static and list the
+// node in status.nodeReferences.
+type NodeImageReconciler struct {
+	client.Client
+	// Pods creates and enriches newly discovered images and decides which images this instance owns
+	Pods *PodReconciler
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+// Reconcile records the node in the static pod images it reports and removes it from the
+// images it no longer reports, including all images of a deleted node
+func (r *NodeImageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	logger := log.FromContext(ctx)
+
+	images := map[string]*image.Reference{}
+	var node corev1.Node
+	err := r.Get(ctx, req.NamespacedName, &node)
+	if err != nil && !apierrors.IsNotFound(err) {
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "nodeimage")
+		return ctrl.Result{}, err
+	}
+	if err == nil && isControlPlaneNode(&node) {
+		if images, err = r.staticImages(ctx, &node); err != nil {
+			metrics.RecordReconcile("error", time.Since(start).Seconds(), "nodeimage")
+			return ctrl.Result{}, err
+		}
+	}
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := r.List(ctx, &crList); err != nil {
+		metrics.RecordReconcile("error", time.Since(start).Seconds(), "nodeimage")
+		return ctrl.Result{}, err
+	}
+
+	now := metav1.Now()
+	for i := range crList.Items {
+		cr := &crList.Items[i]
+		_, reported := images[cr.Name]
+		delete(images, cr.Name)
+		if !r.Pods.Shard.Owns(cr.Name) || reported == slices.Contains(cr.Status.NodeReferences, req.Name) {
+			continue
+		}
+
+		if reported {
+			if err := r.Pods.labelImageSource(ctx, cr, ImageSourceStatic); err != nil {
+				logger.Error(err, "failed to label ImageCertificationInfo", "name", cr.Name)
+				continue
+			}
+			cr.Status.NodeReferences = append(cr.Status.NodeReferences, req.Name)
+			slices.Sort(cr.Status.NodeReferences)
+			cr.Status.LastSeenAt = &now
+			cr.Status.OrphanedAt = nil
+		} else {
+			cr.Status.NodeReferences = slices.DeleteFunc(cr.Status.NodeReferences,
+				func(name string) bool { return name == req.Name })
+			if len(cr.Status.NodeReferences) == 0 && len(cr.Status.PodReferences) == 0 {
+				cr.Status.OrphanedAt = &now
+			}
+		}
+		if err := r.Status().Update(ctx, cr); err != nil {
+			logger.Error(err, "failed to update node references", "name", cr.Name)
+		}
+	}
+
+	// Images not tracked yet are discovered from the node
+	for crName, ref := range images {
+		if !r.Pods.Shard.Owns(crName) {
+			continue
+		}
+		cr := r.Pods.newImageCertificationInfo(ref, crName, now)
+		cr.Status.NodeReferences = []string{req.Name}
+		setImageSource(cr, ImageSourceStatic)
+		if err := r.Pods.createImageCertificationInfo(ctx, ref, cr); err != nil {
+			logger.Error(err, "failed to create ImageCertificationInfo", "name", crName)
+			continue
+		}
+		logger.Info("created ImageCertificationInfo from node status", "name", crName, "node", req.Name)
+	}
+
+	metrics.RecordReconcile("success", time.Since(start).Seconds(), "nodeimage")
+	return ctrl.Result{}, nil
+}
+
+// staticImages returns the images a control-plane node reports that are not used by any of
+// its ordinary pods: those of static pods, whether or not their mirror pods are visible
+func (r *NodeImageReconciler) staticImages(ctx context.Context, node *corev1.Node) (map[string]*image.Reference, error) {
+	images := r.Pods.nodeImages(ctx, node)
+
+	var podList corev1.PodList
+	if err := r.List(ctx, &podList, client.UnsafeDisableDeepCopy); err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName != node.Name || isMirrorPod(pod) {
+			continue
+		}
+		// Every container counts, including those of categories excluded from discovery
+		statuses := slices.Concat(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses,
+			pod.Status.EphemeralContainerStatuses)
+		for _, status := range statuses {
+			ref, err := r.Pods.containerImage(pod, status)
+			if err != nil {
+				continue
+			}
+			delete(images, image.ReferenceToCRName(ref))
+		}
+	}
+	return images, nil
+}
+
+// nodeImagesChanged passes node updates that change the reported images or the node's roles
+func nodeImagesChanged(e event.UpdateEvent) bool {
+	oldNode, okOld := e.ObjectOld.(*corev1.Node)
+	newNode, okNew := e.ObjectNew.(*corev1.Node)
+	if !okOld || !okNew {
+		return false
+	}
+	return isControlPlaneNode(oldNode) != isControlPlaneNode(newNode) ||
+		!slices.EqualFunc(oldNode.Status.Images, newNode.Status.Images, func(a, b corev1.ContainerImage) bool {
+			return slices.Equal(a.Names, b.Names)
+		})
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *NodeImageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Node{}, builder.WithPredicates(predicate.Funcs{UpdateFunc: nodeImagesChanged})).
+		Named("nodeimage")
+	if r.Pods.Shard != nil {
+		// Every instance tracks the images of its own shard, like the Pod controller
+		b = b.WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)})
+	}
+	return b.Complete(r)
+}
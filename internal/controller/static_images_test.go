@@ -0,0 +1,246 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+const (
+	etcdDigest    = "sha256:e7cde7cde7cde7cde7cde7cde7cde7cde7cde7cde7cde7cde7cde7cde7cde7cd"
+	etcdCRName    = "quay.io.openshift.etcd.e7cde7cd"
+	staleCRName   = "quay.io.openshift.old.11111111"
+	controlPlane1 = "master-0"
+)
+
+func TestPodReconciler_Reconcile_MirrorPod(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	mirrorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "etcd-" + controlPlane1,
+			Namespace:   "kube-system",
+			Annotations: map[string]string{annotationMirrorPod: "0123abcd"},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "etcd"}}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "etcd", ImageID: "quay.io/openshift/etcd@" + etcdDigest},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mirrorPod).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+		Build()
+
+	reconciler := &PodReconciler{Client: fakeClient, Scheme: scheme}
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mirrorPod)}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: etcdCRName}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	if cr.Labels[LabelImageSource] != ImageSourceStatic {
+		t.Errorf("labels = %v, want %s=%s", cr.Labels, LabelImageSource, ImageSourceStatic)
+	}
+	if len(cr.Status.PodReferences) != 1 {
+		t.Errorf("PodReferences = %+v, want the mirror pod", cr.Status.PodReferences)
+	}
+}
+
+func TestNodeImageReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   controlPlane1,
+			Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""},
+		},
+		Status: corev1.NodeStatus{
+			Images: []corev1.ContainerImage{
+				// Run by a static pod without a visible mirror pod
+				{Names: []string{"quay.io/openshift/etcd@" + etcdDigest, "quay.io/openshift/etcd:4.18"}},
+				// Used by an ordinary pod on the node
+				{Names: []string{"registry.redhat.io/ubi8/ubi@" + testDigest}},
+				// Only known by tag
+				{Names: []string{"quay.io/openshift/pause:latest"}},
+			},
+		},
+	}
+	appPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec:       corev1.PodSpec{NodeName: controlPlane1, Containers: []corev1.Container{{Name: testContainer}}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: testContainer, ImageID: "registry.redhat.io/ubi8/ubi@" + testDigest},
+			},
+		},
+	}
+	// The node stopped reporting an image it listed before
+	stale := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: staleCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			NodeReferences: []string{controlPlane1},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(node, appPod, stale).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+		Build()
+
+	podReconciler := &PodReconciler{Client: fakeClient, Scheme: scheme}
+	reconciler := &NodeImageReconciler{Client: fakeClient, Pods: podReconciler}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: controlPlane1}}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := fakeClient.List(ctx, &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("got %d ImageCertificationInfos, want the static image and the stale one", len(list.Items))
+	}
+
+	var etcd securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: etcdCRName}, &etcd); err != nil {
+		t.Fatalf("Failed to get static image: %v", err)
+	}
+	if etcd.Labels[LabelImageSource] != ImageSourceStatic {
+		t.Errorf("labels = %v, want %s=%s", etcd.Labels, LabelImageSource, ImageSourceStatic)
+	}
+	if !slices.Equal(etcd.Status.NodeReferences, []string{controlPlane1}) {
+		t.Errorf("NodeReferences = %v, want [%s]", etcd.Status.NodeReferences, controlPlane1)
+	}
+	if etcd.Spec.Registry != "quay.io" || etcd.Spec.Repository != "openshift/etcd" {
+		t.Errorf("spec = %+v", etcd.Spec)
+	}
+
+	var updated securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: staleCRName}, &updated); err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Status.NodeReferences) != 0 || updated.Status.OrphanedAt == nil {
+		t.Errorf("stale image status = %+v, want no node references and orphaned", updated.Status)
+	}
+
+	// A static image is still in use while a node lists it
+	if err := podReconciler.CleanupStaleReferences(ctx); err != nil {
+		t.Fatalf("CleanupStaleReferences() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: etcdCRName}, &etcd); err != nil {
+		t.Fatal(err)
+	}
+	if etcd.Status.OrphanedAt != nil {
+		t.Error("static image was marked orphaned")
+	}
+
+	// Deleting the node releases its images
+	if err := fakeClient.Delete(ctx, node); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: etcdCRName}, &etcd); err != nil {
+		t.Fatal(err)
+	}
+	if len(etcd.Status.NodeReferences) != 0 || etcd.Status.OrphanedAt == nil {
+		t.Errorf("status after node deletion = %+v, want no node references and orphaned", etcd.Status)
+	}
+}
+
+func TestNodeImageReconciler_WorkerNode(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme()
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+		Status: corev1.NodeStatus{
+			Images: []corev1.ContainerImage{{Names: []string{"quay.io/openshift/etcd@" + etcdDigest}}},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(node).Build()
+
+	reconciler := &NodeImageReconciler{Client: fakeClient, Pods: &PodReconciler{Client: fakeClient, Scheme: scheme}}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: node.Name}}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := fakeClient.List(ctx, &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 0 {
+		t.Errorf("got %d ImageCertificationInfos from a worker node, want 0", len(list.Items))
+	}
+}
+
+func TestNodeImagesChanged(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Images: []corev1.ContainerImage{{Names: []string{"quay.io/openshift/etcd@" + etcdDigest}}},
+		},
+	}
+	heartbeat := node.DeepCopy()
+	heartbeat.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+	pulled := node.DeepCopy()
+	pulled.Status.Images = append(pulled.Status.Images, corev1.ContainerImage{Names: []string{"quay.io/other@" + testDigest}})
+	promoted := node.DeepCopy()
+	promoted.Labels = map[string]string{"node-role.kubernetes.io/master": ""}
+
+	tests := []struct {
+		name string
+		new  *corev1.Node
+		want bool
+	}{
+		{name: "status heartbeat", new: heartbeat, want: false},
+		{name: "image pulled", new: pulled, want: true},
+		{name: "role changed", new: promoted, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nodeImagesChanged(event.UpdateEvent{ObjectOld: node, ObjectNew: tt.new}); got != tt.want {
+				t.Errorf("nodeImagesChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// pullFailureReasons are the waiting reasons of containers whose image could not be pulled
+var pullFailureReasons = []string{"ErrImagePull", "ImagePullBackOff"}
+
+// pullFailed reports whether a container is waiting because its image could not be pulled
+func pullFailed(status corev1.ContainerStatus) bool {
+	return status.ImageID == "" && status.State.Waiting != nil &&
+		slices.Contains(pullFailureReasons, status.State.Waiting.Reason)
+}
+
+// unpulledImage returns the image a container that could not pull it asks for in the pod
+// spec. A tag is resolved to the digest it points to when the registry allows it; otherwise
+// the reference has no digest and is tracked under its tag.
+func (r *PodReconciler) unpulledImage(ctx context.Context, pod *corev1.Pod, name string) *image.Reference {
+	specImage := specImage(pod, name)
+	if specImage == "" {
+		return nil
+	}
+	ref := image.ParseSpecImage(specImage)
+	if ref.Digest != "" || r.TagResolver == nil {
+		return ref
+	}
+
+	callCtx, cancel := r.enrichmentContext(ctx)
+	digest, err := r.TagResolver.ResolveTag(callCtx, ref.Registry, ref.Repository, ref.Tag)
+	cancel()
+	if err != nil {
+		log.FromContext(ctx).V(1).Info("failed to resolve image tag", "image", specImage, "error", err.Error())
+	}
+	ref.Digest = digest
+	return ref
+}
+
+// releaseProvisionalImage removes a pod container from the provisional record that tracked
+// its image by tag while the image could not be pulled, once the container has pulled it
+func (r *PodReconciler) releaseProvisionalImage(ctx context.Context, pod *corev1.Pod, name string) error {
+	specImage := specImage(pod, name)
+	if specImage == "" {
+		return nil
+	}
+	ref := image.ParseSpecImage(specImage)
+	if ref.Digest != "" {
+		// Images pinned by digest were tracked under the same record they now use
+		return nil
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := r.Get(ctx, client.ObjectKey{Name: image.ReferenceToCRName(ref)}, &cr); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	refs := slices.DeleteFunc(slices.Clone(cr.Status.PodReferences), func(ref securityv1alpha1.PodReference) bool {
+		return ref.Namespace == pod.Namespace && ref.Name == pod.Name && ref.Container == name
+	})
+	if len(refs) == len(cr.Status.PodReferences) {
+		return nil
+	}
+	setPodReferences(&cr, refs)
+	if err := r.Status().Update(ctx, &cr); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
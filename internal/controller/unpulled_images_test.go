@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// mockTagResolver resolves every tag to Digest
+type mockTagResolver struct {
+	Digest string
+}
+
+func (m *mockTagResolver) ResolveTag(ctx context.Context, registry, repository, tag string) (string, error) {
+	return m.Digest, nil
+}
+
+// newUnpulledPod returns a pending pod whose container cannot pull specImage
+func newUnpulledPod(specImage string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: testPodName, Namespace: testNamespace},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: testContainer, Image: specImage}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  testContainer,
+				Image: specImage,
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}},
+		},
+	}
+}
+
+func TestPodReconciler_Reconcile_UnpulledImage(t *testing.T) {
+	ctx := context.Background()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testPodName, Namespace: testNamespace}}
+
+	tests := []struct {
+		name       string
+		resolved   string
+		wantCRName string
+		wantDigest string
+	}{
+		{"tag resolved to a digest", testDigest, testCRName, testDigest},
+		{"tag not resolved", "", "registry.redhat.io.ubi8.ubi.tag-8.9", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(newTestScheme()).
+				WithObjects(newUnpulledPod("registry.redhat.io/ubi8/ubi:8.9")).
+				WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+				Build()
+			r := &PodReconciler{
+				Client:              fakeClient,
+				TrackUnpulledImages: true,
+				TagResolver:         &mockTagResolver{Digest: tt.resolved},
+			}
+			if _, err := r.Reconcile(ctx, req); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			var cr securityv1alpha1.ImageCertificationInfo
+			if err := fakeClient.Get(ctx, client.ObjectKey{Name: tt.wantCRName}, &cr); err != nil {
+				t.Fatalf("Failed to get ImageCertificationInfo %s: %v", tt.wantCRName, err)
+			}
+			if cr.Spec.ImageDigest != tt.wantDigest || cr.Spec.Tag != "8.9" {
+				t.Errorf("digest = %q, tag = %q, want %q and 8.9", cr.Spec.ImageDigest, cr.Spec.Tag, tt.wantDigest)
+			}
+			if cr.Status.CertificationStatus != securityv1alpha1.CertificationStatusPending ||
+				cr.Status.ReasonCode != securityv1alpha1.ReasonImagePullFailed {
+				t.Errorf("status = %s/%s, want Pending/ImagePullFailed",
+					cr.Status.CertificationStatus, cr.Status.ReasonCode)
+			}
+			if len(cr.Status.PodReferences) != 1 {
+				t.Errorf("PodReferences = %v, want the pod", cr.Status.PodReferences)
+			}
+		})
+	}
+}
+
+func TestPodReconciler_Reconcile_UnpulledImageReleased(t *testing.T) {
+	ctx := context.Background()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testPodName, Namespace: testNamespace}}
+	const provisional = "registry.redhat.io.ubi8.ubi.tag-8.9"
+
+	pod := newUnpulledPod("registry.redhat.io/ubi8/ubi:8.9")
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(pod).
+		WithStatusSubresource(&securityv1alpha1.ImageCertificationInfo{}).
+		Build()
+	r := &PodReconciler{Client: fakeClient, TrackUnpulledImages: true}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// Once the image is pulled, the pod moves to the digest-keyed record
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	pod.Status.ContainerStatuses[0].ImageID = "registry.redhat.io/ubi8/ubi@" + testDigest
+	if err := fakeClient.Status().Update(ctx, pod); err != nil {
+		t.Fatalf("Failed to update pod: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: provisional}, &cr); err != nil {
+		t.Fatalf("Failed to get provisional ImageCertificationInfo: %v", err)
+	}
+	if len(cr.Status.PodReferences) != 0 {
+		t.Errorf("provisional PodReferences = %v, want none", cr.Status.PodReferences)
+	}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: testCRName}, &cr); err != nil {
+		t.Fatalf("Failed to get ImageCertificationInfo: %v", err)
+	}
+	if len(cr.Status.PodReferences) != 1 {
+		t.Errorf("PodReferences = %v, want the pod", cr.Status.PodReferences)
+	}
+}
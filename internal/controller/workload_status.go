@@ -0,0 +1,334 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// Annotations written onto Deployments and StatefulSets
+const (
+	AnnotationWorstCertificationStatus = "security.telco.openshift.io/worst-certification-status"
+	AnnotationCertificationSummary     = "security.telco.openshift.io/certification-summary"
+	AnnotationCriticalCVEs             = "security.telco.openshift.io/critical-cves"
+)
+
+// DefaultWorkloadStatusWriteRate is the default number of workload patches per second
+const DefaultWorkloadStatusWriteRate = 1.0
+
+// certificationRisk orders certification statuses from least to most concerning.
+// A known uncertified image ranks above a failed lookup, and an image past end-of-life
+// just below it.
+var certificationRisk = map[securityv1alpha1.CertificationStatus]int{
+	securityv1alpha1.CertificationStatusCertified:    0,
+	securityv1alpha1.CertificationStatusOfficial:     0,
+	securityv1alpha1.CertificationStatusVerified:     0,
+	securityv1alpha1.CertificationStatusDeprecated:   1,
+	securityv1alpha1.CertificationStatusPending:      2,
+	securityv1alpha1.CertificationStatusUnknown:      3,
+	securityv1alpha1.CertificationStatusError:        4,
+	securityv1alpha1.CertificationStatusEOL:          5,
+	securityv1alpha1.CertificationStatusNotCertified: 6,
+}
+
+// workloadKey identifies a Deployment or StatefulSet
+type workloadKey struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// workloadImage is the certification state of one image run by a workload
+type workloadImage struct {
+	Status       securityv1alpha1.CertificationStatus
+	CriticalCVEs int
+}
+
+// workloadStatus is the certification summary propagated to a workload
+type workloadStatus struct {
+	Worst        securityv1alpha1.CertificationStatus
+	Summary      string
+	CriticalCVEs int
+}
+
+// WorkloadStatusPropagator annotates Deployments and StatefulSets with the worst
+// certification status among their containers' images. Deployment conditions are
+// owned by the deployment controller, so annotations are used instead. Writes are
+// skipped when the annotations already hold the summary and are throttled by Limiter.
+type WorkloadStatusPropagator struct {
+	client.Client
+	// Limiter bounds the rate of workload patches
+	Limiter *rate.Limiter
+	// Interval is how often summaries are recomputed
+	Interval time.Duration
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=list;watch;patch
+
+// Start recomputes workload summaries every Interval until ctx is cancelled. It runs
+// only on the elected leader so that replicas do not duplicate writes.
+func (p *WorkloadStatusPropagator) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Propagate(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to propagate certification status to workloads")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Propagate patches every workload whose annotations differ from its certification summary.
+// The current annotations are read from the workloads, so a restarted or newly elected
+// leader neither rewrites unchanged summaries nor leaves stale ones behind.
+func (p *WorkloadStatusPropagator) Propagate(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("workload-status")
+
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := p.List(ctx, &crList); err != nil {
+		return err
+	}
+	desired := p.workloadStatuses(ctx, crList.Items)
+	current, err := p.annotatedWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+
+	for key, status := range desired {
+		if annotated, ok := current[key]; ok && annotated == status {
+			continue
+		}
+		if err := p.patch(ctx, key, &status); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Error(err, "failed to annotate workload", "kind", key.Kind, "namespace", key.Namespace, "name", key.Name)
+		}
+	}
+
+	// Clear summaries from workloads that no longer run any tracked image
+	for key := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if err := p.patch(ctx, key, nil); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Error(err, "failed to clear workload annotations", "kind", key.Kind, "namespace", key.Namespace, "name", key.Name)
+		}
+	}
+
+	return nil
+}
+
+// annotatedWorkloads returns the summary held by the annotations of every Deployment and
+// StatefulSet that carries any of them. Only workload metadata is read, which keeps the
+// workload cache small on large clusters.
+func (p *WorkloadStatusPropagator) annotatedWorkloads(ctx context.Context) (map[workloadKey]workloadStatus, error) {
+	result := make(map[workloadKey]workloadStatus)
+	for kind, gvk := range map[string]schema.GroupVersionKind{
+		WorkloadKindDeployment:  appsv1.SchemeGroupVersion.WithKind("DeploymentList"),
+		WorkloadKindStatefulSet: appsv1.SchemeGroupVersion.WithKind("StatefulSetList"),
+	} {
+		var workloads metav1.PartialObjectMetadataList
+		workloads.SetGroupVersionKind(gvk)
+		if err := p.List(ctx, &workloads); err != nil {
+			return nil, err
+		}
+
+		for _, workload := range workloads.Items {
+			if status, ok := annotatedStatus(workload.Annotations); ok {
+				result[workloadKey{Kind: kind, Namespace: workload.Namespace, Name: workload.Name}] = status
+			}
+		}
+	}
+	return result, nil
+}
+
+// annotatedStatus parses the summary annotations, reporting false when none is set. An
+// unreadable CVE count yields a status that matches no summary, so it is rewritten.
+func annotatedStatus(annotations map[string]string) (workloadStatus, bool) {
+	worst, hasWorst := annotations[AnnotationWorstCertificationStatus]
+	summary, hasSummary := annotations[AnnotationCertificationSummary]
+	critical, hasCritical := annotations[AnnotationCriticalCVEs]
+	if !hasWorst && !hasSummary && !hasCritical {
+		return workloadStatus{}, false
+	}
+
+	criticalCVEs, err := strconv.Atoi(critical)
+	if err != nil {
+		criticalCVEs = -1
+	}
+	return workloadStatus{
+		Worst:        securityv1alpha1.CertificationStatus(worst),
+		Summary:      summary,
+		CriticalCVEs: criticalCVEs,
+	}, true
+}
+
+// workloadStatuses computes the certification summary for every workload owning a referenced pod
+func (p *WorkloadStatusPropagator) workloadStatuses(ctx context.Context,
+	items []securityv1alpha1.ImageCertificationInfo) map[workloadKey]workloadStatus {
+	images := make(map[workloadKey]map[string]workloadImage)
+	owners := make(map[types.NamespacedName]*workloadKey)
+
+	for i := range items {
+		cr := &items[i]
+		image := workloadImage{Status: cr.Status.CertificationStatus}
+		if image.Status == "" {
+			image.Status = securityv1alpha1.CertificationStatusPending
+		}
+		if vulns := vulnerabilitySummary(cr); vulns != nil {
+			image.CriticalCVEs = vulns.Critical
+		}
+
+		for _, podRef := range cr.Status.PodReferences {
+			podKey := types.NamespacedName{Namespace: podRef.Namespace, Name: podRef.Name}
+			owner, seen := owners[podKey]
+			if !seen {
+				owner = p.podWorkload(ctx, podRef)
+				owners[podKey] = owner
+			}
+			if owner == nil {
+				continue
+			}
+			if images[*owner] == nil {
+				images[*owner] = make(map[string]workloadImage)
+			}
+			images[*owner][cr.Name] = image
+		}
+	}
+
+	result := make(map[workloadKey]workloadStatus, len(images))
+	for key, workloadImages := range images {
+		result[key] = summarizeImages(workloadImages)
+	}
+	return result
+}
+
+// podWorkload resolves the Deployment or StatefulSet that owns a referenced pod, or nil if it
+// has none. References recorded before workload attribution existed fall back to a pod lookup.
+func (p *WorkloadStatusPropagator) podWorkload(ctx context.Context, podRef securityv1alpha1.PodReference) *workloadKey {
+	kind, name := podRef.WorkloadKind, podRef.WorkloadName
+	if kind == "" {
+		var pod corev1.Pod
+		if err := p.Get(ctx, types.NamespacedName{Namespace: podRef.Namespace, Name: podRef.Name}, &pod); err != nil {
+			return nil
+		}
+		kind, name = podWorkload(&pod)
+	}
+
+	if kind != WorkloadKindDeployment && kind != WorkloadKindStatefulSet {
+		return nil
+	}
+	return &workloadKey{Kind: kind, Namespace: podRef.Namespace, Name: name}
+}
+
+// summarizeImages reduces a workload's images to its worst status, a count per status, and
+// the total number of critical CVEs
+func summarizeImages(images map[string]workloadImage) workloadStatus {
+	counts := make(map[securityv1alpha1.CertificationStatus]int)
+	var result workloadStatus
+	for _, image := range images {
+		counts[image.Status]++
+		if result.Worst == "" || certificationRisk[image.Status] > certificationRisk[result.Worst] {
+			result.Worst = image.Status
+		}
+		result.CriticalCVEs += image.CriticalCVEs
+	}
+
+	parts := make([]string, 0, len(counts))
+	for status, n := range counts {
+		parts = append(parts, fmt.Sprintf("%d %s", n, status))
+	}
+	slices.Sort(parts)
+	result.Summary = fmt.Sprintf("%d images: %s", len(images), strings.Join(parts, ", "))
+	if result.CriticalCVEs > 0 {
+		result.Summary += fmt.Sprintf("; %d critical CVEs", result.CriticalCVEs)
+	}
+	return result
+}
+
+// patch writes the summary annotations onto a workload, or removes them when status is nil
+func (p *WorkloadStatusPropagator) patch(ctx context.Context, key workloadKey, status *workloadStatus) error {
+	if p.Limiter != nil {
+		if err := p.Limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	// A null value in a merge patch removes the annotation
+	annotations := map[string]any{
+		AnnotationWorstCertificationStatus: nil,
+		AnnotationCertificationSummary:     nil,
+		AnnotationCriticalCVEs:             nil,
+	}
+	if status != nil {
+		annotations[AnnotationWorstCertificationStatus] = string(status.Worst)
+		annotations[AnnotationCertificationSummary] = status.Summary
+		annotations[AnnotationCriticalCVEs] = strconv.Itoa(status.CriticalCVEs)
+	}
+	data, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": annotations}})
+	if err != nil {
+		return err
+	}
+
+	var obj client.Object
+	meta := metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}
+	if key.Kind == WorkloadKindStatefulSet {
+		obj = &appsv1.StatefulSet{ObjectMeta: meta}
+	} else {
+		obj = &appsv1.Deployment{ObjectMeta: meta}
+	}
+
+	err = p.Patch(ctx, obj, client.RawPatch(types.MergePatchType, data))
+	if apierrors.IsNotFound(err) {
+		// The workload was deleted; nothing to annotate
+		return nil
+	}
+	if err != nil {
+		metrics.RecordWorkloadAnnotationPatch("error")
+		return err
+	}
+	metrics.RecordWorkloadAnnotationPatch("patched")
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestWorkloadStatusPropagator_Propagate(t *testing.T) {
+	ctx := context.Background()
+
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: testNamespace}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-5d8f7c9b6d-x2k4p",
+			Namespace: testNamespace,
+			Labels:    map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5d8f7c9b6d"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "web-5d8f7c9b6d",
+				UID:        "rs-uid",
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+	podRef := securityv1alpha1.PodReference{Namespace: testNamespace, Name: pod.Name, Container: testContainer}
+	certified := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			PodReferences:       []securityv1alpha1.PodReference{podRef},
+		},
+	}
+	uncertified := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "docker.io.library.nginx.def456ab"},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusNotCertified,
+			PodReferences:       []securityv1alpha1.PodReference{podRef},
+			PyxisData: &securityv1alpha1.PyxisData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 3},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(deployment, pod, certified, uncertified).Build()
+	p := &WorkloadStatusPropagator{Client: c}
+
+	if err := p.Propagate(ctx); err != nil {
+		t.Fatalf("Propagate() error = %v", err)
+	}
+
+	var got appsv1.Deployment
+	if err := c.Get(ctx, types.NamespacedName{Name: "web", Namespace: testNamespace}, &got); err != nil {
+		t.Fatalf("failed to get Deployment: %v", err)
+	}
+	if status := got.Annotations[AnnotationWorstCertificationStatus]; status != "NotCertified" {
+		t.Errorf("worst status = %q, want NotCertified", status)
+	}
+	if summary := got.Annotations[AnnotationCertificationSummary]; summary != "2 images: 1 Certified, 1 NotCertified; 3 critical CVEs" {
+		t.Errorf("summary = %q", summary)
+	}
+	if critical := got.Annotations[AnnotationCriticalCVEs]; critical != "3" {
+		t.Errorf("critical CVEs = %q, want 3", critical)
+	}
+
+	// Once the pod is gone the annotations are removed
+	if err := c.Delete(ctx, pod); err != nil {
+		t.Fatalf("failed to delete pod: %v", err)
+	}
+	if err := p.Propagate(ctx); err != nil {
+		t.Fatalf("Propagate() error = %v", err)
+	}
+	if err := c.Get(ctx, types.NamespacedName{Name: "web", Namespace: testNamespace}, &got); err != nil {
+		t.Fatalf("failed to get Deployment: %v", err)
+	}
+	if _, ok := got.Annotations[AnnotationWorstCertificationStatus]; ok {
+		t.Errorf("expected annotations to be removed, got %v", got.Annotations)
+	}
+}
+
+func TestWorkloadStatusPropagator_FreshPropagator(t *testing.T) {
+	ctx := context.Background()
+
+	// A previous leader annotated both workloads; only the StatefulSet still runs a tracked image
+	stale := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name:      "retired",
+		Namespace: testNamespace,
+		Annotations: map[string]string{
+			AnnotationWorstCertificationStatus: "NotCertified",
+			AnnotationCertificationSummary:     "1 images: 1 NotCertified",
+			AnnotationCriticalCVEs:             "0",
+			"team":                             "payments",
+		},
+	}}
+	current := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "db",
+		Namespace: testNamespace,
+		Annotations: map[string]string{
+			AnnotationWorstCertificationStatus: "Certified",
+			AnnotationCertificationSummary:     "1 images: 1 Certified",
+			AnnotationCriticalCVEs:             "0",
+		},
+	}}
+	certified := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRName},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			PodReferences: []securityv1alpha1.PodReference{{
+				Namespace:    testNamespace,
+				Name:         "db-0",
+				Container:    testContainer,
+				WorkloadKind: WorkloadKindStatefulSet,
+				WorkloadName: "db",
+			}},
+		},
+	}
+
+	var patched []string
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).
+		WithObjects(stale, current, certified).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch,
+				opts ...client.PatchOption) error {
+				patched = append(patched, obj.GetName())
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+	p := &WorkloadStatusPropagator{Client: c}
+
+	if err := p.Propagate(ctx); err != nil {
+		t.Fatalf("Propagate() error = %v", err)
+	}
+
+	// The stale summary is removed and the current one is left alone
+	if len(patched) != 1 || patched[0] != stale.Name {
+		t.Errorf("patched workloads = %v, want [%s]", patched, stale.Name)
+	}
+	var got appsv1.Deployment
+	if err := c.Get(ctx, types.NamespacedName{Name: stale.Name, Namespace: testNamespace}, &got); err != nil {
+		t.Fatalf("failed to get Deployment: %v", err)
+	}
+	if _, ok := got.Annotations[AnnotationWorstCertificationStatus]; ok {
+		t.Errorf("expected annotations to be removed, got %v", got.Annotations)
+	}
+	if got.Annotations["team"] != "payments" {
+		t.Errorf("unrelated annotation removed, got %v", got.Annotations)
+	}
+
+	// A second fresh propagator has nothing to write
+	patched = nil
+	if err := (&WorkloadStatusPropagator{Client: c}).Propagate(ctx); err != nil {
+		t.Fatalf("Propagate() error = %v", err)
+	}
+	if len(patched) != 0 {
+		t.Errorf("patched workloads = %v, want none", patched)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Workload kinds resolved from pod owner references
+const (
+	WorkloadKindDeployment  = "Deployment"
+	WorkloadKindStatefulSet = "StatefulSet"
+	WorkloadKindDaemonSet   = "DaemonSet"
+	WorkloadKindReplicaSet  = "ReplicaSet"
+	WorkloadKindJob         = "Job"
+	// WorkloadKindPod stands for a pod without a controller in workload summaries
+	WorkloadKindPod = "Pod"
+)
+
+// podWorkload returns the kind and name of the workload that owns a pod, or empty strings
+// for pods without a controller. Deployments are resolved from the ReplicaSet name, which
+// is the Deployment name followed by the pod-template-hash, so no extra lookups are needed.
+func podWorkload(pod *corev1.Pod) (kind, name string) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "", ""
+	}
+	if owner.Kind == WorkloadKindReplicaSet {
+		hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		if hash != "" && strings.HasSuffix(owner.Name, "-"+hash) {
+			return WorkloadKindDeployment, strings.TrimSuffix(owner.Name, "-"+hash)
+		}
+	}
+	return owner.Kind, owner.Name
+}
+
+// setPodReferences replaces the pod references of an image and recomputes its workload summary
+func setPodReferences(cr *securityv1alpha1.ImageCertificationInfo, refs []securityv1alpha1.PodReference) {
+	cr.Status.PodReferences = refs
+	cr.Status.Workloads = summarizeWorkloads(refs)
+	cr.Status.WorkloadCount = len(cr.Status.Workloads)
+}
+
+// summarizeWorkloads groups pod references by owning workload, counting each pod once.
+// Pods without a controller are listed individually with kind Pod.
+func summarizeWorkloads(refs []securityv1alpha1.PodReference) []securityv1alpha1.WorkloadReference {
+	type podKey struct{ namespace, name string }
+	seenPods := make(map[podKey]bool, len(refs))
+	index := make(map[securityv1alpha1.WorkloadReference]int)
+
+	var workloads []securityv1alpha1.WorkloadReference
+	for _, ref := range refs {
+		if seenPods[podKey{ref.Namespace, ref.Name}] {
+			continue
+		}
+		seenPods[podKey{ref.Namespace, ref.Name}] = true
+
+		key := securityv1alpha1.WorkloadReference{Namespace: ref.Namespace, Kind: ref.WorkloadKind, Name: ref.WorkloadName}
+		if ref.WorkloadKind == "" {
+			key.Kind, key.Name = WorkloadKindPod, ref.Name
+		}
+		if i, ok := index[key]; ok {
+			workloads[i].Pods++
+			continue
+		}
+		index[key] = len(workloads)
+		key.Pods = 1
+		workloads = append(workloads, key)
+	}
+
+	slices.SortFunc(workloads, func(a, b securityv1alpha1.WorkloadReference) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+	})
+	return workloads
+}
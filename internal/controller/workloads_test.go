@@ -0,0 +1,94 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestPodWorkload(t *testing.T) {
+	owned := func(kind, name string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Labels: labels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       kind,
+				Name:       name,
+				Controller: ptr.To(true),
+			}},
+		}}
+	}
+	hash := map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5d8f7c9b6d"}
+
+	tests := []struct {
+		name     string
+		pod      *corev1.Pod
+		wantKind string
+		wantName string
+	}{
+		{"deployment", owned("ReplicaSet", "web-5d8f7c9b6d", hash), WorkloadKindDeployment, "web"},
+		{"bare replicaset", owned("ReplicaSet", "web", nil), WorkloadKindReplicaSet, "web"},
+		{"statefulset", owned("StatefulSet", "db", nil), WorkloadKindStatefulSet, "db"},
+		{"daemonset", owned("DaemonSet", "agent", nil), WorkloadKindDaemonSet, "agent"},
+		{"job", owned("Job", "migrate", nil), WorkloadKindJob, "migrate"},
+		{"standalone", &corev1.Pod{}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, name := podWorkload(tt.pod)
+			if kind != tt.wantKind || name != tt.wantName {
+				t.Errorf("podWorkload() = %s/%s, want %s/%s", kind, name, tt.wantKind, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestSetPodReferences(t *testing.T) {
+	cr := &securityv1alpha1.ImageCertificationInfo{}
+	setPodReferences(cr, []securityv1alpha1.PodReference{
+		{Namespace: "a", Name: "web-1", Container: "app", WorkloadKind: WorkloadKindDeployment, WorkloadName: "web"},
+		{Namespace: "a", Name: "web-1", Container: "sidecar", WorkloadKind: WorkloadKindDeployment, WorkloadName: "web"},
+		{Namespace: "a", Name: "web-2", Container: "app", WorkloadKind: WorkloadKindDeployment, WorkloadName: "web"},
+		{Namespace: "a", Name: "debug", Container: "app"},
+		{Namespace: "b", Name: "web-3", Container: "app", WorkloadKind: WorkloadKindDeployment, WorkloadName: "web"},
+	})
+
+	want := []securityv1alpha1.WorkloadReference{
+		{Namespace: "a", Kind: WorkloadKindDeployment, Name: "web", Pods: 2},
+		{Namespace: "a", Kind: WorkloadKindPod, Name: "debug", Pods: 1},
+		{Namespace: "b", Kind: WorkloadKindDeployment, Name: "web", Pods: 1},
+	}
+	if cr.Status.WorkloadCount != len(want) {
+		t.Errorf("WorkloadCount = %d, want %d", cr.Status.WorkloadCount, len(want))
+	}
+	if len(cr.Status.Workloads) != len(want) {
+		t.Fatalf("Workloads = %+v, want %+v", cr.Status.Workloads, want)
+	}
+	for i := range want {
+		if cr.Status.Workloads[i] != want[i] {
+			t.Errorf("Workloads[%d] = %+v, want %+v", i, cr.Status.Workloads[i], want[i])
+		}
+	}
+}
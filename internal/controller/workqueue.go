@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultPodReconcilerConcurrency is the default number of pods reconciled concurrently
+const DefaultPodReconcilerConcurrency = 1
+
+// WorkqueueSettings tunes the rate limiter of a controller's workqueue. A failed item is
+// retried after a delay that doubles from BaseDelay up to MaxDelay, and requeues of all
+// items together are limited to QPS per second with bursts of Burst.
+type WorkqueueSettings struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	QPS       float64
+	Burst     int
+}
+
+// DefaultWorkqueueSettings match the controller-runtime default rate limiter
+var DefaultWorkqueueSettings = WorkqueueSettings{
+	BaseDelay: 5 * time.Millisecond,
+	MaxDelay:  1000 * time.Second,
+	QPS:       10,
+	Burst:     100,
+}
+
+// RateLimiter returns a workqueue rate limiter applying the settings
+func (s WorkqueueSettings) RateLimiter() workqueue.TypedRateLimiter[reconcile.Request] {
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](s.BaseDelay, s.MaxDelay),
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(s.QPS), s.Burst)},
+	)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestWorkqueueSettings_RateLimiter(t *testing.T) {
+	limiter := WorkqueueSettings{BaseDelay: time.Second, MaxDelay: 4 * time.Second, QPS: 1000, Burst: 1000}.RateLimiter()
+	item := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testPodName}}
+
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		if got := limiter.When(item); got != want {
+			t.Errorf("When() = %v, want %v", got, want)
+		}
+	}
+	if got := limiter.NumRequeues(item); got != 4 {
+		t.Errorf("NumRequeues() = %d, want 4", got)
+	}
+
+	limiter.Forget(item)
+	if got := limiter.When(item); got != time.Second {
+		t.Errorf("When() after Forget() = %v, want the base delay", got)
+	}
+}
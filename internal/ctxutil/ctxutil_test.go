@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctxutil
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type key struct{}
+
+func TestShared(t *testing.T) {
+	parent, cancelParent := context.WithTimeout(context.WithValue(context.Background(), key{}, "v"), time.Hour)
+	shared, cancel := Shared(parent)
+	defer cancel()
+
+	cancelParent()
+	if err := shared.Err(); err != nil {
+		t.Errorf("shared context error after the caller gave up = %v, want nil", err)
+	}
+	if got := shared.Value(key{}); got != "v" {
+		t.Errorf("shared context value = %v, want v", got)
+	}
+	want, _ := parent.Deadline()
+	if got, ok := shared.Deadline(); !ok || !got.Equal(want) {
+		t.Errorf("shared context deadline = %v, %v, want %v", got, ok, want)
+	}
+
+	shared, cancel = Shared(context.Background())
+	defer cancel()
+	if _, ok := shared.Deadline(); ok {
+		t.Error("shared context has a deadline the caller did not set")
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errorbudget temporarily disables an external provider whose error rate
+// exceeds a threshold, so that an outage does not mark every image as errored.
+package errorbudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDisabled is returned for calls made while a provider is disabled
+var ErrDisabled = errors.New("provider temporarily disabled by error budget guard")
+
+// Default guard settings
+const (
+	DefaultThreshold   = 0.5
+	DefaultWindow      = 10 * time.Minute
+	DefaultCooldown    = 5 * time.Minute
+	DefaultMinRequests = 20
+)
+
+// bucketCount is the number of buckets the window is divided into
+const bucketCount = 10
+
+// StateChangeFunc is called when a provider is disabled or re-enabled
+type StateChangeFunc func(provider string, disabled bool, reason string)
+
+// bucket counts outcomes within one slice of the window
+type bucket struct {
+	start    time.Time
+	success  int
+	failures int
+}
+
+// Guard tracks the error rate of a provider over a sliding window and disables
+// it when the rate exceeds the threshold. After the cooldown a single trial call
+// is allowed through; a success re-enables the provider, a failure restarts the cooldown.
+type Guard struct {
+	provider    string
+	threshold   float64
+	window      time.Duration
+	cooldown    time.Duration
+	minRequests int
+	onChange    StateChangeFunc
+	now         func() time.Time
+
+	mu            sync.Mutex
+	buckets       [bucketCount]bucket
+	disabled      bool
+	disabledUntil time.Time
+	probing       bool
+}
+
+// Option is a function that configures a Guard
+type Option func(*Guard)
+
+// WithThreshold sets the error rate (0-1) above which the provider is disabled
+func WithThreshold(threshold float64) Option {
+	return func(g *Guard) {
+		g.threshold = threshold
+	}
+}
+
+// WithWindow sets the sliding window over which the error rate is measured
+func WithWindow(window time.Duration) Option {
+	return func(g *Guard) {
+		g.window = window
+	}
+}
+
+// WithCooldown sets how long the provider stays disabled before a trial call
+func WithCooldown(cooldown time.Duration) Option {
+	return func(g *Guard) {
+		g.cooldown = cooldown
+	}
+}
+
+// WithMinRequests sets the number of calls required in the window before the guard can trip
+func WithMinRequests(n int) Option {
+	return func(g *Guard) {
+		g.minRequests = n
+	}
+}
+
+// WithStateChange sets a callback invoked when the provider is disabled or re-enabled
+func WithStateChange(fn StateChangeFunc) Option {
+	return func(g *Guard) {
+		g.onChange = fn
+	}
+}
+
+// NewGuard creates a guard for the named provider
+func NewGuard(provider string, opts ...Option) *Guard {
+	g := &Guard{
+		provider:    provider,
+		threshold:   DefaultThreshold,
+		window:      DefaultWindow,
+		cooldown:    DefaultCooldown,
+		minRequests: DefaultMinRequests,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Allow reports whether a call to the provider may proceed
+func (g *Guard) Allow() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.disabled {
+		return true
+	}
+	if g.probing || g.now().Before(g.disabledUntil) {
+		return false
+	}
+	// Cooldown elapsed: let a single trial call through
+	g.probing = true
+	return true
+}
+
+// Disabled reports whether the provider is currently disabled
+func (g *Guard) Disabled() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.disabled
+}
+
+// Record records the outcome of a provider call. Cancellation by the caller is not
+// counted against the provider.
+func (g *Guard) Record(err error) {
+	if errors.Is(err, context.Canceled) {
+		g.mu.Lock()
+		g.probing = false
+		g.mu.Unlock()
+		return
+	}
+
+	var notify func()
+	g.mu.Lock()
+	now := g.now()
+	switch {
+	case g.disabled && err == nil:
+		g.disabled = false
+		g.probing = false
+		g.buckets = [bucketCount]bucket{}
+		notify = g.changeFunc(false, "trial call succeeded")
+	case g.disabled:
+		g.probing = false
+		g.disabledUntil = now.Add(g.cooldown)
+	default:
+		b := g.bucketLocked(now)
+		if err == nil {
+			b.success++
+		} else {
+			b.failures++
+		}
+		if total, failures := g.totalsLocked(now); total >= g.minRequests &&
+			float64(failures)/float64(total) > g.threshold {
+			g.disabled = true
+			g.disabledUntil = now.Add(g.cooldown)
+			notify = g.changeFunc(true, fmt.Sprintf("%d of %d calls failed in the last %s", failures, total, g.window))
+		}
+	}
+	g.mu.Unlock()
+
+	// Callbacks run outside the lock so they may call back into the guard
+	if notify != nil {
+		notify()
+	}
+}
+
+// changeFunc binds the state change callback, if any
+func (g *Guard) changeFunc(disabled bool, reason string) func() {
+	if g.onChange == nil {
+		return nil
+	}
+	return func() { g.onChange(g.provider, disabled, reason) }
+}
+
+// bucketLocked returns the bucket for now, resetting it if it belongs to an earlier window
+func (g *Guard) bucketLocked(now time.Time) *bucket {
+	width := max(g.window/bucketCount, time.Nanosecond)
+	start := now.Truncate(width)
+	b := &g.buckets[(start.UnixNano()/int64(width))%bucketCount]
+	if !b.start.Equal(start) {
+		*b = bucket{start: start}
+	}
+	return b
+}
+
+// totalsLocked sums the outcomes recorded within the window
+func (g *Guard) totalsLocked(now time.Time) (total, failures int) {
+	cutoff := now.Add(-g.window)
+	for _, b := range g.buckets {
+		if b.start.After(cutoff) {
+			total += b.success + b.failures
+			failures += b.failures
+		}
+	}
+	return total, failures
+}
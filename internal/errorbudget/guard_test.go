@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorbudget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errUpstream = errors.New("upstream returned 503")
+
+func TestGuard_TripAndRecover(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var changes []bool
+	g := NewGuard("pyxis",
+		WithMinRequests(4),
+		WithCooldown(5*time.Minute),
+		WithStateChange(func(_ string, disabled bool, _ string) { changes = append(changes, disabled) }))
+	g.now = func() time.Time { return now }
+
+	// 2 of 4 failing is exactly the threshold and must not trip
+	for _, err := range []error{nil, errUpstream, nil, errUpstream} {
+		g.Record(err)
+	}
+	if g.Disabled() {
+		t.Fatal("guard tripped at the threshold")
+	}
+
+	// 3 of 5 failing exceeds the threshold
+	g.Record(errUpstream)
+	if !g.Disabled() || g.Allow() {
+		t.Fatal("expected guard to disable the provider")
+	}
+
+	// After the cooldown a single trial call is allowed
+	now = now.Add(6 * time.Minute)
+	if !g.Allow() {
+		t.Fatal("expected a trial call after the cooldown")
+	}
+	if g.Allow() {
+		t.Fatal("expected only one concurrent trial call")
+	}
+
+	// A failed trial restarts the cooldown
+	g.Record(errUpstream)
+	if g.Allow() {
+		t.Fatal("expected cooldown to restart after a failed trial")
+	}
+
+	// A successful trial re-enables the provider
+	now = now.Add(6 * time.Minute)
+	if !g.Allow() {
+		t.Fatal("expected a trial call after the second cooldown")
+	}
+	g.Record(nil)
+	if g.Disabled() || !g.Allow() {
+		t.Fatal("expected provider to be re-enabled")
+	}
+
+	if len(changes) != 2 || !changes[0] || changes[1] {
+		t.Errorf("state changes = %v, want [true false]", changes)
+	}
+}
+
+func TestGuard_MinRequests(t *testing.T) {
+	g := NewGuard("dockerhub", WithMinRequests(10))
+	for range 9 {
+		g.Record(errUpstream)
+	}
+	if g.Disabled() {
+		t.Error("guard tripped before reaching the minimum number of requests")
+	}
+}
+
+func TestGuard_WindowExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	g := NewGuard("pyxis", WithMinRequests(4), WithWindow(10*time.Minute))
+	g.now = func() time.Time { return now }
+
+	for range 3 {
+		g.Record(errUpstream)
+	}
+	// Old failures fall out of the window
+	now = now.Add(15 * time.Minute)
+	for range 3 {
+		g.Record(nil)
+	}
+	g.Record(errUpstream)
+	if g.Disabled() {
+		t.Error("expected failures outside the window to be ignored")
+	}
+}
+
+func TestGuard_IgnoresCancellation(t *testing.T) {
+	g := NewGuard("pyxis", WithMinRequests(1))
+	g.Record(context.Canceled)
+	if g.Disabled() {
+		t.Error("caller cancellation must not count against the provider")
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalid records the identifiers that external systems such as a CMDB or asset
+// inventory assign to tracked images. Each system's ID is kept in an annotation named
+// AnnotationPrefix + "/" + system on the ImageCertificationInfo. The operator only writes
+// status and never rewrites these annotations, so they survive every refresh.
+package externalid
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// AnnotationPrefix is the annotation prefix under which external IDs are stored
+const AnnotationPrefix = "externalid.security.telco.openshift.io"
+
+// MaxIDLength is the longest external ID accepted
+const MaxIDLength = 256
+
+// Annotation returns the annotation key holding the ID assigned by system
+func Annotation(system string) string {
+	return AnnotationPrefix + "/" + system
+}
+
+// ValidateSystem checks that a system name can be used in an annotation key
+func ValidateSystem(system string) error {
+	if system == "" {
+		return fmt.Errorf("system must not be empty")
+	}
+	if errs := validation.IsQualifiedName(Annotation(system)); len(errs) > 0 {
+		return fmt.Errorf("invalid system %q: %s", system, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// IDs returns the external IDs of an image keyed by system, or nil if it has none
+func IDs(cr *securityv1alpha1.ImageCertificationInfo) map[string]string {
+	var ids map[string]string
+	for key, value := range cr.Annotations {
+		system, ok := strings.CutPrefix(key, AnnotationPrefix+"/")
+		if !ok {
+			continue
+		}
+		if ids == nil {
+			ids = make(map[string]string)
+		}
+		ids[system] = value
+	}
+	return ids
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+const testDigest = "sha256:abc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+
+func TestValidateSystem(t *testing.T) {
+	for _, system := range []string{"cmdb", "asset-inventory", "ServiceNow_1"} {
+		if err := ValidateSystem(system); err != nil {
+			t.Errorf("ValidateSystem(%q) error = %v", system, err)
+		}
+	}
+	for _, system := range []string{"", "a/b", "-cmdb", strings.Repeat("x", 64)} {
+		if err := ValidateSystem(system); err == nil {
+			t.Errorf("ValidateSystem(%q) succeeded, want an error", system)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = securityv1alpha1.AddToScheme(scheme)
+	ubi := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "registry.redhat.io.ubi8.ubi.abc123de",
+			Annotations: map[string]string{Annotation("assets"): "A-7", "owner": "platform"},
+		},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{FullImageReference: "registry.redhat.io/ubi8/ubi@" + testDigest},
+	}
+	nginx := &securityv1alpha1.ImageCertificationInfo{ObjectMeta: metav1.ObjectMeta{Name: "docker.io.library.nginx.abc123de"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ubi, nginx).Build()
+	handler := &Handler{Client: c}
+
+	post := func(body string) (int, UpdateResult) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, strings.NewReader(body)))
+		var result UpdateResult
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode result: %v", err)
+			}
+		}
+		return rec.Code, result
+	}
+
+	code, result := post(`{"system": "cmdb", "images": [
+		{"image": "registry.redhat.io/ubi8/ubi@` + testDigest + `", "id": "CI-100"},
+		{"name": "docker.io.library.nginx.abc123de", "id": "CI-200"},
+		{"name": "missing", "id": "CI-300"}]}`)
+	if code != http.StatusOK || result.Updated != 2 || len(result.Failed) != 1 || result.Failed[0].Name != "missing" {
+		t.Fatalf("update = %d %+v, want two updates and the untracked image failed", code, result)
+	}
+
+	var updated securityv1alpha1.ImageCertificationInfo
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(ubi), &updated); err != nil {
+		t.Fatalf("failed to get ImageCertificationInfo: %v", err)
+	}
+	ids := IDs(&updated)
+	if ids["cmdb"] != "CI-100" || ids["assets"] != "A-7" || len(ids) != 2 || updated.Annotations["owner"] != "platform" {
+		t.Errorf("annotations = %v, want the cmdb ID added beside the existing annotations", updated.Annotations)
+	}
+
+	// Repeating an ID changes nothing, and an empty ID removes it
+	code, result = post(`{"system": "cmdb", "images": [{"name": "registry.redhat.io.ubi8.ubi.abc123de", "id": "CI-100"},
+		{"name": "docker.io.library.nginx.abc123de", "id": ""}]}`)
+	if code != http.StatusOK || result.Updated != 1 || result.Unchanged != 1 {
+		t.Errorf("second update = %d %+v, want one removal and one unchanged", code, result)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+"?system=cmdb", nil))
+	var listing Listing
+	if err := json.NewDecoder(rec.Body).Decode(&listing); err != nil {
+		t.Fatalf("failed to decode listing: %v", err)
+	}
+	if len(listing.Images) != 2 || listing.Images[0].ID != "" || listing.Images[1].ID != "CI-100" {
+		t.Errorf("listing = %+v, want nginx without an ID and ubi with CI-100", listing)
+	}
+
+	for _, body := range []string{`{"system": "a/b", "images": []}`, `{"system": "cmdb", "extra": true}`, `not json`} {
+		if code, _ := post(body); code != http.StatusBadRequest {
+			t.Errorf("POST %s status = %d, want %d", body, code, http.StatusBadRequest)
+		}
+	}
+}
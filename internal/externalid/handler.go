@@ -0,0 +1,232 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// Path is where the external ID endpoint is served on the metrics server
+const Path = "/api/v1/external-ids"
+
+// MaxEntries is the largest number of images a single request may update
+const MaxEntries = 1000
+
+// maxBodyBytes bounds the size of an update request
+const maxBodyBytes = 4 << 20
+
+// Entry assigns the ID of one image. The image is given by ImageCertificationInfo name or
+// by digest reference (registry/repository@sha256:...).
+type Entry struct {
+	Name  string `json:"name,omitempty"`
+	Image string `json:"image,omitempty"`
+	// ID is the identifier assigned by the system; an empty ID removes it
+	ID string `json:"id"`
+}
+
+// Update is the body of a bulk update
+type Update struct {
+	// System names the external system, e.g. "cmdb"
+	System string  `json:"system"`
+	Images []Entry `json:"images"`
+}
+
+// Failure reports an entry that could not be applied
+type Failure struct {
+	Entry
+	Error string `json:"error"`
+}
+
+// UpdateResult reports the outcome of a bulk update
+type UpdateResult struct {
+	Updated   int       `json:"updated"`
+	Unchanged int       `json:"unchanged"`
+	Failed    []Failure `json:"failed,omitempty"`
+}
+
+// Listing is the response to a GET, listing every tracked image with the ID assigned by the
+// requested system, empty for images the system has not recorded yet
+type Listing struct {
+	System string  `json:"system"`
+	Images []Entry `json:"images"`
+}
+
+// errorResponse is the body returned for a rejected request
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler lets external inventories read and assign their IDs for tracked images in bulk.
+// GET lists the IDs of the system given by the system parameter; POST applies an Update.
+type Handler struct {
+	// Client reads and patches ImageCertificationInfos
+	Client client.Client
+}
+
+// ServeHTTP answers a listing or applies a bulk update
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		h.list(w, req)
+	case http.MethodPost:
+		h.update(w, req)
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "only GET and POST are supported"})
+	}
+}
+
+// list writes the IDs of one system for all tracked images
+func (h *Handler) list(w http.ResponseWriter, req *http.Request) {
+	system := req.URL.Query().Get("system")
+	if err := ValidateSystem(system); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+
+	list := &securityv1alpha1.ImageCertificationInfoList{}
+	if err := h.Client.List(req.Context(), list); err != nil {
+		log.FromContext(req.Context()).Error(err, "failed to list ImageCertificationInfos for external IDs")
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "failed to list images"})
+		return
+	}
+
+	listing := Listing{System: system, Images: make([]Entry, 0, len(list.Items))}
+	for i := range list.Items {
+		cr := &list.Items[i]
+		listing.Images = append(listing.Images, Entry{
+			Name:  cr.Name,
+			Image: cr.Spec.FullImageReference,
+			ID:    cr.Annotations[Annotation(system)],
+		})
+	}
+	slices.SortFunc(listing.Images, func(a, b Entry) int { return strings.Compare(a.Name, b.Name) })
+	writeJSON(w, http.StatusOK, listing)
+}
+
+// update applies a bulk update, reporting the entries that failed
+func (h *Handler) update(w http.ResponseWriter, req *http.Request) {
+	var update Update
+	decoder := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&update); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid request body: " + err.Error()})
+		return
+	}
+	if err := ValidateSystem(update.System); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+	if len(update.Images) > MaxEntries {
+		writeJSON(w, http.StatusBadRequest, errorResponse{
+			Error: fmt.Sprintf("at most %d images may be updated per request, got %d", MaxEntries, len(update.Images)),
+		})
+		return
+	}
+
+	var result UpdateResult
+	for _, entry := range update.Images {
+		changed, err := h.apply(req.Context(), update.System, entry)
+		switch {
+		case err != nil:
+			result.Failed = append(result.Failed, Failure{Entry: entry, Error: err.Error()})
+		case changed:
+			result.Updated++
+		default:
+			result.Unchanged++
+		}
+	}
+	if result.Updated > 0 {
+		log.FromContext(req.Context()).Info("applied external IDs", "system", update.System,
+			"updated", result.Updated, "failed", len(result.Failed))
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// apply sets or removes the ID of one image and reports whether the image changed
+func (h *Handler) apply(ctx context.Context, system string, entry Entry) (bool, error) {
+	if len(entry.ID) > MaxIDLength {
+		return false, fmt.Errorf("id must be at most %d characters", MaxIDLength)
+	}
+	name, err := entryName(entry)
+	if err != nil {
+		return false, err
+	}
+
+	var cr securityv1alpha1.ImageCertificationInfo
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: name}, &cr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("image is not tracked")
+		}
+		return false, err
+	}
+
+	key := Annotation(system)
+	if current, ok := cr.Annotations[key]; ok == (entry.ID != "") && current == entry.ID {
+		return false, nil
+	}
+	// A merge patch touches only this annotation, leaving those of other systems alone
+	patch := client.MergeFrom(cr.DeepCopy())
+	if entry.ID == "" {
+		delete(cr.Annotations, key)
+	} else {
+		if cr.Annotations == nil {
+			cr.Annotations = make(map[string]string)
+		}
+		cr.Annotations[key] = entry.ID
+	}
+	if err := h.Client.Patch(ctx, &cr, patch); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// entryName returns the ImageCertificationInfo name an entry refers to
+func entryName(entry Entry) (string, error) {
+	switch {
+	case entry.Name != "" && entry.Image != "":
+		return "", fmt.Errorf("set either name or image, not both")
+	case entry.Name != "":
+		return entry.Name, nil
+	case entry.Image != "":
+		ref, err := image.ParseImageID(entry.Image)
+		if err != nil {
+			return "", fmt.Errorf("image must be a digest reference: %w", err)
+		}
+		return image.ReferenceToCRName(ref), nil
+	default:
+		return "", fmt.Errorf("name or image is required")
+	}
+}
+
+// writeJSON writes body as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
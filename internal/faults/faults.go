@@ -0,0 +1,200 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package faults injects latency, errors, and malformed payloads into the HTTP responses
+// of provider clients, so that resilience tests can exercise retries, the error budget,
+// and error classification against a real cluster. Faults are only injected by binaries
+// built with the faultinjection build tag.
+package faults
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvVar configures the faults, e.g.
+// "pyxis:latency=2s,error=0.3,status=503;dockerhub:malformed=0.5"
+const EnvVar = "IMAGECERTINFO_FAULTS"
+
+// ErrInjected is returned for injected connection errors
+var ErrInjected = errors.New("injected fault")
+
+// malformedBody replaces the body of responses chosen to be malformed
+const malformedBody = `{"data": [{"_id": `
+
+// Config describes the faults injected into the responses of one client
+type Config struct {
+	// Latency delays every request
+	Latency time.Duration
+	// ErrorRate is the fraction of requests that fail, between 0 and 1
+	ErrorRate float64
+	// Status is the HTTP status of failed requests. 0 fails them with a connection error.
+	Status int
+	// MalformedRate is the fraction of remaining responses whose body is truncated JSON
+	MalformedRate float64
+}
+
+// Parse parses a fault specification of semicolon-separated client:key=value,... entries.
+// The keys are latency, error, status, and malformed.
+func Parse(spec string) (map[string]Config, error) {
+	configs := make(map[string]Config)
+	for entry := range strings.SplitSeq(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		client, settings, ok := strings.Cut(entry, ":")
+		client = strings.TrimSpace(client)
+		if !ok || client == "" {
+			return nil, fmt.Errorf("invalid fault entry %q: expected client:key=value,...", entry)
+		}
+		var config Config
+		for setting := range strings.SplitSeq(settings, ",") {
+			if err := config.set(strings.TrimSpace(setting)); err != nil {
+				return nil, fmt.Errorf("invalid fault entry %q: %w", entry, err)
+			}
+		}
+		configs[client] = config
+	}
+	return configs, nil
+}
+
+// set applies one key=value setting
+func (c *Config) set(setting string) error {
+	if setting == "" {
+		return nil
+	}
+	key, value, ok := strings.Cut(setting, "=")
+	if !ok {
+		return fmt.Errorf("expected key=value, got %q", setting)
+	}
+	var err error
+	switch key {
+	case "latency":
+		c.Latency, err = time.ParseDuration(value)
+	case "error":
+		c.ErrorRate, err = parseRate(value)
+	case "status":
+		c.Status, err = strconv.Atoi(value)
+		if err == nil && (c.Status < 100 || c.Status > 599) {
+			err = fmt.Errorf("status must be an HTTP status code, got %d", c.Status)
+		}
+	case "malformed":
+		c.MalformedRate, err = parseRate(value)
+	default:
+		err = fmt.Errorf("unknown key %q", key)
+	}
+	return err
+}
+
+// parseRate parses a fraction between 0 and 1
+func parseRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err == nil && (rate < 0 || rate > 1) {
+		err = fmt.Errorf("rate must be between 0 and 1, got %g", rate)
+	}
+	return rate, err
+}
+
+// Transport is an http.RoundTripper that injects faults into the responses of Base
+type Transport struct {
+	Base   http.RoundTripper
+	Config Config
+	// random returns a number in [0, 1); nil uses math/rand
+	random func() float64
+}
+
+// RoundTrip delays the request, then fails it or malforms its response as configured
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Config.Latency > 0 {
+		timer := time.NewTimer(t.Config.Latency)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	if t.chance(t.Config.ErrorRate) {
+		if t.Config.Status == 0 {
+			return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Host, ErrInjected)
+		}
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", t.Config.Status, http.StatusText(t.Config.Status)),
+			StatusCode: t.Config.Status,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       io.NopCloser(strings.NewReader(ErrInjected.Error())),
+			Request:    req,
+		}, nil
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil || !t.chance(t.Config.MalformedRate) {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader([]byte(malformedBody)))
+	resp.ContentLength = int64(len(malformedBody))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// chance reports whether an event with the given probability happens
+func (t *Transport) chance(probability float64) bool {
+	if probability <= 0 {
+		return false
+	}
+	random := t.random
+	if random == nil {
+		random = rand.Float64
+	}
+	return random() < probability
+}
+
+// HTTPClient returns an HTTP client with the given timeout that injects the faults
+// configured for client by EnvVar. It returns nil when no faults are configured for
+// client or the binary was built without the faultinjection tag.
+func HTTPClient(client string, timeout time.Duration) (*http.Client, error) {
+	spec := os.Getenv(EnvVar)
+	if !Enabled || spec == "" {
+		return nil, nil
+	}
+	configs, err := Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", EnvVar, err)
+	}
+	config, ok := configs[client]
+	if !ok {
+		return nil, nil
+	}
+	return &http.Client{Timeout: timeout, Transport: &Transport{Config: config}}, nil
+}
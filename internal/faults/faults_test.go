@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	configs, err := Parse(" pyxis:latency=2s,error=0.3,status=503 ; dockerhub:malformed=1;")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := map[string]Config{
+		"pyxis":     {Latency: 2 * time.Second, ErrorRate: 0.3, Status: 503},
+		"dockerhub": {MalformedRate: 1},
+	}
+	if len(configs) != len(want) || configs["pyxis"] != want["pyxis"] || configs["dockerhub"] != want["dockerhub"] {
+		t.Errorf("Parse() = %+v, want %+v", configs, want)
+	}
+
+	for _, spec := range []string{"pyxis", ":error=1", "pyxis:error=2", "pyxis:status=42", "pyxis:latency=soon",
+		"pyxis:timeout=1s", "pyxis:error"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) expected an error", spec)
+		}
+	}
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"data": []}`)
+	}))
+	defer server.Close()
+
+	get := func(t *testing.T, config Config) (*http.Response, string, error) {
+		t.Helper()
+		client := &http.Client{Transport: &Transport{Config: config, random: func() float64 { return 0.5 }}}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			return nil, "", err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, err := io.ReadAll(resp.Body)
+		return resp, string(body), err
+	}
+
+	t.Run("no faults", func(t *testing.T) {
+		resp, body, err := get(t, Config{ErrorRate: 0.4, MalformedRate: 0.4})
+		if err != nil || resp.StatusCode != http.StatusOK || body != `{"data": []}` {
+			t.Errorf("got status %v, body %q, error %v", resp, body, err)
+		}
+	})
+
+	t.Run("status error", func(t *testing.T) {
+		resp, _, err := get(t, Config{ErrorRate: 0.6, Status: http.StatusServiceUnavailable})
+		if err != nil || resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("got response %v, error %v, want 503", resp, err)
+		}
+	})
+
+	t.Run("connection error", func(t *testing.T) {
+		if _, _, err := get(t, Config{ErrorRate: 1}); !errors.Is(err, ErrInjected) {
+			t.Errorf("error = %v, want ErrInjected", err)
+		}
+	})
+
+	t.Run("malformed payload", func(t *testing.T) {
+		resp, body, err := get(t, Config{MalformedRate: 1})
+		if err != nil || resp.StatusCode != http.StatusOK || body != malformedBody {
+			t.Errorf("got body %q, error %v, want the malformed body", body, err)
+		}
+	})
+
+	t.Run("latency respects the request context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		start := time.Now()
+		_, err := (&Transport{Config: Config{Latency: time.Minute}}).RoundTrip(req)
+		if !errors.Is(err, context.DeadlineExceeded) || time.Since(start) > 5*time.Second {
+			t.Errorf("error = %v after %s, want the deadline to cut the latency short", err, time.Since(start))
+		}
+	})
+}
+
+func TestHTTPClient(t *testing.T) {
+	t.Setenv(EnvVar, "pyxis:error=1")
+	client, err := HTTPClient("pyxis", time.Second)
+	if err != nil {
+		t.Fatalf("HTTPClient() error = %v", err)
+	}
+	if (client != nil) != Enabled {
+		t.Errorf("HTTPClient() = %v, want a client only when fault injection is built in", client)
+	}
+	if client, _ := HTTPClient("dockerhub", time.Second); client != nil {
+		t.Error("HTTPClient() should return nil for clients without faults")
+	}
+
+	t.Setenv(EnvVar, "pyxis:error=2")
+	if _, err := HTTPClient("pyxis", time.Second); Enabled && err == nil {
+		t.Error("HTTPClient() expected an error for an invalid specification")
+	}
+}
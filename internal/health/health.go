@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health provides readiness checks that reflect the state of the operator's subsystems.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// Background loop names used for heartbeat tracking
+const (
+	LoopCleanup = "cleanup-loop"
+	LoopRefresh = "refresh-loop"
+)
+
+// DefaultProviderCheckTimeout bounds how long a provider reachability check may take
+const DefaultProviderCheckTimeout = 5 * time.Second
+
+// DefaultProviderCheckGrace is how long a provider may be unreachable before the
+// provider readiness checks fail
+const DefaultProviderCheckGrace = 2 * time.Minute
+
+// Heartbeats records the last time each background loop reported progress
+type Heartbeats struct {
+	mu    sync.RWMutex
+	beats map[string]time.Time
+	now   func() time.Time
+}
+
+// NewHeartbeats creates an empty heartbeat tracker
+func NewHeartbeats() *Heartbeats {
+	return &Heartbeats{
+		beats: make(map[string]time.Time),
+		now:   time.Now,
+	}
+}
+
+// Beat records that the named loop is alive. It is safe to call on a nil tracker.
+func (h *Heartbeats) Beat(name string) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.beats[name] = h.now()
+}
+
+// Last returns the time of the most recent heartbeat for the named loop
+func (h *Heartbeats) Last(name string) (time.Time, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	t, ok := h.beats[name]
+	return t, ok
+}
+
+// Checker returns a healthz.Checker that fails when the named loop has not
+// reported a heartbeat within maxAge
+func (h *Heartbeats) Checker(name string, maxAge time.Duration) healthz.Checker {
+	return func(_ *http.Request) error {
+		last, ok := h.Last(name)
+		if !ok {
+			return fmt.Errorf("%s has not started", name)
+		}
+		if age := h.now().Sub(last); age > maxAge {
+			return fmt.Errorf("%s last heartbeat was %s ago (max %s)", name, age.Round(time.Second), maxAge)
+		}
+		return nil
+	}
+}
+
+// CacheSyncer is implemented by informer caches that can report sync status
+type CacheSyncer interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// CacheSyncChecker returns a healthz.Checker that fails until the informer cache has synced
+func CacheSyncChecker(c CacheSyncer, timeout time.Duration) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		if !c.WaitForCacheSync(ctx) {
+			return errors.New("informer cache has not synced")
+		}
+		return nil
+	}
+}
+
+// LeaderElectionChecker returns a healthz.Checker that fails until this replica
+// has been elected leader
+func LeaderElectionChecker(elected <-chan struct{}) healthz.Checker {
+	return func(_ *http.Request) error {
+		select {
+		case <-elected:
+			return nil
+		default:
+			return errors.New("waiting to be elected leader")
+		}
+	}
+}
+
+// LeaderOnlyChecker returns a healthz.Checker that passes on replicas that have not been
+// elected leader, and delegates to check once elected. It suits checks of loops that run
+// only on the leader.
+func LeaderOnlyChecker(elected <-chan struct{}, check healthz.Checker) healthz.Checker {
+	return func(req *http.Request) error {
+		select {
+		case <-elected:
+			return check(req)
+		default:
+			return nil
+		}
+	}
+}
+
+// ProviderChecker returns a healthz.Checker that fails when an external provider is
+// unreachable. With a grace period, the check only fails once the provider has been
+// unreachable for that long, so that a brief degradation does not flap readiness.
+func ProviderChecker(name string, isHealthy func(ctx context.Context) bool, timeout,
+	grace time.Duration) healthz.Checker {
+	check := &providerCheck{name: name, isHealthy: isHealthy, timeout: timeout, grace: grace, now: time.Now}
+	return check.check
+}
+
+// providerCheck is the state of a provider readiness check
+type providerCheck struct {
+	name      string
+	isHealthy func(ctx context.Context) bool
+	timeout   time.Duration
+	grace     time.Duration
+	now       func() time.Time
+
+	mu sync.Mutex
+	// failingSince is when the provider was first found unreachable, or zero while reachable
+	failingSince time.Time
+}
+
+// check probes the provider and fails once it has been unreachable for the grace period
+func (c *providerCheck) check(req *http.Request) error {
+	ctx, cancel := context.WithTimeout(req.Context(), c.timeout)
+	defer cancel()
+	healthy := c.isHealthy(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if healthy {
+		c.failingSince = time.Time{}
+		return nil
+	}
+	now := c.now()
+	if c.failingSince.IsZero() {
+		c.failingSince = now
+	}
+	if down := now.Sub(c.failingSince); down >= c.grace {
+		return fmt.Errorf("%s API has been unreachable for %s", c.name, down.Round(time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHeartbeats_Checker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		started bool
+		beatAge time.Duration
+		maxAge  time.Duration
+		wantErr bool
+	}{
+		{
+			name:    "never started",
+			maxAge:  time.Minute,
+			wantErr: true,
+		},
+		{
+			name:    "recent heartbeat",
+			started: true,
+			beatAge: 30 * time.Second,
+			maxAge:  time.Minute,
+			wantErr: false,
+		},
+		{
+			name:    "stale heartbeat",
+			started: true,
+			beatAge: 2 * time.Minute,
+			maxAge:  time.Minute,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHeartbeats()
+			if tt.started {
+				h.now = func() time.Time { return now.Add(-tt.beatAge) }
+				h.Beat(LoopCleanup)
+			}
+			h.now = func() time.Time { return now }
+
+			err := h.Checker(LoopCleanup, tt.maxAge)(httptest.NewRequest("GET", "/readyz", nil))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Checker() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHeartbeats_NilBeat(t *testing.T) {
+	var h *Heartbeats
+	// Must not panic when heartbeats are not configured
+	h.Beat(LoopRefresh)
+}
+
+type fakeCache struct {
+	synced bool
+}
+
+func (f fakeCache) WaitForCacheSync(_ context.Context) bool {
+	return f.synced
+}
+
+func TestCacheSyncChecker(t *testing.T) {
+	req := httptest.NewRequest("GET", "/readyz", nil)
+
+	if err := CacheSyncChecker(fakeCache{synced: true}, time.Second)(req); err != nil {
+		t.Errorf("expected synced cache to be ready, got %v", err)
+	}
+	if err := CacheSyncChecker(fakeCache{synced: false}, time.Second)(req); err == nil {
+		t.Error("expected unsynced cache to be not ready")
+	}
+}
+
+func TestLeaderElectionChecker(t *testing.T) {
+	req := httptest.NewRequest("GET", "/readyz", nil)
+	elected := make(chan struct{})
+	check := LeaderElectionChecker(elected)
+
+	if err := check(req); err == nil {
+		t.Error("expected not ready before election")
+	}
+	close(elected)
+	if err := check(req); err != nil {
+		t.Errorf("expected ready after election, got %v", err)
+	}
+}
+
+func TestLeaderOnlyChecker(t *testing.T) {
+	req := httptest.NewRequest("GET", "/readyz", nil)
+	elected := make(chan struct{})
+	check := LeaderOnlyChecker(elected, func(*http.Request) error { return errors.New("loop stalled") })
+
+	if err := check(req); err != nil {
+		t.Errorf("expected standby replica to be ready, got %v", err)
+	}
+	close(elected)
+	if err := check(req); err == nil {
+		t.Error("expected the leader to report the failing check")
+	}
+}
+
+func TestProviderChecker(t *testing.T) {
+	req := httptest.NewRequest("GET", "/readyz", nil)
+
+	healthy := func(context.Context) bool { return true }
+	unhealthy := func(context.Context) bool { return false }
+
+	if err := ProviderChecker("Pyxis", healthy, time.Second, 0)(req); err != nil {
+		t.Errorf("expected healthy provider to be ready, got %v", err)
+	}
+	if err := ProviderChecker("Pyxis", unhealthy, time.Second, 0)(req); err == nil {
+		t.Error("expected unreachable provider to be not ready")
+	}
+}
+
+func TestProviderChecker_Grace(t *testing.T) {
+	req := httptest.NewRequest("GET", "/readyz", nil)
+	up := false
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	check := &providerCheck{
+		name:      "Pyxis",
+		isHealthy: func(context.Context) bool { return up },
+		timeout:   time.Second,
+		grace:     time.Minute,
+		now:       func() time.Time { return now },
+	}
+
+	if err := check.check(req); err != nil {
+		t.Errorf("expected a newly unreachable provider to stay ready, got %v", err)
+	}
+	now = now.Add(59 * time.Second)
+	if err := check.check(req); err != nil {
+		t.Errorf("expected the provider to stay ready within the grace period, got %v", err)
+	}
+	now = now.Add(time.Second)
+	if err := check.check(req); err == nil {
+		t.Error("expected the provider to be not ready after the grace period")
+	}
+
+	// Recovering resets the grace period
+	up = true
+	if err := check.check(req); err != nil {
+		t.Errorf("expected a recovered provider to be ready, got %v", err)
+	}
+	up = false
+	now = now.Add(time.Hour)
+	if err := check.check(req); err != nil {
+		t.Errorf("expected the grace period to restart after recovery, got %v", err)
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultOutageProbeInterval is how often the outage prober checks each provider
+const DefaultOutageProbeInterval = 30 * time.Second
+
+// maxOutages bounds the outages kept for reporting
+const maxOutages = 20
+
+// FreshnessGap is a period during which an image's certification data could not be
+// refreshed because a provider was unreachable
+type FreshnessGap struct {
+	// Provider is the provider that was unreachable
+	Provider string
+	// From is when the provider became unreachable
+	From time.Time
+	// To is when the image's deferred lookup succeeded
+	To time.Time
+}
+
+// outage is the state of a single provider outage
+type outage struct {
+	provider string
+	started  time.Time
+	ended    time.Time
+	drained  time.Time
+	// deferred holds the images whose lookup was deferred during the outage
+	deferred map[string]bool
+	// pending holds the deferred images whose lookup has not succeeded yet
+	pending map[string]bool
+}
+
+// OutageTracker records provider outages, the certification lookups each one deferred, and
+// how long those lookups took to drain once the provider recovered. A nil tracker records
+// nothing.
+type OutageTracker struct {
+	mu      sync.Mutex
+	outages []*outage
+	now     func() time.Time
+}
+
+// NewOutageTracker creates a tracker with no outages
+func NewOutageTracker() *OutageTracker {
+	return &OutageTracker{now: time.Now}
+}
+
+// Observe records the result of a health probe of a provider, starting an outage when an
+// available provider fails it and ending the outage when the provider passes it again.
+// It reports whether the provider's state changed.
+func (t *OutageTracker) Observe(provider string, healthy bool) bool {
+	if t == nil {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	current := t.ongoing(provider)
+	switch {
+	case !healthy && current == nil:
+		t.outages = append(t.outages, &outage{
+			provider: provider,
+			started:  now,
+			deferred: make(map[string]bool),
+			pending:  make(map[string]bool),
+		})
+		t.prune()
+		return true
+	case healthy && current != nil:
+		current.ended = now
+		if len(current.pending) == 0 {
+			current.drained = now
+		}
+		return true
+	}
+	return false
+}
+
+// Defer records that the certification lookup of the named image has been put off. It
+// counts against every ongoing outage.
+func (t *OutageTracker) Defer(name string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, o := range t.outages {
+		if o.ended.IsZero() {
+			o.deferred[name] = true
+			o.pending[name] = true
+		}
+	}
+}
+
+// Resolve records that the certification lookup of the named image succeeded and returns
+// the freshness gaps of the outages that had deferred it
+func (t *OutageTracker) Resolve(name string) []FreshnessGap {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	var gaps []FreshnessGap
+	for _, o := range t.outages {
+		if !o.pending[name] {
+			continue
+		}
+		gaps = append(gaps, FreshnessGap{Provider: o.provider, From: o.started, To: now})
+		t.drain(o, name, now)
+	}
+	return gaps
+}
+
+// Forget drops a deleted image from the lookups awaiting the end of an outage
+func (t *OutageTracker) Forget(name string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	for _, o := range t.outages {
+		if o.pending[name] {
+			t.drain(o, name, now)
+		}
+	}
+}
+
+// drain removes an image from the pending lookups of an outage, marking the backlog drained
+// when it was the last one after the outage ended
+func (t *OutageTracker) drain(o *outage, name string, now time.Time) {
+	delete(o.pending, name)
+	if len(o.pending) == 0 && !o.ended.IsZero() && o.drained.IsZero() {
+		o.drained = now
+	}
+}
+
+// Outages returns a summary of each recorded outage, most recent first
+func (t *OutageTracker) Outages() []securityv1alpha1.ProviderOutage {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	summaries := make([]securityv1alpha1.ProviderOutage, 0, len(t.outages))
+	for _, o := range slices.Backward(t.outages) {
+		summary := securityv1alpha1.ProviderOutage{
+			Provider:        o.provider,
+			StartedAt:       metav1.NewTime(o.started),
+			Duration:        metav1.Duration{Duration: now.Sub(o.started).Truncate(time.Second)},
+			DeferredLookups: len(o.deferred),
+			PendingLookups:  len(o.pending),
+		}
+		if !o.ended.IsZero() {
+			summary.EndedAt = &metav1.Time{Time: o.ended}
+			summary.Duration.Duration = o.ended.Sub(o.started).Truncate(time.Second)
+		}
+		if !o.drained.IsZero() {
+			summary.DrainedAt = &metav1.Time{Time: o.drained}
+			summary.DrainDuration = &metav1.Duration{Duration: o.drained.Sub(o.ended).Truncate(time.Second)}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// ongoing returns the outage of a provider that has not ended, if any
+func (t *OutageTracker) ongoing(provider string) *outage {
+	for _, o := range t.outages {
+		if o.provider == provider && o.ended.IsZero() {
+			return o
+		}
+	}
+	return nil
+}
+
+// prune drops the oldest finished outages beyond the reporting limit
+func (t *OutageTracker) prune() {
+	for len(t.outages) > maxOutages {
+		i := slices.IndexFunc(t.outages, func(o *outage) bool { return !o.ended.IsZero() })
+		if i < 0 {
+			return
+		}
+		t.outages = slices.Delete(t.outages, i, i+1)
+	}
+}
+
+// OutageProber probes the providers periodically, records their outages, and reports
+// whether each one is reachable in the external_api_up gauge. It runs on every replica so
+// that each one reports the outages it observed.
+type OutageProber struct {
+	// Tracker records the outages
+	Tracker *OutageTracker
+	// Providers maps each provider name to its health check
+	Providers map[string]func(ctx context.Context) bool
+	// Interval is how often each provider is probed
+	Interval time.Duration
+	// Timeout bounds each probe
+	Timeout time.Duration
+}
+
+// NeedLeaderElection returns false so that every replica probes the providers
+func (p *OutageProber) NeedLeaderElection() bool {
+	return false
+}
+
+// Start probes the providers every Interval until ctx is cancelled
+func (p *OutageProber) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.Probe(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Probe checks each provider once
+func (p *OutageProber) Probe(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("outage-prober")
+	for provider, isHealthy := range p.Providers {
+		probeCtx, cancel := context.WithTimeout(ctx, p.Timeout)
+		healthy := isHealthy(probeCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		metrics.SetExternalAPIUp(provider, healthy)
+		if p.Tracker.Observe(provider, healthy) {
+			if healthy {
+				logger.Info("Provider outage ended", "provider", provider)
+			} else {
+				logger.Info("Provider outage started", "provider", provider)
+			}
+		}
+	}
+}
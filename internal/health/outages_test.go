@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+func TestOutageTracker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewOutageTracker()
+	tracker.now = func() time.Time { return now }
+	advance := func(d time.Duration) { now = now.Add(d) }
+
+	// Lookups are only deferred while an outage lasts
+	tracker.Defer("before")
+	if !tracker.Observe("pyxis", false) {
+		t.Error("Observe() of a failed probe should start an outage")
+	}
+	if tracker.Observe("pyxis", false) {
+		t.Error("Observe() of a second failed probe should not start another outage")
+	}
+	tracker.Defer("a")
+	tracker.Defer("b")
+	tracker.Defer("c")
+	tracker.Defer("a")
+
+	advance(10 * time.Minute)
+	outages := tracker.Outages()
+	if len(outages) != 1 || outages[0].EndedAt != nil || outages[0].Duration.Duration != 10*time.Minute {
+		t.Fatalf("Outages() during the outage = %+v, want one ongoing outage of 10m", outages)
+	}
+	if outages[0].DeferredLookups != 3 || outages[0].PendingLookups != 3 {
+		t.Errorf("deferred, pending = %d, %d, want 3, 3", outages[0].DeferredLookups, outages[0].PendingLookups)
+	}
+
+	// The backlog drains once every deferred lookup succeeded or its image was deleted
+	advance(5 * time.Minute)
+	tracker.Observe("pyxis", true)
+	advance(time.Minute)
+	gaps := tracker.Resolve("a")
+	if len(gaps) != 1 || gaps[0].Provider != "pyxis" || gaps[0].To.Sub(gaps[0].From) != 16*time.Minute {
+		t.Errorf("Resolve() = %+v, want a 16m pyxis gap", gaps)
+	}
+	if gaps := tracker.Resolve("before"); len(gaps) != 0 {
+		t.Errorf("Resolve() of an image deferred before the outage = %+v, want no gaps", gaps)
+	}
+	tracker.Forget("b")
+	if outages := tracker.Outages(); outages[0].DrainedAt != nil || outages[0].PendingLookups != 1 {
+		t.Errorf("outage = %+v, want one pending lookup", outages[0])
+	}
+	advance(2 * time.Minute)
+	tracker.Resolve("c")
+	outage := tracker.Outages()[0]
+	if outage.EndedAt == nil || outage.Duration.Duration != 15*time.Minute {
+		t.Errorf("outage = %+v, want an outage of 15m", outage)
+	}
+	if outage.DrainDuration == nil || outage.DrainDuration.Duration != 3*time.Minute || outage.DeferredLookups != 3 {
+		t.Errorf("outage = %+v, want 3 deferred lookups drained in 3m", outage)
+	}
+
+	// An outage that deferred nothing is drained when it ends; the most recent is listed first
+	tracker.Observe("quay", false)
+	advance(time.Minute)
+	tracker.Observe("quay", true)
+	outages = tracker.Outages()
+	if len(outages) != 2 || outages[0].Provider != "quay" || outages[0].DrainDuration.Duration != 0 {
+		t.Errorf("Outages() = %+v, want the drained quay outage first", outages)
+	}
+}
+
+func TestOutageTracker_Prune(t *testing.T) {
+	tracker := NewOutageTracker()
+	tracker.Observe("pyxis", false)
+	for range maxOutages + 5 {
+		tracker.Observe("quay", false)
+		tracker.Observe("quay", true)
+	}
+	outages := tracker.Outages()
+	if len(outages) != maxOutages {
+		t.Fatalf("len(Outages()) = %d, want %d", len(outages), maxOutages)
+	}
+	if last := outages[len(outages)-1]; last.Provider != "pyxis" {
+		t.Errorf("oldest outage = %s, want the ongoing pyxis outage to be kept", last.Provider)
+	}
+
+	var nilTracker *OutageTracker
+	nilTracker.Defer("a")
+	if nilTracker.Observe("pyxis", false) || nilTracker.Resolve("a") != nil || nilTracker.Outages() != nil {
+		t.Error("a nil tracker should record nothing")
+	}
+}
+
+func TestOutageProber_Probe(t *testing.T) {
+	healthy := false
+	prober := &OutageProber{
+		Tracker:   NewOutageTracker(),
+		Providers: map[string]func(context.Context) bool{"pyxis": func(context.Context) bool { return healthy }},
+		Interval:  time.Minute,
+		Timeout:   time.Second,
+	}
+
+	prober.Probe(context.Background())
+	if outages := prober.Tracker.Outages(); len(outages) != 1 || outages[0].EndedAt != nil {
+		t.Fatalf("Outages() after a failed probe = %+v, want one ongoing outage", outages)
+	}
+	if up := externalAPIUp(t, "pyxis"); up != 0 {
+		t.Errorf("external_api_up after a failed probe = %v, want 0", up)
+	}
+	healthy = true
+	prober.Probe(context.Background())
+	if outages := prober.Tracker.Outages(); len(outages) != 1 || outages[0].EndedAt == nil {
+		t.Errorf("Outages() after a passed probe = %+v, want the outage ended", outages)
+	}
+	if up := externalAPIUp(t, "pyxis"); up != 1 {
+		t.Errorf("external_api_up after a passed probe = %v, want 1", up)
+	}
+}
+
+// externalAPIUp returns the value of the external_api_up gauge of an API
+func externalAPIUp(t *testing.T, api string) float64 {
+	t.Helper()
+	var m dto.Metric
+	if err := metrics.ExternalAPIUp.WithLabelValues(api).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetGauge().GetValue()
+}
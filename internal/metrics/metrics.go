@@ -17,7 +17,15 @@ limitations under the License.
 package metrics
 
 import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
@@ -39,6 +47,35 @@ var (
 		[]string{"status"},
 	)
 
+	// ImagesLifecycle tracks Pyxis-certified images that are past end-of-life or deprecated
+	ImagesLifecycle = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_lifecycle",
+			Help:      "Number of Red Hat certified images in the EOL or Deprecated certification status",
+		},
+		[]string{"status"},
+	)
+
+	// ImagesStale tracks images whose certification status was downgraded for stale data
+	ImagesStale = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_stale",
+			Help:      "Number of images whose certification status is Unknown because its provider was not read recently",
+		},
+	)
+
+	// ImagesByOS tracks images by operating system and certification status
+	ImagesByOS = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_by_os",
+			Help:      "Number of images by operating system and certification status",
+		},
+		[]string{"os", "status"},
+	)
+
 	// ImagesByHealth tracks images by health grade
 	ImagesByHealth = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -78,6 +115,132 @@ var (
 		},
 	)
 
+	// ImagesExempted tracks images that an active ImageCertExemption applies to
+	ImagesExempted = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_exempted",
+			Help:      "Number of images exempted by an active ImageCertExemption",
+		},
+	)
+
+	// ImagesEOLTier tracks images by the most urgent end-of-life warning tier they are in
+	ImagesEOLTier = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_eol_tier",
+			Help:      "Number of images in each end-of-life warning tier, counting each image in its most urgent tier",
+		},
+		[]string{"tier"},
+	)
+
+	// InventoryCollectionDuration tracks how long aggregating the image inventory takes
+	InventoryCollectionDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "inventory_collection_duration_seconds",
+			Help:      "Duration of image inventory collections in seconds, including pauses between chunks",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30},
+		},
+	)
+
+	// ObjectSizeBytes tracks the serialized size of ImageCertificationInfo objects when written
+	ObjectSizeBytes = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "object_size_bytes",
+			Help:      "Serialized size in bytes of ImageCertificationInfo objects when their status is written",
+			Buckets:   prometheus.ExponentialBuckets(1024, 2, 12),
+		},
+	)
+
+	// ObjectsOverSizeWarning tracks how many writes exceeded the object size warning threshold
+	ObjectsOverSizeWarning = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "object_size_warnings_total",
+			Help:      "Total ImageCertificationInfo status writes whose serialized size exceeded the warning threshold",
+		},
+	)
+
+	// InventoryCollectionLag tracks how late the inventory gauges were last updated
+	InventoryCollectionLag = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "inventory_collection_lag_seconds",
+			Help:      "Seconds between when the last image inventory collection was due and when it updated the gauges",
+		},
+	)
+
+	// InventoryCollectionImages tracks how many images the last inventory collection aggregated
+	InventoryCollectionImages = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "inventory_collection_images",
+			Help:      "Number of images aggregated by the last image inventory collection",
+		},
+	)
+
+	// CVEAgeDays tracks the age distribution of critical/important CVEs on running images
+	CVEAgeDays = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "cve_age_days",
+			Help:      "Age in days of critical/important CVEs on running images, by severity and quantile",
+		},
+		[]string{"severity", "quantile"},
+	)
+
+	// ImagesMissingArchitecture tracks images that do not support a CPU architecture used by cluster nodes
+	ImagesMissingArchitecture = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_missing_architecture",
+			Help:      "Number of images that do not support a CPU architecture present among the cluster nodes",
+		},
+		[]string{"architecture"},
+	)
+
+	// ClusterComplianceScore tracks the weighted cluster compliance score
+	ClusterComplianceScore = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "cluster_compliance_score",
+			Help:      "Cluster compliance score from 0 to 100, overall and per component",
+		},
+		[]string{"component"},
+	)
+
+	// ClusterComplianceGrade reports the letter grade of the cluster compliance score; its value is always 1
+	ClusterComplianceGrade = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "cluster_compliance_grade",
+			Help:      "Letter grade of the cluster compliance score (always 1)",
+		},
+		[]string{"grade"},
+	)
+
+	// ExternalAPIUp reports whether each external provider API answered its last health probe
+	ExternalAPIUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "external_api_up",
+			Help:      "Whether the external API answered its last health probe (1) or not (0)",
+		},
+		[]string{"api"},
+	)
+
+	// NamespaceRiskScore reports the severity-weighted vulnerability risk of each namespace
+	NamespaceRiskScore = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "namespace_risk_score",
+			Help:      "Vulnerabilities of the images a namespace runs, weighted by severity and pod count",
+		},
+		[]string{"namespace"},
+	)
+
 	// Pyxis API Metrics
 
 	// PyxisRequestsTotal tracks total Pyxis API requests
@@ -106,9 +269,29 @@ var (
 		prometheus.CounterOpts{
 			Namespace: MetricsNamespace,
 			Name:      "pyxis_cache_hits_total",
-			Help:      "Total number of Pyxis cache hits and misses",
+			Help:      "Total number of Pyxis cache hits and misses by query type",
 		},
-		[]string{"result"}, // "hit" or "miss"
+		[]string{"query", "result"}, // query: "image_id", "manifest_list_digest", or "tag"; result: "hit" or "miss"
+	)
+
+	// PyxisSharedLookups tracks cache misses that joined a lookup already in flight
+	PyxisSharedLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "pyxis_shared_lookups_total",
+			Help:      "Total number of Pyxis cache misses answered by a lookup already in flight for the same key",
+		},
+		[]string{"query"},
+	)
+
+	// PyxisRetriesTotal tracks Pyxis API requests retried after a transient failure
+	PyxisRetriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "pyxis_retries_total",
+			Help:      "Total number of Pyxis API requests retried after a transient failure",
+		},
+		[]string{"endpoint", "reason"}, // reason is the HTTP status code or "network_error"
 	)
 
 	// Reconciliation Metrics
@@ -143,6 +326,63 @@ var (
 		},
 	)
 
+	// OrphanedImagesDeleted tracks ImageCertificationInfos garbage-collected after their orphan TTL
+	OrphanedImagesDeleted = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "orphaned_images_deleted_total",
+			Help:      "Total number of ImageCertificationInfos deleted after no pod used them for the orphan TTL",
+		},
+	)
+
+	// ImagesMigrated tracks ImageCertificationInfos migrated from earlier versions on startup
+	ImagesMigrated = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "images_migrated_total",
+			Help:      "Total number of ImageCertificationInfos changed by each startup migration",
+		},
+		[]string{"migration"},
+	)
+
+	// DeprecatedArtifacts tracks images that still use a deprecated name or field
+	DeprecatedArtifacts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "deprecated_artifacts",
+			Help:      "Number of ImageCertificationInfos that still use each deprecated name or field",
+		},
+		[]string{"deprecation"},
+	)
+
+	// DeprecatedArtifactsCleaned tracks legacy artifacts removed by the deprecation scan
+	DeprecatedArtifactsCleaned = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "deprecated_artifacts_cleaned_total",
+			Help:      "Total number of deprecated names or fields cleaned up by the deprecation scan",
+		},
+		[]string{"deprecation"},
+	)
+
+	// EnrichmentQueueDepth tracks newly discovered images waiting for enrichment
+	EnrichmentQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "enrichment_queue_depth",
+			Help:      "Number of enrichment tasks for newly discovered images waiting for a worker",
+		},
+	)
+
+	// EnrichmentWorkersBusy tracks enrichment workers currently calling a provider
+	EnrichmentWorkersBusy = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "enrichment_workers_busy",
+			Help:      "Number of enrichment workers currently processing a task",
+		},
+	)
+
 	// Event Metrics
 
 	// EventsEmitted tracks events emitted by the operator
@@ -155,6 +395,46 @@ var (
 		[]string{"type", "reason"},
 	)
 
+	// AuditRecordsTotal tracks events exported to external audit sinks
+	AuditRecordsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "audit_records_total",
+			Help:      "Total number of events exported to audit sinks by result",
+		},
+		[]string{"sink", "result"}, // result: "written", "dropped", or "error"
+	)
+
+	// ReportExportsTotal tracks inventory report files written to report destinations
+	ReportExportsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "report_exports_total",
+			Help:      "Total number of inventory report files exported by destination, format, and result",
+		},
+		[]string{"destination", "format", "result"}, // result: "success" or "error"
+	)
+
+	// NotificationsTotal tracks certification notifications delivered to chat and webhook sinks
+	NotificationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "notifications_total",
+			Help:      "Total number of notifications by sink and result",
+		},
+		[]string{"sink", "result"}, // result: "sent", "suppressed", "dropped", or "error"
+	)
+
+	// WorkloadAnnotationPatchesTotal tracks certification summary writes to Deployments and StatefulSets
+	WorkloadAnnotationPatchesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "workload_annotation_patches_total",
+			Help:      "Total number of certification summary annotation patches on workloads by result",
+		},
+		[]string{"result"}, // result: "patched" or "error"
+	)
+
 	// Refresh Cycle Metrics
 
 	// RefreshCyclesTotal tracks completed refresh cycles
@@ -227,6 +507,120 @@ var (
 		},
 		[]string{"result"}, // "hit" or "miss"
 	)
+
+	// Rate Limiter Metrics
+
+	// RateLimiterWaitDuration tracks how long requests to external APIs wait for rate limiter budget
+	RateLimiterWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "rate_limiter_wait_seconds",
+			Help:      "Time requests to external APIs spent waiting for rate limiter budget",
+			Buckets:   []float64{0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0},
+		},
+		[]string{"client"}, // "pyxis" or "dockerhub"
+	)
+
+	// OCI Registry Metrics
+
+	// RegistryRequestsTotal tracks image metadata and SBOM lookups against OCI registries
+	RegistryRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "registry_requests_total",
+			Help:      "Total number of image metadata and SBOM lookups against OCI registries",
+		},
+		[]string{"status"},
+	)
+
+	// RegistryRequestDuration tracks OCI registry image metadata and SBOM lookup duration
+	RegistryRequestDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "registry_request_duration_seconds",
+			Help:      "Duration of image metadata and SBOM lookups against OCI registries in seconds",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0},
+		},
+	)
+
+	// Quay API Metrics
+
+	// QuayRequestsTotal tracks the total number of Quay API requests
+	QuayRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "quay_requests_total",
+			Help:      "Total number of Quay security scan API requests",
+		},
+		[]string{"status"},
+	)
+
+	// QuayRequestDuration tracks Quay API request duration
+	QuayRequestDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "quay_request_duration_seconds",
+			Help:      "Duration of Quay security scan API requests in seconds",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0},
+		},
+	)
+
+	// QuayCacheHits tracks Quay cache hits and misses
+	QuayCacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "quay_cache_hits_total",
+			Help:      "Total number of Quay cache hits and misses",
+		},
+		[]string{"result"}, // "hit" or "miss"
+	)
+
+	// Provider Error Budget Metrics
+
+	// ProviderDisabled tracks whether a provider is disabled by its error budget guard
+	ProviderDisabled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "provider_disabled",
+			Help:      "Whether an external provider is temporarily disabled by its error budget guard (1 = disabled)",
+		},
+		[]string{"provider"},
+	)
+
+	// Sharding Metrics
+
+	// ShardMembers tracks the number of live operator instances sharing the work
+	ShardMembers = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "shard_members",
+			Help:      "Number of live operator instances in shard mode",
+		},
+	)
+
+	// Policy Metrics
+
+	// PolicyViolations tracks the number of images violating each ImageCertPolicy
+	PolicyViolations = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "policy_violations",
+			Help:      "Number of violations recorded by an ImageCertPolicy",
+		},
+		[]string{"policy"},
+	)
+
+	// Build Metrics
+
+	// BuildInfo reports the build of the running operator; its value is always 1
+	BuildInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "build_info",
+			Help:      "Build information of the running operator (always 1)",
+		},
+		[]string{"version", "commit", "build_date", "go_version"},
+	)
 )
 
 func init() {
@@ -234,20 +628,48 @@ func init() {
 	metrics.Registry.MustRegister(
 		// Image inventory metrics
 		ImagesTotal,
+		ImagesByOS,
+		ImagesLifecycle,
+		ImagesStale,
 		ImagesByHealth,
 		VulnerabilitiesTotal,
 		ImagesEOLWithinDays,
 		ImagesPastEOL,
+		ImagesEOLTier,
+		ImagesExempted,
+		InventoryCollectionDuration,
+		InventoryCollectionLag,
+		InventoryCollectionImages,
+		ObjectSizeBytes,
+		ObjectsOverSizeWarning,
+		CVEAgeDays,
+		ImagesMissingArchitecture,
+		ClusterComplianceScore,
+		ClusterComplianceGrade,
+		NamespaceRiskScore,
+		ExternalAPIUp,
 		// Pyxis API metrics
 		PyxisRequestsTotal,
 		PyxisRequestDuration,
 		PyxisCacheHits,
+		PyxisSharedLookups,
+		PyxisRetriesTotal,
 		// Reconciliation metrics
 		ReconcileTotal,
 		ReconcileDuration,
 		ImagesDiscovered,
+		OrphanedImagesDeleted,
+		ImagesMigrated,
+		DeprecatedArtifacts,
+		DeprecatedArtifactsCleaned,
+		EnrichmentQueueDepth,
+		EnrichmentWorkersBusy,
 		// Event metrics
 		EventsEmitted,
+		AuditRecordsTotal,
+		ReportExportsTotal,
+		NotificationsTotal,
+		WorkloadAnnotationPatchesTotal,
 		// Refresh cycle metrics
 		RefreshCyclesTotal,
 		RefreshDurationSeconds,
@@ -257,23 +679,75 @@ func init() {
 		DockerHubRequestsTotal,
 		DockerHubRequestDuration,
 		DockerHubCacheHits,
+		// Rate limiter metrics
+		RateLimiterWaitDuration,
+		rateLimiters,
+		RegistryRequestsTotal,
+		RegistryRequestDuration,
+		// Quay API metrics
+		QuayRequestsTotal,
+		QuayRequestDuration,
+		QuayCacheHits,
+		// Provider error budget metrics
+		ProviderDisabled,
+		// Sharding metrics
+		ShardMembers,
+		// Policy metrics
+		PolicyViolations,
+		// Build metrics
+		BuildInfo,
 	)
 }
 
+// cveAgeQuantiles are the quantiles exported by CVEAgeDays (1 is the maximum age)
+var cveAgeQuantiles = []float64{0.5, 0.9, 0.99, 1}
+
+// SetCVEAges replaces the CVE age quantiles with values computed from the given ages (in days) per severity
+func SetCVEAges(agesBySeverity map[string][]float64) {
+	CVEAgeDays.Reset()
+	for severity, ages := range agesBySeverity {
+		if len(ages) == 0 {
+			continue
+		}
+		sorted := slices.Clone(ages)
+		slices.Sort(sorted)
+		for _, q := range cveAgeQuantiles {
+			CVEAgeDays.WithLabelValues(severity, fmt.Sprintf("%g", q)).Set(quantile(sorted, q))
+		}
+	}
+}
+
+// quantile returns the nearest-rank quantile of an ascending sorted slice
+func quantile(sorted []float64, q float64) float64 {
+	idx := int(math.Ceil(q*float64(len(sorted)))) - 1
+	idx = max(0, min(idx, len(sorted)-1))
+	return sorted[idx]
+}
+
 // RecordPyxisRequest records a Pyxis API request metric
 func RecordPyxisRequest(status, endpoint string, durationSeconds float64) {
 	PyxisRequestsTotal.WithLabelValues(status, endpoint).Inc()
 	PyxisRequestDuration.WithLabelValues(endpoint).Observe(durationSeconds)
 }
 
-// RecordCacheHit records a cache hit
-func RecordCacheHit() {
-	PyxisCacheHits.WithLabelValues("hit").Inc()
+// RecordPyxisRetry records a Pyxis API request retried after a transient failure
+func RecordPyxisRetry(endpoint, reason string) {
+	PyxisRetriesTotal.WithLabelValues(endpoint, reason).Inc()
 }
 
-// RecordCacheMiss records a cache miss
-func RecordCacheMiss() {
-	PyxisCacheHits.WithLabelValues("miss").Inc()
+// RecordCacheHit records a Pyxis cache hit for a query type
+func RecordCacheHit(query string) {
+	PyxisCacheHits.WithLabelValues(query, "hit").Inc()
+}
+
+// RecordCacheMiss records a Pyxis cache miss for a query type
+func RecordCacheMiss(query string) {
+	PyxisCacheHits.WithLabelValues(query, "miss").Inc()
+}
+
+// RecordSharedLookup records a Pyxis cache miss that joined a lookup already in flight
+func RecordSharedLookup(query string) {
+	PyxisSharedLookups.WithLabelValues(query).Inc()
 }
 
 // RecordReconcile records a reconciliation result
@@ -287,6 +761,43 @@ func RecordEvent(eventType, reason string) {
 	EventsEmitted.WithLabelValues(eventType, reason).Inc()
 }
 
+// RecordImageMigrated records an image changed by a startup migration
+func RecordImageMigrated(migration string) {
+	ImagesMigrated.WithLabelValues(migration).Inc()
+}
+
+// SetDeprecatedArtifacts sets the number of images that still use each deprecated name or field
+func SetDeprecatedArtifacts(counts map[string]int) {
+	for deprecation, n := range counts {
+		DeprecatedArtifacts.WithLabelValues(deprecation).Set(float64(n))
+	}
+}
+
+// RecordDeprecatedArtifactCleaned records a deprecated name or field removed by the deprecation scan
+func RecordDeprecatedArtifactCleaned(deprecation string) {
+	DeprecatedArtifactsCleaned.WithLabelValues(deprecation).Inc()
+}
+
+// RecordWorkloadAnnotationPatch records the outcome of patching a workload's certification summary
+func RecordWorkloadAnnotationPatch(result string) {
+	WorkloadAnnotationPatchesTotal.WithLabelValues(result).Inc()
+}
+
+// RecordAuditRecord records the outcome of exporting an event to an audit sink
+func RecordAuditRecord(sink, result string) {
+	AuditRecordsTotal.WithLabelValues(sink, result).Inc()
+}
+
+// RecordReportExport records the outcome of exporting a report file to a destination
+func RecordReportExport(destination, format, result string) {
+	ReportExportsTotal.WithLabelValues(destination, format, result).Inc()
+}
+
+// RecordNotifications records the outcome of delivering count notifications to a sink
+func RecordNotifications(sink, result string, count int) {
+	NotificationsTotal.WithLabelValues(sink, result).Add(float64(count))
+}
+
 // RecordRefreshCycle records a completed refresh cycle
 func RecordRefreshCycle(durationSeconds float64) {
 	RefreshCyclesTotal.Inc()
@@ -309,6 +820,28 @@ func RecordDockerHubRequest(status, endpoint string, durationSeconds float64) {
 	DockerHubRequestDuration.WithLabelValues(endpoint).Observe(durationSeconds)
 }
 
+// RecordRegistryRequest records an OCI registry image metadata or SBOM lookup
+func RecordRegistryRequest(status string, durationSeconds float64) {
+	RegistryRequestsTotal.WithLabelValues(status).Inc()
+	RegistryRequestDuration.Observe(durationSeconds)
+}
+
+// RecordQuayRequest records metrics for a Quay API request
+func RecordQuayRequest(status string, durationSeconds float64) {
+	QuayRequestsTotal.WithLabelValues(status).Inc()
+	QuayRequestDuration.Observe(durationSeconds)
+}
+
+// RecordQuayCacheHit records a Quay cache hit
+func RecordQuayCacheHit() {
+	QuayCacheHits.WithLabelValues("hit").Inc()
+}
+
+// RecordQuayCacheMiss records a Quay cache miss
+func RecordQuayCacheMiss() {
+	QuayCacheHits.WithLabelValues("miss").Inc()
+}
+
 // RecordDockerHubCacheHit records a Docker Hub cache hit
 func RecordDockerHubCacheHit() {
 	DockerHubCacheHits.WithLabelValues("hit").Inc()
@@ -318,3 +851,214 @@ func RecordDockerHubCacheHit() {
 func RecordDockerHubCacheMiss() {
 	DockerHubCacheHits.WithLabelValues("miss").Inc()
 }
+
+// SetProviderDisabled records whether a provider is disabled by its error budget guard
+func SetProviderDisabled(provider string, disabled bool) {
+	value := 0.0
+	if disabled {
+		value = 1
+	}
+	ProviderDisabled.WithLabelValues(provider).Set(value)
+}
+
+// SetShardMembers records the number of live shard members
+func SetShardMembers(n int) {
+	ShardMembers.Set(float64(n))
+}
+
+// SetPolicyViolations records the number of violations of a policy
+func SetPolicyViolations(policy string, n int) {
+	PolicyViolations.WithLabelValues(policy).Set(float64(n))
+}
+
+// DeletePolicyViolations removes the violation count of a deleted policy
+func DeletePolicyViolations(policy string) {
+	PolicyViolations.DeleteLabelValues(policy)
+}
+
+// SetImagesMissingArchitecture replaces the per-architecture counts of images lacking support
+// for a node architecture. Every node architecture is reported, including those with no gaps.
+func SetImagesMissingArchitecture(nodeArchitectures []string, missing map[string]int) {
+	ImagesMissingArchitecture.Reset()
+	for _, arch := range nodeArchitectures {
+		ImagesMissingArchitecture.WithLabelValues(arch).Set(float64(missing[arch]))
+	}
+}
+
+// SetClusterCompliance records the cluster compliance score. components maps each score
+// component to its value; the overall score is reported with component "overall".
+func SetClusterCompliance(score int, grade string, components map[string]int) {
+	ClusterComplianceScore.Reset()
+	ClusterComplianceScore.WithLabelValues("overall").Set(float64(score))
+	for component, value := range components {
+		ClusterComplianceScore.WithLabelValues(component).Set(float64(value))
+	}
+	ClusterComplianceGrade.Reset()
+	ClusterComplianceGrade.WithLabelValues(grade).Set(1)
+}
+
+// SetNamespaceRiskScores replaces the namespace risk scores
+func SetNamespaceRiskScores(scores map[string]int) {
+	NamespaceRiskScore.Reset()
+	for namespace, score := range scores {
+		NamespaceRiskScore.WithLabelValues(namespace).Set(float64(score))
+	}
+}
+
+// SetExternalAPIUp records the result of a health probe of an external API
+func SetExternalAPIUp(api string, up bool) {
+	value := 0.0
+	if up {
+		value = 1
+	}
+	ExternalAPIUp.WithLabelValues(api).Set(value)
+}
+
+// SetBuildInfo records the build of the running operator
+func SetBuildInfo(version, commit, buildDate, goVersion string) {
+	BuildInfo.Reset()
+	BuildInfo.WithLabelValues(version, commit, buildDate, goVersion).Set(1)
+}
+
+// RecordInventoryCollection records a completed image inventory collection
+func RecordInventoryCollection(images int, durationSeconds float64) {
+	InventoryCollectionImages.Set(float64(images))
+	InventoryCollectionDuration.Observe(durationSeconds)
+}
+
+// RecordInventoryCollectionLag records how late the last inventory collection updated the gauges
+func RecordInventoryCollectionLag(seconds float64) {
+	InventoryCollectionLag.Set(seconds)
+}
+
+// RecordObjectSize records the serialized size of a written ImageCertificationInfo and
+// whether it exceeded the warning threshold
+func RecordObjectSize(bytes int64, overWarning bool) {
+	ObjectSizeBytes.Observe(float64(bytes))
+	if overWarning {
+		ObjectsOverSizeWarning.Inc()
+	}
+}
+
+// Inventory is a snapshot of the tracked images used to set the image inventory gauges
+type Inventory struct {
+	// ByStatus counts images by certification status
+	ByStatus map[string]int
+	// ByOS counts images by operating system, then certification status
+	ByOS map[string]map[string]int
+	// ByHealth counts images by health grade
+	ByHealth map[string]int
+	// Vulnerabilities sums vulnerabilities across all images by severity
+	Vulnerabilities map[string]int
+	// EOLWithinDays counts images reaching end-of-life within each number of days
+	EOLWithinDays map[int]int
+	// PastEOL counts images past their end-of-life date
+	PastEOL int
+	// EOLTiers counts images by their most urgent end-of-life warning tier
+	EOLTiers map[string]int
+	// Exempted counts images an ImageCertExemption applies to, which are left out of
+	// the health, vulnerability, and end-of-life counts
+	Exempted int
+}
+
+// SetInventory replaces the image inventory gauges with the given snapshot. Label values
+// missing from the snapshot are removed, so callers list zero counts they want reported.
+func SetInventory(inv Inventory) {
+	ImagesTotal.Reset()
+	for status, n := range inv.ByStatus {
+		ImagesTotal.WithLabelValues(status).Set(float64(n))
+	}
+	ImagesByOS.Reset()
+	for os, byStatus := range inv.ByOS {
+		for status, n := range byStatus {
+			ImagesByOS.WithLabelValues(os, status).Set(float64(n))
+		}
+	}
+	ImagesByHealth.Reset()
+	for grade, n := range inv.ByHealth {
+		ImagesByHealth.WithLabelValues(grade).Set(float64(n))
+	}
+	VulnerabilitiesTotal.Reset()
+	for severity, n := range inv.Vulnerabilities {
+		VulnerabilitiesTotal.WithLabelValues(severity).Set(float64(n))
+	}
+	ImagesEOLWithinDays.Reset()
+	for days, n := range inv.EOLWithinDays {
+		ImagesEOLWithinDays.WithLabelValues(strconv.Itoa(days)).Set(float64(n))
+	}
+	ImagesPastEOL.Set(float64(inv.PastEOL))
+	ImagesEOLTier.Reset()
+	for tier, n := range inv.EOLTiers {
+		ImagesEOLTier.WithLabelValues(tier).Set(float64(n))
+	}
+	ImagesExempted.Set(float64(inv.Exempted))
+}
+
+// SetImagesLifecycle sets the number of images in each lifecycle certification status
+func SetImagesLifecycle(counts map[string]int) {
+	for status, n := range counts {
+		ImagesLifecycle.WithLabelValues(status).Set(float64(n))
+	}
+}
+
+// SetImagesStale sets the number of images whose certification status went stale
+func SetImagesStale(n int) {
+	ImagesStale.Set(float64(n))
+}
+
+// RecordRateLimiterWait records the time a request to an external API waited for rate limiter budget
+func RecordRateLimiterWait(client string, wait time.Duration) {
+	RateLimiterWaitDuration.WithLabelValues(client).Observe(wait.Seconds())
+}
+
+// RegisterRateLimiter reports the tokens and burst utilization of limiter under the client label.
+// Registering another limiter for the same client replaces it.
+func RegisterRateLimiter(client string, limiter *rate.Limiter) {
+	rateLimiters.mu.Lock()
+	defer rateLimiters.mu.Unlock()
+	rateLimiters.limiters[client] = limiter
+}
+
+// rateLimiters reads the registered rate limiters when scraped, since their tokens refill
+// between requests
+var rateLimiters = &rateLimiterCollector{
+	tokens: prometheus.NewDesc(MetricsNamespace+"_rate_limiter_tokens",
+		"Tokens currently available in the rate limiter of an external API client",
+		[]string{"client"}, nil),
+	burst: prometheus.NewDesc(MetricsNamespace+"_rate_limiter_burst",
+		"Burst size of the rate limiter of an external API client",
+		[]string{"client"}, nil),
+	utilization: prometheus.NewDesc(MetricsNamespace+"_rate_limiter_utilization",
+		"Fraction of the rate limiter burst in use; above 1 while requests are reserved ahead of the refill",
+		[]string{"client"}, nil),
+	limiters: map[string]*rate.Limiter{},
+}
+
+// rateLimiterCollector exports the state of the registered rate limiters
+type rateLimiterCollector struct {
+	tokens, burst, utilization *prometheus.Desc
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// Describe implements prometheus.Collector
+func (c *rateLimiterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tokens
+	ch <- c.burst
+	ch <- c.utilization
+}
+
+// Collect implements prometheus.Collector
+func (c *rateLimiterCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for client, limiter := range c.limiters {
+		tokens, burst := limiter.Tokens(), float64(limiter.Burst())
+		ch <- prometheus.MustNewConstMetric(c.tokens, prometheus.GaugeValue, tokens, client)
+		ch <- prometheus.MustNewConstMetric(c.burst, prometheus.GaugeValue, burst, client)
+		if burst > 0 {
+			ch <- prometheus.MustNewConstMetric(c.utilization, prometheus.GaugeValue, (burst-tokens)/burst, client)
+		}
+	}
+}
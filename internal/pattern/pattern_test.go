@@ -0,0 +1,40 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pattern
+
+import "testing"
+
+func TestMatchAny(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		name     string
+		want     bool
+	}{
+		{nil, "default", false},
+		{[]string{"default"}, "default", true},
+		{[]string{"default"}, "default-2", false},
+		{[]string{"kube-*"}, "kube-system", true},
+		{[]string{"kube-*"}, "openshift", false},
+		{[]string{"openshift", "*"}, "anything", true},
+	}
+
+	for _, tt := range tests {
+		if got := MatchAny(tt.patterns, tt.name); got != tt.want {
+			t.Errorf("MatchAny(%v, %q) = %v, want %v", tt.patterns, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prescan
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Path is where the pre-scan endpoint is served on the metrics server
+const Path = "/api/v1/prescan"
+
+// maxBodyBytes bounds the size of a pre-scan request
+const maxBodyBytes = 4 << 20
+
+// errorResponse is the body returned for a rejected request
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler lets chart authors and pipelines check the images of a rendered workload against
+// the cluster's certification policies before installing it. It answers a POSTed Request
+// with a Result.
+type Handler struct {
+	// Reader lists ImageCertificationInfos, ImageCertPolicies, and Namespaces
+	Reader client.Reader
+}
+
+// ServeHTTP evaluates a pre-scan request
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "only POST is supported"})
+		return
+	}
+
+	var request Request
+	decoder := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid request body: " + err.Error()})
+		return
+	}
+	if err := request.Validate(); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := Evaluate(req.Context(), h.Reader, &request)
+	if err != nil {
+		log.FromContext(req.Context()).Error(err, "failed to evaluate pre-scan request")
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "failed to evaluate images"})
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// writeJSON writes body as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
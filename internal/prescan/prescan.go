@@ -0,0 +1,249 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package prescan checks the images of a workload that is not running yet against the
+// certification data and ImageCertPolicies of the cluster.
+package prescan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/controller"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/search"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// MaxImages is the largest number of images a single request may check
+const MaxImages = 1000
+
+// Verdict is the outcome of checking an image or a whole request
+type Verdict string
+
+const (
+	// VerdictPass means the image violates no policy
+	VerdictPass Verdict = "Pass"
+	// VerdictFail means the image violates at least one policy rule
+	VerdictFail Verdict = "Fail"
+	// VerdictUnknown means the image is not tracked yet or its certification lookup is pending
+	VerdictUnknown Verdict = "Unknown"
+)
+
+// Request lists the images to check, as a rendered pod spec, as image references, or both
+type Request struct {
+	// Namespace the workload will run in; policies with a namespace selector are only
+	// checked when it is set
+	Namespace string `json:"namespace,omitempty"`
+	// PodSpec is a rendered pod spec, such as the spec.template.spec of a Deployment
+	PodSpec *corev1.PodSpec `json:"podSpec,omitempty"`
+	// Images are image references (registry/repo:tag or registry/repo@sha256:...)
+	Images []string `json:"images,omitempty"`
+}
+
+// Violation is a rule of an ImageCertPolicy that an image violates
+type Violation struct {
+	Policy  string `json:"policy"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// ImageVerdict is the outcome of checking one image
+type ImageVerdict struct {
+	Image string `json:"image"`
+	// Containers are the pod spec containers using the image
+	Containers []string `json:"containers,omitempty"`
+	Verdict    Verdict  `json:"verdict"`
+	// Name is the ImageCertificationInfo tracking the image, empty if it is not tracked
+	Name                string                                 `json:"name,omitempty"`
+	CertificationStatus securityv1alpha1.CertificationStatus   `json:"certificationStatus,omitempty"`
+	Vulnerabilities     *securityv1alpha1.VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	DaysUntilEOL        *int                                   `json:"daysUntilEol,omitempty"`
+	Violations          []Violation                            `json:"violations,omitempty"`
+	// Message explains an Unknown verdict
+	Message string `json:"message,omitempty"`
+}
+
+// Result is the outcome of a pre-scan
+type Result struct {
+	// Verdict is Fail if any image fails, else Unknown if any image is unknown, else Pass
+	Verdict Verdict `json:"verdict"`
+	// Policies is the number of ImageCertPolicies checked
+	Policies int            `json:"policies"`
+	Images   []ImageVerdict `json:"images"`
+}
+
+// Validate checks that the request lists between one and MaxImages images
+func (r *Request) Validate() error {
+	images := requestImages(r)
+	if len(images) == 0 {
+		return fmt.Errorf("podSpec or images must list at least one image")
+	}
+	if len(images) > MaxImages {
+		return fmt.Errorf("at most %d images may be checked per request, got %d", MaxImages, len(images))
+	}
+	return nil
+}
+
+// Evaluate checks every image of the request against the ImageCertPolicies of the cluster,
+// using the ImageCertificationInfos that track them. Tag references are matched against
+// the most recently seen digest for the tag.
+func Evaluate(ctx context.Context, reader client.Reader, req *Request) (*Result, error) {
+	images := requestImages(req)
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := reader.List(ctx, &crList); err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+	var policies securityv1alpha1.ImageCertPolicyList
+	if err := reader.List(ctx, &policies); err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	result := &Result{Verdict: VerdictPass, Policies: len(policies.Items), Images: make([]ImageVerdict, 0, len(images))}
+	for _, img := range images {
+		verdict := ImageVerdict{Image: img.ref, Containers: img.containers, Verdict: VerdictUnknown}
+		if cr := findImage(crList.Items, img.ref); cr != nil {
+			if err := evaluateImage(ctx, reader, policies.Items, cr, req.Namespace, &verdict); err != nil {
+				return nil, err
+			}
+		} else {
+			verdict.Message = "image is not tracked in this cluster"
+		}
+
+		switch {
+		case verdict.Verdict == VerdictFail:
+			result.Verdict = VerdictFail
+		case verdict.Verdict == VerdictUnknown && result.Verdict == VerdictPass:
+			result.Verdict = VerdictUnknown
+		}
+		result.Images = append(result.Images, verdict)
+	}
+	return result, nil
+}
+
+// evaluateImage fills in the verdict for a tracked image from its ImageCertificationInfo
+// and the policies it violates
+func evaluateImage(ctx context.Context, reader client.Reader, policies []securityv1alpha1.ImageCertPolicy,
+	cr *securityv1alpha1.ImageCertificationInfo, namespace string, verdict *ImageVerdict) error {
+	verdict.Name = cr.Name
+	verdict.CertificationStatus = cr.Status.CertificationStatus
+	verdict.Vulnerabilities = search.Vulnerabilities(cr)
+	verdict.DaysUntilEOL = cr.Status.DaysUntilEOL
+
+	for i := range policies {
+		policy := &policies[i]
+		violations, err := controller.EvaluateImage(ctx, reader, policy, cr, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate ImageCertPolicy %s: %w", policy.Name, err)
+		}
+		for _, v := range violations {
+			verdict.Violations = append(verdict.Violations, Violation{Policy: policy.Name, Rule: v.Rule, Message: v.Message})
+		}
+	}
+
+	// Policies do not judge images still being looked up, so they pass no verdict on them
+	switch status := cr.Status.CertificationStatus; {
+	case len(verdict.Violations) > 0:
+		verdict.Verdict = VerdictFail
+	case status == "" || status == securityv1alpha1.CertificationStatusPending:
+		verdict.Verdict = VerdictUnknown
+		verdict.Message = "certification lookup is pending"
+	default:
+		verdict.Verdict = VerdictPass
+	}
+	return nil
+}
+
+// requestImage is a distinct image of a request and the containers using it
+type requestImage struct {
+	ref        string
+	containers []string
+}
+
+// requestImages lists the distinct images of the pod spec containers, in order, then the
+// listed images
+func requestImages(req *Request) []requestImage {
+	var images []requestImage
+	add := func(ref, container string) {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			return
+		}
+		i := slices.IndexFunc(images, func(img requestImage) bool { return img.ref == ref })
+		if i < 0 {
+			images = append(images, requestImage{ref: ref})
+			i = len(images) - 1
+		}
+		if container != "" {
+			images[i].containers = append(images[i].containers, container)
+		}
+	}
+	if spec := req.PodSpec; spec != nil {
+		for _, c := range spec.InitContainers {
+			add(c.Image, c.Name)
+		}
+		for _, c := range spec.Containers {
+			add(c.Image, c.Name)
+		}
+		for _, c := range spec.EphemeralContainers {
+			add(c.Image, c.Name)
+		}
+	}
+	for _, ref := range req.Images {
+		add(ref, "")
+	}
+	return images
+}
+
+// findImage returns the ImageCertificationInfo tracking a reference, or nil if it is not
+// tracked. A tag may have been seen at several digests; the most recently seen one is the
+// best guess for what the tag resolves to now.
+func findImage(items []securityv1alpha1.ImageCertificationInfo, ref string) *securityv1alpha1.ImageCertificationInfo {
+	if strings.Contains(ref, "@") {
+		parsed, err := image.ParseImageID(ref)
+		if err != nil {
+			return nil
+		}
+		name := image.ReferenceToCRName(parsed)
+		i := slices.IndexFunc(items, func(cr securityv1alpha1.ImageCertificationInfo) bool { return cr.Name == name })
+		if i < 0 {
+			return nil
+		}
+		return &items[i]
+	}
+
+	registry, repository, tag := image.ParseTagReference(ref)
+	if tag == "" {
+		tag = "latest"
+	}
+	var match *securityv1alpha1.ImageCertificationInfo
+	for i := range items {
+		cr := &items[i]
+		if cr.Spec.Registry != registry || cr.Spec.Repository != repository || cr.Spec.Tag != tag {
+			continue
+		}
+		if match == nil || (cr.Status.LastSeenAt != nil &&
+			(match.Status.LastSeenAt == nil || cr.Status.LastSeenAt.After(match.Status.LastSeenAt.Time))) {
+			match = cr
+		}
+	}
+	return match
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prescan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+const (
+	certifiedImage = "registry.redhat.io/ubi9/ubi@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	oldAppImage    = "quay.io/team/app@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	newAppImage    = "quay.io/team/app@sha256:cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+)
+
+// newImageInfo builds the ImageCertificationInfo of a digest reference
+func newImageInfo(t *testing.T, ref, tag string, status securityv1alpha1.CertificationStatus, critical int,
+	lastSeen time.Time) *securityv1alpha1.ImageCertificationInfo {
+	parsed, err := image.ParseImageID(ref)
+	if err != nil {
+		t.Fatalf("ParseImageID(%q) error = %v", ref, err)
+	}
+	seen := metav1.NewTime(lastSeen)
+	return &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: image.ReferenceToCRName(parsed)},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			Registry:           parsed.Registry,
+			Repository:         parsed.Repository,
+			Tag:                tag,
+			FullImageReference: ref,
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: status,
+			LastSeenAt:          &seen,
+			PyxisData: &securityv1alpha1.PyxisData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: critical},
+			},
+		},
+	}
+}
+
+func newTestClient(t *testing.T) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = securityv1alpha1.AddToScheme(scheme)
+
+	now := time.Now()
+	var maxCritical int32
+	objects := []client.Object{
+		newImageInfo(t, certifiedImage, "9.4", securityv1alpha1.CertificationStatusCertified, 0, now),
+		// The tag moved from the old digest, which has critical CVEs, to the new one
+		newImageInfo(t, oldAppImage, "v1", securityv1alpha1.CertificationStatusNotCertified, 3, now.Add(-time.Hour)),
+		newImageInfo(t, newAppImage, "v1", securityv1alpha1.CertificationStatusPending, 0, now),
+		&securityv1alpha1.ImageCertPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-critical"},
+			Spec: securityv1alpha1.ImageCertPolicySpec{Rules: []securityv1alpha1.ImageCertPolicyRule{
+				{Name: "no-critical-cves", MaxCriticalVulnerabilities: &maxCritical},
+			}},
+		},
+		&securityv1alpha1.ImageCertPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "production"},
+			Spec: securityv1alpha1.ImageCertPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}},
+				Rules: []securityv1alpha1.ImageCertPolicyRule{{
+					Name:                         "certified-only",
+					AllowedCertificationStatuses: []securityv1alpha1.CertificationStatus{securityv1alpha1.CertificationStatusCertified},
+				}},
+			},
+		},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"environment": "production"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	reader := newTestClient(t)
+
+	req := &Request{
+		PodSpec: &corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", Image: certifiedImage}},
+			Containers: []corev1.Container{
+				{Name: "app", Image: "quay.io/team/app:v1"},
+				{Name: "sidecar", Image: certifiedImage},
+			},
+		},
+		Images: []string{"docker.io/library/nginx:1.27", oldAppImage},
+	}
+	result, err := Evaluate(ctx, reader, req)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if result.Verdict != VerdictFail || result.Policies != 2 || len(result.Images) != 4 {
+		t.Fatalf("Evaluate() = %+v, want Fail for 4 images against 2 policies", result)
+	}
+
+	ubi := result.Images[0]
+	if ubi.Verdict != VerdictPass || strings.Join(ubi.Containers, ",") != "init,sidecar" ||
+		ubi.CertificationStatus != securityv1alpha1.CertificationStatusCertified {
+		t.Errorf("ubi verdict = %+v, want Pass for init and sidecar", ubi)
+	}
+	// The tag resolves to the most recently seen digest, whose lookup is pending
+	newApp := newImageInfo(t, newAppImage, "v1", "", 0, time.Time{})
+	if app := result.Images[1]; app.Verdict != VerdictUnknown || app.Name != newApp.Name {
+		t.Errorf("app tag verdict = %+v, want Unknown for the newest digest", app)
+	}
+	if nginx := result.Images[2]; nginx.Verdict != VerdictUnknown || nginx.Name != "" || nginx.Message == "" {
+		t.Errorf("untracked image verdict = %+v, want Unknown", nginx)
+	}
+	old := result.Images[3]
+	if old.Verdict != VerdictFail || len(old.Violations) != 1 || old.Violations[0].Policy != "no-critical" ||
+		old.Vulnerabilities == nil || old.Vulnerabilities.Critical != 3 {
+		t.Errorf("old digest verdict = %+v, want Fail on no-critical only without a namespace", old)
+	}
+
+	// Policies with a namespace selector apply to matching namespaces only
+	for namespace, want := range map[string]int{"prod": 2, "dev": 1} {
+		result, err := Evaluate(ctx, reader, &Request{Namespace: namespace, Images: []string{oldAppImage}})
+		if err != nil {
+			t.Fatalf("Evaluate(%s) error = %v", namespace, err)
+		}
+		if got := len(result.Images[0].Violations); got != want {
+			t.Errorf("violations in %s = %+v, want %d", namespace, result.Images[0].Violations, want)
+		}
+	}
+
+	result, err = Evaluate(ctx, reader, &Request{Namespace: "prod", Images: []string{certifiedImage}})
+	if err != nil || result.Verdict != VerdictPass {
+		t.Errorf("Evaluate(certified) = %+v, %v, want Pass", result, err)
+	}
+}
+
+func TestRequest_Validate(t *testing.T) {
+	if err := (&Request{}).Validate(); err == nil {
+		t.Error("Validate() without images: expected error")
+	}
+	if err := (&Request{PodSpec: &corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}}}).Validate(); err == nil {
+		t.Error("Validate() with an empty image: expected error")
+	}
+	images := make([]string, MaxImages+1)
+	for i := range images {
+		images[i] = "quay.io/team/app:" + strings.Repeat("v", i+1)
+	}
+	if err := (&Request{Images: images}).Validate(); err == nil {
+		t.Errorf("Validate() with %d images: expected error", len(images))
+	}
+	if err := (&Request{Images: []string{certifiedImage, certifiedImage}}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler := &Handler{Reader: newTestClient(t)}
+	post := func(body string) (int, Result) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, strings.NewReader(body)))
+		var result Result
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode result: %v", err)
+			}
+		}
+		return rec.Code, result
+	}
+
+	code, result := post(`{"podSpec":{"containers":[{"name":"app","image":"` + certifiedImage + `"}]}}`)
+	if code != http.StatusOK || result.Verdict != VerdictPass || len(result.Images) != 1 {
+		t.Errorf("POST = %d %+v, want a passing verdict", code, result)
+	}
+	if code, _ := post(`{"images":[]}`); code != http.StatusBadRequest {
+		t.Errorf("empty request status = %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, _ := post(`{"pod":{}}`); code != http.StatusBadRequest {
+		t.Errorf("unknown field status = %d, want %d", code, http.StatusBadRequest)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
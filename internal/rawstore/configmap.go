@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rawstore
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultConfigMapName is the name of the ConfigMap holding raw responses
+const DefaultConfigMapName = "imagecertinfo-raw-responses"
+
+// DefaultFlushInterval is how often buffered responses are written to the ConfigMap
+const DefaultFlushInterval = 30 * time.Second
+
+// maxConfigMapBytes keeps the ConfigMap comfortably below the 1MiB object size limit
+const maxConfigMapBytes = 900 * 1024
+
+// configMapEntry is a compressed response and when it was stored
+type configMapEntry struct {
+	data     []byte
+	storedAt time.Time
+}
+
+// ConfigMapStore keeps compressed responses in memory and periodically writes them
+// to a single ConfigMap. When the ConfigMap would exceed its size limit the oldest
+// responses are evicted.
+type ConfigMapStore struct {
+	client    client.Client
+	namespace string
+	name      string
+	maxBytes  int
+
+	mu      sync.Mutex
+	entries map[string]configMapEntry
+	dirty   bool
+}
+
+// NewConfigMapStore creates a store backed by the named ConfigMap
+func NewConfigMapStore(c client.Client, namespace, name string, maxBytes int) *ConfigMapStore {
+	return &ConfigMapStore{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+		maxBytes:  maxBytes,
+		entries:   make(map[string]configMapEntry),
+	}
+}
+
+// Put buffers body until the next flush
+func (s *ConfigMapStore) Put(_ context.Context, provider, key string, body []byte) error {
+	data, err := compress(body, s.maxBytes)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[EntryName(provider, key)] = configMapEntry{data: data, storedAt: time.Now()}
+	s.evictLocked()
+	s.dirty = true
+	return nil
+}
+
+// evictLocked drops the oldest entries until the total size fits in a ConfigMap
+func (s *ConfigMapStore) evictLocked() {
+	total := 0
+	for name, e := range s.entries {
+		total += len(name) + len(e.data)
+	}
+	if total <= maxConfigMapBytes {
+		return
+	}
+
+	names := slices.SortedFunc(maps.Keys(s.entries), func(a, b string) int {
+		return s.entries[a].storedAt.Compare(s.entries[b].storedAt)
+	})
+	for _, name := range names {
+		if total <= maxConfigMapBytes {
+			return
+		}
+		total -= len(name) + len(s.entries[name].data)
+		delete(s.entries, name)
+	}
+}
+
+// Start flushes buffered responses every DefaultFlushInterval until ctx is cancelled
+func (s *ConfigMapStore) Start(ctx context.Context) error {
+	ticker := time.NewTicker(DefaultFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.Flush(ctx); err != nil {
+				log.FromContext(ctx).Error(err, "failed to write raw responses ConfigMap", "name", s.name)
+			}
+		}
+	}
+}
+
+// Flush writes the buffered responses to the ConfigMap if anything changed
+func (s *ConfigMapStore) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.dirty {
+		s.mu.Unlock()
+		return nil
+	}
+	binaryData := make(map[string][]byte, len(s.entries))
+	for name, e := range s.entries {
+		binaryData[name] = e.data
+	}
+	s.dirty = false
+	s.mu.Unlock()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.name,
+			Namespace: s.namespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "imagecertinfo-operator"},
+		},
+		BinaryData: binaryData,
+	}
+
+	// The in-memory entries are authoritative, so overwrite unconditionally
+	err := s.client.Update(ctx, cm)
+	if apierrors.IsNotFound(err) {
+		err = s.client.Create(ctx, cm)
+	}
+	if err != nil {
+		s.mu.Lock()
+		s.dirty = true
+		s.mu.Unlock()
+	}
+	return err
+}
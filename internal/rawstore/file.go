@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rawstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileStore writes each response to a gzip file named after its provider and key
+// under a directory, overwriting the previous response for the same key.
+type FileStore struct {
+	dir      string
+	maxBytes int
+}
+
+// NewFileStore creates the directory if needed and returns a store writing into it
+func NewFileStore(dir string, maxBytes int) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create raw response directory: %w", err)
+	}
+	return &FileStore{dir: dir, maxBytes: maxBytes}, nil
+}
+
+// Put writes body to <dir>/<provider>.<key>.json.gz
+func (s *FileStore) Put(_ context.Context, provider, key string, body []byte) error {
+	data, err := compress(body, s.maxBytes)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial response
+	path := filepath.Join(s.dir, EntryName(provider, key))
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write raw response: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rawstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testDigest = "sha256:abc123def456"
+
+func decompress(t *testing.T, data []byte) string {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("failed to decompress: %v", err)
+	}
+	return string(out)
+}
+
+func TestEntryName(t *testing.T) {
+	if got := EntryName(ProviderPyxis, testDigest); got != "pyxis.sha256_abc123def456.json.gz" {
+		t.Errorf("EntryName() = %s", got)
+	}
+	if got := EntryName(ProviderDockerHub, "library/nginx"); got != "dockerhub.library_nginx.json.gz" {
+		t.Errorf("EntryName() = %s", got)
+	}
+}
+
+func TestFileStore_Put(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(dir, 8)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	if err := store.Put(context.Background(), ProviderPyxis, testDigest, []byte(`{"data":[]}`)); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, EntryName(ProviderPyxis, testDigest)))
+	if err != nil {
+		t.Fatalf("expected stored response: %v", err)
+	}
+	if got := decompress(t, data); got != `{"data":` {
+		t.Errorf("stored response = %q, want it truncated to 8 bytes", got)
+	}
+}
+
+func TestConfigMapStore_Flush(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	store := NewConfigMapStore(c, "test-ns", DefaultConfigMapName, DefaultMaxBytes)
+
+	for _, body := range []string{`{"first":true}`, `{"second":true}`} {
+		if err := store.Put(ctx, ProviderDockerHub, "library/nginx", []byte(body)); err != nil {
+			t.Fatalf("Put() error = %v", err)
+		}
+		if err := store.Flush(ctx); err != nil {
+			t.Fatalf("Flush() error = %v", err)
+		}
+	}
+
+	var cm corev1.ConfigMap
+	if err := c.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: DefaultConfigMapName}, &cm); err != nil {
+		t.Fatalf("expected ConfigMap: %v", err)
+	}
+	if got := decompress(t, cm.BinaryData[EntryName(ProviderDockerHub, "library/nginx")]); got != `{"second":true}` {
+		t.Errorf("stored response = %q, want the latest response", got)
+	}
+}
+
+func TestConfigMapStore_Evicts(t *testing.T) {
+	store := NewConfigMapStore(nil, "test-ns", DefaultConfigMapName, 0)
+	// Random data does not compress, so a few entries exceed the ConfigMap limit
+	body := make([]byte, 300*1024)
+	_, _ = rand.Read(body)
+	for _, key := range []string{"a", "b", "c", "d"} {
+		if err := store.Put(context.Background(), ProviderPyxis, key, body); err != nil {
+			t.Fatalf("Put() error = %v", err)
+		}
+	}
+
+	if _, ok := store.entries[EntryName(ProviderPyxis, "a")]; ok {
+		t.Error("expected the oldest entry to be evicted")
+	}
+	if _, ok := store.entries[EntryName(ProviderPyxis, "d")]; !ok {
+		t.Error("expected the newest entry to be kept")
+	}
+}
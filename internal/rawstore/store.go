@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rawstore keeps the last raw response returned by an external provider for
+// each image, so that unexpected status fields can be traced back to the API payload.
+package rawstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"regexp"
+)
+
+// DefaultMaxBytes is the default cap on the size of a stored response before compression
+const DefaultMaxBytes = 256 * 1024
+
+// Provider names used as the first component of stored keys
+const (
+	ProviderPyxis     = "pyxis"
+	ProviderDockerHub = "dockerhub"
+)
+
+// Store persists the most recent raw response per provider and key. Implementations
+// must be safe for concurrent use and should return quickly.
+type Store interface {
+	Put(ctx context.Context, provider, key string, body []byte) error
+}
+
+// invalidKeyChars matches characters not allowed in ConfigMap keys or file names
+var invalidKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// EntryName builds a filesystem and ConfigMap safe name for a provider and key
+func EntryName(provider, key string) string {
+	return provider + "." + invalidKeyChars.ReplaceAllString(key, "_") + ".json.gz"
+}
+
+// compress truncates body to maxBytes and gzips it
+func compress(body []byte, maxBytes int) ([]byte, error) {
+	if maxBytes > 0 && len(body) > maxBytes {
+		body = body[:maxBytes]
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
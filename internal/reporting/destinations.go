@@ -0,0 +1,243 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporting
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultRetain is the number of reports of each format kept in a directory
+const DefaultRetain = 30
+
+// DefaultS3Region is the region used to sign S3 requests when none is configured
+const DefaultS3Region = "us-east-1"
+
+// DefaultS3Timeout is the timeout for a single S3 upload
+const DefaultS3Timeout = time.Minute
+
+// Destination stores rendered report files
+type Destination interface {
+	// Name identifies the destination in logs and metrics
+	Name() string
+	// Write stores a report file under the given name
+	Write(ctx context.Context, name, contentType string, data []byte) error
+}
+
+// DirDestination writes reports to a directory, typically a mounted PersistentVolumeClaim,
+// and removes the oldest reports of each format beyond the retention count
+type DirDestination struct {
+	// Dir is the directory the reports are written to
+	Dir string
+	// Retain is the number of reports of each format kept; 0 keeps every report
+	Retain int
+}
+
+// Name returns "dir"
+func (d *DirDestination) Name() string {
+	return "dir"
+}
+
+// Write writes the file atomically, so that readers never see a partial report, then
+// prunes older reports with the same extension
+func (d *DirDestination) Write(_ context.Context, name, _ string, data []byte) error {
+	tmp, err := os.CreateTemp(d.Dir, "."+name+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(d.Dir, name)); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+	return d.prune(filepath.Ext(name))
+}
+
+// prune removes the oldest reports with the extension beyond the retention count. Report
+// names embed their generation time, so they sort oldest first.
+func (d *DirDestination) prune(ext string) error {
+	if d.Retain <= 0 {
+		return nil
+	}
+	matches, err := filepath.Glob(filepath.Join(d.Dir, filePrefix+"*"+ext))
+	if err != nil {
+		return err
+	}
+	slices.Sort(matches)
+	for _, path := range matches[:max(len(matches)-d.Retain, 0)] {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove old report: %w", err)
+		}
+	}
+	return nil
+}
+
+// S3Destination uploads reports to a bucket of an S3-compatible object store, such as
+// AWS S3, MinIO, or Ceph RGW, using path-style URLs and Signature Version 4. Expire old
+// reports with a bucket lifecycle rule.
+type S3Destination struct {
+	// Endpoint is the base URL of the object store, e.g. https://s3.us-east-1.amazonaws.com
+	Endpoint string
+	// Bucket is the bucket the reports are uploaded to
+	Bucket string
+	// Prefix is prepended to each object key, e.g. "imagecertinfo/"
+	Prefix string
+	// Region is the region requests are signed for
+	Region string
+	// AccessKeyID, SecretAccessKey, and the optional SessionToken are the credentials
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Client sends the requests; a client with DefaultS3Timeout is used if nil
+	Client *http.Client
+
+	now func() time.Time
+}
+
+// Name returns "s3"
+func (d *S3Destination) Name() string {
+	return "s3"
+}
+
+// Write uploads the file as an object named Prefix + name
+func (d *S3Destination) Write(ctx context.Context, name, contentType string, data []byte) error {
+	endpoint, err := url.Parse(strings.TrimSuffix(d.Endpoint, "/"))
+	if err != nil {
+		return fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+	endpoint.Path += "/" + d.Bucket + "/" + d.Prefix + name
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if d.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", d.SessionToken)
+	}
+	now := time.Now
+	if d.now != nil {
+		now = d.now
+	}
+	d.sign(req, payloadHash(data), now())
+
+	client := d.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultS3Timeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload report: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to upload report: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// payloadHash returns the hex-encoded SHA-256 of a request body
+func payloadHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sign adds a Signature Version 4 Authorization header to the request, covering the host
+// and every header already set on it
+func (d *S3Destination) sign(req *http.Request, bodyHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", bodyHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		bodyHash,
+	}, "\n")
+	scope := date + "/" + d.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256", amzDate, scope, payloadHash([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+d.SecretAccessKey), date)
+	for _, part := range []string{d.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		d.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of data with key
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// uriEncode percent-encodes a path as Signature Version 4 requires: every byte except
+// unreserved characters and slashes is encoded
+func uriEncode(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporting
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// filePrefix starts the name of every report file
+const filePrefix = "imagecertinfo-report-"
+
+// FileName returns the name of the report file generated at the given time, e.g.
+// imagecertinfo-report-20260101T120000Z.csv
+func FileName(generatedAt time.Time, format Format) string {
+	return filePrefix + generatedAt.UTC().Format("20060102T150405Z") + "." + format.Extension()
+}
+
+// Exporter renders the image inventory to each format every Interval and writes the files
+// to each destination. It runs only on the elected leader so that replicas do not export
+// duplicate reports.
+type Exporter struct {
+	client.Reader
+	// Interval is how often a report is exported
+	Interval time.Duration
+	// Formats are the formats each report is rendered to
+	Formats []Format
+	// Destinations store the report files
+	Destinations []Destination
+
+	now func() time.Time
+}
+
+// Start exports a report every Interval until ctx is cancelled
+func (e *Exporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Export(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "failed to export the inventory report")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Export renders a report of every ImageCertificationInfo and writes it to each
+// destination. A destination that fails does not prevent writing to the others.
+func (e *Exporter) Export(ctx context.Context) error {
+	var crList securityv1alpha1.ImageCertificationInfoList
+	if err := e.List(ctx, &crList); err != nil {
+		return err
+	}
+	now := time.Now
+	if e.now != nil {
+		now = e.now
+	}
+	report := NewReport(crList.Items, now())
+
+	var errs []error
+	for _, format := range e.Formats {
+		var buf bytes.Buffer
+		if err := report.Render(&buf, format); err != nil {
+			errs = append(errs, fmt.Errorf("failed to render %s report: %w", format, err))
+			continue
+		}
+		name := FileName(report.GeneratedAt, format)
+		for _, dest := range e.Destinations {
+			if err := dest.Write(ctx, name, format.contentType(), buf.Bytes()); err != nil {
+				metrics.RecordReportExport(dest.Name(), string(format), "error")
+				errs = append(errs, fmt.Errorf("%s: %w", dest.Name(), err))
+				continue
+			}
+			metrics.RecordReportExport(dest.Name(), string(format), "success")
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Exported inventory report", "images", len(report.Images),
+		"generatedAt", report.GeneratedAt)
+	return nil
+}
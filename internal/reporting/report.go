@@ -0,0 +1,239 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reporting renders the image inventory to JSON, CSV, and SARIF files and exports
+// them periodically to a directory or an S3-compatible bucket, for compliance teams that
+// need a file artifact rather than custom resources.
+package reporting
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/search"
+)
+
+// Format is a report file format
+type Format string
+
+const (
+	FormatJSON  Format = "json"
+	FormatCSV   Format = "csv"
+	FormatSARIF Format = "sarif"
+)
+
+// AllFormats lists every supported format
+var AllFormats = []Format{FormatJSON, FormatCSV, FormatSARIF}
+
+// ParseFormats parses a comma-separated list of formats, e.g. "json,sarif"
+func ParseFormats(spec string) ([]Format, error) {
+	var formats []Format
+	for name := range strings.SplitSeq(spec, ",") {
+		format := Format(strings.ToLower(strings.TrimSpace(name)))
+		if format == "" {
+			continue
+		}
+		if !slices.Contains(AllFormats, format) {
+			return nil, fmt.Errorf("unknown report format %q: expected json, csv, or sarif", name)
+		}
+		if !slices.Contains(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("no report formats in %q", spec)
+	}
+	return formats, nil
+}
+
+// Extension returns the file name extension of a format
+func (f Format) Extension() string {
+	if f == FormatSARIF {
+		return "sarif"
+	}
+	return string(f)
+}
+
+// contentType returns the media type of a format
+func (f Format) contentType() string {
+	if f == FormatCSV {
+		return "text/csv"
+	}
+	return "application/json"
+}
+
+// CVE is a vulnerability of a reported image
+type CVE struct {
+	ID       string `json:"id"`
+	Severity string `json:"severity,omitempty"`
+}
+
+// Record is the reported state of one image
+type Record struct {
+	Name                string                                 `json:"name"`
+	Image               string                                 `json:"image"`
+	Registry            string                                 `json:"registry,omitempty"`
+	Repository          string                                 `json:"repository"`
+	Digest              string                                 `json:"digest"`
+	RegistryType        string                                 `json:"registryType,omitempty"`
+	CertificationStatus string                                 `json:"certificationStatus,omitempty"`
+	ReasonCode          string                                 `json:"reasonCode,omitempty"`
+	HealthGrade         string                                 `json:"healthGrade,omitempty"`
+	Vulnerabilities     *securityv1alpha1.VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	CVEs                []CVE                                  `json:"cves,omitempty"`
+	EOLDate             *time.Time                             `json:"eolDate,omitempty"`
+	DaysUntilEOL        *int                                   `json:"daysUntilEol,omitempty"`
+	PodReferences       []securityv1alpha1.PodReference        `json:"podReferences,omitempty"`
+}
+
+// Report is the inventory rendered to every format
+type Report struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	Images      []Record  `json:"images"`
+}
+
+// NewReport builds a report of the images, ordered by name
+func NewReport(items []securityv1alpha1.ImageCertificationInfo, generatedAt time.Time) *Report {
+	report := &Report{GeneratedAt: generatedAt.UTC(), Images: make([]Record, 0, len(items))}
+	for i := range items {
+		report.Images = append(report.Images, newRecord(&items[i]))
+	}
+	slices.SortFunc(report.Images, func(a, b Record) int { return cmp.Compare(a.Name, b.Name) })
+	return report
+}
+
+// newRecord flattens an ImageCertificationInfo into a report record
+func newRecord(cr *securityv1alpha1.ImageCertificationInfo) Record {
+	record := Record{
+		Name:                cr.Name,
+		Image:               cr.Spec.FullImageReference,
+		Registry:            cr.Spec.Registry,
+		Repository:          cr.Spec.Repository,
+		Digest:              cr.Spec.ImageDigest,
+		RegistryType:        string(cr.Status.RegistryType),
+		CertificationStatus: string(cr.Status.CertificationStatus),
+		ReasonCode:          string(cr.Status.ReasonCode),
+		Vulnerabilities:     search.Vulnerabilities(cr),
+		CVEs:                imageCVEs(cr),
+		DaysUntilEOL:        cr.Status.DaysUntilEOL,
+		PodReferences:       cr.Status.PodReferences,
+	}
+	if pyxisData := cr.Status.PyxisData; pyxisData != nil {
+		record.HealthGrade = pyxisData.HealthIndex
+		if pyxisData.EOLDate != nil {
+			eol := pyxisData.EOLDate.UTC()
+			record.EOLDate = &eol
+		}
+	}
+	return record
+}
+
+// imageCVEs merges the CVEs reported by Pyxis with the tracked critical and important
+// CVEs, which also cover Quay scans, ordered by ID
+func imageCVEs(cr *securityv1alpha1.ImageCertificationInfo) []CVE {
+	var cves []CVE
+	add := func(id, severity string) {
+		i := slices.IndexFunc(cves, func(c CVE) bool { return c.ID == id })
+		if i < 0 {
+			cves = append(cves, CVE{ID: id, Severity: severity})
+		} else if cves[i].Severity == "" {
+			cves[i].Severity = severity
+		}
+	}
+	if cr.Status.PyxisData != nil {
+		for _, cve := range cr.Status.PyxisData.CVEs {
+			add(cve.ID, cve.Severity)
+		}
+	}
+	for _, cve := range cr.Status.TrackedCVEs {
+		add(cve.ID, cve.Severity)
+	}
+	slices.SortFunc(cves, func(a, b CVE) int { return cmp.Compare(a.ID, b.ID) })
+	return cves
+}
+
+// Render writes the report in the given format
+func (r *Report) Render(w io.Writer, format Format) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case FormatCSV:
+		return r.renderCSV(w)
+	case FormatSARIF:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r.sarif())
+	}
+	return fmt.Errorf("unknown report format %q", format)
+}
+
+// csvHeader names the CSV columns
+var csvHeader = []string{
+	"name", "image", "registry", "repository", "digest", "registryType", "certificationStatus",
+	"reasonCode", "healthGrade", "criticalCVEs", "importantCVEs", "moderateCVEs", "lowCVEs", "cves",
+	"eolDate", "daysUntilEol", "pods",
+}
+
+// renderCSV writes one row per image. Lists are separated by semicolons, and pods are
+// written as namespace/pod/container.
+func (r *Report) renderCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, record := range r.Images {
+		var critical, important, moderate, low string
+		if v := record.Vulnerabilities; v != nil {
+			critical, important = strconv.Itoa(v.Critical), strconv.Itoa(v.Important)
+			moderate, low = strconv.Itoa(v.Moderate), strconv.Itoa(v.Low)
+		}
+		cves := make([]string, len(record.CVEs))
+		for i, cve := range record.CVEs {
+			cves[i] = cve.ID
+		}
+		var eolDate, daysUntilEOL string
+		if record.EOLDate != nil {
+			eolDate = record.EOLDate.Format(time.DateOnly)
+		}
+		if record.DaysUntilEOL != nil {
+			daysUntilEOL = strconv.Itoa(*record.DaysUntilEOL)
+		}
+		pods := make([]string, len(record.PodReferences))
+		for i, ref := range record.PodReferences {
+			pods[i] = ref.Namespace + "/" + ref.Name + "/" + ref.Container
+		}
+		if err := out.Write([]string{
+			record.Name, record.Image, record.Registry, record.Repository, record.Digest,
+			record.RegistryType, record.CertificationStatus, record.ReasonCode, record.HealthGrade,
+			critical, important, moderate, low, strings.Join(cves, ";"),
+			eolDate, daysUntilEOL, strings.Join(pods, ";"),
+		}); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
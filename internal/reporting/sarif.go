@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporting
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/version"
+)
+
+// SARIF schema and version written by the SARIF renderer
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// Rule IDs of the SARIF findings
+const (
+	RuleNotCertified  = "image-not-certified"
+	RuleDeprecated    = "image-deprecated"
+	RuleEndOfLife     = "image-end-of-life"
+	RuleVulnerability = "image-vulnerability"
+)
+
+// sarifRules describes each rule a finding can reference
+var sarifRules = []sarifRule{
+	{ID: RuleNotCertified, ShortDescription: sarifMessage{Text: "Image is not certified"}},
+	{ID: RuleDeprecated, ShortDescription: sarifMessage{Text: "Image is in a deprecated release"}},
+	{ID: RuleEndOfLife, ShortDescription: sarifMessage{Text: "Image is past its end-of-life date"}},
+	{ID: RuleVulnerability, ShortDescription: sarifMessage{Text: "Image has a known vulnerability"}},
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// sarif converts the report to a SARIF log with one result per finding. Each result is
+// located at the image it concerns.
+func (r *Report) sarif() sarifLog {
+	results := []sarifResult{}
+	for _, record := range r.Images {
+		location := []sarifLocation{{LogicalLocations: []sarifLogicalLocation{{
+			Name:               record.Name,
+			FullyQualifiedName: record.Image,
+			Kind:               "image",
+		}}}}
+		result := func(ruleID, level, format string, args ...any) {
+			results = append(results, sarifResult{
+				RuleID:    ruleID,
+				Level:     level,
+				Message:   sarifMessage{Text: fmt.Sprintf(format, args...)},
+				Locations: location,
+			})
+		}
+
+		switch securityv1alpha1.CertificationStatus(record.CertificationStatus) {
+		case securityv1alpha1.CertificationStatusNotCertified:
+			result(RuleNotCertified, "warning", "%s is not certified", record.Image)
+		case securityv1alpha1.CertificationStatusDeprecated:
+			result(RuleDeprecated, "warning", "%s is in a deprecated release", record.Image)
+		case securityv1alpha1.CertificationStatusEOL:
+			result(RuleEndOfLife, "error", "%s is past its end-of-life date", record.Image)
+		}
+		for _, cve := range record.CVEs {
+			result(RuleVulnerability, sarifLevel(cve.Severity), "%s is affected by %s (%s)",
+				record.Image, cve.ID, cmp.Or(cve.Severity, "unknown severity"))
+		}
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "imagecertinfo-operator",
+				Version:        version.Get().Version,
+				InformationURI: "https://github.com/sebrandon1/imagecertinfo-operator",
+				Rules:          sarifRules,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+// sarifLevel maps a CVE severity to a SARIF result level
+func sarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical", "important":
+		return "error"
+	case "moderate":
+		return "warning"
+	}
+	return "note"
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Path is where the search endpoint is served on the metrics server
+const Path = "/api/v1/search"
+
+const (
+	// DefaultLimit is the page size when the request does not set one
+	DefaultLimit = 100
+	// MaxLimit is the largest page size a request may ask for
+	MaxLimit = 1000
+)
+
+// Image is the search result for one ImageCertificationInfo
+type Image struct {
+	Name                string                                 `json:"name"`
+	FullImageReference  string                                 `json:"fullImageReference"`
+	Registry            string                                 `json:"registry"`
+	Repository          string                                 `json:"repository"`
+	Tag                 string                                 `json:"tag,omitempty"`
+	RegistryType        securityv1alpha1.RegistryType          `json:"registryType,omitempty"`
+	CertificationStatus securityv1alpha1.CertificationStatus   `json:"certificationStatus,omitempty"`
+	HealthIndex         string                                 `json:"healthIndex,omitempty"`
+	Vulnerabilities     *securityv1alpha1.VulnerabilitySummary `json:"vulnerabilities,omitempty"`
+	DaysUntilEOL        *int                                   `json:"daysUntilEol,omitempty"`
+	Namespaces          []string                               `json:"namespaces,omitempty"`
+	OperatingSystems    []string                               `json:"operatingSystems,omitempty"`
+	Pods                int                                    `json:"pods"`
+	Workloads           int                                    `json:"workloads"`
+}
+
+// Result is one page of search results
+type Result struct {
+	// Items are the matching images on this page, ordered by name
+	Items []Image `json:"items"`
+	// Total is the number of matching images across all pages
+	Total int `json:"total"`
+	// Continue is passed as the continue parameter to fetch the next page; it is
+	// empty on the last page
+	Continue string `json:"continue,omitempty"`
+}
+
+// errorResponse is the body returned for a rejected request
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler answers search queries against the ImageCertificationInfos in the manager
+// cache, so dashboards and bots can query the inventory without kubectl. It accepts
+// the parameters q (see Parse), limit, and continue.
+type Handler struct {
+	// Reader lists ImageCertificationInfos, normally from the informer cache
+	Reader client.Reader
+}
+
+// ServeHTTP writes the requested page of matching images
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "only GET is supported"})
+		return
+	}
+	params := req.URL.Query()
+	query, err := Parse(params.Get("q"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+	limit, err := parseLimit(params.Get("limit"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+
+	list := &securityv1alpha1.ImageCertificationInfoList{}
+	if err := h.Reader.List(req.Context(), list); err != nil {
+		log.FromContext(req.Context()).Error(err, "failed to list ImageCertificationInfos for search")
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "failed to list images"})
+		return
+	}
+	writeJSON(w, http.StatusOK, search(list.Items, query, limit, params.Get("continue")))
+}
+
+// search returns the page of images matching the query that follows the image named after
+func search(items []securityv1alpha1.ImageCertificationInfo, query Query, limit int, after string) Result {
+	slices.SortFunc(items, func(a, b securityv1alpha1.ImageCertificationInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	result := Result{Items: []Image{}}
+	for i := range items {
+		cr := &items[i]
+		if !query.Matches(cr) {
+			continue
+		}
+		result.Total++
+		if cr.Name <= after {
+			continue
+		}
+		if len(result.Items) == limit {
+			// Another match follows, so the page ends at the previous image
+			result.Continue = result.Items[len(result.Items)-1].Name
+			continue
+		}
+		result.Items = append(result.Items, newImage(cr))
+	}
+	return result
+}
+
+// parseLimit reads the page size, falling back to DefaultLimit
+func parseLimit(value string) (int, error) {
+	if value == "" {
+		return DefaultLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > MaxLimit {
+		return 0, fmt.Errorf("limit must be an integer between 1 and %d, got %q", MaxLimit, value)
+	}
+	return limit, nil
+}
+
+// newImage summarizes an ImageCertificationInfo as a search result
+func newImage(cr *securityv1alpha1.ImageCertificationInfo) Image {
+	image := Image{
+		Name:                cr.Name,
+		FullImageReference:  cr.Spec.FullImageReference,
+		Registry:            cr.Spec.Registry,
+		Repository:          cr.Spec.Repository,
+		Tag:                 cr.Spec.Tag,
+		RegistryType:        cr.Status.RegistryType,
+		CertificationStatus: cr.Status.CertificationStatus,
+		Vulnerabilities:     Vulnerabilities(cr),
+		DaysUntilEOL:        cr.Status.DaysUntilEOL,
+		Namespaces:          Namespaces(cr),
+		OperatingSystems:    cr.Status.OperatingSystems,
+		Pods:                len(cr.Status.PodReferences),
+		Workloads:           cr.Status.WorkloadCount,
+	}
+	if cr.Status.PyxisData != nil {
+		image.HealthIndex = cr.Status.PyxisData.HealthIndex
+	}
+	return image
+}
+
+// writeJSON writes body as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// Operator compares an image field with a query value
+type Operator string
+
+const (
+	OpEqual        Operator = "="
+	OpNotEqual     Operator = "!="
+	OpGreater      Operator = ">"
+	OpGreaterEqual Operator = ">="
+	OpLess         Operator = "<"
+	OpLessEqual    Operator = "<="
+)
+
+// operators are checked longest first so that ">=" is not read as ">"
+var operators = []Operator{OpNotEqual, OpGreaterEqual, OpLessEqual, OpEqual, OpGreater, OpLess}
+
+// Condition is a single field comparison, e.g. criticalCVEs>0
+type Condition struct {
+	Field string
+	Op    Operator
+	Value string
+}
+
+// Query is a list of conditions that an image must all satisfy. An empty query
+// matches every image.
+type Query []Condition
+
+// field reads one searchable attribute of an image
+type field struct {
+	// numeric fields compare as integers; the others compare as strings
+	numeric bool
+	// values returns the field values of an image. A multi-valued field such as
+	// namespace matches = when any value matches and != when none does, so a field
+	// without values, such as an unscanned image's CVE count, only matches !=.
+	values func(cr *securityv1alpha1.ImageCertificationInfo) []string
+}
+
+// fields are the searchable attributes, keyed by lower-cased name
+var fields = map[string]field{
+	"name": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{cr.Name}
+	}},
+	"registry": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{cr.Spec.Registry}
+	}},
+	"repository": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{cr.Spec.Repository}
+	}},
+	"tag": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{cr.Spec.Tag}
+	}},
+	"status": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{string(cr.Status.CertificationStatus)}
+	}},
+	"registrytype": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{string(cr.Status.RegistryType)}
+	}},
+	"health": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		if cr.Status.PyxisData == nil || cr.Status.PyxisData.HealthIndex == "" {
+			return nil
+		}
+		return []string{cr.Status.PyxisData.HealthIndex}
+	}},
+	"namespace": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return Namespaces(cr)
+	}},
+	"os": {values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return cr.Status.OperatingSystems
+	}},
+	"criticalcves": {numeric: true, values: vulnerabilityCount(func(v *securityv1alpha1.VulnerabilitySummary) int {
+		return v.Critical
+	})},
+	"importantcves": {numeric: true, values: vulnerabilityCount(func(v *securityv1alpha1.VulnerabilitySummary) int {
+		return v.Important
+	})},
+	"moderatecves": {numeric: true, values: vulnerabilityCount(func(v *securityv1alpha1.VulnerabilitySummary) int {
+		return v.Moderate
+	})},
+	"lowcves": {numeric: true, values: vulnerabilityCount(func(v *securityv1alpha1.VulnerabilitySummary) int {
+		return v.Low
+	})},
+	"pods": {numeric: true, values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{strconv.Itoa(len(cr.Status.PodReferences))}
+	}},
+	"workloads": {numeric: true, values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return []string{strconv.Itoa(cr.Status.WorkloadCount)}
+	}},
+	"daysuntileol": {numeric: true, values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return optionalInt(cr.Status.DaysUntilEOL)
+	}},
+	"maxcveagedays": {numeric: true, values: func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		return optionalInt(cr.Status.MaxCVEAgeDays)
+	}},
+}
+
+// fieldNames lists the searchable fields as they are documented
+var fieldNames = []string{"name", "registry", "repository", "tag", "status", "registryType", "health", "namespace",
+	"os", "criticalCVEs", "importantCVEs", "moderateCVEs", "lowCVEs", "pods", "workloads", "daysUntilEOL", "maxCVEAgeDays"}
+
+// Parse parses a comma-separated list of conditions, e.g.
+// "registry=registry.redhat.io, status!=Certified, criticalCVEs>0". Field names are
+// case-insensitive, and a string value ending in '*' matches by prefix.
+func Parse(expr string) (Query, error) {
+	var query Query
+	for part := range strings.SplitSeq(expr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		condition, err := parseCondition(part)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, condition)
+	}
+	return query, nil
+}
+
+// parseCondition parses a single field, operator, and value
+func parseCondition(expr string) (Condition, error) {
+	i := strings.IndexAny(expr, "!=<>")
+	if i <= 0 {
+		return Condition{}, fmt.Errorf("invalid condition %q: expected <field><operator><value>", expr)
+	}
+	var op Operator
+	for _, candidate := range operators {
+		if strings.HasPrefix(expr[i:], string(candidate)) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return Condition{}, fmt.Errorf("invalid condition %q: unknown operator", expr)
+	}
+
+	condition := Condition{
+		Field: strings.TrimSpace(expr[:i]),
+		Op:    op,
+		Value: strings.TrimSpace(expr[i+len(op):]),
+	}
+	f, ok := fields[strings.ToLower(condition.Field)]
+	if !ok {
+		return Condition{}, fmt.Errorf("unknown field %q, expected one of %s", condition.Field,
+			strings.Join(fieldNames, ", "))
+	}
+	if f.numeric {
+		if _, err := strconv.Atoi(condition.Value); err != nil {
+			return Condition{}, fmt.Errorf("field %s requires an integer value, got %q", condition.Field, condition.Value)
+		}
+	} else if op != OpEqual && op != OpNotEqual {
+		return Condition{}, fmt.Errorf("field %s only supports = and !=", condition.Field)
+	}
+	return condition, nil
+}
+
+// Matches reports whether the image satisfies every condition of the query
+func (q Query) Matches(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	for _, condition := range q {
+		if !condition.Matches(cr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether the image satisfies the condition
+func (c Condition) Matches(cr *securityv1alpha1.ImageCertificationInfo) bool {
+	f, ok := fields[strings.ToLower(c.Field)]
+	if !ok {
+		return false
+	}
+	values := f.values(cr)
+	if c.Op == OpNotEqual {
+		return !slices.ContainsFunc(values, func(v string) bool { return compare(f.numeric, OpEqual, v, c.Value) })
+	}
+	return slices.ContainsFunc(values, func(v string) bool { return compare(f.numeric, c.Op, v, c.Value) })
+}
+
+// compare applies op to an image value and a query value
+func compare(numeric bool, op Operator, value, want string) bool {
+	if !numeric {
+		if prefix, ok := strings.CutSuffix(want, "*"); ok {
+			return len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix)
+		}
+		return strings.EqualFold(value, want)
+	}
+
+	got, err := strconv.Atoi(value)
+	if err != nil {
+		return false
+	}
+	limit, err := strconv.Atoi(want)
+	if err != nil {
+		return false
+	}
+	switch op {
+	case OpEqual:
+		return got == limit
+	case OpGreater:
+		return got > limit
+	case OpGreaterEqual:
+		return got >= limit
+	case OpLess:
+		return got < limit
+	case OpLessEqual:
+		return got <= limit
+	}
+	return false
+}
+
+// Namespaces returns the sorted namespaces of the pods using an image
+func Namespaces(cr *securityv1alpha1.ImageCertificationInfo) []string {
+	var namespaces []string
+	for _, ref := range cr.Status.PodReferences {
+		if !slices.Contains(namespaces, ref.Namespace) {
+			namespaces = append(namespaces, ref.Namespace)
+		}
+	}
+	slices.Sort(namespaces)
+	return namespaces
+}
+
+// Vulnerabilities returns the vulnerability counts of an image from Pyxis, or from
+// Quay for quay.io images, or nil if neither has scanned it
+func Vulnerabilities(cr *securityv1alpha1.ImageCertificationInfo) *securityv1alpha1.VulnerabilitySummary {
+	if cr.Status.PyxisData != nil && cr.Status.PyxisData.Vulnerabilities != nil {
+		return cr.Status.PyxisData.Vulnerabilities
+	}
+	if cr.Status.QuayData != nil {
+		return cr.Status.QuayData.Vulnerabilities
+	}
+	return nil
+}
+
+// vulnerabilityCount reads one severity count, with no value for unscanned images
+func vulnerabilityCount(get func(*securityv1alpha1.VulnerabilitySummary) int) func(
+	*securityv1alpha1.ImageCertificationInfo) []string {
+	return func(cr *securityv1alpha1.ImageCertificationInfo) []string {
+		vulns := Vulnerabilities(cr)
+		if vulns == nil {
+			return nil
+		}
+		return []string{strconv.Itoa(get(vulns))}
+	}
+}
+
+// optionalInt renders an optional integer, with no value when unset
+func optionalInt(v *int) []string {
+	if v == nil {
+		return nil
+	}
+	return []string{strconv.Itoa(*v)}
+}
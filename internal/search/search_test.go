@@ -0,0 +1,175 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// newImageInfo builds an ImageCertificationInfo used by pods in the given namespaces
+func newImageInfo(name, registry string, status securityv1alpha1.CertificationStatus, critical *int,
+	namespaces ...string) *securityv1alpha1.ImageCertificationInfo {
+	cr := &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			Registry:   registry,
+			Repository: "team/" + name,
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{CertificationStatus: status},
+	}
+	if critical != nil {
+		cr.Status.PyxisData = &securityv1alpha1.PyxisData{
+			HealthIndex:     "B",
+			Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: *critical},
+		}
+	}
+	for _, ns := range namespaces {
+		cr.Status.PodReferences = append(cr.Status.PodReferences,
+			securityv1alpha1.PodReference{Namespace: ns, Name: name + "-pod", Container: "app"})
+	}
+	return cr
+}
+
+func TestParse(t *testing.T) {
+	query, err := Parse(" registry = registry.redhat.io , criticalCVEs>=2,status!=Certified")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := Query{
+		{Field: "registry", Op: OpEqual, Value: "registry.redhat.io"},
+		{Field: "criticalCVEs", Op: OpGreaterEqual, Value: "2"},
+		{Field: "status", Op: OpNotEqual, Value: "Certified"},
+	}
+	if len(query) != len(want) {
+		t.Fatalf("Parse() = %+v, want %+v", query, want)
+	}
+	for i := range want {
+		if query[i] != want[i] {
+			t.Errorf("condition %d = %+v, want %+v", i, query[i], want[i])
+		}
+	}
+
+	for _, expr := range []string{"registry", "=quay.io", "owner=me", "criticalCVEs>many", "registry>quay.io"} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestQuery_Matches(t *testing.T) {
+	critical := 3
+	scanned := newImageInfo("ubi", "registry.redhat.io", securityv1alpha1.CertificationStatusNotCertified, &critical,
+		"payments", "frontend")
+	scanned.Status.OperatingSystems = []string{"linux"}
+	unscanned := newImageInfo("nginx", "docker.io", securityv1alpha1.CertificationStatusUnknown, nil)
+
+	tests := []struct {
+		expr                    string
+		wantScanned, wantOthers bool
+	}{
+		{"", true, true},
+		{"registry=registry.redhat.io", true, false},
+		{"Registry=REGISTRY.REDHAT.IO", true, false},
+		{"registry=registry.*", true, false},
+		{"status!=Certified", true, true},
+		{"criticalCVEs>0", true, false},
+		{"criticalCVEs<=2", false, false},
+		{"criticalCVEs!=3", false, true},
+		{"namespace=frontend", true, false},
+		{"namespace!=frontend", false, true},
+		{"health=B, pods=2", true, false},
+		{"os=linux", true, false},
+		{"os!=windows", true, true},
+		{"registry=registry.redhat.io, namespace=billing", false, false},
+	}
+	for _, tt := range tests {
+		query, err := Parse(tt.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.expr, err)
+		}
+		if got := query.Matches(scanned); got != tt.wantScanned {
+			t.Errorf("%q matches scanned image = %v, want %v", tt.expr, got, tt.wantScanned)
+		}
+		if got := query.Matches(unscanned); got != tt.wantOthers {
+			t.Errorf("%q matches unscanned image = %v, want %v", tt.expr, got, tt.wantOthers)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = securityv1alpha1.AddToScheme(scheme)
+	critical := 1
+	objects := []client.Object{
+		newImageInfo("c", "quay.io", securityv1alpha1.CertificationStatusUnknown, &critical, "apps"),
+		newImageInfo("a", "quay.io", securityv1alpha1.CertificationStatusUnknown, &critical, "apps"),
+		newImageInfo("b", "quay.io", securityv1alpha1.CertificationStatusUnknown, &critical, "apps"),
+		newImageInfo("d", "registry.redhat.io", securityv1alpha1.CertificationStatusCertified, nil, "apps"),
+	}
+	handler := &Handler{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()}
+
+	get := func(params url.Values) (int, Result) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+"?"+params.Encode(), nil))
+		var result Result
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode result: %v", err)
+			}
+		}
+		return rec.Code, result
+	}
+
+	code, page := get(url.Values{"q": {"registry=quay.io,criticalCVEs>0"}, "limit": {"2"}})
+	if code != http.StatusOK || page.Total != 3 || len(page.Items) != 2 || page.Continue != "b" {
+		t.Fatalf("first page = %d %+v, want a and b of 3 continuing after b", code, page)
+	}
+	if page.Items[0].Name != "a" || page.Items[0].Vulnerabilities.Critical != 1 ||
+		len(page.Items[0].Namespaces) != 1 {
+		t.Errorf("first item = %+v, want image a with its vulnerabilities and namespace", page.Items[0])
+	}
+
+	code, page = get(url.Values{"q": {"registry=quay.io,criticalCVEs>0"}, "limit": {"2"}, "continue": {"b"}})
+	if code != http.StatusOK || len(page.Items) != 1 || page.Items[0].Name != "c" || page.Continue != "" {
+		t.Errorf("second page = %d %+v, want only c and no continue token", code, page)
+	}
+
+	if code, _ := get(url.Values{"q": {"criticalCVEs>lots"}}); code != http.StatusBadRequest {
+		t.Errorf("invalid query status = %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, _ := get(url.Values{"limit": {"0"}}); code != http.StatusBadRequest {
+		t.Errorf("invalid limit status = %d, want %d", code, http.StatusBadRequest)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// LabelShard records on each ImageCertificationInfo the instance that owns it
+const LabelShard = "security.telco.openshift.io/shard"
+
+// labelMember marks the Leases used for shard membership
+const labelMember = "security.telco.openshift.io/shard-member"
+
+// leasePrefix is prepended to the instance ID to name its membership Lease
+const leasePrefix = "imagecertinfo-shard-"
+
+// DefaultLeaseDuration is how long a member stays in the ring without renewing its Lease
+const DefaultLeaseDuration = 30 * time.Second
+
+// ChangeFunc is called after the set of live members changes
+type ChangeFunc func(ctx context.Context)
+
+// Membership maintains this instance's Lease, tracks the live members, and answers
+// whether this instance owns a key. A nil Membership owns every key, which is the
+// single-instance behavior.
+type Membership struct {
+	client        client.Client
+	namespace     string
+	id            string
+	leaseDuration time.Duration
+	vnodes        int
+	onChange      []ChangeFunc
+
+	mu      sync.RWMutex
+	ring    *Ring
+	members []string
+}
+
+// Option is a function that configures a Membership
+type Option func(*Membership)
+
+// WithLeaseDuration sets how long a member stays in the ring without renewing
+func WithLeaseDuration(d time.Duration) Option {
+	return func(m *Membership) {
+		m.leaseDuration = d
+	}
+}
+
+// WithVirtualNodes sets the number of ring points per member
+func WithVirtualNodes(n int) Option {
+	return func(m *Membership) {
+		m.vnodes = n
+	}
+}
+
+// NewMembership creates a membership for the instance id. The client should not be
+// backed by the manager cache so that Leases are not watched cluster-wide.
+func NewMembership(c client.Client, namespace, id string, opts ...Option) *Membership {
+	m := &Membership{
+		client:        c,
+		namespace:     namespace,
+		id:            id,
+		leaseDuration: DefaultLeaseDuration,
+		vnodes:        DefaultVirtualNodes,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// ID returns this instance's member ID
+func (m *Membership) ID() string {
+	if m == nil {
+		return ""
+	}
+	return m.id
+}
+
+// OnChange registers a callback invoked after membership changes, including the first sync
+func (m *Membership) OnChange(fn ChangeFunc) {
+	m.onChange = append(m.onChange, fn)
+}
+
+// Owns reports whether this instance is responsible for key. Nothing is owned until
+// the first membership sync completes.
+func (m *Membership) Owns(key string) bool {
+	if m == nil {
+		return true
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ring != nil && m.ring.Owner(key) == m.id
+}
+
+// Members returns the live members from the last sync
+func (m *Membership) Members() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.members)
+}
+
+// NeedLeaderElection returns false so that every instance participates
+func (m *Membership) NeedLeaderElection() bool {
+	return false
+}
+
+// Start renews this instance's Lease and refreshes the member list until ctx is
+// cancelled, then releases the Lease so peers rebalance promptly.
+func (m *Membership) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("sharding")
+	ticker := time.NewTicker(m.leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Sync(ctx); err != nil {
+			logger.Error(err, "failed to sync shard membership")
+		}
+		select {
+		case <-ctx.Done():
+			release, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: m.leaseName(), Namespace: m.namespace}}
+			if err := m.client.Delete(release, lease); client.IgnoreNotFound(err) != nil {
+				logger.Error(err, "failed to release shard lease")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync renews this instance's Lease, lists live members, and rebuilds the ring if they changed
+func (m *Membership) Sync(ctx context.Context) error {
+	now := time.Now()
+	if err := m.renew(ctx, now); err != nil {
+		return err
+	}
+
+	var leases coordinationv1.LeaseList
+	if err := m.client.List(ctx, &leases, client.InNamespace(m.namespace), client.HasLabels{labelMember}); err != nil {
+		return err
+	}
+	var members []string
+	for _, lease := range leases.Items {
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if expiry.After(now) {
+			members = append(members, *lease.Spec.HolderIdentity)
+		}
+	}
+	slices.Sort(members)
+
+	m.mu.Lock()
+	changed := m.ring == nil || !slices.Equal(members, m.members)
+	if changed {
+		m.members = members
+		m.ring = NewRing(members, m.vnodes)
+	}
+	m.mu.Unlock()
+
+	if changed {
+		log.FromContext(ctx).Info("shard membership changed", "members", members, "self", m.id)
+		metrics.SetShardMembers(len(members))
+		for _, fn := range m.onChange {
+			fn(ctx)
+		}
+	}
+	return nil
+}
+
+// renew creates or updates this instance's Lease
+func (m *Membership) renew(ctx context.Context, now time.Time) error {
+	lease := &coordinationv1.Lease{}
+	err := m.client.Get(ctx, client.ObjectKey{Namespace: m.namespace, Name: m.leaseName()}, lease)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	notFound := apierrors.IsNotFound(err)
+
+	lease.Name = m.leaseName()
+	lease.Namespace = m.namespace
+	lease.Labels = map[string]string{labelMember: "true"}
+	lease.Spec.HolderIdentity = ptr.To(m.id)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(m.leaseDuration.Seconds()))
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+
+	if notFound {
+		return m.client.Create(ctx, lease)
+	}
+	return m.client.Update(ctx, lease)
+}
+
+// leaseName is the name of this instance's membership Lease
+func (m *Membership) leaseName() string {
+	return leasePrefix + m.id
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding splits image processing across operator instances by consistent
+// hashing of ImageCertificationInfo names over the set of live instances.
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"strconv"
+)
+
+// DefaultVirtualNodes is the number of points each member places on the ring
+const DefaultVirtualNodes = 64
+
+// Ring is an immutable consistent hash ring. Adding or removing a member only moves
+// the keys adjacent to that member's points.
+type Ring struct {
+	points []uint64
+	owners map[uint64]string
+}
+
+// NewRing builds a ring with vnodes points per member
+func NewRing(members []string, vnodes int) *Ring {
+	r := &Ring{owners: make(map[uint64]string, len(members)*vnodes)}
+	for _, member := range members {
+		for i := range vnodes {
+			h := hashKey(member + "#" + strconv.Itoa(i))
+			// Resolve the rare collision deterministically
+			if existing, ok := r.owners[h]; ok && existing < member {
+				continue
+			}
+			r.owners[h] = member
+		}
+	}
+	for h := range r.owners {
+		r.points = append(r.points, h)
+	}
+	slices.Sort(r.points)
+	return r
+}
+
+// Owner returns the member responsible for key, or "" if the ring is empty
+func (r *Ring) Owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	h := hashKey(key)
+	i, _ := slices.BinarySearch(r.points, h)
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// hashKey hashes a key onto the ring. FNV spreads similar short keys poorly, so a
+// truncated SHA-256 is used for an even distribution.
+func hashKey(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
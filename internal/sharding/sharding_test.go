@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNamespace = "imagecertinfo-operator-system"
+
+func TestRing_Stability(t *testing.T) {
+	before := NewRing([]string{"a", "b", "c"}, DefaultVirtualNodes)
+	after := NewRing([]string{"a", "b"}, DefaultVirtualNodes)
+
+	counts := map[string]int{}
+	for i := range 3000 {
+		key := fmt.Sprintf("registry.redhat.io.ubi8.ubi.%08x", i)
+		owner := before.Owner(key)
+		counts[owner]++
+		// Only keys owned by the removed member may move
+		if owner != "c" && after.Owner(key) != owner {
+			t.Fatalf("key %s moved from %s to %s", key, owner, after.Owner(key))
+		}
+	}
+	for _, member := range []string{"a", "b", "c"} {
+		if counts[member] < 600 {
+			t.Errorf("member %s owns %d of 3000 keys, expected a roughly even split", member, counts[member])
+		}
+	}
+
+	if owner := NewRing(nil, DefaultVirtualNodes).Owner("key"); owner != "" {
+		t.Errorf("empty ring Owner() = %q, want empty", owner)
+	}
+}
+
+func lease(id string, renewed time.Time) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      leasePrefix + id,
+			Namespace: testNamespace,
+			Labels:    map[string]string{labelMember: "true"},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(id),
+			LeaseDurationSeconds: ptr.To[int32](30),
+			RenewTime:            &metav1.MicroTime{Time: renewed},
+		},
+	}
+}
+
+func TestMembership_Sync(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(lease("peer", time.Now()), lease("gone", time.Now().Add(-time.Hour))).Build()
+
+	m := NewMembership(c, testNamespace, "self")
+	if m.Owns("anything") {
+		t.Error("expected nothing to be owned before the first sync")
+	}
+
+	changes := 0
+	m.OnChange(func(context.Context) { changes++ })
+	for range 2 {
+		if err := m.Sync(ctx); err != nil {
+			t.Fatalf("Sync() error = %v", err)
+		}
+	}
+
+	if got := m.Members(); !slices.Equal(got, []string{"peer", "self"}) {
+		t.Errorf("Members() = %v, want [peer self]", got)
+	}
+	if changes != 1 {
+		t.Errorf("OnChange called %d times, want 1", changes)
+	}
+
+	var own coordinationv1.Lease
+	if err := c.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: leasePrefix + "self"}, &own); err != nil {
+		t.Fatalf("expected own lease to be created: %v", err)
+	}
+
+	owned := 0
+	for i := range 100 {
+		if m.Owns(fmt.Sprintf("image-%d", i)) {
+			owned++
+		}
+	}
+	if owned == 0 || owned == 100 {
+		t.Errorf("self owns %d of 100 keys, expected a share", owned)
+	}
+
+	var nilMembership *Membership
+	if !nilMembership.Owns("anything") {
+		t.Error("a nil membership must own every key")
+	}
+}
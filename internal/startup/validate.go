@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package startup validates the operator's command-line configuration and reports
+// the effective settings before the manager starts.
+package startup
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MinCacheTTL is the shortest accepted cache TTL. Shorter TTLs defeat the cache and
+// mostly add API load.
+const MinCacheTTL = time.Minute
+
+// Validator collects problems with flag values so that all of them are reported at once
+type Validator struct {
+	problems []string
+	warnings []string
+}
+
+// Check records a problem described by format and args unless ok is true.
+// Messages should name the flag and say how to fix the value.
+func (v *Validator) Check(ok bool, format string, args ...any) {
+	if !ok {
+		v.problems = append(v.problems, fmt.Sprintf(format, args...))
+	}
+}
+
+// Warn records a warning unless ok is true. Warnings do not fail startup.
+func (v *Validator) Warn(ok bool, format string, args ...any) {
+	if !ok {
+		v.warnings = append(v.warnings, fmt.Sprintf(format, args...))
+	}
+}
+
+// Warnings returns the recorded warnings
+func (v *Validator) Warnings() []string {
+	return v.warnings
+}
+
+// Err returns all recorded problems joined into one error, or nil if there are none
+func (v *Validator) Err() error {
+	errs := make([]error, 0, len(v.problems))
+	for _, p := range v.problems {
+		errs = append(errs, errors.New(p))
+	}
+	return errors.Join(errs...)
+}
+
+// Report returns the value of every flag in fs keyed by name, with the values of the
+// redacted flags masked, and the sorted names of the flags set on the command line
+func Report(fs *flag.FlagSet, redacted ...string) (map[string]string, []string) {
+	values := make(map[string]string)
+	fs.VisitAll(func(f *flag.Flag) {
+		value := f.Value.String()
+		if value != "" && slices.Contains(redacted, f.Name) {
+			value = "<redacted>"
+		}
+		values[f.Name] = value
+	})
+
+	var overridden []string
+	fs.Visit(func(f *flag.Flag) {
+		overridden = append(overridden, f.Name)
+	})
+	slices.Sort(overridden)
+	return values, overridden
+}
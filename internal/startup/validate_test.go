@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package startup
+
+import (
+	"flag"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestValidator(t *testing.T) {
+	v := &Validator{}
+	v.Check(true, "never reported")
+	v.Check(false, "--rate must be positive, got %d", -1)
+	v.Check(false, "--ttl must be at least %s", MinCacheTTL)
+	v.Warn(false, "--flag has no effect")
+
+	err := v.Err()
+	if err == nil {
+		t.Fatal("Err() = nil, want the recorded problems")
+	}
+	for _, want := range []string{"--rate must be positive, got -1", "--ttl must be at least 1m0s"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Err() = %q, want it to contain %q", err, want)
+		}
+	}
+	if got := v.Warnings(); len(got) != 1 || got[0] != "--flag has no effect" {
+		t.Errorf("Warnings() = %v", got)
+	}
+
+	if err := (&Validator{}).Err(); err != nil {
+		t.Errorf("Err() without problems = %v, want nil", err)
+	}
+}
+
+func TestReport(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("api-key", "", "")
+	fs.Int("burst", 20, "")
+	fs.Bool("enabled", true, "")
+	if err := fs.Parse([]string{"--api-key=secret", "--burst=5"}); err != nil {
+		t.Fatal(err)
+	}
+
+	values, overridden := Report(fs, "api-key")
+	if values["api-key"] != "<redacted>" {
+		t.Errorf("api-key = %q, want it redacted", values["api-key"])
+	}
+	if values["burst"] != "5" || values["enabled"] != "true" {
+		t.Errorf("values = %v", values)
+	}
+	if !slices.Equal(overridden, []string{"api-key", "burst"}) {
+		t.Errorf("overridden = %v, want [api-key burst]", overridden)
+	}
+}
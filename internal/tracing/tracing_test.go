@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestHTTPClient(t *testing.T) {
+	// A nil client gets a new one with the timeout
+	client := HTTPClient(nil, 5*time.Second)
+	if client.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want 5s", client.Timeout)
+	}
+	if client.Transport == nil || client.Transport == http.DefaultTransport {
+		t.Error("Transport should wrap the default transport")
+	}
+
+	// An existing client keeps its timeout and transport and is not modified
+	base := &http.Client{Timeout: time.Minute, Transport: &http.Transport{}}
+	transport := base.Transport
+	wrapped := HTTPClient(base, 5*time.Second)
+	if wrapped == base {
+		t.Fatal("HTTPClient() should return a copy of an existing client")
+	}
+	if wrapped.Timeout != time.Minute {
+		t.Errorf("Timeout = %v, want the client's 1m", wrapped.Timeout)
+	}
+	if wrapped.Transport == transport {
+		t.Error("Transport should be wrapped")
+	}
+	if base.Transport != transport {
+		t.Error("the original client's transport was replaced")
+	}
+}
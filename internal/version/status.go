@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// DefaultConfigMapName is the ConfigMap the elected leader records its build information in
+const DefaultConfigMapName = "imagecertinfo-operator-info"
+
+// retryInterval is how long to wait before retrying a failed ConfigMap write
+const retryInterval = time.Minute
+
+// Status is the document served on /statusz
+type Status struct {
+	Info
+	// StartTime is when this replica started
+	StartTime metav1.Time `json:"startTime"`
+	// Uptime is how long this replica has been running
+	Uptime string `json:"uptime"`
+	// Leader reports whether this replica is the elected leader
+	Leader bool `json:"leader"`
+	// ProviderOutages lists the provider outages this replica observed, most recent first
+	ProviderOutages []securityv1alpha1.ProviderOutage `json:"providerOutages,omitempty"`
+	// LastRefreshCycle summarizes the warnings of the last refresh cycle, when refresh
+	// events are summarized
+	LastRefreshCycle any `json:"lastRefreshCycle,omitempty"`
+}
+
+// StatusHandler serves the build information and uptime of this replica as JSON
+type StatusHandler struct {
+	// StartTime is when the operator started
+	StartTime time.Time
+	// Elected is closed once this replica is elected leader
+	Elected <-chan struct{}
+	// Outages, if set, returns the provider outages observed by this replica
+	Outages func() []securityv1alpha1.ProviderOutage
+	// RefreshCycle, if set, returns the summary of the last refresh cycle, or nil
+	RefreshCycle func() any
+}
+
+// ServeHTTP writes the current Status
+func (h *StatusHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	status := Status{
+		Info:      Get(),
+		StartTime: metav1.NewTime(h.StartTime),
+		Uptime:    time.Since(h.StartTime).Truncate(time.Second).String(),
+	}
+	select {
+	case <-h.Elected:
+		status.Leader = true
+	default:
+	}
+	if h.Outages != nil {
+		status.ProviderOutages = h.Outages()
+	}
+	if h.RefreshCycle != nil {
+		status.LastRefreshCycle = h.RefreshCycle()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// Publisher records the build information of the elected leader in a ConfigMap in the
+// operator namespace, so that fleet tooling can read the running version through the
+// Kubernetes API. It runs once on the elected leader at startup.
+type Publisher struct {
+	// Client must not be backed by the manager cache, which would otherwise start a
+	// cluster-wide ConfigMap informer
+	Client client.Client
+	// Namespace is the operator namespace
+	Namespace string
+	// Name is the name of the ConfigMap
+	Name string
+	// PodName is the name of the replica publishing the information
+	PodName string
+	// StartTime is when the operator started
+	StartTime time.Time
+}
+
+// Start publishes the build information, retrying until it succeeds or ctx is cancelled
+func (p *Publisher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("build-info")
+	for {
+		err := p.Publish(ctx)
+		if err == nil {
+			return nil
+		}
+		logger.Error(err, "failed to publish build information, retrying", "retryInterval", retryInterval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// Publish creates or updates the build information ConfigMap
+func (p *Publisher) Publish(ctx context.Context) error {
+	info := Get()
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: p.Name, Namespace: p.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, p.Client, configMap, func() error {
+		configMap.Labels = map[string]string{"app.kubernetes.io/name": "imagecertinfo-operator"}
+		configMap.Data = map[string]string{
+			"version":   info.Version,
+			"commit":    info.Commit,
+			"buildDate": info.BuildDate,
+			"goVersion": info.GoVersion,
+			"platform":  info.Platform,
+			"leader":    p.PodName,
+			"startTime": p.StartTime.UTC().Format(time.RFC3339),
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to apply ConfigMap %s/%s: %w", p.Namespace, p.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestStatusHandler(t *testing.T) {
+	elected := make(chan struct{})
+	handler := &StatusHandler{StartTime: time.Now().Add(-time.Hour), Elected: elected}
+
+	get := func() Status {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/statusz", nil))
+		var status Status
+		if err := json.NewDecoder(rec.Body).Decode(&status); err != nil {
+			t.Fatalf("failed to decode status: %v", err)
+		}
+		return status
+	}
+
+	status := get()
+	if status.Version != version || status.GoVersion == "" {
+		t.Errorf("status = %+v, want the build information", status)
+	}
+	if status.Uptime != "1h0m0s" {
+		t.Errorf("Uptime = %q, want 1h0m0s", status.Uptime)
+	}
+	if status.Leader {
+		t.Error("Leader = true before election")
+	}
+
+	close(elected)
+	if !get().Leader {
+		t.Error("Leader = false after election")
+	}
+
+	if len(status.ProviderOutages) != 0 {
+		t.Errorf("ProviderOutages = %+v, want none without an outage tracker", status.ProviderOutages)
+	}
+	handler.Outages = func() []securityv1alpha1.ProviderOutage {
+		return []securityv1alpha1.ProviderOutage{{Provider: "pyxis", StartedAt: metav1.Now(), DeferredLookups: 3}}
+	}
+	if outages := get().ProviderOutages; len(outages) != 1 || outages[0].DeferredLookups != 3 {
+		t.Errorf("ProviderOutages = %+v, want the pyxis outage", outages)
+	}
+
+	if status.LastRefreshCycle != nil {
+		t.Errorf("LastRefreshCycle = %v, want none unless refresh events are summarized", status.LastRefreshCycle)
+	}
+	handler.RefreshCycle = func() any { return map[string]int{"newlyVulnerable": 2} }
+	if cycle, ok := get().LastRefreshCycle.(map[string]any); !ok || cycle["newlyVulnerable"] != float64(2) {
+		t.Errorf("LastRefreshCycle = %v, want the refresh cycle summary", get().LastRefreshCycle)
+	}
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	publisher := &Publisher{Client: fakeClient, Namespace: "system", Name: DefaultConfigMapName,
+		PodName: "manager-0", StartTime: start}
+
+	// Publishing twice updates the existing ConfigMap
+	for range 2 {
+		if err := publisher.Publish(ctx); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	var cm corev1.ConfigMap
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: "system", Name: DefaultConfigMapName}, &cm); err != nil {
+		t.Fatalf("failed to get ConfigMap: %v", err)
+	}
+	want := map[string]string{
+		"version":   version,
+		"leader":    "manager-0",
+		"startTime": "2026-01-02T03:04:05Z",
+	}
+	for k, v := range want {
+		if cm.Data[k] != v {
+			t.Errorf("Data[%q] = %q, want %q", k, cm.Data[k], v)
+		}
+	}
+	if cm.Data["commit"] == "" || cm.Data["goVersion"] == "" {
+		t.Errorf("Data = %v, want commit and Go version", cm.Data)
+	}
+}
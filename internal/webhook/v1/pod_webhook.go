@@ -0,0 +1,201 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/controller"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/pattern"
+	"github.com/sebrandon1/imagecertinfo-operator/pkg/image"
+)
+
+// log is for logging in this package.
+var podlog = logf.Log.WithName("pod-resource")
+
+// SetupPodWebhookWithManager registers the webhook for Pod in the manager.
+func SetupPodWebhookWithManager(mgr ctrl.Manager, validator *PodCustomValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr, &corev1.Pod{}).
+		WithValidator(validator).
+		Complete()
+}
+
+// The webhook fails open so that an operator outage never blocks pod creation.
+// +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create;update,versions=v1,name=vpod-v1.kb.io,admissionReviewVersions=v1
+
+// PodCustomValidator rejects pods that use images whose ImageCertificationInfo shows
+// they are not certified or carry too many critical vulnerabilities. Images that are
+// not tracked yet are allowed, since nothing is known about them.
+type PodCustomValidator struct {
+	Client client.Reader
+	// WarnOnly returns admission warnings instead of rejecting the pod
+	WarnOnly bool
+	// MaxCriticalVulnerabilities is the highest allowed critical CVE count (negative disables the check)
+	MaxCriticalVulnerabilities int
+	// ExcludedNamespaces are never checked. An entry ending in '*' matches by prefix.
+	ExcludedNamespaces []string
+}
+
+var _ admission.Validator[*corev1.Pod] = &PodCustomValidator{}
+
+// ValidateCreate checks every image used by a new pod.
+func (v *PodCustomValidator) ValidateCreate(ctx context.Context, pod *corev1.Pod) (admission.Warnings, error) {
+	return v.validate(ctx, pod, nil)
+}
+
+// ValidateUpdate checks only images that the update introduces.
+func (v *PodCustomValidator) ValidateUpdate(ctx context.Context, oldPod, newPod *corev1.Pod) (admission.Warnings, error) {
+	existing := make(map[string]bool)
+	for _, ref := range podImages(oldPod) {
+		existing[ref] = true
+	}
+	return v.validate(ctx, newPod, existing)
+}
+
+// ValidateDelete allows every deletion.
+func (v *PodCustomValidator) ValidateDelete(_ context.Context, _ *corev1.Pod) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate collects a violation for each image not in skip and denies or warns accordingly
+func (v *PodCustomValidator) validate(ctx context.Context, pod *corev1.Pod, skip map[string]bool) (admission.Warnings, error) {
+	if v.excluded(pod.Namespace) {
+		return nil, nil
+	}
+
+	var violations []string
+	for _, ref := range podImages(pod) {
+		if skip[ref] {
+			continue
+		}
+		cr, err := v.lookup(ctx, ref)
+		if err != nil {
+			// Fail open like the webhook itself
+			podlog.Error(err, "failed to look up image", "image", ref)
+			continue
+		}
+		if cr == nil {
+			continue
+		}
+		if violation := v.violation(cr); violation != "" {
+			violations = append(violations, fmt.Sprintf("image %s %s", ref, violation))
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil, nil
+	}
+	if v.WarnOnly {
+		return violations, nil
+	}
+	return nil, fmt.Errorf("pod rejected by image certification policy: %s", strings.Join(violations, "; "))
+}
+
+// violation describes why an image fails the policy, or returns "" if it passes. Images with
+// an active ImageCertExemption always pass.
+func (v *PodCustomValidator) violation(cr *securityv1alpha1.ImageCertificationInfo) string {
+	if cr.Labels[controller.LabelExemption] != "" {
+		return ""
+	}
+	if cr.Status.CertificationStatus == securityv1alpha1.CertificationStatusNotCertified {
+		return "is not certified"
+	}
+	if v.MaxCriticalVulnerabilities >= 0 && cr.Status.PyxisData != nil && cr.Status.PyxisData.Vulnerabilities != nil {
+		if critical := cr.Status.PyxisData.Vulnerabilities.Critical; critical > v.MaxCriticalVulnerabilities {
+			return fmt.Sprintf("has %d critical vulnerabilities (maximum %d)", critical, v.MaxCriticalVulnerabilities)
+		}
+	}
+	return ""
+}
+
+// lookup finds the ImageCertificationInfo for a pod spec image, or nil if it is not tracked
+func (v *PodCustomValidator) lookup(ctx context.Context, ref string) (*securityv1alpha1.ImageCertificationInfo, error) {
+	if strings.Contains(ref, "@") {
+		parsed, err := image.ParseImageID(ref)
+		if err != nil {
+			return nil, nil
+		}
+		var cr securityv1alpha1.ImageCertificationInfo
+		err = v.Client.Get(ctx, client.ObjectKey{Name: image.ReferenceToCRName(parsed)}, &cr)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &cr, nil
+	}
+
+	registry, repository, tag := image.ParseTagReference(ref)
+	if tag == "" {
+		tag = "latest"
+	}
+	var list securityv1alpha1.ImageCertificationInfoList
+	if err := v.Client.List(ctx, &list); err != nil {
+		return nil, err
+	}
+
+	// A tag may have been seen at several digests; the most recently seen one is the
+	// best guess for what the tag resolves to now
+	var match *securityv1alpha1.ImageCertificationInfo
+	for i := range list.Items {
+		cr := &list.Items[i]
+		if cr.Spec.Registry != registry || cr.Spec.Repository != repository || cr.Spec.Tag != tag {
+			continue
+		}
+		if match == nil || lastSeen(cr).After(lastSeen(match).Time) {
+			match = cr
+		}
+	}
+	return match, nil
+}
+
+// lastSeen returns when an image was last seen running, or the zero time
+func lastSeen(cr *securityv1alpha1.ImageCertificationInfo) metav1.Time {
+	if cr.Status.LastSeenAt == nil {
+		return metav1.Time{}
+	}
+	return *cr.Status.LastSeenAt
+}
+
+// excluded reports whether a namespace is exempt from the policy
+func (v *PodCustomValidator) excluded(namespace string) bool {
+	return pattern.MatchAny(v.ExcludedNamespaces, namespace)
+}
+
+// podImages lists the images of all init and regular containers
+func podImages(pod *corev1.Pod) []string {
+	images := make([]string, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, c := range pod.Spec.InitContainers {
+		images = append(images, c.Image)
+	}
+	for _, c := range pod.Spec.Containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
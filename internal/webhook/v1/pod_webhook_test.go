@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/controller"
+)
+
+const (
+	certifiedDigest   = "sha256:abc123def456789012345678901234567890123456789012345678901234"
+	vulnerableDigest  = "sha256:def456abc789012345678901234567890123456789012345678901234567"
+	uncertifiedDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789ab"
+	exemptDigest      = "sha256:89abcdef0123456789abcdef0123456789abcdef0123456789abcdef01234567"
+)
+
+func newTestCR(name, registry, repo, tag, digest string, status securityv1alpha1.CertificationStatus,
+	critical int) *securityv1alpha1.ImageCertificationInfo {
+	return &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        digest,
+			FullImageReference: registry + "/" + repo + "@" + digest,
+			Registry:           registry,
+			Repository:         repo,
+			Tag:                tag,
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			CertificationStatus: status,
+			PyxisData: &securityv1alpha1.PyxisData{
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: critical},
+			},
+		},
+	}
+}
+
+func newTestValidator(warnOnly bool) *PodCustomValidator {
+	exemptCR := newTestCR("quay.io.example.legacy.89abcdef", "quay.io", "example/legacy", "v2",
+		exemptDigest, securityv1alpha1.CertificationStatusNotCertified, 5)
+	exemptCR.Labels = map[string]string{controller.LabelExemption: "legacy-app"}
+
+	scheme := runtime.NewScheme()
+	_ = securityv1alpha1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newTestCR("registry.redhat.io.ubi8.ubi.abc123de", "registry.redhat.io", "ubi8/ubi", "8.9",
+			certifiedDigest, securityv1alpha1.CertificationStatusCertified, 0),
+		newTestCR("registry.redhat.io.ubi9.ubi.def456ab", "registry.redhat.io", "ubi9/ubi", "latest",
+			vulnerableDigest, securityv1alpha1.CertificationStatusCertified, 2),
+		newTestCR("quay.io.example.app.01234567", "quay.io", "example/app", "v1",
+			uncertifiedDigest, securityv1alpha1.CertificationStatusNotCertified, 0),
+		exemptCR,
+	).Build()
+	return &PodCustomValidator{Client: c, WarnOnly: warnOnly, ExcludedNamespaces: []string{"openshift-*"}}
+}
+
+func newTestPod(namespace string, images ...string) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: namespace}}
+	for _, img := range images {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: "c", Image: img})
+	}
+	return pod
+}
+
+func TestPodCustomValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		pod       *corev1.Pod
+		wantError string
+	}{
+		{name: "certified by tag", pod: newTestPod("default", "registry.redhat.io/ubi8/ubi:8.9")},
+		{name: "untracked image", pod: newTestPod("default", "docker.io/library/nginx:1.25")},
+		{
+			name:      "critical vulnerabilities by default tag",
+			pod:       newTestPod("default", "registry.redhat.io/ubi9/ubi"),
+			wantError: "2 critical vulnerabilities",
+		},
+		{
+			name:      "not certified by digest",
+			pod:       newTestPod("default", "quay.io/example/app@"+uncertifiedDigest),
+			wantError: "is not certified",
+		},
+		{name: "excluded namespace", pod: newTestPod("openshift-monitoring", "quay.io/example/app:v1")},
+		{name: "exempt by digest", pod: newTestPod("default", "quay.io/example/legacy@"+exemptDigest)},
+		{name: "exempt by tag", pod: newTestPod("default", "quay.io/example/legacy:v2")},
+	}
+
+	v := newTestValidator(false)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.ValidateCreate(context.Background(), tt.pod)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() error = %v, want allowed", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("ValidateCreate() error = %v, want %q", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestPodCustomValidator_WarnOnly(t *testing.T) {
+	v := newTestValidator(true)
+	warnings, err := v.ValidateCreate(context.Background(), newTestPod("default", "quay.io/example/app:v1"))
+	if err != nil {
+		t.Fatalf("ValidateCreate() error = %v, want warn-only to allow", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "is not certified") {
+		t.Errorf("warnings = %v, want one not-certified warning", warnings)
+	}
+}
+
+func TestPodCustomValidator_ValidateUpdate(t *testing.T) {
+	v := newTestValidator(false)
+	oldPod := newTestPod("default", "quay.io/example/app:v1")
+	newPod := newTestPod("default", "quay.io/example/app:v1")
+	newPod.Labels = map[string]string{"updated": "true"}
+
+	// Existing images are not re-checked on unrelated updates
+	if _, err := v.ValidateUpdate(context.Background(), oldPod, newPod); err != nil {
+		t.Errorf("ValidateUpdate() error = %v, want allowed", err)
+	}
+
+	newPod.Spec.Containers[0].Image = "registry.redhat.io/ubi9/ubi:latest"
+	if _, err := v.ValidateUpdate(context.Background(), oldPod, newPod); err == nil {
+		t.Error("ValidateUpdate() allowed a newly introduced vulnerable image")
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+	securityv1beta1 "github.com/sebrandon1/imagecertinfo-operator/api/v1beta1"
+)
+
+const testDigest = "sha256:abc123def456789012345678901234567890123456789012345678901234abcd"
+
+func newHub() *securityv1alpha1.ImageCertificationInfo {
+	now := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	eol, cveAge := 90, 12
+	return &securityv1alpha1.ImageCertificationInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "registry.redhat.io.ubi8.ubi.abc123de",
+			Labels: map[string]string{"app": "test"},
+		},
+		Spec: securityv1alpha1.ImageCertificationInfoSpec{
+			ImageDigest:        testDigest,
+			FullImageReference: "mirror.example.com/ubi8/ubi@" + testDigest,
+			Registry:           "registry.redhat.io",
+			Repository:         "ubi8/ubi",
+			Tag:                "8.9",
+			ObservedRegistry:   "mirror.example.com",
+			ObservedRepository: "ubi8/ubi",
+		},
+		Status: securityv1alpha1.ImageCertificationInfoStatus{
+			RegistryType:        securityv1alpha1.RegistryTypeRedHat,
+			CertificationStatus: securityv1alpha1.CertificationStatusCertified,
+			ReasonCode:          securityv1alpha1.ReasonCertifiedByPyxis,
+			LastPyxisCheckAt:    &now,
+			PyxisData: &securityv1alpha1.PyxisData{
+				Publisher:   "Red Hat",
+				HealthIndex: "A",
+				Vulnerabilities: &securityv1alpha1.VulnerabilitySummary{
+					Critical: 1,
+				},
+			},
+			DockerHubData: &securityv1alpha1.DockerHubData{PullCount: 42},
+			QuayData:      &securityv1alpha1.QuayData{ScanStatus: "scanned"},
+			RegistryData:  &securityv1alpha1.RegistryData{LayerCount: 3},
+			SBOM:          &securityv1alpha1.SBOMData{Present: true, Format: "SPDX"},
+			Ownership:     &securityv1alpha1.ImageOwnership{Vendor: "Red Hat"},
+			DataSources: []securityv1alpha1.DataSource{
+				{Name: securityv1alpha1.DataSourcePyxis, Fields: []string{"pyxisData"}, SyncedAt: now},
+			},
+			PodReferences: []securityv1alpha1.PodReference{
+				{Namespace: "default", Name: "test-pod", Container: "app"},
+			},
+			Workloads: []securityv1alpha1.WorkloadReference{
+				{Namespace: "default", Kind: "Deployment", Name: "test", Pods: 1},
+			},
+			WorkloadCount:  1,
+			NodeReferences: []string{"master-0"},
+			FirstSeenAt:    &now,
+			LastSeenAt:     &now,
+			OrphanedAt:     &now,
+			Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Checked", LastTransitionTime: now},
+			},
+			ImageAge:     "45 days",
+			DaysUntilEOL: &eol,
+			TrackedCVEs: []securityv1alpha1.TrackedCVE{
+				{ID: "CVE-2026-0001", Severity: "critical", FirstObservedAt: now},
+			},
+			MaxCVEAgeDays:         &cveAge,
+			MissingArchitectures:  []string{"arm64"},
+			OperatingSystems:      []string{"linux"},
+			ObjectSizeBytes:       4096,
+			LastReconcileAt:       &now,
+			ConsecutiveErrorCount: 3,
+			LastErrorAt:           &now,
+			EOLWarningTier:        "warning",
+			WarnedVulnerabilities: &securityv1alpha1.VulnerabilitySummary{Critical: 1, Important: 2},
+		},
+	}
+}
+
+func TestImageCertificationInfoConversion_RoundTrip(t *testing.T) {
+	hub := newHub()
+
+	spoke := &securityv1beta1.ImageCertificationInfo{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if spoke.Spec.Digest != testDigest || spoke.Spec.Mirror == nil ||
+		spoke.Spec.Mirror.Registry != "mirror.example.com" {
+		t.Errorf("unexpected v1beta1 spec: %+v", spoke.Spec)
+	}
+	if spoke.Status.Certification.Status != securityv1alpha1.CertificationStatusCertified ||
+		spoke.Status.Providers.Pyxis == nil || len(spoke.Status.Usage.Pods) != 1 ||
+		*spoke.Status.Vulnerabilities.MaxAgeDays != 12 {
+		t.Errorf("unexpected v1beta1 status: %+v", spoke.Status)
+	}
+
+	back := &securityv1alpha1.ImageCertificationInfo{}
+	if err := spoke.ConvertTo(back); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if !equality.Semantic.DeepEqual(hub, back) {
+		t.Errorf("round trip changed the object:\ngot  %+v\nwant %+v", back, hub)
+	}
+}
+
+func TestImageCertificationInfoConversion_NoMirror(t *testing.T) {
+	hub := newHub()
+	hub.Spec.ObservedRegistry = ""
+	hub.Spec.ObservedRepository = ""
+
+	spoke := &securityv1beta1.ImageCertificationInfo{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if spoke.Spec.Mirror != nil {
+		t.Errorf("Mirror = %+v, want nil when the image was not pulled through a mirror", spoke.Spec.Mirror)
+	}
+
+	back := &securityv1alpha1.ImageCertificationInfo{}
+	if err := spoke.ConvertTo(back); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if !equality.Semantic.DeepEqual(hub, back) {
+		t.Errorf("round trip changed the object:\ngot  %+v\nwant %+v", back, hub)
+	}
+}
+
+func TestImageCertificationInfoConversion_DoesNotAlias(t *testing.T) {
+	hub := newHub()
+
+	spoke := &securityv1beta1.ImageCertificationInfo{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	spoke.Status.Usage.Pods[0].Name = "changed"
+	spoke.Labels["app"] = "changed"
+
+	if hub.Status.PodReferences[0].Name != "test-pod" || hub.Labels["app"] != "test" {
+		t.Error("changing the converted object changed the source")
+	}
+}
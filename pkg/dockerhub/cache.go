@@ -21,32 +21,53 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 	"golang.org/x/time/rate"
 
+	"github.com/sebrandon1/imagecertinfo-operator/internal/ctxutil"
 	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
 )
 
+// tracer records the spans of lookups and rate limiter waits
+var tracer = otel.Tracer("github.com/sebrandon1/imagecertinfo-operator/pkg/dockerhub")
+
 // DefaultCacheTTL is the default time-to-live for cache entries
 const DefaultCacheTTL = 1 * time.Hour
 
+// DefaultNegativeCacheTTL is the default time-to-live for empty results, i.e. repositories Docker Hub does not know.
+// It is shorter than DefaultCacheTTL so that newly published data is noticed sooner.
+const DefaultNegativeCacheTTL = 15 * time.Minute
+
 // DefaultRateLimit is the default rate limit (requests per second)
 const DefaultRateLimit = 5.0
 
 // DefaultRateBurst is the default burst size for rate limiting
 const DefaultRateBurst = 10
 
+// metricsClient is the client label of the rate limiter metrics
+const metricsClient = "dockerhub"
+
 // cacheEntry represents a cached repository info entry
 type cacheEntry struct {
 	data      *RepositoryInfo
 	expiresAt time.Time
 }
 
-// CachedClient wraps a Client with caching capabilities
+// CachedClient wraps a Client with caching capabilities. Concurrent misses for the
+// same key share a single upstream call.
 type CachedClient struct {
 	client Client
 	cache  map[string]cacheEntry
 	mu     sync.RWMutex
-	ttl    time.Duration
+	// ttl is guarded by mu so that it can change at runtime
+	ttl time.Duration
+	// negativeTTL applies to empty results
+	negativeTTL time.Duration
+	group       singleflight.Group
 }
 
 // CacheOption is a function that configures a CachedClient
@@ -59,12 +80,20 @@ func WithCacheTTL(ttl time.Duration) CacheOption {
 	}
 }
 
+// WithNegativeCacheTTL sets the time-to-live for empty results. It never exceeds the cache TTL.
+func WithNegativeCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.negativeTTL = ttl
+	}
+}
+
 // NewCachedClient creates a new cached client wrapper
 func NewCachedClient(client Client, opts ...CacheOption) *CachedClient {
 	c := &CachedClient{
-		client: client,
-		cache:  make(map[string]cacheEntry),
-		ttl:    DefaultCacheTTL,
+		client:      client,
+		cache:       make(map[string]cacheEntry),
+		ttl:         DefaultCacheTTL,
+		negativeTTL: DefaultNegativeCacheTTL,
 	}
 
 	for _, opt := range opts {
@@ -83,6 +112,9 @@ func cacheKey(namespace, repository string) string {
 func (c *CachedClient) GetRepositoryInfo(
 	ctx context.Context, namespace, repository string,
 ) (*RepositoryInfo, error) {
+	ctx, span := tracer.Start(ctx, "dockerhub.GetRepositoryInfo", trace.WithAttributes(
+		attribute.String("namespace", namespace), attribute.String("repository", repository)))
+	defer span.End()
 	key := cacheKey(namespace, repository)
 
 	// Try to get from cache first
@@ -92,26 +124,47 @@ func (c *CachedClient) GetRepositoryInfo(
 
 	if found && time.Now().Before(entry.expiresAt) {
 		metrics.RecordDockerHubCacheHit()
+		span.AddEvent("cache hit")
 		return entry.data, nil
 	}
 
 	metrics.RecordDockerHubCacheMiss()
 
-	// Fetch from underlying client
-	data, err := c.client.GetRepositoryInfo(ctx, namespace, repository)
-	if err != nil {
-		return nil, err
-	}
+	// Fetch from underlying client, joining a call already in flight for this key
+	results := c.group.DoChan(key, func() (any, error) {
+		callCtx, cancel := ctxutil.Shared(ctx)
+		defer cancel()
 
-	// Store in cache
-	c.mu.Lock()
-	c.cache[key] = cacheEntry{
-		data:      data,
-		expiresAt: time.Now().Add(c.ttl),
-	}
-	c.mu.Unlock()
+		data, err := c.client.GetRepositoryInfo(callCtx, namespace, repository)
+		if err != nil {
+			return nil, err
+		}
 
-	return data, nil
+		// Store in cache; empty results expire sooner
+		c.mu.Lock()
+		ttl := c.ttl
+		if data == nil {
+			ttl = min(c.negativeTTL, c.ttl)
+		}
+		c.cache[key] = cacheEntry{
+			data:      data,
+			expiresAt: time.Now().Add(ttl),
+		}
+		c.mu.Unlock()
+
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.Err != nil {
+			span.SetStatus(codes.Error, res.Err.Error())
+			return nil, res.Err
+		}
+		return res.Val.(*RepositoryInfo), nil
+	}
 }
 
 // IsHealthy delegates to the underlying client
@@ -119,6 +172,20 @@ func (c *CachedClient) IsHealthy(ctx context.Context) bool {
 	return c.client.IsHealthy(ctx)
 }
 
+// SetTTL changes the time-to-live of entries cached from now on
+func (c *CachedClient) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	c.ttl = ttl
+	c.mu.Unlock()
+}
+
+// SetRateLimit changes the rate limit of the underlying client if it is rate limited
+func (c *CachedClient) SetRateLimit(rps float64, burst int) {
+	if limited, ok := c.client.(*RateLimitedClient); ok {
+		limited.SetRateLimit(rps, burst)
+	}
+}
+
 // ClearCache removes all entries from the cache
 func (c *CachedClient) ClearCache() {
 	c.mu.Lock()
@@ -189,6 +256,7 @@ func NewRateLimitedClient(client Client, opts ...RateLimitOption) *RateLimitedCl
 	for _, opt := range opts {
 		opt(c)
 	}
+	metrics.RegisterRateLimiter(metricsClient, c.limiter)
 
 	return c
 }
@@ -198,22 +266,36 @@ func (c *RateLimitedClient) GetRepositoryInfo(
 	ctx context.Context, namespace, repository string,
 ) (*RepositoryInfo, error) {
 	// Wait for rate limiter
-	if err := c.limiter.Wait(ctx); err != nil {
+	_, span := tracer.Start(ctx, "dockerhub.RateLimitWait")
+	start := time.Now()
+	err := c.limiter.Wait(ctx)
+	metrics.RecordRateLimiterWait(metricsClient, time.Since(start))
+	span.End()
+	if err != nil {
 		return nil, err
 	}
 
 	return c.client.GetRepositoryInfo(ctx, namespace, repository)
 }
 
+// SetRateLimit changes the rate limit (requests per second) and burst size
+func (c *RateLimitedClient) SetRateLimit(rps float64, burst int) {
+	c.limiter.SetLimit(rate.Limit(rps))
+	c.limiter.SetBurst(burst)
+}
+
 // IsHealthy delegates to the underlying client (no rate limiting for health checks)
 func (c *RateLimitedClient) IsHealthy(ctx context.Context) bool {
 	return c.client.IsHealthy(ctx)
 }
 
-// NewCachedRateLimitedClient creates a client with both caching and rate limiting
-func NewCachedRateLimitedClient(baseClient Client, cacheTTL time.Duration, rateLimit float64, burst int) Client {
+// NewCachedRateLimitedClient creates a client with both caching and rate limiting. opts
+// further configure the cache.
+func NewCachedRateLimitedClient(
+	baseClient Client, cacheTTL time.Duration, rateLimit float64, burst int, opts ...CacheOption,
+) Client {
 	// Apply rate limiting first, then caching
 	rateLimited := NewRateLimitedClient(baseClient, WithRateLimit(rateLimit), WithBurst(burst))
-	cached := NewCachedClient(rateLimited, WithCacheTTL(cacheTTL))
+	cached := NewCachedClient(rateLimited, append([]CacheOption{WithCacheTTL(cacheTTL)}, opts...)...)
 	return cached
 }
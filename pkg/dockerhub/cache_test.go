@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dockerhub
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingClient answers every lookup with data and counts the calls
+type countingClient struct {
+	data  *RepositoryInfo
+	calls atomic.Int32
+}
+
+func (c *countingClient) GetRepositoryInfo(context.Context, string, string) (*RepositoryInfo, error) {
+	c.calls.Add(1)
+	return c.data, nil
+}
+
+func (c *countingClient) IsHealthy(context.Context) bool { return true }
+
+func TestCachedClient_NegativeCacheTTL(t *testing.T) {
+	const negativeTTL = 50 * time.Millisecond
+
+	tests := []struct {
+		name        string
+		data        *RepositoryInfo
+		ttl         time.Duration
+		negativeTTL time.Duration
+		// wantCalls is the number of upstream calls once the negative TTL has passed
+		wantCalls int32
+	}{
+		{name: "found", data: &RepositoryInfo{Namespace: "library", Name: "nginx"}, ttl: time.Hour,
+			negativeTTL: negativeTTL, wantCalls: 1},
+		{name: "not found expires", ttl: time.Hour, negativeTTL: negativeTTL, wantCalls: 2},
+		{name: "not found capped by cache TTL", ttl: negativeTTL, negativeTTL: time.Hour, wantCalls: 2},
+		{name: "not found not cached", ttl: time.Hour, negativeTTL: 0, wantCalls: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upstream := &countingClient{data: tt.data}
+			cached := NewCachedRateLimitedClient(upstream, tt.ttl, 1000, 1000, WithNegativeCacheTTL(tt.negativeTTL))
+
+			for range 2 {
+				if _, err := cached.GetRepositoryInfo(context.Background(), "library", "nginx"); err != nil {
+					t.Fatalf("GetRepositoryInfo() error = %v", err)
+				}
+			}
+			time.Sleep(2 * negativeTTL)
+			if _, err := cached.GetRepositoryInfo(context.Background(), "library", "nginx"); err != nil {
+				t.Fatalf("GetRepositoryInfo() error = %v", err)
+			}
+
+			if calls := upstream.calls.Load(); calls != tt.wantCalls {
+				t.Errorf("upstream calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
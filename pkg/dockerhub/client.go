@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/rawstore"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -48,6 +49,7 @@ type Client interface {
 type HTTPClient struct {
 	baseURL    string
 	httpClient *http.Client
+	rawStore   rawstore.Store // Optional - keeps raw responses for debugging
 }
 
 // ClientOption is a function that configures an HTTPClient
@@ -74,6 +76,13 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithRawResponseStore keeps the last raw repository response per repository for debugging
+func WithRawResponseStore(store rawstore.Store) ClientOption {
+	return func(c *HTTPClient) {
+		c.rawStore = store
+	}
+}
+
 // NewHTTPClient creates a new Docker Hub HTTP client.
 // No authentication is required for the public API.
 func NewHTTPClient(opts ...ClientOption) *HTTPClient {
@@ -129,6 +138,7 @@ func (c *HTTPClient) GetRepositoryInfo(
 		return nil, fmt.Errorf("rate limited by Docker Hub")
 	default:
 		body, _ := io.ReadAll(resp.Body)
+		c.storeRawResponse(ctx, namespace+"/"+repository, body)
 		metrics.RecordDockerHubRequest("error", "repository", duration)
 		return nil, fmt.Errorf("unexpected response status %s: %s", resp.Status, string(body))
 	}
@@ -137,6 +147,7 @@ func (c *HTTPClient) GetRepositoryInfo(
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
+	c.storeRawResponse(ctx, namespace+"/"+repository, body)
 
 	var repoResp DockerHubRepositoryResponse
 	if err := json.Unmarshal(body, &repoResp); err != nil {
@@ -166,6 +177,16 @@ func (c *HTTPClient) GetRepositoryInfo(
 	return info, nil
 }
 
+// storeRawResponse saves body to the raw response store, if configured
+func (c *HTTPClient) storeRawResponse(ctx context.Context, key string, body []byte) {
+	if c.rawStore == nil {
+		return
+	}
+	if err := c.rawStore.Put(ctx, rawstore.ProviderDockerHub, key, body); err != nil {
+		ctrl.LoggerFrom(ctx).V(1).Info("failed to store raw Docker Hub response", "repository", key, "error", err)
+	}
+}
+
 // checkVerifiedPublisher checks if a namespace belongs to a Docker Verified Publisher.
 // This uses the orgs API endpoint which returns a "badge" field.
 func (c *HTTPClient) checkVerifiedPublisher(ctx context.Context, namespace string) bool {
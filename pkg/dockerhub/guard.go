@@ -0,0 +1,57 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dockerhub
+
+import (
+	"context"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/errorbudget"
+)
+
+// GuardedClient wraps a Client with an error budget guard that temporarily
+// disables Docker Hub calls while the API is failing
+type GuardedClient struct {
+	client Client
+	guard  *errorbudget.Guard
+}
+
+// NewGuardedClient creates a new guarded client wrapper
+func NewGuardedClient(client Client, guard *errorbudget.Guard) *GuardedClient {
+	return &GuardedClient{
+		client: client,
+		guard:  guard,
+	}
+}
+
+// GetRepositoryInfo calls the underlying client unless the guard has disabled it,
+// in which case errorbudget.ErrDisabled is returned
+func (c *GuardedClient) GetRepositoryInfo(
+	ctx context.Context, namespace, repository string,
+) (*RepositoryInfo, error) {
+	if !c.guard.Allow() {
+		return nil, errorbudget.ErrDisabled
+	}
+
+	data, err := c.client.GetRepositoryInfo(ctx, namespace, repository)
+	c.guard.Record(err)
+	return data, err
+}
+
+// IsHealthy delegates to the underlying client
+func (c *GuardedClient) IsHealthy(ctx context.Context) bool {
+	return c.client.IsHealthy(ctx)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MirrorMap maps mirror locations back to the registries they mirror, in the style
+// of an ImageDigestMirrorSet. Keys and values are either a registry host or a
+// registry host followed by a repository path. It is safe for concurrent use.
+type MirrorMap struct {
+	mu      sync.RWMutex
+	mirrors map[string]string
+}
+
+// NewMirrorMap returns a MirrorMap holding the given mirror to source mappings
+func NewMirrorMap(mirrors map[string]string) *MirrorMap {
+	m := &MirrorMap{}
+	m.Set(mirrors)
+	return m
+}
+
+// Set replaces all mirror to source mappings
+func (m *MirrorMap) Set(mirrors map[string]string) {
+	copied := make(map[string]string, len(mirrors))
+	for mirror, source := range mirrors {
+		copied[strings.TrimSuffix(mirror, "/")] = strings.TrimSuffix(source, "/")
+	}
+	m.mu.Lock()
+	m.mirrors = copied
+	m.mu.Unlock()
+}
+
+// Len returns the number of mirror to source mappings
+func (m *MirrorMap) Len() int {
+	if m == nil {
+		return 0
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.mirrors)
+}
+
+// Source returns the location an image was mirrored from. The longest mirror
+// matching the image's registry and repository wins. It returns false when no
+// mirror matches. A nil MirrorMap matches nothing.
+func (m *MirrorMap) Source(registry, repository string) (sourceRegistry, sourceRepository string, ok bool) {
+	if m == nil {
+		return "", "", false
+	}
+	location := registry + "/" + repository
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var best string
+	for mirror := range m.mirrors {
+		if len(mirror) <= len(best) {
+			continue
+		}
+		if location == mirror || strings.HasPrefix(location, mirror+"/") {
+			best = mirror
+		}
+	}
+	if best == "" {
+		return "", "", false
+	}
+
+	source := m.mirrors[best] + strings.TrimPrefix(location, best)
+	sourceRegistry, sourceRepository, found := strings.Cut(source, "/")
+	if !found || sourceRepository == "" {
+		return "", "", false
+	}
+	return sourceRegistry, sourceRepository, true
+}
+
+// ParseMirrorMap parses comma-separated mirror=source pairs, e.g.
+// "mirror.example.com:5000/rh=registry.redhat.io"
+func ParseMirrorMap(spec string) (map[string]string, error) {
+	mirrors := make(map[string]string)
+	for pair := range strings.SplitSeq(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		mirror, source, ok := strings.Cut(pair, "=")
+		mirror, source = strings.TrimSpace(mirror), strings.TrimSpace(source)
+		if !ok || mirror == "" || source == "" {
+			return nil, fmt.Errorf("invalid mirror mapping %q: expected mirror=source", pair)
+		}
+		mirrors[mirror] = source
+	}
+	return mirrors, nil
+}
+
+// ResolveSource returns the canonical reference for an image whose imageID may
+// name a mirror rather than the registry it was requested from. statusImage is the
+// container status image, which reports the reference from the pod spec. When
+// the pod spec names a different registry for the same repository, that registry
+// is the source. Otherwise the mirror map is consulted. The returned reference
+// records the mirror in ObservedRegistry and ObservedRepository. ref is returned
+// unchanged when it was not pulled through a mirror.
+func ResolveSource(ref *Reference, statusImage string, mirrors *MirrorMap) *Reference {
+	registry, repository, ok := mirrors.Source(ref.Registry, ref.Repository)
+	if !ok {
+		registry, repository, ok = sourceFromStatusImage(ref, statusImage)
+	}
+	if !ok || (registry == ref.Registry && repository == ref.Repository) {
+		return ref
+	}
+
+	resolved := *ref
+	resolved.Registry = registry
+	resolved.Repository = repository
+	resolved.ObservedRegistry = ref.Registry
+	resolved.ObservedRepository = ref.Repository
+	resolved.FullReference = registry + "/" + repository + "@" + ref.Digest
+	return &resolved
+}
+
+// sourceFromStatusImage reads the source registry from the container status image
+// when it explicitly names a registry other than the one in the imageID. The mirror
+// must carry the same repository, optionally below a namespace prefix.
+func sourceFromStatusImage(ref *Reference, statusImage string) (registry, repository string, ok bool) {
+	name, _, _ := strings.Cut(statusImage, "@")
+	host, _, found := strings.Cut(name, "/")
+	// Short names are resolved by the runtime's search registries, not mirrors
+	if !found || !(strings.ContainsAny(host, ".:") || host == "localhost") {
+		return "", "", false
+	}
+
+	registry, repository, _ = ParseTagReference(name)
+	if strings.EqualFold(registry, ref.Registry) {
+		return "", "", false
+	}
+	if repository != ref.Repository && !strings.HasSuffix(ref.Repository, "/"+repository) {
+		return "", "", false
+	}
+	return registry, repository, true
+}
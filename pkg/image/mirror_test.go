@@ -0,0 +1,157 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import "testing"
+
+const mirrorTestDigest = "sha256:abc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+
+func TestParseMirrorMap(t *testing.T) {
+	mirrors, err := ParseMirrorMap(" mirror.local:5000/rh = registry.redhat.io ,mirror.local:5000/quay=quay.io,")
+	if err != nil {
+		t.Fatalf("ParseMirrorMap() error = %v", err)
+	}
+	if len(mirrors) != 2 || mirrors["mirror.local:5000/rh"] != "registry.redhat.io" ||
+		mirrors["mirror.local:5000/quay"] != "quay.io" {
+		t.Errorf("ParseMirrorMap() = %v", mirrors)
+	}
+
+	for _, spec := range []string{"mirror.local", "=registry.redhat.io", "mirror.local="} {
+		if _, err := ParseMirrorMap(spec); err == nil {
+			t.Errorf("ParseMirrorMap(%q) expected an error", spec)
+		}
+	}
+}
+
+func TestMirrorMap_Source(t *testing.T) {
+	m := NewMirrorMap(map[string]string{
+		"mirror.local:5000":                "docker.io",
+		"mirror.local:5000/rh/":            "registry.redhat.io",
+		"mirror.local:5000/quay/openshift": "quay.io/openshift",
+	})
+
+	tests := []struct {
+		registry, repository string
+		wantRegistry         string
+		wantRepository       string
+		wantOK               bool
+	}{
+		{"mirror.local:5000", "rh/ubi9/ubi", "registry.redhat.io", "ubi9/ubi", true},
+		{"mirror.local:5000", "quay/openshift/origin-cli", "quay.io", "openshift/origin-cli", true},
+		{"mirror.local:5000", "library/nginx", "docker.io", "library/nginx", true},
+		{"mirror.local:5000", "rhx/ubi", "docker.io", "rhx/ubi", true},
+		{"other.local", "rh/ubi9/ubi", "", "", false},
+	}
+	for _, tt := range tests {
+		registry, repository, ok := m.Source(tt.registry, tt.repository)
+		if registry != tt.wantRegistry || repository != tt.wantRepository || ok != tt.wantOK {
+			t.Errorf("Source(%s, %s) = %s, %s, %v, want %s, %s, %v", tt.registry, tt.repository,
+				registry, repository, ok, tt.wantRegistry, tt.wantRepository, tt.wantOK)
+		}
+	}
+
+	var nilMap *MirrorMap
+	if _, _, ok := nilMap.Source("mirror.local:5000", "rh/ubi9/ubi"); ok {
+		t.Error("nil MirrorMap should match nothing")
+	}
+}
+
+func TestResolveSource(t *testing.T) {
+	mirrored := &Reference{
+		Registry:      "mirror.local:5000",
+		Repository:    "rh/ubi9/ubi",
+		Digest:        mirrorTestDigest,
+		FullReference: "mirror.local:5000/rh/ubi9/ubi@" + mirrorTestDigest,
+	}
+
+	tests := []struct {
+		name           string
+		statusImage    string
+		mirrors        *MirrorMap
+		wantRegistry   string
+		wantRepository string
+		wantObserved   string
+	}{
+		{
+			name:           "status image names the source registry",
+			statusImage:    "registry.redhat.io/ubi9/ubi:latest",
+			wantRegistry:   "registry.redhat.io",
+			wantRepository: "ubi9/ubi",
+			wantObserved:   "mirror.local:5000",
+		},
+		{
+			name:           "status image with digest",
+			statusImage:    "registry.redhat.io/ubi9/ubi@" + mirrorTestDigest,
+			wantRegistry:   "registry.redhat.io",
+			wantRepository: "ubi9/ubi",
+			wantObserved:   "mirror.local:5000",
+		},
+		{
+			name:           "status image names the mirror",
+			statusImage:    "mirror.local:5000/rh/ubi9/ubi:latest",
+			wantRegistry:   "mirror.local:5000",
+			wantRepository: "rh/ubi9/ubi",
+		},
+		{
+			name:           "short name is not treated as a source",
+			statusImage:    "ubi9/ubi:latest",
+			wantRegistry:   "mirror.local:5000",
+			wantRepository: "rh/ubi9/ubi",
+		},
+		{
+			name:           "different repository is not treated as a source",
+			statusImage:    "registry.redhat.io/ubi8/ubi:latest",
+			wantRegistry:   "mirror.local:5000",
+			wantRepository: "rh/ubi9/ubi",
+		},
+		{
+			name:           "mirror map takes precedence",
+			statusImage:    "mirror.local:5000/rh/ubi9/ubi:latest",
+			mirrors:        NewMirrorMap(map[string]string{"mirror.local:5000/rh": "registry.access.redhat.com"}),
+			wantRegistry:   "registry.access.redhat.com",
+			wantRepository: "ubi9/ubi",
+			wantObserved:   "mirror.local:5000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveSource(mirrored, tt.statusImage, tt.mirrors)
+			if got.Registry != tt.wantRegistry || got.Repository != tt.wantRepository {
+				t.Errorf("ResolveSource() = %s/%s, want %s/%s", got.Registry, got.Repository,
+					tt.wantRegistry, tt.wantRepository)
+			}
+			if got.ObservedRegistry != tt.wantObserved {
+				t.Errorf("ObservedRegistry = %q, want %q", got.ObservedRegistry, tt.wantObserved)
+			}
+			if tt.wantObserved == "" {
+				if got != mirrored {
+					t.Error("ResolveSource() should return an unmirrored reference unchanged")
+				}
+				return
+			}
+			if got.ObservedRepository != "rh/ubi9/ubi" {
+				t.Errorf("ObservedRepository = %q, want rh/ubi9/ubi", got.ObservedRepository)
+			}
+			if want := tt.wantRegistry + "/" + tt.wantRepository + "@" + mirrorTestDigest; got.FullReference != want {
+				t.Errorf("FullReference = %q, want %q", got.FullReference, want)
+			}
+			if got.Digest != mirrorTestDigest {
+				t.Errorf("Digest = %q, want %q", got.Digest, mirrorTestDigest)
+			}
+		})
+	}
+}
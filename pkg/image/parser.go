@@ -17,6 +17,8 @@ limitations under the License.
 package image
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"slices"
 	"strings"
@@ -36,6 +38,11 @@ type Reference struct {
 	Digest string
 	// FullReference is the complete image reference
 	FullReference string
+	// ObservedRegistry is the mirror registry the image was pulled from, when it
+	// differs from Registry
+	ObservedRegistry string
+	// ObservedRepository is the repository path on ObservedRegistry
+	ObservedRepository string
 }
 
 // ParseImageID parses a container status imageID into its components.
@@ -97,40 +104,129 @@ func ParseImageID(imageID string) (*Reference, error) {
 	return ref, nil
 }
 
+// ParseContainerImage parses the image of a container from its status imageID. Container
+// runtimes such as containerd often report the imageID as a bare digest (sha256:...), without
+// registry or repository. The registry and repository are then taken from the first of images,
+// typically the container status image and the pod spec image, that names a repository, and
+// combined with the digest.
+func ParseContainerImage(imageID string, images ...string) (*Reference, error) {
+	ref, err := ParseImageID(imageID)
+	if err == nil {
+		return ref, nil
+	}
+	imageID = strings.TrimPrefix(imageID, "docker://")
+	if !IsDigest(imageID) {
+		return nil, err
+	}
+
+	for _, candidate := range images {
+		name, _, _ := strings.Cut(candidate, "@")
+		if name == "" || IsDigest(candidate) {
+			continue
+		}
+		registry, repository, tag := ParseTagReference(name)
+		return &Reference{
+			Registry:      registry,
+			Repository:    repository,
+			Tag:           tag,
+			Digest:        imageID,
+			FullReference: name + "@" + imageID,
+		}, nil
+	}
+	return nil, fmt.Errorf("imageID %s is a bare digest and no image names its repository", imageID)
+}
+
+// IsDigest reports whether s is a bare image digest (sha256:<hex>) without a repository
+func IsDigest(s string) bool {
+	algorithm, hexDigest, ok := strings.Cut(s, ":")
+	if !ok || !slices.Contains([]string{"sha256", "sha384", "sha512"}, algorithm) || hexDigest == "" {
+		return false
+	}
+	_, err := hex.DecodeString(hexDigest)
+	return err == nil
+}
+
+// ParseTagReference splits a pod spec image reference (registry/repo:tag) into its
+// parts using the same defaulting rules as ParseImageID. The tag is empty when the
+// reference has none.
+func ParseTagReference(ref string) (registry, repository, tag string) {
+	if i := strings.LastIndex(ref, ":"); i != -1 && !strings.Contains(ref[i+1:], "/") {
+		ref, tag = ref[:i], ref[i+1:]
+	}
+
+	before, after, ok := strings.Cut(ref, "/")
+	switch {
+	case !ok:
+		return "docker.io", "library/" + ref, tag
+	case strings.ContainsAny(before, ".:") || before == "localhost":
+		return before, after, tag
+	default:
+		return "docker.io", ref, tag
+	}
+}
+
+// ParseSpecImage parses a pod spec image reference, which names a tag, a digest, or both.
+// The digest is empty when the reference names only a tag, and the tag defaults to latest
+// like the container runtime when it names neither.
+func ParseSpecImage(specImage string) *Reference {
+	name, digest, _ := strings.Cut(specImage, "@")
+	registry, repository, tag := ParseTagReference(name)
+	if tag == "" && digest == "" {
+		tag = "latest"
+	}
+	return &Reference{
+		Registry:      registry,
+		Repository:    repository,
+		Tag:           tag,
+		Digest:        digest,
+		FullReference: specImage,
+	}
+}
+
+// maxCRNameLength is the longest name Kubernetes accepts for a resource
+const maxCRNameLength = 253
+
 // ReferenceToCRName generates a human-readable CR name from an image reference.
 // Format: {registry}.{repo}.{short-digest}
 // Example: registry.redhat.io.ubi8.ubi.abc123de
+//
+// A reference not resolved to a digest is named by its tag instead, e.g.
+// quay.io.org.app.tag-v1, so that it never collides with a digest-keyed name.
+//
+// Names that would exceed the Kubernetes limit keep the start of the registry and
+// repository and the short digest, and replace the rest with a hash of the full
+// registry and repository, so that long repositories sharing a prefix do not collide.
 func ReferenceToCRName(ref *Reference) string {
-	// Start with registry and repository
-	name := ref.Registry + "." + ref.Repository
-
-	// Replace / with .
-	name = strings.ReplaceAll(name, "/", ".")
+	// Start with registry and repository, replacing / with .
+	prefix := strings.ReplaceAll(ref.Registry+"."+ref.Repository, "/", ".")
 
 	// Extract short digest (first 8 chars after sha256:)
 	shortDigest := ref.Digest
-	if trimmed, ok := strings.CutPrefix(shortDigest, "sha256:"); ok {
+	if shortDigest == "" {
+		shortDigest = "tag-" + ref.Tag
+	} else if trimmed, ok := strings.CutPrefix(shortDigest, "sha256:"); ok {
 		shortDigest = trimmed
 		if len(shortDigest) > 8 {
 			shortDigest = shortDigest[:8]
 		}
 	}
 
-	// Append short digest
-	name = name + "." + shortDigest
-
-	// Convert to lowercase
-	name = strings.ToLower(name)
-
-	// Replace any remaining invalid characters with -
-	name = sanitizeK8sName(name)
-
-	// Ensure max length of 253 characters
-	if len(name) > 253 {
-		name = name[:253]
+	// Convert to lowercase and replace any invalid characters with -
+	name := sanitizeK8sName(strings.ToLower(prefix + "." + shortDigest))
+	if len(name) <= maxCRNameLength {
+		return name
 	}
 
-	return name
+	// Trim the middle of the name, never the digest
+	suffix := "." + sanitizeK8sName(strings.ToLower(shortDigest))
+	sum := sha256.Sum256([]byte(ref.Registry + "/" + ref.Repository))
+	suffix = "." + hex.EncodeToString(sum[:])[:8] + suffix
+	if len(suffix) >= maxCRNameLength {
+		return strings.Trim(suffix[len(suffix)-maxCRNameLength:], ".-")
+	}
+	head := sanitizeK8sName(strings.ToLower(prefix))
+	head = strings.Trim(head[:min(len(head), maxCRNameLength-len(suffix))], ".-")
+	return strings.TrimLeft(head+suffix, ".-")
 }
 
 // sanitizeK8sName ensures the name is valid for Kubernetes resources
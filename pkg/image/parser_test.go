@@ -17,8 +17,11 @@ limitations under the License.
 package image
 
 import (
+	"strings"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/util/validation"
+
 	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
 )
 
@@ -211,6 +214,15 @@ func TestReferenceToCRName(t *testing.T) {
 			},
 			want: "gcr.io.google-containers.some.deep.path.aabbccdd",
 		},
+		{
+			name: "Unresolved tag",
+			ref: &Reference{
+				Registry:   "quay.io",
+				Repository: "org/app",
+				Tag:        "V1_2",
+			},
+			want: "quay.io.org.app.tag-v1.2",
+		},
 	}
 
 	for _, tt := range tests {
@@ -222,6 +234,152 @@ func TestReferenceToCRName(t *testing.T) {
 	}
 }
 
+func TestReferenceToCRName_LongRepositories(t *testing.T) {
+	digest := "sha256:aabbccdd11223344aabbccdd11223344aabbccdd11223344aabbccdd11223344"
+	long := strings.Repeat("segment/", 40)
+	refs := []*Reference{
+		// Repositories that only differ after the point where the name is trimmed
+		{Registry: "quay.io", Repository: long + "first", Digest: digest},
+		{Registry: "quay.io", Repository: long + "second", Digest: digest},
+		// The same repository in a different registry
+		{Registry: "mirror.example.com", Repository: long + "first", Digest: digest},
+		// A single path component far longer than a name allows
+		{Registry: "quay.io", Repository: strings.Repeat("x", 600), Digest: digest},
+		{Registry: "quay.io", Repository: strings.Repeat("x", 601), Digest: digest},
+	}
+
+	seen := make(map[string]*Reference)
+	for _, ref := range refs {
+		name := ReferenceToCRName(ref)
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			t.Errorf("ReferenceToCRName(%s/%s) = %q is not a valid name: %v", ref.Registry, ref.Repository, name, errs)
+		}
+		if !strings.HasSuffix(name, ".aabbccdd") {
+			t.Errorf("ReferenceToCRName(%s/%s) = %q does not end with the short digest", ref.Registry, ref.Repository,
+				name)
+		}
+		if other, ok := seen[name]; ok {
+			t.Errorf("%s/%s and %s/%s both map to %q", other.Registry, other.Repository, ref.Registry, ref.Repository,
+				name)
+		}
+		seen[name] = ref
+		if again := ReferenceToCRName(ref); again != name {
+			t.Errorf("ReferenceToCRName() is not deterministic: %q then %q", name, again)
+		}
+	}
+
+	// Names within the limit keep the readable format
+	short := &Reference{Registry: "quay.io", Repository: strings.Repeat("a", 200), Digest: digest}
+	if name := ReferenceToCRName(short); name != "quay.io."+strings.Repeat("a", 200)+".aabbccdd" {
+		t.Errorf("ReferenceToCRName() = %q, want the untrimmed name", name)
+	}
+}
+
+func TestParseTagReference(t *testing.T) {
+	tests := []struct {
+		ref                       string
+		registry, repository, tag string
+	}{
+		{ref: "nginx", registry: "docker.io", repository: "library/nginx"},
+		{ref: "nginx:1.25", registry: "docker.io", repository: "library/nginx", tag: "1.25"},
+		{ref: "bitnami/redis:7.2", registry: "docker.io", repository: "bitnami/redis", tag: "7.2"},
+		{ref: "registry.redhat.io/ubi8/ubi:8.9", registry: "registry.redhat.io", repository: "ubi8/ubi", tag: "8.9"},
+		{ref: "localhost:5000/app", registry: "localhost:5000", repository: "app"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			registry, repository, tag := ParseTagReference(tt.ref)
+			if registry != tt.registry || repository != tt.repository || tag != tt.tag {
+				t.Errorf("ParseTagReference() = %s, %s, %s; want %s, %s, %s",
+					registry, repository, tag, tt.registry, tt.repository, tt.tag)
+			}
+		})
+	}
+}
+
+func TestParseSpecImage(t *testing.T) {
+	const digest = "sha256:abc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+	tests := map[string]Reference{
+		"nginx":                     {Registry: "docker.io", Repository: "library/nginx", Tag: "latest"},
+		"quay.io/org/app:v1":        {Registry: "quay.io", Repository: "org/app", Tag: "v1"},
+		"quay.io/org/app@" + digest: {Registry: "quay.io", Repository: "org/app", Digest: digest},
+		"quay.io/org/app:v1@" + digest: {
+			Registry: "quay.io", Repository: "org/app", Tag: "v1", Digest: digest,
+		},
+	}
+	for specImage, want := range tests {
+		want.FullReference = specImage
+		if got := ParseSpecImage(specImage); *got != want {
+			t.Errorf("ParseSpecImage(%q) = %+v, want %+v", specImage, *got, want)
+		}
+	}
+}
+
+func TestParseContainerImage(t *testing.T) {
+	const digest = "sha256:abc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+	tests := []struct {
+		name    string
+		imageID string
+		images  []string
+		wantErr bool
+		wantRef *Reference
+	}{
+		{
+			name:    "imageID with repository",
+			imageID: "quay.io/app/web@" + digest,
+			images:  []string{"quay.io/app/web:v1"},
+			wantRef: &Reference{Registry: "quay.io", Repository: "app/web", Digest: digest,
+				FullReference: "quay.io/app/web@" + digest},
+		},
+		{
+			name:    "containerd bare digest",
+			imageID: digest,
+			images:  []string{"registry.redhat.io/ubi9/ubi:9.4"},
+			wantRef: &Reference{Registry: "registry.redhat.io", Repository: "ubi9/ubi", Tag: "9.4", Digest: digest,
+				FullReference: "registry.redhat.io/ubi9/ubi:9.4@" + digest},
+		},
+		{
+			name:    "status image is also a digest",
+			imageID: digest,
+			images:  []string{digest, "nginx:1.25"},
+			wantRef: &Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "1.25", Digest: digest,
+				FullReference: "nginx:1.25@" + digest},
+		},
+		{
+			name:    "pod spec pinned by digest",
+			imageID: "docker://" + digest,
+			images:  []string{"", "quay.io/app/web@" + digest},
+			wantRef: &Reference{Registry: "quay.io", Repository: "app/web", Digest: digest,
+				FullReference: "quay.io/app/web@" + digest},
+		},
+		{
+			name:    "bare digest without image names",
+			imageID: digest,
+			images:  []string{digest},
+			wantErr: true,
+		},
+		{
+			name:    "tag without digest",
+			imageID: "nginx:1234",
+			images:  []string{"nginx:1234"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseContainerImage(tt.imageID, tt.images...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseContainerImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantRef != nil && *got != *tt.wantRef {
+				t.Errorf("ParseContainerImage() = %+v, want %+v", got, tt.wantRef)
+			}
+		})
+	}
+}
+
 func TestDigestToCRName(t *testing.T) {
 	tests := []struct {
 		digest string
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+// RegistryRule classifies the registries matching Pattern. Pattern is a registry host,
+// optionally with a port, or a wildcard such as "*.mirror.example.com" that matches
+// any subdomain.
+type RegistryRule struct {
+	Pattern string
+	Type    securityv1alpha1.RegistryType
+	// PyxisEligible marks the registries whose images are looked up in Pyxis
+	PyxisEligible bool
+}
+
+// RegistryClassifier classifies registries by configured rules, falling back to
+// ClassifyRegistry for registries no rule matches. It is safe for concurrent use.
+type RegistryClassifier struct {
+	mu    sync.RWMutex
+	rules []RegistryRule
+}
+
+// NewRegistryClassifier returns a RegistryClassifier holding the given rules
+func NewRegistryClassifier(rules []RegistryRule) *RegistryClassifier {
+	c := &RegistryClassifier{}
+	c.Set(rules)
+	return c
+}
+
+// Set replaces all classification rules
+func (c *RegistryClassifier) Set(rules []RegistryRule) {
+	copied := make([]RegistryRule, len(rules))
+	for i, rule := range rules {
+		rule.Pattern = strings.ToLower(strings.TrimSpace(rule.Pattern))
+		copied[i] = rule
+	}
+	c.mu.Lock()
+	c.rules = copied
+	c.mu.Unlock()
+}
+
+// Len returns the number of classification rules
+func (c *RegistryClassifier) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.rules)
+}
+
+// Classify returns the RegistryType of a registry. A nil RegistryClassifier uses
+// ClassifyRegistry.
+func (c *RegistryClassifier) Classify(registry string) securityv1alpha1.RegistryType {
+	if rule, ok := c.match(registry); ok {
+		return rule.Type
+	}
+	return ClassifyRegistry(registry)
+}
+
+// PyxisEligible reports whether images from a registry are looked up in Pyxis. Without a
+// matching rule only Red Hat registries are eligible.
+func (c *RegistryClassifier) PyxisEligible(registry string) bool {
+	if rule, ok := c.match(registry); ok {
+		return rule.PyxisEligible
+	}
+	return IsRedHatRegistry(registry)
+}
+
+// match returns the rule for a registry. An exact host wins over wildcards, and the
+// longest wildcard wins over shorter ones.
+func (c *RegistryClassifier) match(registry string) (RegistryRule, bool) {
+	if c == nil {
+		return RegistryRule{}, false
+	}
+	registry = strings.ToLower(registry)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var best RegistryRule
+	found := false
+	for _, rule := range c.rules {
+		if rule.Pattern == registry {
+			return rule, true
+		}
+		suffix, ok := strings.CutPrefix(rule.Pattern, "*")
+		if !ok || !strings.HasSuffix(registry, suffix) || len(registry) == len(suffix) {
+			continue
+		}
+		if !found || len(rule.Pattern) > len(best.Pattern) {
+			best, found = rule, true
+		}
+	}
+	return best, found
+}
+
+// ValidateRegistryPattern checks that pattern is a registry host or a "*." wildcard
+func ValidateRegistryPattern(pattern string) error {
+	host := strings.TrimPrefix(pattern, "*.")
+	if host == "" || strings.ContainsAny(host, "/*@ ") {
+		return fmt.Errorf("invalid registry pattern %q: expected a host such as registry.example.com "+
+			"or a wildcard such as *.example.com", pattern)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"testing"
+
+	securityv1alpha1 "github.com/sebrandon1/imagecertinfo-operator/api/v1alpha1"
+)
+
+func TestRegistryClassifier(t *testing.T) {
+	classifier := NewRegistryClassifier([]RegistryRule{
+		{Pattern: "Mirror.Example.com", Type: securityv1alpha1.RegistryTypeRedHat, PyxisEligible: true},
+		{Pattern: "*.example.com", Type: securityv1alpha1.RegistryTypePrivate},
+		{Pattern: "*.partners.example.com", Type: securityv1alpha1.RegistryTypePartner},
+		{Pattern: "registry.access.redhat.com", Type: securityv1alpha1.RegistryTypeRedHat},
+	})
+
+	tests := []struct {
+		registry  string
+		wantType  securityv1alpha1.RegistryType
+		wantPyxis bool
+	}{
+		{"mirror.example.com", securityv1alpha1.RegistryTypeRedHat, true},
+		{"harbor.example.com", securityv1alpha1.RegistryTypePrivate, false},
+		{"acme.partners.example.com", securityv1alpha1.RegistryTypePartner, false},
+		{"example.com", securityv1alpha1.RegistryTypeUnknown, false},
+		{"registry.access.redhat.com", securityv1alpha1.RegistryTypeRedHat, false},
+		{"registry.redhat.io", securityv1alpha1.RegistryTypeRedHat, true},
+		{"docker.io", securityv1alpha1.RegistryTypeCommunity, false},
+	}
+	for _, tt := range tests {
+		if got := classifier.Classify(tt.registry); got != tt.wantType {
+			t.Errorf("Classify(%q) = %s, want %s", tt.registry, got, tt.wantType)
+		}
+		if got := classifier.PyxisEligible(tt.registry); got != tt.wantPyxis {
+			t.Errorf("PyxisEligible(%q) = %v, want %v", tt.registry, got, tt.wantPyxis)
+		}
+	}
+
+	var nilClassifier *RegistryClassifier
+	if nilClassifier.Classify("registry.redhat.io") != securityv1alpha1.RegistryTypeRedHat ||
+		!nilClassifier.PyxisEligible("registry.redhat.io") || nilClassifier.Len() != 0 {
+		t.Error("nil RegistryClassifier should use the built-in classification")
+	}
+
+	classifier.Set(nil)
+	if classifier.Len() != 0 || classifier.PyxisEligible("mirror.example.com") {
+		t.Error("Set(nil) should remove all rules")
+	}
+}
+
+func TestValidateRegistryPattern(t *testing.T) {
+	for _, pattern := range []string{"mirror.example.com", "mirror.local:5000", "*.example.com"} {
+		if err := ValidateRegistryPattern(pattern); err != nil {
+			t.Errorf("ValidateRegistryPattern(%q) error = %v", pattern, err)
+		}
+	}
+	for _, pattern := range []string{"", "*", "*.", "*example.com", "mirror.example.com/rh", "a.*.com"} {
+		if err := ValidateRegistryPattern(pattern); err == nil {
+			t.Errorf("ValidateRegistryPattern(%q) expected an error", pattern)
+		}
+	}
+}
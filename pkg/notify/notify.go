@@ -0,0 +1,203 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify delivers notifications about certification changes to chat and webhook
+// sinks. Notifications are deduplicated and sent in batches so that a refresh cycle that
+// touches many images produces one message per sink rather than one per image.
+package notify
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// Type classifies a notification
+type Type string
+
+// Notification types
+const (
+	TypeCertificationChanged Type = "CertificationChanged"
+	TypeEOLApproaching       Type = "EOLApproaching"
+	TypeHealthDegraded       Type = "HealthDegraded"
+	TypeCriticalCVEs         Type = "CriticalCVEsFound"
+)
+
+// Defaults for batching and deduplication
+const (
+	// DefaultFlushInterval is how often pending notifications are sent
+	DefaultFlushInterval = time.Minute
+	// DefaultDedupWindow is how long an identical notification is suppressed
+	DefaultDedupWindow = 24 * time.Hour
+	// DefaultQueueSize is the number of notifications held between flushes
+	DefaultQueueSize = 1000
+)
+
+// Notification describes a change to one image
+type Notification struct {
+	Type Type `json:"type"`
+	// Name is the ImageCertificationInfo name
+	Name string `json:"name"`
+	// Image is the full image reference
+	Image     string    `json:"image"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// key identifies identical notifications for deduplication
+func (n Notification) key() string {
+	return string(n.Type) + "\x00" + n.Name + "\x00" + n.Message
+}
+
+// Sink delivers a batch of notifications
+type Sink interface {
+	// Name identifies the sink in metrics and logs
+	Name() string
+	// Send delivers the notifications in one request
+	Send(ctx context.Context, notifications []Notification) error
+}
+
+// Notifier queues notifications, drops those already sent within the dedup window, and
+// periodically sends the pending batch to every sink. A nil Notifier discards notifications.
+type Notifier struct {
+	sinks         []Sink
+	flushInterval time.Duration
+	dedupWindow   time.Duration
+	queueSize     int
+	now           func() time.Time
+
+	mu      sync.Mutex
+	pending []Notification
+	// seen records when each notification was last queued
+	seen map[string]time.Time
+}
+
+// Option configures a Notifier
+type Option func(*Notifier)
+
+// WithFlushInterval sets how often pending notifications are sent
+func WithFlushInterval(interval time.Duration) Option {
+	return func(n *Notifier) {
+		n.flushInterval = interval
+	}
+}
+
+// WithDedupWindow sets how long an identical notification is suppressed
+func WithDedupWindow(window time.Duration) Option {
+	return func(n *Notifier) {
+		n.dedupWindow = window
+	}
+}
+
+// WithQueueSize sets the number of notifications held between flushes
+func WithQueueSize(size int) Option {
+	return func(n *Notifier) {
+		n.queueSize = size
+	}
+}
+
+// NewNotifier creates a notifier that delivers to sinks
+func NewNotifier(sinks []Sink, opts ...Option) *Notifier {
+	n := &Notifier{
+		sinks:         sinks,
+		flushInterval: DefaultFlushInterval,
+		dedupWindow:   DefaultDedupWindow,
+		queueSize:     DefaultQueueSize,
+		now:           time.Now,
+		seen:          make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
+}
+
+// Notify queues a notification unless an identical one was queued within the dedup window
+func (n *Notifier) Notify(notification Notification) {
+	if n == nil {
+		return
+	}
+	now := n.now()
+	if notification.Timestamp.IsZero() {
+		notification.Timestamp = now.UTC()
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	key := notification.key()
+	if last, ok := n.seen[key]; ok && now.Sub(last) < n.dedupWindow {
+		metrics.RecordNotifications("all", "suppressed", 1)
+		return
+	}
+	if len(n.pending) >= n.queueSize {
+		metrics.RecordNotifications("all", "dropped", 1)
+		return
+	}
+	n.seen[key] = now
+	n.pending = append(n.pending, notification)
+}
+
+// Start sends pending notifications every flush interval until ctx is cancelled
+func (n *Notifier) Start(ctx context.Context) error {
+	ticker := time.NewTicker(n.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			n.Flush(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection returns false: each replica sends the notifications of the images it
+// refreshed, which in shard mode are not the leader's alone
+func (n *Notifier) NeedLeaderElection() bool {
+	return false
+}
+
+// Flush sends the pending notifications to every sink
+func (n *Notifier) Flush(ctx context.Context) {
+	n.mu.Lock()
+	batch := n.pending
+	n.pending = nil
+	// Forget notifications whose dedup window has passed
+	now := n.now()
+	for key, last := range n.seen {
+		if now.Sub(last) >= n.dedupWindow {
+			delete(n.seen, key)
+		}
+	}
+	n.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+	logger := log.FromContext(ctx).WithName("notify")
+	for _, sink := range n.sinks {
+		if err := sink.Send(ctx, batch); err != nil {
+			metrics.RecordNotifications(sink.Name(), "error", len(batch))
+			logger.Error(err, "failed to send notifications", "sink", sink.Name(), "count", len(batch))
+			continue
+		}
+		metrics.RecordNotifications(sink.Name(), "sent", len(batch))
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// memorySink collects the batches it is sent
+type memorySink struct {
+	batches [][]Notification
+	err     error
+}
+
+func (m *memorySink) Name() string { return "memory" }
+
+func (m *memorySink) Send(_ context.Context, notifications []Notification) error {
+	m.batches = append(m.batches, notifications)
+	return m.err
+}
+
+func TestNotifier_Dedup(t *testing.T) {
+	sink := &memorySink{}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := NewNotifier([]Sink{sink}, WithDedupWindow(time.Hour))
+	n.now = func() time.Time { return now }
+
+	changed := Notification{Type: TypeCertificationChanged, Name: "a", Message: "Certified to NotCertified"}
+	n.Notify(changed)
+	n.Notify(changed)
+	n.Notify(Notification{Type: TypeHealthDegraded, Name: "a", Message: "A to C"})
+	n.Flush(context.Background())
+	if len(sink.batches) != 1 || len(sink.batches[0]) != 2 {
+		t.Fatalf("batches = %+v, want one batch of 2 notifications", sink.batches)
+	}
+	if sink.batches[0][0].Timestamp.IsZero() {
+		t.Error("Timestamp not set")
+	}
+
+	// A repeat within the window is suppressed even after a flush
+	n.Notify(changed)
+	n.Flush(context.Background())
+	if len(sink.batches) != 1 {
+		t.Errorf("repeat within the dedup window was sent: %+v", sink.batches)
+	}
+
+	// Once the window passes it is sent again
+	now = now.Add(time.Hour)
+	n.Flush(context.Background())
+	n.Notify(changed)
+	n.Flush(context.Background())
+	if len(sink.batches) != 2 || len(sink.batches[1]) != 1 {
+		t.Errorf("batches = %+v, want the repeat sent after the dedup window", sink.batches)
+	}
+}
+
+func TestNotifier_QueueFull(t *testing.T) {
+	sink := &memorySink{err: errors.New("unavailable")}
+	n := NewNotifier([]Sink{sink}, WithQueueSize(2))
+	for _, name := range []string{"a", "b", "c"} {
+		n.Notify(Notification{Type: TypeEOLApproaching, Name: name})
+	}
+	n.Flush(context.Background())
+	if len(sink.batches) != 1 || len(sink.batches[0]) != 2 {
+		t.Errorf("batches = %+v, want one batch capped at the queue size", sink.batches)
+	}
+
+	// A failed batch is not retried
+	n.Flush(context.Background())
+	if len(sink.batches) != 1 {
+		t.Errorf("failed batch was resent: %+v", sink.batches)
+	}
+
+	var nilNotifier *Notifier
+	nilNotifier.Notify(Notification{Type: TypeEOLApproaching, Name: "a"})
+}
+
+func TestSinks_Send(t *testing.T) {
+	var bodies []map[string]json.RawMessage
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid JSON body: %v", err)
+		}
+		bodies = append(bodies, body)
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sinks, err := SinksFromSecret(map[string][]byte{
+		SecretKeySlackWebhookURL:      []byte(server.URL + "/slack\n"),
+		SecretKeyWebhookURL:           []byte(server.URL + "/webhook"),
+		SecretKeyWebhookAuthorization: []byte("Bearer token"),
+	})
+	if err != nil {
+		t.Fatalf("SinksFromSecret() error = %v", err)
+	}
+	if len(sinks) != 2 || sinks[0].Name() != "slack" || sinks[1].Name() != "webhook" {
+		t.Fatalf("SinksFromSecret() = %v, want slack and webhook sinks", sinks)
+	}
+
+	batch := []Notification{{
+		Type:    TypeCriticalCVEs,
+		Name:    "registry.redhat.io.ubi8.ubi.abc123de",
+		Image:   "registry.redhat.io/ubi8/ubi@sha256:abc123",
+		Message: "Critical vulnerabilities increased from 0 to 1: CVE-2026-0001",
+	}}
+	for _, sink := range sinks {
+		if err := sink.Send(context.Background(), batch); err != nil {
+			t.Fatalf("%s Send() error = %v", sink.Name(), err)
+		}
+	}
+
+	var text string
+	if err := json.Unmarshal(bodies[0]["text"], &text); err != nil || !strings.Contains(text, "CVE-2026-0001") {
+		t.Errorf("Slack text = %q, want the notification message", text)
+	}
+	var notifications []Notification
+	if err := json.Unmarshal(bodies[1]["notifications"], &notifications); err != nil ||
+		len(notifications) != 1 || notifications[0].Type != TypeCriticalCVEs {
+		t.Errorf("webhook notifications = %+v, want the batch", notifications)
+	}
+	if authorization != "Bearer token" {
+		t.Errorf("Authorization = %q, want the configured header", authorization)
+	}
+
+	if _, err := SinksFromSecret(map[string][]byte{}); err == nil {
+		t.Error("SinksFromSecret() with no sinks configured: expected error")
+	}
+}
+
+func TestSinks_ErrorHidesURL(t *testing.T) {
+	sink := &SlackSink{URL: "http://127.0.0.1:1/services/secret-token"}
+	err := sink.Send(context.Background(), []Notification{{Type: TypeEOLApproaching}})
+	if err == nil {
+		t.Fatal("Send() to an unreachable URL: expected error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error %q leaks the webhook URL", err)
+	}
+}
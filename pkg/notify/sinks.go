@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+// DefaultHTTPTimeout is the timeout for a single sink request
+const DefaultHTTPTimeout = 10 * time.Second
+
+// maxSlackLines is the most notifications listed in one Slack message
+const maxSlackLines = 50
+
+// Keys read from the notification Secret
+const (
+	// SecretKeySlackWebhookURL holds a Slack incoming webhook URL
+	SecretKeySlackWebhookURL = "slack-webhook-url"
+	// SecretKeyWebhookURL holds the URL of a generic JSON webhook
+	SecretKeyWebhookURL = "webhook-url"
+	// SecretKeyWebhookAuthorization holds an optional Authorization header for the generic webhook
+	SecretKeyWebhookAuthorization = "webhook-authorization"
+)
+
+// SlackSink posts notifications to a Slack incoming webhook as a single message
+type SlackSink struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// Name identifies the sink
+func (s *SlackSink) Name() string {
+	return "slack"
+}
+
+// Send posts one message listing the notifications
+func (s *SlackSink) Send(ctx context.Context, notifications []Notification) error {
+	var text strings.Builder
+	fmt.Fprintf(&text, "*ImageCertInfo: %d image change(s)*\n", len(notifications))
+	for i, n := range notifications {
+		if i == maxSlackLines {
+			fmt.Fprintf(&text, "…and %d more\n", len(notifications)-maxSlackLines)
+			break
+		}
+		fmt.Fprintf(&text, "• *%s* `%s`: %s\n", n.Type, n.Image, n.Message)
+	}
+	return post(ctx, s.HTTPClient, s.URL, "", map[string]string{"text": text.String()})
+}
+
+// WebhookSink posts notifications as JSON to a generic HTTP endpoint
+type WebhookSink struct {
+	URL string
+	// Authorization is sent as the Authorization header when set
+	Authorization string
+	HTTPClient    *http.Client
+}
+
+// webhookPayload is the body posted by WebhookSink
+type webhookPayload struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+// Name identifies the sink
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Send posts the notifications in one request
+func (s *WebhookSink) Send(ctx context.Context, notifications []Notification) error {
+	return post(ctx, s.HTTPClient, s.URL, s.Authorization, webhookPayload{Notifications: notifications})
+}
+
+// SinksFromSecret builds the sinks configured by the data of the notification Secret
+func SinksFromSecret(data map[string][]byte) ([]Sink, error) {
+	httpClient := &http.Client{Timeout: DefaultHTTPTimeout}
+	var sinks []Sink
+	if url := strings.TrimSpace(string(data[SecretKeySlackWebhookURL])); url != "" {
+		sinks = append(sinks, &SlackSink{URL: url, HTTPClient: httpClient})
+	}
+	if url := strings.TrimSpace(string(data[SecretKeyWebhookURL])); url != "" {
+		sinks = append(sinks, &WebhookSink{
+			URL:           url,
+			Authorization: strings.TrimSpace(string(data[SecretKeyWebhookAuthorization])),
+			HTTPClient:    httpClient,
+		})
+	}
+	if len(sinks) == 0 {
+		return nil, fmt.Errorf("secret sets neither %s nor %s", SecretKeySlackWebhookURL, SecretKeyWebhookURL)
+	}
+	return sinks, nil
+}
+
+// post sends body as JSON and fails on a non-2xx response
+func post(ctx context.Context, httpClient *http.Client, url, authorization string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return errors.New("invalid notification sink URL")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// Webhook URLs carry credentials, so they are kept out of the error
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("%s request failed: %w", urlErr.Op, urlErr.Err)
+		}
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification sink returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -18,35 +18,57 @@ package pyxis
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 	"golang.org/x/time/rate"
 
+	"github.com/sebrandon1/imagecertinfo-operator/internal/ctxutil"
 	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
 )
 
+// tracer records the spans of lookups and rate limiter waits
+var tracer = otel.Tracer("github.com/sebrandon1/imagecertinfo-operator/pkg/pyxis")
+
 // DefaultCacheTTL is the default time-to-live for cache entries
 const DefaultCacheTTL = 1 * time.Hour
 
+// DefaultNegativeCacheTTL is the default time-to-live for empty results, i.e. images Pyxis has no certification data for.
+// It is shorter than DefaultCacheTTL so that newly published data is noticed sooner.
+const DefaultNegativeCacheTTL = 15 * time.Minute
+
 // DefaultRateLimit is the default rate limit (requests per second)
 const DefaultRateLimit = 10.0
 
 // DefaultRateBurst is the default burst size for rate limiting
 const DefaultRateBurst = 20
 
+// metricsClient is the client label of the rate limiter metrics
+const metricsClient = "pyxis"
+
 // cacheEntry represents a cached certification data entry
 type cacheEntry struct {
 	data      *CertificationData
 	expiresAt time.Time
 }
 
-// CachedClient wraps a Client with caching capabilities
+// CachedClient wraps a Client with caching capabilities. Concurrent misses for the
+// same key share a single upstream call.
 type CachedClient struct {
 	client Client
 	cache  map[string]cacheEntry
 	mu     sync.RWMutex
-	ttl    time.Duration
+	// ttl is guarded by mu so that it can change at runtime
+	ttl time.Duration
+	// negativeTTL applies to empty results and to results missing their vulnerabilities
+	negativeTTL time.Duration
+	group       singleflight.Group
 }
 
 // CacheOption is a function that configures a CachedClient
@@ -59,12 +81,20 @@ func WithCacheTTL(ttl time.Duration) CacheOption {
 	}
 }
 
+// WithNegativeCacheTTL sets the time-to-live for empty results. It never exceeds the cache TTL.
+func WithNegativeCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.negativeTTL = ttl
+	}
+}
+
 // NewCachedClient creates a new cached client wrapper
 func NewCachedClient(client Client, opts ...CacheOption) *CachedClient {
 	c := &CachedClient{
-		client: client,
-		cache:  make(map[string]cacheEntry),
-		ttl:    DefaultCacheTTL,
+		client:      client,
+		cache:       make(map[string]cacheEntry),
+		ttl:         DefaultCacheTTL,
+		negativeTTL: DefaultNegativeCacheTTL,
 	}
 
 	for _, opt := range opts {
@@ -74,16 +104,46 @@ func NewCachedClient(client Client, opts ...CacheOption) *CachedClient {
 	return c
 }
 
-// cacheKey generates a cache key from registry, repository, and digest
-func cacheKey(registry, repository, digest string) string {
-	return registry + "/" + repository + "@" + digest
+// cacheKey generates a cache key from the query type, auth identity, and the normalized
+// registry, repository, and digest or tag. Registries and repositories are case-insensitive
+// and so are digests, but tags are not.
+func cacheKey(query QueryType, identity, registry, repository, value string) string {
+	if query != QueryTag {
+		value = strings.ToLower(value)
+	}
+	return string(query) + "|" + identity + "|" + strings.ToLower(registry) + "/" +
+		strings.ToLower(strings.Trim(repository, "/")) + "@" + value
 }
 
-// GetImageCertification retrieves certification data, using cache when available
+// GetImageCertification retrieves certification data, using cache when available. Each lookup
+// strategy is cached on its own, so a miss by image ID is remembered separately from the
+// manifest list digest lookup that follows it.
 func (c *CachedClient) GetImageCertification(
 	ctx context.Context, registry, repository, digest string,
 ) (*CertificationData, error) {
-	key := cacheKey(registry, repository, digest)
+	ctx, span := tracer.Start(ctx, "pyxis.GetImageCertification", trace.WithAttributes(
+		attribute.String("registry", registry), attribute.String("repository", repository),
+		attribute.String("digest", digest)))
+	defer span.End()
+
+	identity := authIdentity(c.client)
+	for _, query := range queryTypes(digest) {
+		data, err := c.query(ctx, query, identity, registry, repository, digest)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		if err != nil || data != nil {
+			return data, err
+		}
+	}
+	return nil, nil
+}
+
+// query runs a single lookup strategy, using cache when available
+func (c *CachedClient) query(
+	ctx context.Context, query QueryType, identity, registry, repository, value string,
+) (*CertificationData, error) {
+	key := cacheKey(query, identity, registry, repository, value)
 
 	// Try to get from cache first
 	c.mu.RLock()
@@ -91,27 +151,55 @@ func (c *CachedClient) GetImageCertification(
 	c.mu.RUnlock()
 
 	if found && time.Now().Before(entry.expiresAt) {
-		metrics.RecordCacheHit()
+		metrics.RecordCacheHit(string(query))
+		trace.SpanFromContext(ctx).AddEvent("cache hit", trace.WithAttributes(attribute.String("query", string(query))))
 		return entry.data, nil
 	}
 
-	metrics.RecordCacheMiss()
+	metrics.RecordCacheMiss(string(query))
 
-	// Fetch from underlying client
-	data, err := c.client.GetImageCertification(ctx, registry, repository, digest)
-	if err != nil {
-		return nil, err
-	}
+	// Fetch from underlying client, joining a call already in flight for this key. Only
+	// the caller that starts the call runs the function, after which it reads leader.
+	leader := false
+	results := c.group.DoChan(key, func() (any, error) {
+		leader = true
+		callCtx, cancel := ctxutil.Shared(ctx)
+		defer cancel()
 
-	// Store in cache
-	c.mu.Lock()
-	c.cache[key] = cacheEntry{
-		data:      data,
-		expiresAt: time.Now().Add(c.ttl),
-	}
-	c.mu.Unlock()
+		data, err := queryImage(callCtx, c.client, query, registry, repository, value)
+		if err != nil {
+			return nil, err
+		}
 
-	return data, nil
+		// Store in cache; empty and incomplete results expire sooner
+		c.mu.Lock()
+		ttl := c.ttl
+		if data == nil || data.VulnerabilitiesUnavailable {
+			ttl = min(c.negativeTTL, c.ttl)
+		}
+		c.cache[key] = cacheEntry{
+			data:      data,
+			expiresAt: time.Now().Add(ttl),
+		}
+		c.mu.Unlock()
+
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if !leader {
+			metrics.RecordSharedLookup(string(query))
+			trace.SpanFromContext(ctx).AddEvent("joined lookup in flight",
+				trace.WithAttributes(attribute.String("query", string(query))))
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*CertificationData), nil
+	}
 }
 
 // IsHealthy delegates to the underlying client
@@ -119,6 +207,20 @@ func (c *CachedClient) IsHealthy(ctx context.Context) bool {
 	return c.client.IsHealthy(ctx)
 }
 
+// SetTTL changes the time-to-live of entries cached from now on
+func (c *CachedClient) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	c.ttl = ttl
+	c.mu.Unlock()
+}
+
+// SetRateLimit changes the rate limit of the underlying client if it is rate limited
+func (c *CachedClient) SetRateLimit(rps float64, burst int) {
+	if limited, ok := c.client.(*RateLimitedClient); ok {
+		limited.SetRateLimit(rps, burst)
+	}
+}
+
 // ClearCache removes all entries from the cache
 func (c *CachedClient) ClearCache() {
 	c.mu.Lock()
@@ -139,6 +241,50 @@ func (c *CachedClient) CleanupExpired() {
 	}
 }
 
+// CacheEntry is an exported cache entry, used to persist the cache across restarts
+type CacheEntry struct {
+	Key       string             `json:"key"`
+	Data      *CertificationData `json:"data"`
+	ExpiresAt time.Time          `json:"expiresAt"`
+}
+
+// Entries returns all unexpired cache entries
+func (c *CachedClient) Entries() []CacheEntry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	entries := make([]CacheEntry, 0, len(c.cache))
+	for key, entry := range c.cache {
+		if now.Before(entry.expiresAt) {
+			entries = append(entries, CacheEntry{Key: key, Data: entry.data, ExpiresAt: entry.expiresAt})
+		}
+	}
+	return entries
+}
+
+// Restore adds entries to the cache, keeping their original expiry. Expired entries and
+// entries older than the one already cached for the same key are skipped. It returns
+// the number of entries added.
+func (c *CachedClient) Restore(entries []CacheEntry) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	restored := 0
+	for _, entry := range entries {
+		if !now.Before(entry.ExpiresAt) {
+			continue
+		}
+		if existing, ok := c.cache[entry.Key]; ok && !existing.expiresAt.Before(entry.ExpiresAt) {
+			continue
+		}
+		c.cache[entry.Key] = cacheEntry{data: entry.Data, expiresAt: entry.ExpiresAt}
+		restored++
+	}
+	return restored
+}
+
 // StartCleanupLoop starts a goroutine that periodically cleans up expired cache entries
 func (c *CachedClient) StartCleanupLoop(ctx context.Context, interval time.Duration) {
 	go func() {
@@ -156,10 +302,14 @@ func (c *CachedClient) StartCleanupLoop(ctx context.Context, interval time.Durat
 	}()
 }
 
-// RateLimitedClient wraps a Client with rate limiting capabilities
+// RateLimitedClient wraps a Client with rate limiting capabilities.
+// Requests are scheduled fairly between interactive and batch priority classes
+// so that periodic refreshes cannot starve lookups for newly discovered images.
 type RateLimitedClient struct {
-	client  Client
-	limiter *rate.Limiter
+	client            Client
+	limiter           *rate.Limiter
+	interactiveWeight int
+	scheduler         *fairScheduler
 }
 
 // RateLimitOption is a function that configures a RateLimitedClient
@@ -179,41 +329,87 @@ func WithBurst(burst int) RateLimitOption {
 	}
 }
 
+// WithInteractiveWeight sets how many interactive requests are admitted for every
+// batch request while both priority classes are waiting
+func WithInteractiveWeight(weight int) RateLimitOption {
+	return func(c *RateLimitedClient) {
+		c.interactiveWeight = weight
+	}
+}
+
 // NewRateLimitedClient creates a new rate-limited client wrapper
 func NewRateLimitedClient(client Client, opts ...RateLimitOption) *RateLimitedClient {
 	c := &RateLimitedClient{
-		client:  client,
-		limiter: rate.NewLimiter(rate.Limit(DefaultRateLimit), DefaultRateBurst),
+		client:            client,
+		limiter:           rate.NewLimiter(rate.Limit(DefaultRateLimit), DefaultRateBurst),
+		interactiveWeight: DefaultInteractiveWeight,
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.scheduler = newFairScheduler(c.limiter, c.interactiveWeight)
+	metrics.RegisterRateLimiter(metricsClient, c.limiter)
 
 	return c
 }
 
+// wait blocks until the rate limiter grants budget to the request's priority class
+func (c *RateLimitedClient) wait(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "pyxis.RateLimitWait")
+	defer span.End()
+	start := time.Now()
+	err := c.scheduler.Wait(ctx, PriorityFromContext(ctx))
+	metrics.RecordRateLimiterWait(metricsClient, time.Since(start))
+	return err
+}
+
 // GetImageCertification retrieves certification data with rate limiting
 func (c *RateLimitedClient) GetImageCertification(
 	ctx context.Context, registry, repository, digest string,
 ) (*CertificationData, error) {
-	// Wait for rate limiter
-	if err := c.limiter.Wait(ctx); err != nil {
+	// Wait for rate limiter budget in this request's priority class
+	if err := c.wait(ctx); err != nil {
 		return nil, err
 	}
 
 	return c.client.GetImageCertification(ctx, registry, repository, digest)
 }
 
+// QueryImage runs a single lookup strategy with rate limiting
+func (c *RateLimitedClient) QueryImage(
+	ctx context.Context, query QueryType, registry, repository, value string,
+) (*CertificationData, error) {
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return queryImage(ctx, c.client, query, registry, repository, value)
+}
+
+// AuthIdentity delegates to the underlying client
+func (c *RateLimitedClient) AuthIdentity() string {
+	return authIdentity(c.client)
+}
+
+// SetRateLimit changes the rate limit (requests per second) and burst size
+func (c *RateLimitedClient) SetRateLimit(rps float64, burst int) {
+	c.limiter.SetLimit(rate.Limit(rps))
+	c.limiter.SetBurst(burst)
+}
+
 // IsHealthy delegates to the underlying client (no rate limiting for health checks)
 func (c *RateLimitedClient) IsHealthy(ctx context.Context) bool {
 	return c.client.IsHealthy(ctx)
 }
 
-// NewCachedRateLimitedClient creates a client with both caching and rate limiting
-func NewCachedRateLimitedClient(baseClient Client, cacheTTL time.Duration, rateLimit float64, burst int) Client {
+// NewCachedRateLimitedClient creates a client with both caching and rate limiting. opts
+// further configure the cache.
+func NewCachedRateLimitedClient(
+	baseClient Client, cacheTTL time.Duration, rateLimit float64, burst int, opts ...CacheOption,
+) Client {
 	// Apply rate limiting first, then caching
 	rateLimited := NewRateLimitedClient(baseClient, WithRateLimit(rateLimit), WithBurst(burst))
-	cached := NewCachedClient(rateLimited, WithCacheTTL(cacheTTL))
+	cached := NewCachedClient(rateLimited, append([]CacheOption{WithCacheTTL(cacheTTL)}, opts...)...)
 	return cached
 }
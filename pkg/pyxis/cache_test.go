@@ -0,0 +1,334 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// blockingClient counts lookups and holds each one until release is closed
+type blockingClient struct {
+	calls   atomic.Int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingClient) GetImageCertification(ctx context.Context, _, _, _ string) (*CertificationData, error) {
+	if c.calls.Add(1) == 1 {
+		close(c.started)
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.release:
+		return &CertificationData{ProjectID: "ubi9"}, nil
+	}
+}
+
+func (c *blockingClient) IsHealthy(context.Context) bool { return true }
+
+func TestCachedClient_SharesConcurrentMisses(t *testing.T) {
+	upstream := &blockingClient{started: make(chan struct{}), release: make(chan struct{})}
+	cached := NewCachedClient(upstream)
+	shared := metrics.PyxisSharedLookups.WithLabelValues(string(QueryImageID))
+	hits := metrics.PyxisCacheHits.WithLabelValues(string(QueryImageID), "hit")
+	before := testutil.ToFloat64(shared) + testutil.ToFloat64(hits)
+
+	// The caller that starts the lookup gives up; the others must still get the result
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := cached.GetImageCertification(leaderCtx, "registry.redhat.io", "ubi9/ubi", "sha256:abc123")
+		leaderErr <- err
+	}()
+	<-upstream.started
+
+	const followers = 10
+	var wg sync.WaitGroup
+	results := make(chan *CertificationData, followers)
+	for range followers {
+		wg.Go(func() {
+			data, err := cached.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123")
+			if err != nil {
+				t.Errorf("GetImageCertification() error = %v", err)
+			}
+			results <- data
+		})
+	}
+
+	cancelLeader()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled caller error = %v, want context.Canceled", err)
+	}
+	close(upstream.release)
+	wg.Wait()
+	close(results)
+
+	for data := range results {
+		if data == nil || data.ProjectID != "ubi9" {
+			t.Errorf("GetImageCertification() = %+v, want ProjectID ubi9", data)
+		}
+	}
+	if calls := upstream.calls.Load(); calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+	// Followers that arrive after the lookup completes hit the cache instead of joining it
+	if got := testutil.ToFloat64(shared) + testutil.ToFloat64(hits) - before; got != followers {
+		t.Errorf("shared lookups and cache hits = %v, want %d", got, followers)
+	}
+
+	// The shared result was cached
+	if _, err := cached.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123"); err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	if calls := upstream.calls.Load(); calls != 1 {
+		t.Errorf("upstream calls after cache hit = %d, want 1", calls)
+	}
+}
+
+// countingClient counts lookups and returns data, which may be nil for an image Pyxis does not know
+type countingClient struct {
+	calls atomic.Int32
+	data  *CertificationData
+}
+
+func (c *countingClient) GetImageCertification(context.Context, string, string, string) (*CertificationData, error) {
+	c.calls.Add(1)
+	return c.data, nil
+}
+
+func (c *countingClient) IsHealthy(context.Context) bool { return true }
+
+func TestCachedClient_NegativeCacheTTL(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        *CertificationData
+		ttl         time.Duration
+		negativeTTL time.Duration
+		wantTTL     time.Duration
+		// wantEntries is the number of query types cached; a miss tries every one
+		wantEntries int
+	}{
+		{name: "found", data: &CertificationData{ProjectID: "ubi9"}, ttl: time.Hour, negativeTTL: time.Minute,
+			wantTTL: time.Hour, wantEntries: 1},
+		{name: "not found", ttl: time.Hour, negativeTTL: time.Minute, wantTTL: time.Minute, wantEntries: 2},
+		{name: "vulnerabilities unavailable", data: &CertificationData{ProjectID: "ubi9", VulnerabilitiesUnavailable: true},
+			ttl: time.Hour, negativeTTL: time.Minute, wantTTL: time.Minute, wantEntries: 1},
+		{name: "not found capped by cache TTL", ttl: time.Minute, negativeTTL: time.Hour, wantTTL: time.Minute,
+			wantEntries: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upstream := &countingClient{data: tt.data}
+			cached := NewCachedClient(upstream, WithCacheTTL(tt.ttl), WithNegativeCacheTTL(tt.negativeTTL))
+
+			for range 2 {
+				if _, err := cached.GetImageCertification(context.Background(), "quay.io", "app", "sha256:abc123"); err != nil {
+					t.Fatalf("GetImageCertification() error = %v", err)
+				}
+			}
+			if calls := upstream.calls.Load(); calls != 1 {
+				t.Errorf("upstream calls = %d, want 1 (the repeated lookup is cached)", calls)
+			}
+
+			entries := cached.Entries()
+			if len(entries) != tt.wantEntries {
+				t.Fatalf("Entries() = %d, want %d", len(entries), tt.wantEntries)
+			}
+			for _, entry := range entries {
+				if ttl := time.Until(entry.ExpiresAt); ttl > tt.wantTTL || ttl < tt.wantTTL-time.Second {
+					t.Errorf("entry %s expires in %v, want %v", entry.Key, ttl, tt.wantTTL)
+				}
+			}
+		})
+	}
+}
+
+func TestCachedClient_SetTTLAndRateLimit(t *testing.T) {
+	upstream := &countingClient{data: &CertificationData{ProjectID: "ubi9"}}
+	limited := NewRateLimitedClient(upstream, WithRateLimit(1), WithBurst(1))
+	cached := NewCachedClient(limited, WithCacheTTL(time.Hour))
+
+	cached.SetTTL(5 * time.Minute)
+	cached.SetRateLimit(20, 40)
+
+	if _, err := cached.GetImageCertification(context.Background(), "quay.io", "app", "sha256:abc123"); err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	entries := cached.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Entries() = %d, want 1", len(entries))
+	}
+	if ttl := time.Until(entries[0].ExpiresAt); ttl > 5*time.Minute || ttl < 5*time.Minute-time.Second {
+		t.Errorf("entry expires in %v, want 5m", ttl)
+	}
+	if limit, burst := limited.limiter.Limit(), limited.limiter.Burst(); limit != 20 || burst != 40 {
+		t.Errorf("limiter = %v/%d, want 20/40", limit, burst)
+	}
+}
+
+// queryingClient answers each lookup strategy separately and records the queries it receives
+type queryingClient struct {
+	countingClient
+	identity string
+	found    QueryType
+	mu       sync.Mutex
+	queries  []string
+}
+
+func (c *queryingClient) QueryImage(
+	_ context.Context, query QueryType, _, _, _ string,
+) (*CertificationData, error) {
+	c.mu.Lock()
+	c.queries = append(c.queries, c.identity+"/"+string(query))
+	c.mu.Unlock()
+	if query == c.found {
+		return c.data, nil
+	}
+	return nil, nil
+}
+
+func (c *queryingClient) AuthIdentity() string { return c.identity }
+
+func (c *queryingClient) received() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.queries)
+}
+
+func TestCachedClient_CachesQueryTypesSeparately(t *testing.T) {
+	upstream := &queryingClient{
+		countingClient: countingClient{data: &CertificationData{ProjectID: "ubi9"}},
+		identity:       anonymousIdentity,
+		found:          QueryManifestListDigest,
+	}
+	cached := NewCachedClient(upstream)
+	hits := metrics.PyxisCacheHits.WithLabelValues(string(QueryManifestListDigest), "hit")
+	before := testutil.ToFloat64(hits)
+
+	for range 2 {
+		data, err := cached.GetImageCertification(context.Background(), "Registry.RedHat.io", "ubi9/ubi", "sha256:ABC123")
+		if err != nil || data == nil || data.ProjectID != "ubi9" {
+			t.Fatalf("GetImageCertification() = %+v, %v, want the manifest list result", data, err)
+		}
+	}
+	want := []string{"anonymous/image_id", "anonymous/manifest_list_digest"}
+	if got := upstream.received(); !slices.Equal(got, want) {
+		t.Errorf("upstream queries = %v, want %v (the repeated lookup is cached per query type)", got, want)
+	}
+	if got := testutil.ToFloat64(hits) - before; got != 1 {
+		t.Errorf("manifest_list_digest cache hits = %v, want 1", got)
+	}
+
+	// The lookup is normalized, so the same image spelled differently is cached too
+	if _, err := cached.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123"); err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	if got := upstream.received(); len(got) != 2 {
+		t.Errorf("upstream queries = %v, want the normalized lookup answered from cache", got)
+	}
+
+	// A tag is looked up by tag only
+	if _, err := cached.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "9.4"); err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	if got := upstream.received(); got[len(got)-1] != "anonymous/tag" || len(got) != 3 {
+		t.Errorf("upstream queries = %v, want a single tag query", got)
+	}
+}
+
+func TestCachedClient_VariesOnAuthIdentity(t *testing.T) {
+	upstream := &queryingClient{
+		countingClient: countingClient{data: &CertificationData{ProjectID: "ubi9"}},
+		identity:       anonymousIdentity,
+		found:          QueryImageID,
+	}
+	cached := NewCachedClient(NewRateLimitedClient(upstream))
+
+	lookup := func() {
+		if _, err := cached.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123"); err != nil {
+			t.Fatalf("GetImageCertification() error = %v", err)
+		}
+	}
+	lookup()
+	upstream.identity = "0a1b2c3d4e5f"
+	lookup()
+	lookup()
+
+	want := []string{"anonymous/image_id", "0a1b2c3d4e5f/image_id"}
+	if got := upstream.received(); !slices.Equal(got, want) {
+		t.Errorf("upstream queries = %v, want %v (responses are cached per auth identity)", got, want)
+	}
+}
+
+func TestRateLimitedClient_RecordsWaitAndSaturation(t *testing.T) {
+	waits := func() uint64 {
+		var m dto.Metric
+		observer := metrics.RateLimiterWaitDuration.WithLabelValues(metricsClient).(prometheus.Histogram)
+		if err := observer.Write(&m); err != nil {
+			t.Fatalf("failed to read the wait histogram: %v", err)
+		}
+		return m.GetHistogram().GetSampleCount()
+	}
+	before := waits()
+
+	limited := NewRateLimitedClient(&countingClient{}, WithRateLimit(1), WithBurst(2))
+	for range 2 {
+		if _, err := limited.GetImageCertification(context.Background(), "quay.io", "app", "sha256:abc123"); err != nil {
+			t.Fatalf("GetImageCertification() error = %v", err)
+		}
+	}
+	if got := waits() - before; got != 2 {
+		t.Errorf("rate limiter waits recorded = %d, want 2", got)
+	}
+
+	// Both tokens of the burst are spent, so the limiter reports it is saturated
+	families, err := ctrlmetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+	gauges := map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "client" && label.GetValue() == metricsClient {
+					gauges[family.GetName()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	if burst := gauges["imagecertinfo_rate_limiter_burst"]; burst != 2 {
+		t.Errorf("rate limiter burst = %v, want 2", burst)
+	}
+	if used := gauges["imagecertinfo_rate_limiter_utilization"]; used < 0.9 {
+		t.Errorf("rate limiter utilization = %v, want the burst nearly used up", used)
+	}
+}
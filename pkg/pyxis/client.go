@@ -18,15 +18,24 @@ package pyxis
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"slices"
+	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/ctxutil"
 	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/rawstore"
 )
 
 const (
@@ -34,6 +43,16 @@ const (
 	DefaultBaseURL = "https://catalog.redhat.com/api/containers/v1"
 	// DefaultTimeout is the default HTTP client timeout
 	DefaultTimeout = 30 * time.Second
+
+	// imagesPageSize is the number of image records requested per page
+	imagesPageSize = 100
+	// maxImagePages bounds the pages read for a single digest lookup
+	maxImagePages = 10
+
+	// PartnerRegistry is the registry serving partner-certified images
+	PartnerRegistry = "registry.connect.redhat.com"
+	// CNFBadge is the certification badge awarded to cloud-native network functions
+	CNFBadge = "CNF"
 )
 
 // Client interface for Pyxis API operations
@@ -49,8 +68,15 @@ type Client interface {
 // An optional API key can be provided for authenticated access.
 type HTTPClient struct {
 	baseURL    string
+	apiKeyMu   sync.RWMutex
 	apiKey     string // Optional - public API works without auth
 	httpClient *http.Client
+	rawStore   rawstore.Store // Optional - keeps raw responses for debugging
+	retry      RetryConfig
+	// pages coalesces concurrent requests for the same page of image records, which Pyxis
+	// answers by digest alone, so lookups of one digest through several registries or
+	// repositories make a single upstream query
+	pages singleflight.Group
 }
 
 // ClientOption is a function that configures an HTTPClient
@@ -72,6 +98,23 @@ func WithAPIKey(apiKey string) ClientOption {
 	}
 }
 
+// SetAPIKey replaces the API key used by subsequent requests, e.g. after the Secret holding it
+// is rotated. An empty key switches to unauthenticated access.
+func (c *HTTPClient) SetAPIKey(apiKey string) {
+	c.apiKeyMu.Lock()
+	defer c.apiKeyMu.Unlock()
+	c.apiKey = apiKey
+}
+
+// setAPIKeyHeader authenticates a request when an API key is configured
+func (c *HTTPClient) setAPIKeyHeader(req *http.Request) {
+	c.apiKeyMu.RLock()
+	defer c.apiKeyMu.RUnlock()
+	if c.apiKey != "" {
+		req.Header.Set("X-API-KEY", c.apiKey)
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *HTTPClient) {
@@ -86,6 +129,13 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithRawResponseStore keeps the last raw image response per digest for debugging
+func WithRawResponseStore(store rawstore.Store) ClientOption {
+	return func(c *HTTPClient) {
+		c.rawStore = store
+	}
+}
+
 // NewHTTPClient creates a new Pyxis HTTP client.
 // By default, no authentication is required - the public API works for read-only queries.
 // Use WithAPIKey option if you need authenticated access.
@@ -95,6 +145,7 @@ func NewHTTPClient(opts ...ClientOption) *HTTPClient {
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
 		},
+		retry: DefaultRetryConfig(),
 	}
 
 	for _, opt := range opts {
@@ -105,46 +156,75 @@ func NewHTTPClient(opts ...ClientOption) *HTTPClient {
 }
 
 // GetImageCertification retrieves certification data for an image from Pyxis.
-// It tries two API endpoints: first by image_id (single-arch),
-// then by manifest_list_digest (multi-arch).
+// A digest is looked up by image_id (single-arch) first, then by manifest_list_digest
+// (multi-arch). Anything else is looked up as a tag.
 func (c *HTTPClient) GetImageCertification(
 	ctx context.Context, registry, repository, digest string,
 ) (*CertificationData, error) {
-	// Try first by image_id (single architecture images)
-	certData, err := c.queryByImageID(ctx, digest)
-	if err != nil {
-		return nil, err
-	}
-	if certData != nil {
-		// Verify this image is from a Red Hat registry
-		return certData, nil
+	for _, query := range queryTypes(digest) {
+		certData, err := c.QueryImage(ctx, query, registry, repository, digest)
+		if err != nil || certData != nil {
+			return certData, err
+		}
 	}
+	return nil, nil
+}
 
-	// Try by manifest_list_digest (multi-architecture images)
-	certData, err = c.queryByManifestListDigest(ctx, digest)
-	if err != nil {
-		return nil, err
-	}
+// QueryImage looks up an image by a single strategy
+func (c *HTTPClient) QueryImage(
+	ctx context.Context, query QueryType, registry, repository, value string,
+) (*CertificationData, error) {
+	switch query {
+	case QueryImageID:
+		return c.queryByImageID(ctx, registry, repository, value)
+	case QueryManifestListDigest:
+		return c.queryByManifestListDigest(ctx, registry, repository, value)
+	case QueryTag:
+		return c.queryByTag(ctx, registry, repository, value)
+	}
+	return nil, fmt.Errorf("unknown Pyxis query type %q", query)
+}
 
-	return certData, nil
+// AuthIdentity identifies the configured API key by a short hash of it, or returns
+// "anonymous" when no API key is set
+func (c *HTTPClient) AuthIdentity() string {
+	c.apiKeyMu.RLock()
+	defer c.apiKeyMu.RUnlock()
+	if c.apiKey == "" {
+		return anonymousIdentity
+	}
+	sum := sha256.Sum256([]byte(c.apiKey))
+	return hex.EncodeToString(sum[:6])
 }
 
 // queryByImageID queries the Pyxis API by image_id (single-arch images)
-func (c *HTTPClient) queryByImageID(ctx context.Context, digest string) (*CertificationData, error) {
+func (c *HTTPClient) queryByImageID(ctx context.Context, registry, repository, digest string) (*CertificationData, error) {
 	requestURL := fmt.Sprintf("%s/images?filter=image_id==%s", c.baseURL, url.QueryEscape(digest))
-	return c.queryAndParse(ctx, requestURL)
+	return c.queryAndParse(ctx, requestURL, registry, repository, digest)
 }
 
 // queryByManifestListDigest queries the Pyxis API by manifest_list_digest (multi-arch images)
-func (c *HTTPClient) queryByManifestListDigest(ctx context.Context, digest string) (*CertificationData, error) {
+func (c *HTTPClient) queryByManifestListDigest(
+	ctx context.Context, registry, repository, digest string,
+) (*CertificationData, error) {
 	requestURL := fmt.Sprintf("%s/images?filter=repositories.manifest_list_digest==%s", c.baseURL, url.QueryEscape(digest))
-	return c.queryAndParse(ctx, requestURL)
+	return c.queryAndParse(ctx, requestURL, registry, repository, digest)
+}
+
+// queryByTag queries the Pyxis API for the image published under a tag in a repository
+func (c *HTTPClient) queryByTag(ctx context.Context, registry, repository, tag string) (*CertificationData, error) {
+	filter := fmt.Sprintf("repositories.registry==%s;repositories.repository==%s;repositories.tags.name==%s",
+		registry, repository, tag)
+	requestURL := fmt.Sprintf("%s/images?filter=%s", c.baseURL, url.QueryEscape(filter))
+	return c.queryAndParse(ctx, requestURL, registry, repository, tag)
 }
 
 // queryAndParse executes the request and parses the response
-func (c *HTTPClient) queryAndParse(ctx context.Context, requestURL string) (*CertificationData, error) {
+func (c *HTTPClient) queryAndParse(
+	ctx context.Context, requestURL, registry, repository, digest string,
+) (*CertificationData, error) {
 	start := time.Now()
-	pyxisResp, err := c.fetchAndParseResponse(ctx, requestURL)
+	pyxisResp, err := c.fetchAndParseResponse(ctx, requestURL, registry, repository, digest)
 	duration := time.Since(start).Seconds()
 
 	// Record metrics
@@ -160,20 +240,69 @@ func (c *HTTPClient) queryAndParse(ctx context.Context, requestURL string) (*Cer
 	metrics.RecordPyxisRequest("success", endpoint, duration)
 
 	// Check if this is from a Red Hat registry
-	if !c.isFromRedHatRegistry(pyxisResp) {
+	if !isFromRedHatRegistry(pyxisResp) {
 		return nil, nil
 	}
 
 	// Convert to CertificationData
-	certData := c.convertToCertificationData(ctx, pyxisResp)
+	certData := c.convertToCertificationData(ctx, pyxisResp, registry, repository)
 
 	return certData, nil
 }
 
-// fetchAndParseResponse fetches and parses the Pyxis API response
+// fetchAndParseResponse reads the image records matching requestURL page by page and selects
+// the one published in the queried registry and repository. Paging stops once such a record is
+// found, the last page is reached, or maxImagePages pages have been read.
 func (c *HTTPClient) fetchAndParseResponse(
-	ctx context.Context, requestURL string,
+	ctx context.Context, requestURL, registry, repository, digest string,
 ) (*PyxisImageResponse, error) {
+	var records []PyxisImageResponse
+	for page := range maxImagePages {
+		pagedResp, err := c.fetchPage(ctx, fmt.Sprintf("%s&page=%d&page_size=%d", requestURL, page, imagesPageSize), digest)
+		if err != nil {
+			return nil, err
+		}
+		if pagedResp == nil {
+			break
+		}
+		records = append(records, pagedResp.Data...)
+
+		if slices.ContainsFunc(pagedResp.Data, func(img PyxisImageResponse) bool {
+			return matchingRepository(&img, registry, repository) != nil
+		}) {
+			break
+		}
+		if len(pagedResp.Data) < imagesPageSize || (pagedResp.Total > 0 && len(records) >= pagedResp.Total) {
+			break
+		}
+	}
+
+	return selectImage(records, registry, repository), nil
+}
+
+// fetchPage fetches and parses a single page of image records, returning nil when there are
+// none. Callers requesting the same page concurrently share one request and its result,
+// which they must not modify.
+func (c *HTTPClient) fetchPage(ctx context.Context, requestURL, digest string) (*PyxisPagedResponse, error) {
+	results := c.pages.DoChan(requestURL, func() (any, error) {
+		callCtx, cancel := ctxutil.Shared(ctx)
+		defer cancel()
+		return c.fetchPageOnce(callCtx, requestURL, digest)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*PyxisPagedResponse), nil
+	}
+}
+
+// fetchPageOnce fetches and parses a single page of image records
+func (c *HTTPClient) fetchPageOnce(ctx context.Context, requestURL, digest string) (*PyxisPagedResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -181,11 +310,9 @@ func (c *HTTPClient) fetchAndParseResponse(
 
 	// Set headers
 	req.Header.Set("Accept", "application/json")
-	if c.apiKey != "" {
-		req.Header.Set("X-API-KEY", c.apiKey)
-	}
+	c.setAPIKeyHeader(req)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req, "images")
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -201,6 +328,7 @@ func (c *HTTPClient) fetchAndParseResponse(
 		return nil, fmt.Errorf("authentication failed: %s", resp.Status)
 	default:
 		body, _ := io.ReadAll(resp.Body)
+		c.storeRawResponse(ctx, digest, body)
 		return nil, fmt.Errorf("unexpected response status %s: %s", resp.Status, string(body))
 	}
 
@@ -208,6 +336,7 @@ func (c *HTTPClient) fetchAndParseResponse(
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
+	c.storeRawResponse(ctx, digest, body)
 
 	var pagedResp PyxisPagedResponse
 	if err := json.Unmarshal(body, &pagedResp); err != nil {
@@ -218,11 +347,62 @@ func (c *HTTPClient) fetchAndParseResponse(
 		return nil, nil
 	}
 
-	return &pagedResp.Data[0], nil
+	return &pagedResp, nil
+}
+
+// selectImage picks the record published in the queried registry and repository. When none
+// matches exactly, it prefers a record in the same repository of another Red Hat registry
+// (registry.access.redhat.com mirrors registry.redhat.io), then any record from a Red Hat registry.
+func selectImage(records []PyxisImageResponse, registry, repository string) *PyxisImageResponse {
+	if len(records) == 0 {
+		return nil
+	}
+	for i := range records {
+		if matchingRepository(&records[i], registry, repository) != nil {
+			return &records[i]
+		}
+	}
+	for i := range records {
+		for _, repo := range records[i].Repositories {
+			if repo.Repository == repository && isRedHatRegistry(repo.Registry) {
+				return &records[i]
+			}
+		}
+	}
+	for i := range records {
+		if slices.ContainsFunc(records[i].Repositories, func(repo PyxisImageRepository) bool {
+			return isRedHatRegistry(repo.Registry)
+		}) {
+			return &records[i]
+		}
+	}
+	return &records[0]
+}
+
+// matchingRepository returns the repository entry of an image record for the queried
+// registry and repository, or nil if the image is not published there
+func matchingRepository(img *PyxisImageResponse, registry, repository string) *PyxisImageRepository {
+	for i := range img.Repositories {
+		repo := &img.Repositories[i]
+		if repo.Registry == registry && repo.Repository == repository {
+			return repo
+		}
+	}
+	return nil
+}
+
+// storeRawResponse saves body to the raw response store, if configured
+func (c *HTTPClient) storeRawResponse(ctx context.Context, digest string, body []byte) {
+	if c.rawStore == nil {
+		return
+	}
+	if err := c.rawStore.Put(ctx, rawstore.ProviderPyxis, digest, body); err != nil {
+		log.FromContext(ctx).V(1).Info("failed to store raw Pyxis response", "digest", digest, "error", err)
+	}
 }
 
 // isFromRedHatRegistry checks if the image is from a Red Hat registry
-func (c *HTTPClient) isFromRedHatRegistry(pyxisResp *PyxisImageResponse) bool {
+func isFromRedHatRegistry(pyxisResp *PyxisImageResponse) bool {
 	if len(pyxisResp.Repositories) == 0 {
 		return true // No repos, assume valid
 	}
@@ -234,10 +414,44 @@ func (c *HTTPClient) isFromRedHatRegistry(pyxisResp *PyxisImageResponse) bool {
 	return false
 }
 
-// convertToCertificationData converts a Pyxis response to CertificationData
+// convertToCertificationData converts a Pyxis response to CertificationData, fetching the
+// repository, vulnerability, and certification project details it references
 func (c *HTTPClient) convertToCertificationData(
-	ctx context.Context, pyxisResp *PyxisImageResponse,
+	ctx context.Context, pyxisResp *PyxisImageResponse, registry, repository string,
 ) *CertificationData {
+	certData := imageCertificationData(pyxisResp)
+	c.populateRepositoryData(ctx, pyxisResp, registry, repository, certData)
+
+	if certData.ImageID != "" {
+		cves, severities, advisoryIDs, cveAdvisories, err := c.getVulnerabilitiesWithAdvisories(ctx, certData.ImageID)
+		if err != nil {
+			// An image without CVEs would look the same, so callers must keep what they know
+			log.FromContext(ctx).V(1).Info("failed to fetch Pyxis vulnerabilities", "imageID", certData.ImageID,
+				"error", err)
+			certData.VulnerabilitiesUnavailable = true
+		}
+		if len(cves) > 0 {
+			certData.CVEs = cves
+			certData.CVESeverities = severities
+		}
+		if len(cveAdvisories) > 0 {
+			certData.CVEAdvisories = cveAdvisories
+		}
+		if len(advisoryIDs) > 0 {
+			certData.AdvisoryIDs = advisoryIDs
+		}
+		certData.CVEFixes = c.resolveCVEFixes(ctx, severities, cveAdvisories, registry, repository)
+	}
+
+	if registry == PartnerRegistry && pyxisResp.CertProject != "" {
+		certData.PartnerCertification = c.getCertificationProject(ctx, pyxisResp.CertProject)
+	}
+
+	return certData
+}
+
+// imageCertificationData converts the fields of a Pyxis image record itself to CertificationData
+func imageCertificationData(pyxisResp *PyxisImageResponse) *CertificationData {
 	certData := &CertificationData{
 		ImageID:            pyxisResp.ID,
 		AutoRebuildEnabled: pyxisResp.CanAutoReleaseCVERebuild,
@@ -260,25 +474,14 @@ func (c *HTTPClient) convertToCertificationData(
 
 	certData.Architectures = extractArchitectures(pyxisResp.ContentStreamGrades)
 	certData.ArchitectureHealth = extractArchitectureHealth(pyxisResp.ContentStreamGrades)
-	c.populateRepositoryData(ctx, pyxisResp, certData)
 
 	if len(pyxisResp.FreshnessGrades) > 0 {
 		certData.HealthIndex = pyxisResp.FreshnessGrades[0].Grade
 	}
 
 	extractPublisherInfo(pyxisResp.ParsedData, certData)
+	certData.Labels = extractOwnershipLabels(pyxisResp.ParsedData)
 	copyVulnerabilitySummary(pyxisResp.VulnerabilitySummary, certData)
-
-	if certData.ImageID != "" {
-		cves, advisoryIDs := c.getVulnerabilitiesWithAdvisories(ctx, certData.ImageID)
-		if len(cves) > 0 {
-			certData.CVEs = cves
-		}
-		if len(advisoryIDs) > 0 {
-			certData.AdvisoryIDs = advisoryIDs
-		}
-	}
-
 	return certData
 }
 
@@ -311,15 +514,19 @@ func extractArchitectureHealth(grades []PyxisContentStreamGrade) map[string]stri
 	return archHealth
 }
 
-// populateRepositoryData populates repository-related fields in CertificationData
+// populateRepositoryData populates repository-related fields in CertificationData from the
+// queried repository, or the image's first repository if it is not published there
 func (c *HTTPClient) populateRepositoryData(
-	ctx context.Context, pyxisResp *PyxisImageResponse, certData *CertificationData,
+	ctx context.Context, pyxisResp *PyxisImageResponse, registry, repository string, certData *CertificationData,
 ) {
 	if len(pyxisResp.Repositories) == 0 {
 		return
 	}
 
 	repo := pyxisResp.Repositories[0]
+	if match := matchingRepository(pyxisResp, registry, repository); match != nil {
+		repo = *match
+	}
 	repoInfo := c.getRepositoryInfo(ctx, repo.Registry, repo.Repository)
 	if repoInfo != nil {
 		if repoInfo.ID != "" {
@@ -355,6 +562,25 @@ func extractPublisherInfo(parsedData *PyxisImageParsedData, certData *Certificat
 	}
 }
 
+// extractOwnershipLabels returns the labels that identify who publishes an image
+func extractOwnershipLabels(parsedData *PyxisImageParsedData) map[string]string {
+	if parsedData == nil {
+		return nil
+	}
+	var labels map[string]string
+	for _, label := range parsedData.Labels {
+		switch {
+		case strings.HasPrefix(label.Name, "org.opencontainers.image."),
+			label.Name == "vendor", label.Name == "version", label.Name == "vcs-ref":
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[label.Name] = label.Value
+		}
+	}
+	return labels
+}
+
 // copyVulnerabilitySummary copies vulnerability summary to CertificationData
 func copyVulnerabilitySummary(summary *PyxisVulnerabilitySummary, certData *CertificationData) {
 	if summary == nil {
@@ -387,11 +613,9 @@ func (c *HTTPClient) getRepositoryInfo(ctx context.Context, registry, repository
 	}
 
 	req.Header.Set("Accept", "application/json")
-	if c.apiKey != "" {
-		req.Header.Set("X-API-KEY", c.apiKey)
-	}
+	c.setAPIKeyHeader(req)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req, "repositories")
 	if err != nil {
 		return nil
 	}
@@ -425,55 +649,114 @@ func (c *HTTPClient) getRepositoryInfo(ctx context.Context, registry, repository
 	return info
 }
 
-// getVulnerabilitiesWithAdvisories fetches CVE IDs and advisory IDs for an image from Pyxis
-func (c *HTTPClient) getVulnerabilitiesWithAdvisories(ctx context.Context, imageID string) ([]string, []string) {
+// getCertificationProject fetches the certification project of a partner image, returning
+// nil when it cannot be read
+func (c *HTTPClient) getCertificationProject(ctx context.Context, projectID string) *PartnerCertification {
 	start := time.Now()
-	requestURL := fmt.Sprintf("%s/images/id/%s/vulnerabilities", c.baseURL, imageID)
+	requestURL := fmt.Sprintf("%s/projects/certification/id/%s", c.baseURL, url.PathEscape(projectID))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
-		return nil, nil
+		return nil
 	}
 
 	req.Header.Set("Accept", "application/json")
-	if c.apiKey != "" {
-		req.Header.Set("X-API-KEY", c.apiKey)
+	c.setAPIKeyHeader(req)
+
+	resp, err := c.do(req, "projects")
+	duration := time.Since(start).Seconds()
+	if err != nil {
+		metrics.RecordPyxisRequest("error", "projects", duration)
+		return nil
 	}
+	defer func() { _ = resp.Body.Close() }()
 
-	resp, err := c.httpClient.Do(req)
+	if resp.StatusCode != http.StatusOK {
+		metrics.RecordPyxisRequest("error", "projects", duration)
+		return nil
+	}
+
+	var project PyxisCertificationProject
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		metrics.RecordPyxisRequest("error", "projects", duration)
+		return nil
+	}
+	metrics.RecordPyxisRequest("success", "projects", duration)
+
+	partner := &PartnerCertification{
+		ProjectID:   projectID,
+		ProjectName: project.Name,
+		Level:       project.CertificationLevel,
+		Status:      project.CertificationStatus,
+		Badges:      project.Badges,
+		CNF:         slices.ContainsFunc(project.Badges, func(badge string) bool { return strings.EqualFold(badge, CNFBadge) }),
+	}
+	if project.Container != nil {
+		partner.SupportedOCPVersions = project.Container.SupportedOCPVersions
+	}
+	return partner
+}
+
+// getVulnerabilitiesWithAdvisories fetches CVE IDs, their severities, advisory IDs, and the
+// advisory fixing each CVE for an image from Pyxis
+func (c *HTTPClient) getVulnerabilitiesWithAdvisories(
+	ctx context.Context, imageID string,
+) ([]string, map[string]string, []string, map[string]string, error) {
+	start := time.Now()
+	requestURL := fmt.Sprintf("%s/images/id/%s/vulnerabilities", c.baseURL, imageID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	c.setAPIKeyHeader(req)
+
+	resp, err := c.do(req, "vulnerabilities")
 	duration := time.Since(start).Seconds()
 	if err != nil {
 		metrics.RecordPyxisRequest("error", "vulnerabilities", duration)
-		return nil, nil
+		return nil, nil, nil, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
 		metrics.RecordPyxisRequest("error", "vulnerabilities", duration)
-		return nil, nil
+		return nil, nil, nil, nil, fmt.Errorf("unexpected response status %s", resp.Status)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, nil
+		metrics.RecordPyxisRequest("error", "vulnerabilities", duration)
+		return nil, nil, nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	var vulnResp PyxisVulnerabilitiesResponse
 	if err := json.Unmarshal(body, &vulnResp); err != nil {
-		return nil, nil
+		metrics.RecordPyxisRequest("error", "vulnerabilities", duration)
+		return nil, nil, nil, nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	metrics.RecordPyxisRequest("success", "vulnerabilities", duration)
 
-	// Extract CVE IDs and advisory IDs
+	// Extract CVE IDs, severities, and advisory IDs
 	var cves []string
+	severities := make(map[string]string)
+	cveAdvisories := make(map[string]string)
 	advisorySet := make(map[string]bool)
 	for _, vuln := range vulnResp.Data {
 		if vuln.CVEID != "" {
 			cves = append(cves, vuln.CVEID)
+			if vuln.Severity != "" {
+				severities[vuln.CVEID] = strings.ToLower(vuln.Severity)
+			}
 		}
 		if vuln.AdvisoryID != "" {
 			advisorySet[vuln.AdvisoryID] = true
+			if vuln.CVEID != "" {
+				cveAdvisories[vuln.CVEID] = vuln.AdvisoryID
+			}
 		}
 	}
 
@@ -482,7 +765,97 @@ func (c *HTTPClient) getVulnerabilitiesWithAdvisories(ctx context.Context, image
 		advisoryIDs = append(advisoryIDs, id)
 	}
 
-	return cves, advisoryIDs
+	return cves, severities, advisoryIDs, cveAdvisories, nil
+}
+
+// resolveCVEFixes looks up the image that fixes each critical and important CVE. Each advisory
+// is looked up once; CVEs without an advisory have no fix yet and are omitted.
+func (c *HTTPClient) resolveCVEFixes(
+	ctx context.Context, severities, cveAdvisories map[string]string, registry, repository string,
+) map[string]CVEFix {
+	fixedIn := make(map[string]string)
+	fixes := make(map[string]CVEFix)
+	for cve, advisoryID := range cveAdvisories {
+		if severity := severities[cve]; severity != "critical" && severity != "important" {
+			continue
+		}
+		ref, ok := fixedIn[advisoryID]
+		if !ok {
+			ref = c.getAdvisoryImage(ctx, advisoryID, registry, repository)
+			fixedIn[advisoryID] = ref
+		}
+		fixes[cve] = CVEFix{AdvisoryID: advisoryID, FixedIn: ref}
+	}
+	if len(fixes) == 0 {
+		return nil
+	}
+	return fixes
+}
+
+// getAdvisoryImage returns a tagged reference to the image an advisory shipped in the given
+// repository, or an empty string if Pyxis does not list one
+func (c *HTTPClient) getAdvisoryImage(ctx context.Context, advisoryID, registry, repository string) string {
+	start := time.Now()
+	filter := fmt.Sprintf("repositories.image_advisory_id==%s;repositories.repository==%s", advisoryID, repository)
+	requestURL := fmt.Sprintf("%s/images?filter=%s&page_size=%d", c.baseURL, url.QueryEscape(filter), imagesPageSize)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return ""
+	}
+
+	req.Header.Set("Accept", "application/json")
+	c.setAPIKeyHeader(req)
+
+	resp, err := c.do(req, "advisory_images")
+	duration := time.Since(start).Seconds()
+	if err != nil {
+		metrics.RecordPyxisRequest("error", "advisory_images", duration)
+		return ""
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		metrics.RecordPyxisRequest("error", "advisory_images", duration)
+		return ""
+	}
+
+	var pagedResp PyxisPagedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&pagedResp); err != nil {
+		return ""
+	}
+	metrics.RecordPyxisRequest("success", "advisory_images", duration)
+
+	for i := range pagedResp.Data {
+		for _, repo := range pagedResp.Data[i].Repositories {
+			if repo.ImageAdvisoryID != advisoryID || repo.Repository != repository {
+				continue
+			}
+			if tag := fixedImageTag(repo.Tags); tag != "" {
+				if registry == "" || !isRedHatRegistry(registry) {
+					registry = repo.Registry
+				}
+				return fmt.Sprintf("%s/%s:%s", registry, repository, tag)
+			}
+		}
+	}
+	return ""
+}
+
+// fixedImageTag picks the most specific tag of a fixed image. Floating tags such as latest
+// move on with later releases, so they are only used when nothing else is available.
+func fixedImageTag(tags []PyxisImageTag) string {
+	var best string
+	for _, tag := range tags {
+		switch {
+		case tag.Name == "":
+		case best == "", best == "latest":
+			best = tag.Name
+		case tag.Name != "latest" && len(tag.Name) > len(best):
+			best = tag.Name
+		}
+	}
+	return best
 }
 
 // isRedHatRegistry checks if the registry is a Red Hat registry
@@ -490,7 +863,7 @@ func isRedHatRegistry(registry string) bool {
 	redHatRegistries := []string{
 		"registry.redhat.io",
 		"registry.access.redhat.com",
-		"registry.connect.redhat.com",
+		PartnerRegistry,
 	}
 	return slices.Contains(redHatRegistries, registry)
 }
@@ -21,8 +21,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestHTTPClient_GetImageCertification(t *testing.T) {
@@ -56,6 +61,7 @@ func TestHTTPClient_GetImageCertification(t *testing.T) {
 					Labels: []PyxisLabel{
 						{Name: "vendor", Value: "Red Hat"},
 						{Name: "com.redhat.component", Value: "ubi8-container"},
+						{Name: "org.opencontainers.image.revision", Value: "0123abc"},
 					},
 				},
 				VulnerabilitySummary: &PyxisVulnerabilitySummary{
@@ -171,6 +177,100 @@ func TestHTTPClient_GetImageCertification(t *testing.T) {
 				if len(got.Architectures) == 0 && tt.imageResponse != nil && len(tt.imageResponse.ContentStreamGrades) > 0 {
 					t.Error("GetImageCertification() Architectures not populated")
 				}
+				if tt.imageResponse != nil && tt.imageResponse.ParsedData != nil &&
+					(got.Labels["vendor"] != "Red Hat" || got.Labels["org.opencontainers.image.revision"] != "0123abc" ||
+						got.Labels["com.redhat.component"] != "") {
+					t.Errorf("GetImageCertification() Labels = %v, want only the ownership labels", got.Labels)
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPClient_GetImageCertification_Pagination(t *testing.T) {
+	// The first page is full of records from other repositories; the queried one is on the second
+	other := PyxisImageResponse{
+		ID:              "other",
+		FreshnessGrades: []PyxisFreshnessGrade{{Grade: "F"}},
+		Repositories:    []PyxisImageRepository{{Registry: "registry.redhat.io", Repository: "other/image"}},
+	}
+	firstPage := make([]PyxisImageResponse, imagesPageSize)
+	for i := range firstPage {
+		firstPage[i] = other
+	}
+	wanted := PyxisImageResponse{
+		ID:              "wanted",
+		FreshnessGrades: []PyxisFreshnessGrade{{Grade: "A"}},
+		Repositories:    []PyxisImageRepository{{Registry: "registry.redhat.io", Repository: "ubi9/ubi"}},
+	}
+
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/images" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		pages = append(pages, r.URL.Query().Get("page"))
+		if r.URL.Query().Get("page_size") != strconv.Itoa(imagesPageSize) {
+			t.Errorf("page_size = %q, want %d", r.URL.Query().Get("page_size"), imagesPageSize)
+		}
+		resp := PyxisPagedResponse{Total: imagesPageSize + 1, PageSize: imagesPageSize}
+		switch r.URL.Query().Get("page") {
+		case "0":
+			resp.Data = firstPage
+		case "1":
+			resp.Page = 1
+			resp.Data = []PyxisImageResponse{wanted}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(WithBaseURL(server.URL))
+	got, err := client.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123")
+	if err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	if got == nil || got.ImageID != "wanted" {
+		t.Fatalf("GetImageCertification() = %+v, want the record from ubi9/ubi", got)
+	}
+	if strings.Join(pages, ",") != "0,1" {
+		t.Errorf("requested pages = %v, want [0 1]", pages)
+	}
+}
+
+func TestSelectImage(t *testing.T) {
+	image := func(id string, repos ...PyxisImageRepository) PyxisImageResponse {
+		return PyxisImageResponse{ID: id, Repositories: repos}
+	}
+	exact := image("exact", PyxisImageRepository{Registry: "registry.redhat.io", Repository: "ubi9/ubi"})
+	mirror := image("mirror", PyxisImageRepository{Registry: "registry.access.redhat.com", Repository: "ubi9/ubi"})
+	redHat := image("red-hat", PyxisImageRepository{Registry: "registry.redhat.io", Repository: "other/image"})
+	thirdParty := image("third-party", PyxisImageRepository{Registry: "quay.io", Repository: "ubi9/ubi"})
+
+	tests := []struct {
+		name    string
+		records []PyxisImageResponse
+		want    string
+	}{
+		{name: "no records"},
+		{name: "exact match wins", records: []PyxisImageResponse{thirdParty, redHat, mirror, exact}, want: "exact"},
+		{name: "same repository on a mirror", records: []PyxisImageResponse{thirdParty, redHat, mirror}, want: "mirror"},
+		{name: "any Red Hat registry", records: []PyxisImageResponse{thirdParty, redHat}, want: "red-hat"},
+		{name: "first record otherwise", records: []PyxisImageResponse{thirdParty}, want: "third-party"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectImage(tt.records, "registry.redhat.io", "ubi9/ubi")
+			if got == nil {
+				if tt.want != "" {
+					t.Errorf("selectImage() = nil, want %s", tt.want)
+				}
+				return
+			}
+			if got.ID != tt.want {
+				t.Errorf("selectImage() = %s, want %s", got.ID, tt.want)
 			}
 		})
 	}
@@ -232,3 +332,207 @@ func TestNewHTTPClient_Options(t *testing.T) {
 		t.Errorf("apiKey = %v, want test-api-key", client.apiKey)
 	}
 }
+
+func TestHTTPClient_GetImageCertification_CVEFixes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/vulnerabilities"):
+			_ = json.NewEncoder(w).Encode(PyxisVulnerabilitiesResponse{Data: []PyxisVulnerability{
+				{CVEID: "CVE-2024-0001", Severity: "Critical", AdvisoryID: "RHSA-2024:0001"},
+				{CVEID: "CVE-2024-0002", Severity: "Important", AdvisoryID: "RHSA-2024:0001"},
+				{CVEID: "CVE-2024-0003", Severity: "Important"},
+				{CVEID: "CVE-2024-0004", Severity: "Low", AdvisoryID: "RHSA-2024:0004"},
+			}})
+		case r.URL.Path == "/images" && strings.Contains(r.URL.Query().Get("filter"), "image_advisory_id"):
+			if !strings.Contains(r.URL.Query().Get("filter"), "RHSA-2024:0001") {
+				t.Errorf("unexpected advisory lookup: %s", r.URL.Query().Get("filter"))
+			}
+			_ = json.NewEncoder(w).Encode(PyxisPagedResponse{Data: []PyxisImageResponse{{
+				ID: "fixed-id",
+				Repositories: []PyxisImageRepository{{
+					Registry:        "registry.access.redhat.com",
+					Repository:      "ubi9/ubi",
+					ImageAdvisoryID: "RHSA-2024:0001",
+					Tags:            []PyxisImageTag{{Name: "latest"}, {Name: "9.4"}, {Name: "9.4-1214"}},
+				}},
+			}}})
+		case r.URL.Path == "/images":
+			_ = json.NewEncoder(w).Encode(PyxisPagedResponse{Data: []PyxisImageResponse{{
+				ID:           "test-id",
+				Repositories: []PyxisImageRepository{{Registry: "registry.redhat.io", Repository: "ubi9/ubi"}},
+			}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(WithBaseURL(server.URL))
+	got, err := client.GetImageCertification(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123")
+	if err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+
+	want := map[string]CVEFix{
+		"CVE-2024-0001": {AdvisoryID: "RHSA-2024:0001", FixedIn: "registry.redhat.io/ubi9/ubi:9.4-1214"},
+		"CVE-2024-0002": {AdvisoryID: "RHSA-2024:0001", FixedIn: "registry.redhat.io/ubi9/ubi:9.4-1214"},
+	}
+	if len(got.CVEFixes) != len(want) {
+		t.Fatalf("CVEFixes = %v, want %v", got.CVEFixes, want)
+	}
+	for cve, fix := range want {
+		if got.CVEFixes[cve] != fix {
+			t.Errorf("CVEFixes[%s] = %+v, want %+v", cve, got.CVEFixes[cve], fix)
+		}
+	}
+}
+
+func TestHTTPClient_GetImageCertification_PartnerCertification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/images":
+			_ = json.NewEncoder(w).Encode(PyxisPagedResponse{Data: []PyxisImageResponse{{
+				ID:          "partner-id",
+				CertProject: "5f0c1a2b",
+				Repositories: []PyxisImageRepository{
+					{Registry: PartnerRegistry, Repository: "acme/upf"},
+				},
+			}}})
+		case "/projects/certification/id/5f0c1a2b":
+			_ = json.NewEncoder(w).Encode(PyxisCertificationProject{
+				ID:                  "5f0c1a2b",
+				Name:                "ACME UPF",
+				CertificationStatus: "Certified",
+				CertificationLevel:  "Vendor Validated",
+				Badges:              []string{"CNF", "Multi-arch"},
+				Container: &PyxisCertificationProjectContainer{
+					SupportedOCPVersions: []string{"4.14", "4.15"},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(WithBaseURL(server.URL))
+	got, err := client.GetImageCertification(context.Background(), PartnerRegistry, "acme/upf", "sha256:abc123")
+	if err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	partner := got.PartnerCertification
+	if partner == nil {
+		t.Fatal("PartnerCertification = nil, want the certification project")
+	}
+	if partner.ProjectID != "5f0c1a2b" || partner.ProjectName != "ACME UPF" || partner.Level != "Vendor Validated" ||
+		partner.Status != "Certified" || !partner.CNF {
+		t.Errorf("PartnerCertification = %+v", partner)
+	}
+	if !slices.Equal(partner.SupportedOCPVersions, []string{"4.14", "4.15"}) {
+		t.Errorf("SupportedOCPVersions = %v, want [4.14 4.15]", partner.SupportedOCPVersions)
+	}
+
+	// Red Hat images have no partner certification project
+	got, err = client.GetImageCertification(context.Background(), "registry.redhat.io", "acme/upf", "sha256:abc123")
+	if err != nil {
+		t.Fatalf("GetImageCertification() error = %v", err)
+	}
+	if got.PartnerCertification != nil {
+		t.Errorf("PartnerCertification for a Red Hat registry = %+v, want nil", got.PartnerCertification)
+	}
+}
+
+func TestHTTPClient_GetImageCertification_CoalescesDigest(t *testing.T) {
+	record := PyxisImageResponse{
+		ID:              "ubi",
+		FreshnessGrades: []PyxisFreshnessGrade{{Grade: "A"}},
+		Repositories: []PyxisImageRepository{
+			{Registry: "registry.redhat.io", Repository: "ubi9/ubi"},
+			{Registry: "registry.access.redhat.com", Repository: "ubi9"},
+		},
+	}
+	var requests atomic.Int32
+	received := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/images" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if requests.Add(1) == 1 {
+			close(received)
+		}
+		<-release
+		_ = json.NewEncoder(w).Encode(PyxisPagedResponse{Data: []PyxisImageResponse{record}, Total: 1})
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(WithBaseURL(server.URL))
+	lookup := func(registry, repository string) {
+		data, err := client.GetImageCertification(context.Background(), registry, repository, "sha256:abc123")
+		if err != nil || data == nil || data.ImageID != "ubi" {
+			t.Errorf("GetImageCertification(%s/%s) = %+v, %v, want the ubi record", registry, repository, data, err)
+		}
+	}
+
+	// Lookups of one digest through different repositories share the upstream query
+	var wg sync.WaitGroup
+	wg.Go(func() { lookup("registry.redhat.io", "ubi9/ubi") })
+	<-received
+	wg.Go(func() { lookup("registry.access.redhat.com", "ubi9") })
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("upstream image queries = %d, want 1", n)
+	}
+}
+
+func TestHTTPClient_QueryImage(t *testing.T) {
+	var filters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filters = append(filters, r.URL.Query().Get("filter"))
+		_ = json.NewEncoder(w).Encode(PyxisPagedResponse{})
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(WithBaseURL(server.URL))
+	for _, query := range []QueryType{QueryImageID, QueryManifestListDigest} {
+		if _, err := client.QueryImage(context.Background(), query, "registry.redhat.io", "ubi9/ubi", "sha256:abc123"); err != nil {
+			t.Fatalf("QueryImage(%s) error = %v", query, err)
+		}
+	}
+	if _, err := client.QueryImage(context.Background(), QueryTag, "registry.redhat.io", "ubi9/ubi", "9.4"); err != nil {
+		t.Fatalf("QueryImage(tag) error = %v", err)
+	}
+	if _, err := client.QueryImage(context.Background(), "sbom", "registry.redhat.io", "ubi9/ubi", "9.4"); err == nil {
+		t.Error("QueryImage() with an unknown query type should fail")
+	}
+
+	want := []string{
+		"image_id==sha256:abc123",
+		"repositories.manifest_list_digest==sha256:abc123",
+		"repositories.registry==registry.redhat.io;repositories.repository==ubi9/ubi;repositories.tags.name==9.4",
+	}
+	if !slices.Equal(filters, want) {
+		t.Errorf("filters = %v, want %v", filters, want)
+	}
+}
+
+func TestHTTPClient_AuthIdentity(t *testing.T) {
+	client := NewHTTPClient()
+	if got := client.AuthIdentity(); got != anonymousIdentity {
+		t.Errorf("AuthIdentity() = %q without an API key, want %q", got, anonymousIdentity)
+	}
+
+	client.SetAPIKey("first-key")
+	first := client.AuthIdentity()
+	if first == anonymousIdentity || strings.Contains(first, "first-key") {
+		t.Errorf("AuthIdentity() = %q, want a hash of the API key", first)
+	}
+	client.SetAPIKey("second-key")
+	if second := client.AuthIdentity(); second == first {
+		t.Errorf("AuthIdentity() = %q for a rotated key, want it to change", second)
+	}
+}
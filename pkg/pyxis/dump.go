@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// DumpClient answers certification lookups from an offline dump of Pyxis image records,
+// for sites without network access to the Pyxis API. It only knows what the image
+// records contain, so repository lifecycle data, CVE lists, and partner certification
+// projects are not filled in.
+type DumpClient struct {
+	records []PyxisImageResponse
+}
+
+// LoadDump reads a dump of Pyxis image records. The file holds one or more responses
+// of the Pyxis images endpoint ({"data": [...]}) or JSON arrays of image records.
+func LoadDump(path string) (*DumpClient, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	records, err := parseDump(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Pyxis dump %s: %w", path, err)
+	}
+	return &DumpClient{records: records}, nil
+}
+
+// parseDump decodes the image records of every JSON value in data
+func parseDump(data []byte) ([]PyxisImageResponse, error) {
+	var records []PyxisImageResponse
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var value json.RawMessage
+		if err := decoder.Decode(&value); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if trimmed := bytes.TrimSpace(value); len(trimmed) > 0 && trimmed[0] == '[' {
+			var page []PyxisImageResponse
+			if err := json.Unmarshal(value, &page); err != nil {
+				return nil, err
+			}
+			records = append(records, page...)
+			continue
+		}
+		var page PyxisPagedResponse
+		if err := json.Unmarshal(value, &page); err != nil {
+			return nil, err
+		}
+		records = append(records, page.Data...)
+	}
+}
+
+// Len returns the number of image records in the dump
+func (c *DumpClient) Len() int {
+	return len(c.records)
+}
+
+// GetImageCertification looks an image up by image ID, then by manifest list digest, the
+// same way the Pyxis API is queried
+func (c *DumpClient) GetImageCertification(_ context.Context, registry, repository, digest string) (
+	*CertificationData, error) {
+	var byImageID, byManifestList []PyxisImageResponse
+	for _, record := range c.records {
+		if record.ImageID == digest {
+			byImageID = append(byImageID, record)
+		}
+		if slices.ContainsFunc(record.Repositories, func(repo PyxisImageRepository) bool {
+			return repo.ManifestListDigest == digest
+		}) {
+			byManifestList = append(byManifestList, record)
+		}
+	}
+
+	for _, records := range [][]PyxisImageResponse{byImageID, byManifestList} {
+		img := selectImage(records, registry, repository)
+		if img == nil {
+			continue
+		}
+		if !isFromRedHatRegistry(img) {
+			return nil, nil
+		}
+		return imageCertificationData(img), nil
+	}
+	return nil, nil
+}
+
+// IsHealthy always returns true, as the dump is read from disk
+func (c *DumpClient) IsHealthy(_ context.Context) bool {
+	return true
+}
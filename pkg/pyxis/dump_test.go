@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDumpClient_GetImageCertification(t *testing.T) {
+	dump := `{"data": [
+  {"_id": "single", "image_id": "sha256:aaa", "freshness_grades": [{"grade": "B"}],
+   "vulnerability_summary": {"critical": 1, "important": 2},
+   "repositories": [{"registry": "registry.redhat.io", "repository": "ubi9/ubi"}]}
+]}
+[
+  {"_id": "multi", "image_id": "sha256:child",
+   "repositories": [{"registry": "registry.access.redhat.com", "repository": "ubi9/ubi", "manifest_list_digest": "sha256:list"}]},
+  {"_id": "other", "image_id": "sha256:ccc",
+   "repositories": [{"registry": "docker.io", "repository": "library/nginx"}]}
+]`
+	path := filepath.Join(t.TempDir(), "pyxis.json")
+	if err := os.WriteFile(path, []byte(dump), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := LoadDump(path)
+	if err != nil {
+		t.Fatalf("LoadDump() error = %v", err)
+	}
+	if client.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", client.Len())
+	}
+
+	ctx := context.Background()
+	got, err := client.GetImageCertification(ctx, "registry.redhat.io", "ubi9/ubi", "sha256:aaa")
+	if err != nil || got == nil {
+		t.Fatalf("GetImageCertification(single-arch) = %v, %v", got, err)
+	}
+	if got.ImageID != "single" || got.HealthIndex != "B" || got.Vulnerabilities == nil || got.Vulnerabilities.Critical != 1 {
+		t.Errorf("GetImageCertification(single-arch) = %+v", got)
+	}
+
+	got, err = client.GetImageCertification(ctx, "registry.redhat.io", "ubi9/ubi", "sha256:list")
+	if err != nil || got == nil || got.ImageID != "multi" {
+		t.Errorf("GetImageCertification(manifest list) = %+v, %v, want the multi record", got, err)
+	}
+
+	for _, digest := range []string{"sha256:ccc", "sha256:missing"} {
+		got, err = client.GetImageCertification(ctx, "docker.io", "library/nginx", digest)
+		if err != nil || got != nil {
+			t.Errorf("GetImageCertification(%s) = %+v, %v, want nil", digest, got, err)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(`{"data": [`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDump(path); err == nil {
+		t.Error("LoadDump() of a truncated file: expected error")
+	}
+}
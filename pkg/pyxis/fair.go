@@ -0,0 +1,171 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Priority classifies a Pyxis request for fair scheduling over the shared rate limiter
+type Priority int
+
+const (
+	// PriorityInteractive is used for lookups triggered by newly discovered images
+	PriorityInteractive Priority = iota
+	// PriorityBatch is used for periodic refresh of already known images
+	PriorityBatch
+)
+
+// String returns the label used for the priority class
+func (p Priority) String() string {
+	if p == PriorityBatch {
+		return "batch"
+	}
+	return "interactive"
+}
+
+// DefaultInteractiveWeight is the number of interactive requests granted for every
+// batch request while both classes are waiting
+const DefaultInteractiveWeight = 3
+
+type priorityKey struct{}
+
+// WithPriority returns a context that marks Pyxis requests with the given priority class
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFromContext returns the priority class of the context, defaulting to interactive
+func PriorityFromContext(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+	return PriorityInteractive
+}
+
+// fairScheduler hands out tokens from a shared rate limiter to two priority classes.
+// Interactive waiters are preferred, but a waiting batch request is admitted after
+// every interactiveWeight interactive grants so refresh cycles still make progress.
+type fairScheduler struct {
+	limiter           *rate.Limiter
+	interactiveWeight int
+
+	mu          sync.Mutex
+	queues      [2][]chan struct{}
+	streak      int
+	dispatching bool
+}
+
+// newFairScheduler creates a scheduler over the given limiter
+func newFairScheduler(limiter *rate.Limiter, interactiveWeight int) *fairScheduler {
+	if interactiveWeight < 1 {
+		interactiveWeight = 1
+	}
+	return &fairScheduler{
+		limiter:           limiter,
+		interactiveWeight: interactiveWeight,
+	}
+}
+
+// Wait blocks until a token is granted to the caller's priority class or ctx is done
+func (s *fairScheduler) Wait(ctx context.Context, p Priority) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ready := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.queues[p] = append(s.queues[p], ready)
+	if !s.dispatching {
+		s.dispatching = true
+		go s.dispatch()
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if i := slices.Index(s.queues[p], ready); i >= 0 {
+			s.queues[p] = slices.Delete(s.queues[p], i, i+1)
+			return ctx.Err()
+		}
+		// The token was granted concurrently with cancellation; use it
+		return nil
+	}
+}
+
+// dispatch grants tokens to waiters until both queues are empty
+func (s *fairScheduler) dispatch() {
+	for {
+		s.mu.Lock()
+		if len(s.queues[PriorityInteractive]) == 0 && len(s.queues[PriorityBatch]) == 0 {
+			s.dispatching = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+
+		// Reserve the token before choosing a waiter so the choice reflects
+		// whoever is queued at the moment budget becomes available
+		_ = s.limiter.Wait(context.Background())
+
+		s.mu.Lock()
+		if next := s.nextLocked(); next != nil {
+			next <- struct{}{}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// nextLocked pops the next waiter according to the fairness policy. Callers must hold s.mu.
+func (s *fairScheduler) nextLocked() chan struct{} {
+	interactive := len(s.queues[PriorityInteractive]) > 0
+	batch := len(s.queues[PriorityBatch]) > 0
+
+	var p Priority
+	switch {
+	case interactive && batch:
+		if s.streak >= s.interactiveWeight {
+			p = PriorityBatch
+		} else {
+			p = PriorityInteractive
+		}
+	case interactive:
+		p = PriorityInteractive
+	case batch:
+		p = PriorityBatch
+	default:
+		return nil
+	}
+
+	if p == PriorityInteractive {
+		s.streak++
+	} else {
+		s.streak = 0
+	}
+
+	next := s.queues[p][0]
+	s.queues[p] = s.queues[p][1:]
+	return next
+}
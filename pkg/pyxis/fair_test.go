@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestPriorityFromContext(t *testing.T) {
+	if got := PriorityFromContext(context.Background()); got != PriorityInteractive {
+		t.Errorf("default priority = %v, want interactive", got)
+	}
+	ctx := WithPriority(context.Background(), PriorityBatch)
+	if got := PriorityFromContext(ctx); got != PriorityBatch {
+		t.Errorf("priority = %v, want batch", got)
+	}
+}
+
+func TestFairScheduler_NextLocked(t *testing.T) {
+	tests := []struct {
+		name        string
+		weight      int
+		interactive int
+		batch       int
+		want        string
+	}{
+		{
+			name:        "interactive preferred with batch admitted by weight",
+			weight:      3,
+			interactive: 5,
+			batch:       3,
+			want:        "IIIBIIBB",
+		},
+		{
+			name:  "batch only",
+			batch: 3,
+			want:  "BBB",
+		},
+		{
+			name:        "weight of one alternates",
+			weight:      1,
+			interactive: 2,
+			batch:       2,
+			want:        "IBIB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newFairScheduler(rate.NewLimiter(rate.Inf, 1), tt.weight)
+			owner := make(map[chan struct{}]string)
+			for range tt.interactive {
+				ch := make(chan struct{}, 1)
+				owner[ch] = "I"
+				s.queues[PriorityInteractive] = append(s.queues[PriorityInteractive], ch)
+			}
+			for range tt.batch {
+				ch := make(chan struct{}, 1)
+				owner[ch] = "B"
+				s.queues[PriorityBatch] = append(s.queues[PriorityBatch], ch)
+			}
+
+			var order strings.Builder
+			for next := s.nextLocked(); next != nil; next = s.nextLocked() {
+				order.WriteString(owner[next])
+			}
+			if order.String() != tt.want {
+				t.Errorf("grant order = %s, want %s", order.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFairScheduler_Wait(t *testing.T) {
+	s := newFairScheduler(rate.NewLimiter(rate.Inf, 1), DefaultInteractiveWeight)
+	if err := s.Wait(context.Background(), PriorityBatch); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if err := s.Wait(context.Background(), PriorityInteractive); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+}
+
+func TestFairScheduler_WaitCancelled(t *testing.T) {
+	// One token per hour with no burst left guarantees the waiter is still queued
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	limiter.Allow()
+	s := newFairScheduler(limiter, DefaultInteractiveWeight)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := s.Wait(ctx, PriorityBatch); err == nil {
+		t.Fatal("expected error for cancelled wait")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n := len(s.queues[PriorityBatch]); n != 0 {
+		t.Errorf("expected cancelled waiter to be removed, %d still queued", n)
+	}
+}
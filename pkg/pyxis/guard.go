@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/errorbudget"
+)
+
+// GuardedClient wraps a Client with an error budget guard that temporarily
+// disables Pyxis calls while the API is failing
+type GuardedClient struct {
+	client Client
+	guard  *errorbudget.Guard
+}
+
+// NewGuardedClient creates a new guarded client wrapper
+func NewGuardedClient(client Client, guard *errorbudget.Guard) *GuardedClient {
+	return &GuardedClient{
+		client: client,
+		guard:  guard,
+	}
+}
+
+// GetImageCertification calls the underlying client unless the guard has disabled it,
+// in which case errorbudget.ErrDisabled is returned
+func (c *GuardedClient) GetImageCertification(
+	ctx context.Context, registry, repository, digest string,
+) (*CertificationData, error) {
+	if !c.guard.Allow() {
+		return nil, errorbudget.ErrDisabled
+	}
+
+	data, err := c.client.GetImageCertification(ctx, registry, repository, digest)
+	c.guard.Record(err)
+	return data, err
+}
+
+// QueryImage runs a single lookup strategy unless the guard has disabled the client
+func (c *GuardedClient) QueryImage(
+	ctx context.Context, query QueryType, registry, repository, value string,
+) (*CertificationData, error) {
+	if !c.guard.Allow() {
+		return nil, errorbudget.ErrDisabled
+	}
+
+	data, err := queryImage(ctx, c.client, query, registry, repository, value)
+	c.guard.Record(err)
+	return data, err
+}
+
+// AuthIdentity delegates to the underlying client
+func (c *GuardedClient) AuthIdentity() string {
+	return authIdentity(c.client)
+}
+
+// IsHealthy delegates to the underlying client
+func (c *GuardedClient) IsHealthy(ctx context.Context) bool {
+	return c.client.IsHealthy(ctx)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Cache backends selectable with --pyxis-cache-backend
+const (
+	CacheBackendMemory    = "memory"
+	CacheBackendConfigMap = "configmap"
+)
+
+// DefaultCacheConfigMapName is the name of the ConfigMap holding the persisted cache
+const DefaultCacheConfigMapName = "imagecertinfo-pyxis-cache"
+
+// DefaultCacheFlushInterval is how often the cache is written to the ConfigMap
+const DefaultCacheFlushInterval = 5 * time.Minute
+
+// cacheDataKey is the ConfigMap key holding the gzipped JSON cache entries
+const cacheDataKey = "cache.json.gz"
+
+// maxCacheConfigMapBytes keeps the ConfigMap comfortably below the 1MiB object size limit
+const maxCacheConfigMapBytes = 900 * 1024
+
+// ConfigMapCacheStore persists a CachedClient in a ConfigMap so that a restarted operator
+// does not query Pyxis again for every image. Every replica loads the ConfigMap at startup
+// and merges it with its own entries before writing, so replicas share their lookups.
+type ConfigMapCacheStore struct {
+	client    client.Client
+	namespace string
+	name      string
+	cache     *CachedClient
+	interval  time.Duration
+}
+
+// NewConfigMapCacheStore creates a store persisting cache in the named ConfigMap. The client
+// should not be backed by the manager cache, which would watch every ConfigMap in the cluster.
+func NewConfigMapCacheStore(c client.Client, namespace, name string, cache *CachedClient) *ConfigMapCacheStore {
+	return &ConfigMapCacheStore{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+		cache:     cache,
+		interval:  DefaultCacheFlushInterval,
+	}
+}
+
+// Load restores the persisted entries into the cache and returns how many were restored
+func (s *ConfigMapCacheStore) Load(ctx context.Context) (int, error) {
+	cm, entries, err := s.read(ctx)
+	if err != nil || cm == nil {
+		return 0, err
+	}
+	return s.cache.Restore(entries), nil
+}
+
+// NeedLeaderElection returns false so that every replica persists its lookups
+func (s *ConfigMapCacheStore) NeedLeaderElection() bool {
+	return false
+}
+
+// Start flushes the cache every flush interval, and once more on shutdown, until ctx is cancelled
+func (s *ConfigMapCacheStore) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("pyxis-cache")
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Keep the entries fetched since the last flush for the next start
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			defer cancel()
+			if err := s.Flush(flushCtx); err != nil {
+				logger.Error(err, "failed to persist Pyxis cache on shutdown", "name", s.name)
+			}
+			return nil
+		case <-ticker.C:
+			if err := s.Flush(ctx); err != nil {
+				logger.Error(err, "failed to persist Pyxis cache", "name", s.name)
+			}
+		}
+	}
+}
+
+// Flush merges the persisted entries into the cache and writes the result to the ConfigMap.
+// A concurrent write by another replica fails with a conflict and is retried on the next flush.
+func (s *ConfigMapCacheStore) Flush(ctx context.Context) error {
+	cm, persisted, err := s.read(ctx)
+	if err != nil {
+		return err
+	}
+	s.cache.Restore(persisted)
+
+	data, err := encodeCacheEntries(s.cache.Entries(), maxCacheConfigMapBytes)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.name,
+				Namespace: s.namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "imagecertinfo-operator"},
+			},
+			BinaryData: map[string][]byte{cacheDataKey: data},
+		}
+		return s.client.Create(ctx, cm)
+	}
+	cm.BinaryData = map[string][]byte{cacheDataKey: data}
+	return s.client.Update(ctx, cm)
+}
+
+// read fetches the ConfigMap and decodes its entries, returning a nil ConfigMap if it does not exist
+func (s *ConfigMapCacheStore) read(ctx context.Context) (*corev1.ConfigMap, []CacheEntry, error) {
+	var cm corev1.ConfigMap
+	err := s.client.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.name}, &cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := CacheEntriesFromConfigMap(&cm)
+	if err != nil {
+		// A corrupt cache is not worth failing over; it is overwritten on the next flush
+		log.FromContext(ctx).Error(err, "ignoring unreadable persisted Pyxis cache", "name", s.name)
+		return &cm, nil, nil
+	}
+	return &cm, entries, nil
+}
+
+// CacheEntriesFromConfigMap decodes the entries persisted in a cache ConfigMap, including
+// expired ones. A ConfigMap without a cache yields no entries.
+func CacheEntriesFromConfigMap(cm *corev1.ConfigMap) ([]CacheEntry, error) {
+	data, ok := cm.BinaryData[cacheDataKey]
+	if !ok {
+		return nil, nil
+	}
+	return decodeCacheEntries(data)
+}
+
+// encodeCacheEntries gzips entries as JSON. If the result exceeds maxBytes, the entries
+// closest to expiry are dropped until it fits.
+func encodeCacheEntries(entries []CacheEntry, maxBytes int) ([]byte, error) {
+	slices.SortFunc(entries, func(a, b CacheEntry) int {
+		return cmp.Or(b.ExpiresAt.Compare(a.ExpiresAt), cmp.Compare(a.Key, b.Key))
+	})
+	for {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if err := json.NewEncoder(zw).Encode(entries); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		if buf.Len() <= maxBytes || len(entries) == 0 {
+			return buf.Bytes(), nil
+		}
+		entries = entries[:len(entries)*3/4]
+	}
+}
+
+// decodeCacheEntries reverses encodeCacheEntries
+func decodeCacheEntries(data []byte) ([]CacheEntry, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress cache: %w", err)
+	}
+	body, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress cache: %w", err)
+	}
+	var entries []CacheEntry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse cache: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapCacheStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	redHatKey := func(repository, digest string) string {
+		return cacheKey(QueryImageID, anonymousIdentity, "registry.redhat.io", repository, digest)
+	}
+
+	// The previous run cached one image and another replica cached a second one
+	previous := NewCachedClient(nil)
+	previous.Restore([]CacheEntry{
+		{Key: redHatKey("ubi9/ubi", "sha256:aaa"), Data: &CertificationData{ProjectID: "ubi9"}, ExpiresAt: time.Now().Add(time.Hour)},
+		{Key: redHatKey("ubi8/ubi", "sha256:old"), Data: &CertificationData{ProjectID: "ubi8"}, ExpiresAt: time.Now().Add(time.Minute)},
+	})
+	if err := NewConfigMapCacheStore(fakeClient, "operator", DefaultCacheConfigMapName, previous).Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	replica := NewCachedClient(nil)
+	replica.Restore([]CacheEntry{
+		{Key: redHatKey("ubi9/ubi-minimal", "sha256:bbb"), Data: &CertificationData{ProjectID: "ubi9-minimal"}, ExpiresAt: time.Now().Add(time.Hour)},
+	})
+	if err := NewConfigMapCacheStore(fakeClient, "operator", DefaultCacheConfigMapName, replica).Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	// A restarted operator restores both, keeping their original expiry
+	restarted := NewCachedClient(nil)
+	restored, err := NewConfigMapCacheStore(fakeClient, "operator", DefaultCacheConfigMapName, restarted).Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if restored != 3 {
+		t.Errorf("Load() restored %d entries, want 3", restored)
+	}
+	data, err := restarted.GetImageCertification(ctx, "registry.redhat.io", "ubi9/ubi-minimal", "sha256:bbb")
+	if err != nil || data == nil || data.ProjectID != "ubi9-minimal" {
+		t.Errorf("GetImageCertification() = %+v, %v, want the persisted entry", data, err)
+	}
+
+	var cm corev1.ConfigMap
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: "operator", Name: DefaultCacheConfigMapName}, &cm); err != nil {
+		t.Fatalf("failed to get cache ConfigMap: %v", err)
+	}
+	if _, ok := cm.BinaryData[cacheDataKey]; !ok {
+		t.Errorf("cache ConfigMap has no %s key", cacheDataKey)
+	}
+}
+
+func TestConfigMapCacheStore_LoadMissing(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	store := NewConfigMapCacheStore(fake.NewClientBuilder().WithScheme(scheme).Build(), "operator",
+		DefaultCacheConfigMapName, NewCachedClient(nil))
+
+	restored, err := store.Load(context.Background())
+	if err != nil || restored != 0 {
+		t.Errorf("Load() = %d, %v, want 0, nil", restored, err)
+	}
+}
+
+func TestCachedClient_RestoreSkipsExpiredAndOlder(t *testing.T) {
+	now := time.Now()
+	cached := NewCachedClient(nil)
+	cached.Restore([]CacheEntry{{Key: "a", Data: &CertificationData{ProjectID: "newer"}, ExpiresAt: now.Add(2 * time.Hour)}})
+
+	restored := cached.Restore([]CacheEntry{
+		{Key: "a", Data: &CertificationData{ProjectID: "older"}, ExpiresAt: now.Add(time.Hour)},
+		{Key: "b", Data: &CertificationData{ProjectID: "expired"}, ExpiresAt: now.Add(-time.Minute)},
+	})
+	if restored != 0 {
+		t.Errorf("Restore() = %d, want 0", restored)
+	}
+	entries := cached.Entries()
+	if len(entries) != 1 || entries[0].Data.ProjectID != "newer" {
+		t.Errorf("Entries() = %+v, want only the newer entry", entries)
+	}
+}
+
+func TestEncodeCacheEntries_TrimsToFit(t *testing.T) {
+	now := time.Now()
+	var entries []CacheEntry
+	for i := range 200 {
+		entries = append(entries, CacheEntry{
+			Key:       time.Duration(i).String(),
+			Data:      &CertificationData{ProjectID: "project", CVEs: []string{time.Duration(i * 7919).String()}},
+			ExpiresAt: now.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	full, err := encodeCacheEntries(entries, maxCacheConfigMapBytes)
+	if err != nil {
+		t.Fatalf("encodeCacheEntries() error = %v", err)
+	}
+
+	trimmed, err := encodeCacheEntries(entries, len(full)/2)
+	if err != nil {
+		t.Fatalf("encodeCacheEntries() error = %v", err)
+	}
+	if len(trimmed) > len(full)/2 {
+		t.Errorf("encoded size = %d, want at most %d", len(trimmed), len(full)/2)
+	}
+	decoded, err := decodeCacheEntries(trimmed)
+	if err != nil {
+		t.Fatalf("decodeCacheEntries() error = %v", err)
+	}
+	// The entries furthest from expiry are kept
+	if len(decoded) == 0 || len(decoded) >= len(entries) {
+		t.Fatalf("decoded %d entries, want a non-empty subset of %d", len(decoded), len(entries))
+	}
+	if decoded[0].Key != (199 * time.Nanosecond).String() {
+		t.Errorf("first decoded entry = %v, want the one with the latest expiry", decoded[0].Key)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"strings"
+)
+
+// QueryType identifies the strategy used to look up an image in Pyxis
+type QueryType string
+
+const (
+	// QueryImageID looks up a single-architecture image by its image ID
+	QueryImageID QueryType = "image_id"
+	// QueryManifestListDigest looks up a multi-architecture image by its manifest list digest
+	QueryManifestListDigest QueryType = "manifest_list_digest"
+	// QueryTag looks up an image by the tag it is published under in a repository
+	QueryTag QueryType = "tag"
+)
+
+// anonymousIdentity is the auth identity of requests made without credentials
+const anonymousIdentity = "anonymous"
+
+// QueryClient is a Client that can run each lookup strategy on its own. CachedClient caches
+// the result of every strategy separately, so that a lookup answered by the manifest list
+// digest does not repeat the image ID query once it is cached.
+type QueryClient interface {
+	Client
+	// QueryImage looks up an image by a single strategy. value is a digest or a tag.
+	QueryImage(ctx context.Context, query QueryType, registry, repository, value string) (*CertificationData, error)
+	// AuthIdentity identifies the credentials requests are made with, without revealing them.
+	// Responses may differ between identities, so they are cached apart.
+	AuthIdentity() string
+}
+
+// queryTypes returns the strategies for looking up value, in the order they are tried.
+// Digests are looked up as single-architecture images, then as manifest lists. A tag never
+// contains a colon, so anything else is looked up as a tag.
+func queryTypes(value string) []QueryType {
+	if strings.Contains(value, ":") {
+		return []QueryType{QueryImageID, QueryManifestListDigest}
+	}
+	return []QueryType{QueryTag}
+}
+
+// queryImage runs a single lookup strategy. A client that is not a QueryClient is asked for
+// the whole lookup under the first strategy and reports nothing for the others.
+func queryImage(
+	ctx context.Context, client Client, query QueryType, registry, repository, value string,
+) (*CertificationData, error) {
+	if queryClient, ok := client.(QueryClient); ok {
+		return queryClient.QueryImage(ctx, query, registry, repository, value)
+	}
+	if query != queryTypes(value)[0] {
+		return nil, nil
+	}
+	return client.GetImageCertification(ctx, registry, repository, value)
+}
+
+// authIdentity returns the auth identity of client, or anonymousIdentity when it is not a QueryClient
+func authIdentity(client Client) string {
+	if queryClient, ok := client.(QueryClient); ok {
+		return queryClient.AuthIdentity()
+	}
+	return anonymousIdentity
+}
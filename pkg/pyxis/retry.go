@@ -0,0 +1,136 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// Default retry settings
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 500 * time.Millisecond
+	DefaultRetryJitter      = 0.2
+	// maxRetryDelay caps both the backoff and a server-requested Retry-After
+	maxRetryDelay = 30 * time.Second
+)
+
+// RetryConfig controls how HTTPClient retries transient failures: network errors,
+// 5xx responses, and 429 responses (honoring Retry-After)
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts per request; 1 disables retries
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry; it doubles with every further retry
+	BaseDelay time.Duration
+	// Jitter randomizes each delay by up to this fraction in either direction
+	Jitter float64
+}
+
+// DefaultRetryConfig returns the default retry settings
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		Jitter:      DefaultRetryJitter,
+	}
+}
+
+// WithRetry sets how transient failures are retried
+func WithRetry(cfg RetryConfig) ClientOption {
+	return func(c *HTTPClient) {
+		c.retry = cfg
+	}
+}
+
+// do sends req, retrying transient failures. The response of the last attempt is returned
+// as is, so callers handle a persistent 5xx or 429 like any other status.
+func (c *HTTPClient) do(req *http.Request, endpoint string) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := c.httpClient.Do(req)
+		if attempt >= c.retry.MaxAttempts || !retryable(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay := c.retry.backoff(attempt)
+		reason := "network_error"
+		if resp != nil {
+			reason = strconv.Itoa(resp.StatusCode)
+			if after, ok := retryAfter(resp); ok {
+				delay = after
+			}
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		metrics.RecordPyxisRetry(endpoint, reason)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryable reports whether a request that returned resp or err may succeed if sent again
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// Do not retry once the caller has given up
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the jittered delay before retry number attempt
+func (cfg RetryConfig) backoff(attempt int) time.Duration {
+	delay := min(cfg.BaseDelay<<(attempt-1), maxRetryDelay)
+	if delay <= 0 {
+		// The shift overflowed
+		delay = maxRetryDelay
+	}
+	if cfg.Jitter > 0 {
+		delay = time.Duration(float64(delay) * (1 + cfg.Jitter*(2*rand.Float64()-1)))
+	}
+	return delay
+}
+
+// retryAfter returns the delay requested by a 429 or 503 response's Retry-After header,
+// given either in seconds or as an HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxRetryDelay), true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return min(max(time.Until(at), 0), maxRetryDelay), true
+	}
+	return 0, false
+}
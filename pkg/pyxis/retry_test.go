@@ -0,0 +1,149 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pyxis
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPClient_Retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		statuses     []int
+		retryAfter   string
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:         "retries 5xx until success",
+			maxAttempts:  3,
+			statuses:     []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 3,
+		},
+		{
+			name:         "honors Retry-After on 429",
+			maxAttempts:  3,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "0",
+			wantAttempts: 2,
+		},
+		{
+			name:         "reports the last failure once attempts are exhausted",
+			maxAttempts:  2,
+			statuses:     []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK},
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry client errors",
+			maxAttempts:  3,
+			statuses:     []int{http.StatusUnauthorized, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "single attempt disables retries",
+			maxAttempts:  1,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/images" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				status := tt.statuses[min(attempts, len(tt.statuses)-1)]
+				attempts++
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"data":[{"_id":"test-id"}]}`))
+				}
+			}))
+			defer server.Close()
+
+			client := NewHTTPClient(
+				WithBaseURL(server.URL),
+				WithRetry(RetryConfig{MaxAttempts: tt.maxAttempts, BaseDelay: time.Millisecond}),
+			)
+			got, err := client.queryByImageID(context.Background(), "registry.redhat.io", "ubi9/ubi", "sha256:abc123")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("queryByImageID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (got == nil || got.ImageID != "test-id") {
+				t.Errorf("queryByImageID() = %+v, want image test-id", got)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	cfg := RetryConfig{BaseDelay: time.Second, Jitter: 0.2}
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 40: maxRetryDelay} {
+		got := cfg.backoff(attempt)
+		if got < time.Duration(float64(want)*0.8) || got > time.Duration(float64(want)*1.2) {
+			t.Errorf("backoff(%d) = %v, want %v ± 20%%", attempt, got, want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "seconds", status: http.StatusTooManyRequests, header: "5", want: 5 * time.Second, wantOK: true},
+		{name: "capped", status: http.StatusTooManyRequests, header: "3600", want: maxRetryDelay, wantOK: true},
+		{name: "date in the past", status: http.StatusServiceUnavailable, header: "Mon, 02 Jan 2006 15:04:05 GMT", wantOK: true},
+		{name: "ignored on other statuses", status: http.StatusInternalServerError, header: "5"},
+		{name: "missing", status: http.StatusTooManyRequests},
+		{name: "invalid", status: http.StatusTooManyRequests, header: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			got, ok := retryAfter(resp)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("retryAfter() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -32,8 +32,21 @@ type CertificationData struct {
 	ImageID string
 	// PublishedAt is when the image was published to the registry
 	PublishedAt string
+	// Labels holds the image's OCI standard labels and the vendor, version, and vcs-ref
+	// labels, which identify who publishes the image
+	Labels map[string]string
 	// CVEs is a list of CVE identifiers affecting this image
 	CVEs []string
+	// CVESeverities maps each CVE identifier to its severity rating (e.g., critical, important)
+	CVESeverities map[string]string
+	// CVEAdvisories maps CVE identifiers to the Red Hat advisory that addresses them
+	CVEAdvisories map[string]string
+	// CVEFixes maps critical and important CVE identifiers to the advisory and image that fix them
+	CVEFixes map[string]CVEFix
+	// VulnerabilitiesUnavailable is true when the image's vulnerabilities could not be fetched,
+	// in which case CVEs, CVESeverities, CVEAdvisories, CVEFixes, and AdvisoryIDs are empty
+	// rather than known to be empty
+	VulnerabilitiesUnavailable bool
 
 	// Lifecycle fields
 
@@ -68,6 +81,37 @@ type CertificationData struct {
 	BuildDate string
 	// AdvisoryIDs contains Red Hat advisory IDs related to this image
 	AdvisoryIDs []string
+
+	// PartnerCertification describes the certification project of a partner image,
+	// nil for images not published through the partner registry
+	PartnerCertification *PartnerCertification
+}
+
+// PartnerCertification contains the certification project details of a partner image
+type PartnerCertification struct {
+	// ProjectID is the Pyxis certification project ID
+	ProjectID string
+	// ProjectName is the name of the certification project
+	ProjectName string
+	// Level is the certification level (e.g., Certified, Vendor Validated)
+	Level string
+	// Status is the certification status of the project (e.g., Certified)
+	Status string
+	// SupportedOCPVersions lists the OpenShift versions the partner supports
+	SupportedOCPVersions []string
+	// Badges lists the certification badges awarded to the project
+	Badges []string
+	// CNF is true when the project carries the CNF (cloud-native network function) badge
+	CNF bool
+}
+
+// CVEFix describes how a CVE is remediated
+type CVEFix struct {
+	// AdvisoryID is the Red Hat advisory that fixes the CVE (e.g., RHSA-2024:1234)
+	AdvisoryID string
+	// FixedIn is the image reference shipped by the advisory in the same repository
+	// (e.g., registry.redhat.io/ubi9/ubi:9.4-1214), empty if Pyxis does not list one
+	FixedIn string
 }
 
 // VulnerabilitySummary contains vulnerability counts by severity
@@ -81,6 +125,7 @@ type VulnerabilitySummary struct {
 // PyxisImageResponse represents a single image from the Pyxis API
 type PyxisImageResponse struct {
 	ID                   string                     `json:"_id"`
+	ImageID              string                     `json:"image_id,omitempty"`
 	Certified            bool                       `json:"certified"`
 	ParsedData           *PyxisImageParsedData      `json:"parsed_data,omitempty"`
 	FreshnessGrades      []PyxisFreshnessGrade      `json:"freshness_grades,omitempty"`
@@ -100,19 +145,32 @@ type PyxisImageResponse struct {
 	// Enhanced fields for v0.2.0
 	LayerCount int    `json:"layer_count,omitempty"`
 	BuildDate  string `json:"build_date,omitempty"`
+
+	// CertProject is the ID of the certification project of a partner image
+	CertProject string `json:"cert_project,omitempty"`
 }
 
 // PyxisImageRepository represents repository info within an image response
 type PyxisImageRepository struct {
-	Registry           string `json:"registry"`
-	Repository         string `json:"repository"`
-	ManifestListDigest string `json:"manifest_list_digest,omitempty"`
-	PushDate           string `json:"push_date,omitempty"`
+	Registry           string          `json:"registry"`
+	Repository         string          `json:"repository"`
+	ManifestListDigest string          `json:"manifest_list_digest,omitempty"`
+	PushDate           string          `json:"push_date,omitempty"`
+	ImageAdvisoryID    string          `json:"image_advisory_id,omitempty"`
+	Tags               []PyxisImageTag `json:"tags,omitempty"`
+}
+
+// PyxisImageTag is a tag of an image within a repository
+type PyxisImageTag struct {
+	Name string `json:"name"`
 }
 
 // PyxisPagedResponse represents a paginated response from Pyxis
 type PyxisPagedResponse struct {
-	Data []PyxisImageResponse `json:"data"`
+	Data     []PyxisImageResponse `json:"data"`
+	Page     int                  `json:"page"`
+	PageSize int                  `json:"page_size"`
+	Total    int                  `json:"total"`
 }
 
 // PyxisImageParsedData contains parsed image metadata
@@ -159,6 +217,22 @@ type PyxisContainerRepository struct {
 	ReplacedByRepositoryName string   `json:"replaced_by_repository_name,omitempty"`
 }
 
+// PyxisCertificationProject represents a partner certification project from Pyxis
+type PyxisCertificationProject struct {
+	ID                  string                              `json:"_id"`
+	Name                string                              `json:"name"`
+	CertificationStatus string                              `json:"certification_status,omitempty"`
+	CertificationLevel  string                              `json:"certification_level,omitempty"`
+	Badges              []string                            `json:"badges,omitempty"`
+	Container           *PyxisCertificationProjectContainer `json:"container,omitempty"`
+}
+
+// PyxisCertificationProjectContainer holds the container details of a certification project
+type PyxisCertificationProjectContainer struct {
+	Type                 string   `json:"type,omitempty"`
+	SupportedOCPVersions []string `json:"supported_openshift_versions,omitempty"`
+}
+
 // PyxisVendor represents a vendor from Pyxis
 type PyxisVendor struct {
 	Name string `json:"name"`
@@ -0,0 +1,256 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/ctxutil"
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// DefaultCacheTTL is the default time-to-live for cache entries
+const DefaultCacheTTL = 1 * time.Hour
+
+// DefaultNegativeCacheTTL is the default time-to-live for empty and incomplete results, i.e. images Quay
+// does not know or has not finished scanning. It is shorter than DefaultCacheTTL so that new scans are noticed sooner.
+const DefaultNegativeCacheTTL = 15 * time.Minute
+
+// DefaultRateLimit is the default rate limit (requests per second)
+const DefaultRateLimit = 5.0
+
+// DefaultRateBurst is the default burst size for rate limiting
+const DefaultRateBurst = 10
+
+// cacheEntry represents a cached scan result entry
+type cacheEntry struct {
+	data      *ScanResult
+	expiresAt time.Time
+}
+
+// CachedClient wraps a Client with caching capabilities. Concurrent misses for the
+// same key share a single upstream call.
+type CachedClient struct {
+	client Client
+	cache  map[string]cacheEntry
+	mu     sync.RWMutex
+	ttl    time.Duration
+	// negativeTTL applies to empty results
+	negativeTTL time.Duration
+	group       singleflight.Group
+}
+
+// CacheOption is a function that configures a CachedClient
+type CacheOption func(*CachedClient)
+
+// WithCacheTTL sets the cache time-to-live
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.ttl = ttl
+	}
+}
+
+// WithNegativeCacheTTL sets the time-to-live for empty results. It never exceeds the cache TTL.
+func WithNegativeCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.negativeTTL = ttl
+	}
+}
+
+// NewCachedClient creates a new cached client wrapper
+func NewCachedClient(client Client, opts ...CacheOption) *CachedClient {
+	c := &CachedClient{
+		client:      client,
+		cache:       make(map[string]cacheEntry),
+		ttl:         DefaultCacheTTL,
+		negativeTTL: DefaultNegativeCacheTTL,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// cacheKey generates a cache key from repository and digest
+func cacheKey(repository, digest string) string {
+	return repository + "@" + digest
+}
+
+// GetSecurityScan retrieves a security scan, using cache when available
+func (c *CachedClient) GetSecurityScan(
+	ctx context.Context, repository, digest string,
+) (*ScanResult, error) {
+	key := cacheKey(repository, digest)
+
+	// Try to get from cache first
+	c.mu.RLock()
+	entry, found := c.cache[key]
+	c.mu.RUnlock()
+
+	if found && time.Now().Before(entry.expiresAt) {
+		metrics.RecordQuayCacheHit()
+		return entry.data, nil
+	}
+
+	metrics.RecordQuayCacheMiss()
+
+	// Fetch from underlying client, joining a call already in flight for this key
+	results := c.group.DoChan(key, func() (any, error) {
+		callCtx, cancel := ctxutil.Shared(ctx)
+		defer cancel()
+
+		data, err := c.client.GetSecurityScan(callCtx, repository, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store in cache; empty and pending results expire sooner
+		ttl := c.ttl
+		if data == nil || data.Status != ScanStatusScanned {
+			ttl = min(c.negativeTTL, c.ttl)
+		}
+		c.mu.Lock()
+		c.cache[key] = cacheEntry{
+			data:      data,
+			expiresAt: time.Now().Add(ttl),
+		}
+		c.mu.Unlock()
+
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*ScanResult), nil
+	}
+}
+
+// IsHealthy delegates to the underlying client
+func (c *CachedClient) IsHealthy(ctx context.Context) bool {
+	return c.client.IsHealthy(ctx)
+}
+
+// ClearCache removes all entries from the cache
+func (c *CachedClient) ClearCache() {
+	c.mu.Lock()
+	c.cache = make(map[string]cacheEntry)
+	c.mu.Unlock()
+}
+
+// CleanupExpired removes expired entries from the cache
+func (c *CachedClient) CleanupExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.cache {
+		if now.After(entry.expiresAt) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// StartCleanupLoop starts a goroutine that periodically cleans up expired cache entries
+func (c *CachedClient) StartCleanupLoop(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.CleanupExpired()
+			}
+		}
+	}()
+}
+
+// RateLimitedClient wraps a Client with rate limiting capabilities
+type RateLimitedClient struct {
+	client  Client
+	limiter *rate.Limiter
+}
+
+// RateLimitOption is a function that configures a RateLimitedClient
+type RateLimitOption func(*RateLimitedClient)
+
+// WithRateLimit sets the rate limit (requests per second)
+func WithRateLimit(rps float64) RateLimitOption {
+	return func(c *RateLimitedClient) {
+		c.limiter.SetLimit(rate.Limit(rps))
+	}
+}
+
+// WithBurst sets the burst size
+func WithBurst(burst int) RateLimitOption {
+	return func(c *RateLimitedClient) {
+		c.limiter.SetBurst(burst)
+	}
+}
+
+// NewRateLimitedClient creates a new rate-limited client wrapper
+func NewRateLimitedClient(client Client, opts ...RateLimitOption) *RateLimitedClient {
+	c := &RateLimitedClient{
+		client:  client,
+		limiter: rate.NewLimiter(rate.Limit(DefaultRateLimit), DefaultRateBurst),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// GetSecurityScan retrieves a security scan with rate limiting
+func (c *RateLimitedClient) GetSecurityScan(
+	ctx context.Context, repository, digest string,
+) (*ScanResult, error) {
+	// Wait for rate limiter
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.client.GetSecurityScan(ctx, repository, digest)
+}
+
+// IsHealthy delegates to the underlying client (no rate limiting for health checks)
+func (c *RateLimitedClient) IsHealthy(ctx context.Context) bool {
+	return c.client.IsHealthy(ctx)
+}
+
+// NewCachedRateLimitedClient creates a client with both caching and rate limiting
+func NewCachedRateLimitedClient(baseClient Client, cacheTTL time.Duration, rateLimit float64, burst int) Client {
+	// Apply rate limiting first, then caching
+	rateLimited := NewRateLimitedClient(baseClient, WithRateLimit(rateLimit), WithBurst(burst))
+	cached := NewCachedClient(rateLimited, WithCacheTTL(cacheTTL))
+	return cached
+}
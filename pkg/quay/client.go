@@ -0,0 +1,208 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quay reads the Clair security scans that Quay publishes for the images it hosts.
+// The public API returns scans of public repositories without authentication.
+package quay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+const (
+	// DefaultBaseURL is the default Quay API base URL
+	DefaultBaseURL = "https://quay.io/api/v1"
+	// DefaultTimeout is the default HTTP client timeout
+	DefaultTimeout = 30 * time.Second
+	// maxResponseBytes bounds the scan reports read from Quay
+	maxResponseBytes = 32 << 20
+)
+
+// severityRanks orders Clair severities; Negligible and Unknown are not counted
+var severityRanks = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+	"defcon1":  4,
+}
+
+// Client interface for Quay API operations
+type Client interface {
+	// GetSecurityScan retrieves the security scan of an image by digest. It returns nil
+	// if the image does not exist or its repository is private.
+	GetSecurityScan(ctx context.Context, repository, digest string) (*ScanResult, error)
+	// IsHealthy checks if the Quay API is accessible
+	IsHealthy(ctx context.Context) bool
+}
+
+// HTTPClient implements the Client interface using HTTP
+type HTTPClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// ClientOption is a function that configures an HTTPClient
+type ClientOption func(*HTTPClient)
+
+// WithBaseURL sets a custom base URL
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *HTTPClient) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *HTTPClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets a custom timeout
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *HTTPClient) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// NewHTTPClient creates a new Quay HTTP client
+func NewHTTPClient(opts ...ClientOption) *HTTPClient {
+	client := &HTTPClient{
+		baseURL: DefaultBaseURL,
+		httpClient: &http.Client{
+			Timeout: DefaultTimeout,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// GetSecurityScan retrieves the security scan of an image by digest
+func (c *HTTPClient) GetSecurityScan(ctx context.Context, repository, digest string) (*ScanResult, error) {
+	start := time.Now()
+
+	requestURL := fmt.Sprintf("%s/repository/%s/manifest/%s/security?vulnerabilities=true",
+		c.baseURL, repository, digest)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	duration := time.Since(start).Seconds()
+	if err != nil {
+		metrics.RecordQuayRequest("error", duration)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Continue processing
+	case http.StatusNotFound, http.StatusUnauthorized, http.StatusForbidden:
+		// Missing, or in a private repository
+		metrics.RecordQuayRequest("not_found", duration)
+		return nil, nil
+	case http.StatusTooManyRequests:
+		metrics.RecordQuayRequest("rate_limited", duration)
+		return nil, fmt.Errorf("rate limited by Quay")
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		metrics.RecordQuayRequest("error", duration)
+		return nil, fmt.Errorf("unexpected response status %s: %s", resp.Status, string(body))
+	}
+
+	var securityResp SecurityResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&securityResp); err != nil {
+		metrics.RecordQuayRequest("error", duration)
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	metrics.RecordQuayRequest("success", duration)
+	return summarizeScan(&securityResp), nil
+}
+
+// summarizeScan counts the distinct vulnerabilities of a scan. A vulnerability reported
+// for several packages is counted once, at its highest severity.
+func summarizeScan(resp *SecurityResponse) *ScanResult {
+	result := &ScanResult{Status: resp.Status}
+	if resp.Status != ScanStatusScanned || resp.Data == nil {
+		return result
+	}
+
+	ranks := make(map[string]int)
+	fixable := make(map[string]bool)
+	for _, feature := range resp.Data.Layer.Features {
+		for _, vuln := range feature.Vulnerabilities {
+			rank := severityRanks[strings.ToLower(vuln.Severity)]
+			if rank == 0 || vuln.Name == "" {
+				continue
+			}
+			ranks[vuln.Name] = max(ranks[vuln.Name], rank)
+			if vuln.FixedBy != "" {
+				fixable[vuln.Name] = true
+			}
+		}
+	}
+
+	result.CVESeverities = make(map[string]string)
+	for name, rank := range ranks {
+		switch rank {
+		case 4:
+			result.Vulnerabilities.Critical++
+			result.CVESeverities[name] = "critical"
+		case 3:
+			result.Vulnerabilities.Important++
+			result.CVESeverities[name] = "important"
+		case 2:
+			result.Vulnerabilities.Moderate++
+		default:
+			result.Vulnerabilities.Low++
+		}
+	}
+	result.FixableCount = len(fixable)
+	return result
+}
+
+// IsHealthy checks if the Quay API is accessible
+func (c *HTTPClient) IsHealthy(ctx context.Context) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/discovery", nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return resp.StatusCode == http.StatusOK
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPClient_GetSecurityScan(t *testing.T) {
+	scanned := SecurityResponse{
+		Status: ScanStatusScanned,
+		Data: &SecurityData{Layer: SecurityLayer{Features: []Feature{
+			{Name: "openssl", Vulnerabilities: []Vulnerability{
+				{Name: "CVE-2024-0001", Severity: "Critical", FixedBy: "3.0.14"},
+				{Name: "CVE-2024-0002", Severity: "High"},
+			}},
+			{Name: "libssl", Vulnerabilities: []Vulnerability{
+				// Reported again for another package at a lower severity
+				{Name: "CVE-2024-0001", Severity: "Medium"},
+				{Name: "CVE-2024-0003", Severity: "Medium", FixedBy: "1.2"},
+				{Name: "CVE-2024-0004", Severity: "Low"},
+				{Name: "CVE-2024-0005", Severity: "Negligible"},
+			}},
+		}}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repository/org/app/manifest/sha256:scanned/security":
+			if r.URL.Query().Get("vulnerabilities") != "true" {
+				t.Errorf("vulnerabilities were not requested")
+			}
+			_ = json.NewEncoder(w).Encode(scanned)
+		case "/repository/org/app/manifest/sha256:queued/security":
+			_ = json.NewEncoder(w).Encode(SecurityResponse{Status: ScanStatusQueued})
+		case "/repository/org/private/manifest/sha256:scanned/security":
+			w.WriteHeader(http.StatusUnauthorized)
+		case "/repository/org/broken/manifest/sha256:scanned/security":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewHTTPClient(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	result, err := client.GetSecurityScan(ctx, "org/app", "sha256:scanned")
+	if err != nil {
+		t.Fatalf("GetSecurityScan() error = %v", err)
+	}
+	want := VulnerabilityCounts{Critical: 1, Important: 1, Moderate: 1, Low: 1}
+	if result.Status != ScanStatusScanned || result.Vulnerabilities != want {
+		t.Errorf("GetSecurityScan() = %+v, want scanned with %+v", result, want)
+	}
+	if result.FixableCount != 2 {
+		t.Errorf("FixableCount = %d, want 2", result.FixableCount)
+	}
+	if result.CVESeverities["CVE-2024-0001"] != "critical" || result.CVESeverities["CVE-2024-0002"] != "important" ||
+		len(result.CVESeverities) != 2 {
+		t.Errorf("CVESeverities = %v, want the critical and important CVEs", result.CVESeverities)
+	}
+
+	result, err = client.GetSecurityScan(ctx, "org/app", "sha256:queued")
+	if err != nil || result == nil || result.Status != ScanStatusQueued {
+		t.Errorf("GetSecurityScan() for a queued scan = %+v, %v, want queued", result, err)
+	}
+
+	for _, repository := range []string{"org/private", "org/missing"} {
+		result, err = client.GetSecurityScan(ctx, repository, "sha256:scanned")
+		if err != nil || result != nil {
+			t.Errorf("GetSecurityScan(%s) = %+v, %v, want nil, nil", repository, result, err)
+		}
+	}
+
+	if _, err = client.GetSecurityScan(ctx, "org/broken", "sha256:scanned"); err == nil {
+		t.Error("GetSecurityScan() for a server error returned no error")
+	}
+}
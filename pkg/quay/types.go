@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quay
+
+// Scan statuses reported by Quay
+const (
+	ScanStatusScanned     = "scanned"
+	ScanStatusQueued      = "queued"
+	ScanStatusFailed      = "failed"
+	ScanStatusUnsupported = "unsupported"
+)
+
+// VulnerabilityCounts contains vulnerability counts using Red Hat severity names.
+// Clair's High and Medium severities map to Important and Moderate.
+type VulnerabilityCounts struct {
+	Critical  int
+	Important int
+	Moderate  int
+	Low       int
+}
+
+// ScanResult contains the Clair security scan of an image as reported by Quay
+type ScanResult struct {
+	// Status is the scan status (scanned, queued, failed, or unsupported)
+	Status string
+	// Vulnerabilities counts the distinct vulnerabilities by severity (only set when scanned)
+	Vulnerabilities VulnerabilityCounts
+	// FixableCount is the number of distinct vulnerabilities with a fixed package version
+	FixableCount int
+	// CVESeverities maps each critical and important vulnerability to its severity
+	// ("critical" or "important")
+	CVESeverities map[string]string
+}
+
+// SecurityResponse represents the response from the Quay security API
+// GET https://quay.io/api/v1/repository/{repository}/manifest/{digest}/security?vulnerabilities=true
+type SecurityResponse struct {
+	Status string        `json:"status"`
+	Data   *SecurityData `json:"data"`
+}
+
+// SecurityData wraps the scanned layer
+type SecurityData struct {
+	Layer SecurityLayer `json:"Layer"`
+}
+
+// SecurityLayer lists the packages found in the image
+type SecurityLayer struct {
+	Name     string    `json:"Name"`
+	Features []Feature `json:"Features"`
+}
+
+// Feature is a package found in the image
+type Feature struct {
+	Name            string          `json:"Name"`
+	Version         string          `json:"Version"`
+	Vulnerabilities []Vulnerability `json:"Vulnerabilities"`
+}
+
+// Vulnerability is a vulnerability affecting a package
+type Vulnerability struct {
+	Name     string `json:"Name"`
+	Severity string `json:"Severity"`
+	FixedBy  string `json:"FixedBy"`
+	Link     string `json:"Link"`
+}
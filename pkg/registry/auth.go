@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+)
+
+// Credential is a username and password accepted by a registry, such as from a pull secret
+type Credential struct {
+	Username string
+	Password string
+}
+
+// basic returns the credential encoded for HTTP basic authentication
+func (c Credential) basic() string {
+	return base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password))
+}
+
+// credentialsKey is the context key of the credentials attached by WithCredentials
+type credentialsKey struct{}
+
+// WithCredentials returns a context whose registry requests authenticate with creds when
+// anonymous access is refused. The credentials are tried in order until one is accepted.
+func WithCredentials(ctx context.Context, creds ...Credential) context.Context {
+	if len(creds) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, credentialsKey{}, creds)
+}
+
+// credentialsFrom returns the credentials attached to ctx
+func credentialsFrom(ctx context.Context) []Credential {
+	creds, _ := ctx.Value(credentialsKey{}).([]Credential)
+	return creds
+}
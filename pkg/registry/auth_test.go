@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newPrivateRegistry serves the config of one image to user:pass only. Anonymous token
+// requests get a token that does not grant access. With basic set, the registry challenges
+// for HTTP basic authentication instead of a token.
+func newPrivateRegistry(t *testing.T, basic bool) string {
+	t.Helper()
+	var server *httptest.Server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		token := "anonymous"
+		if user, pass, ok := r.BasicAuth(); ok {
+			if user != "user" || pass != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			token = "private"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+	})
+	mux.HandleFunc("/v2/org/private/", func(w http.ResponseWriter, r *http.Request) {
+		authorized := r.Header.Get("Authorization") == "Bearer private"
+		if basic {
+			user, pass, ok := r.BasicAuth()
+			authorized = ok && user == "user" && pass == "pass"
+		}
+		if !authorized {
+			if basic {
+				w.Header().Set("WWW-Authenticate", `Basic realm="private"`)
+			} else {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test"`)
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body any
+		switch strings.TrimPrefix(r.URL.Path, "/v2/org/private/") {
+		case "manifests/sha256:image":
+			body = manifest{MediaType: MediaTypeOCIManifest, Config: &descriptor{Digest: "sha256:config"}}
+		case "blobs/sha256:config":
+			body = map[string]any{"architecture": "amd64", "os": "linux"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+func TestHTTPClient_GetImageMetadataWithCredentials(t *testing.T) {
+	for _, basic := range []bool{false, true} {
+		registry := newPrivateRegistry(t, basic)
+		client := NewHTTPClient(WithPlainHTTP(registry))
+
+		// Anonymous access is refused without an error
+		metadata, err := client.GetImageMetadata(context.Background(), registry, "org/private", "sha256:image")
+		if err != nil || metadata != nil {
+			t.Errorf("basic=%v: anonymous GetImageMetadata() = %v, %v, want nil, nil", basic, metadata, err)
+		}
+
+		// Credentials are tried in order until one is accepted
+		ctx := WithCredentials(context.Background(),
+			Credential{Username: "user", Password: "wrong"},
+			Credential{Username: "user", Password: "pass"})
+		metadata, err = client.GetImageMetadata(ctx, registry, "org/private", "sha256:image")
+		if err != nil {
+			t.Fatalf("basic=%v: GetImageMetadata() error = %v", basic, err)
+		}
+		if metadata == nil || len(metadata.Architectures) != 1 || metadata.Architectures[0] != "amd64" {
+			t.Errorf("basic=%v: GetImageMetadata() = %+v, want the amd64 image", basic, metadata)
+		}
+	}
+}
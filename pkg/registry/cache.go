@@ -0,0 +1,225 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/ctxutil"
+)
+
+// DefaultCacheTTL is the default time-to-live for cache entries. Images are read by
+// digest, so their metadata never changes and can be cached for long.
+const DefaultCacheTTL = 24 * time.Hour
+
+// DefaultNegativeCacheTTL is the default time-to-live for images that could not be read
+const DefaultNegativeCacheTTL = 15 * time.Minute
+
+// DefaultRateLimit is the default rate limit (image lookups per second)
+const DefaultRateLimit = 5.0
+
+// DefaultRateBurst is the default burst size for rate limiting
+const DefaultRateBurst = 10
+
+// cacheEntry represents a cached image metadata entry
+type cacheEntry struct {
+	data      *ImageMetadata
+	expiresAt time.Time
+}
+
+// CachedClient wraps a Client with caching capabilities. Concurrent misses for the
+// same key share a single upstream call.
+type CachedClient struct {
+	client      Client
+	cache       map[string]cacheEntry
+	mu          sync.RWMutex
+	ttl         time.Duration
+	negativeTTL time.Duration
+	group       singleflight.Group
+}
+
+// CacheOption is a function that configures a CachedClient
+type CacheOption func(*CachedClient)
+
+// WithCacheTTL sets the cache time-to-live
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.ttl = ttl
+	}
+}
+
+// WithNegativeCacheTTL sets the time-to-live for images that could not be read. It never
+// exceeds the cache TTL.
+func WithNegativeCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.negativeTTL = ttl
+	}
+}
+
+// NewCachedClient creates a new cached client wrapper
+func NewCachedClient(client Client, opts ...CacheOption) *CachedClient {
+	c := &CachedClient{
+		client:      client,
+		cache:       make(map[string]cacheEntry),
+		ttl:         DefaultCacheTTL,
+		negativeTTL: DefaultNegativeCacheTTL,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// GetImageMetadata retrieves image metadata, using cache when available
+func (c *CachedClient) GetImageMetadata(
+	ctx context.Context, registry, repository, digest string,
+) (*ImageMetadata, error) {
+	key := registry + "/" + repository + "@" + digest
+
+	c.mu.RLock()
+	entry, found := c.cache[key]
+	c.mu.RUnlock()
+
+	if found && time.Now().Before(entry.expiresAt) {
+		return entry.data, nil
+	}
+
+	// Fetch from underlying client, joining a call already in flight for this key
+	results := c.group.DoChan(key, func() (any, error) {
+		callCtx, cancel := ctxutil.Shared(ctx)
+		defer cancel()
+
+		data, err := c.client.GetImageMetadata(callCtx, registry, repository, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store in cache; unreadable images expire sooner
+		ttl := c.ttl
+		if data == nil {
+			ttl = min(c.negativeTTL, c.ttl)
+		}
+		c.mu.Lock()
+		c.cache[key] = cacheEntry{
+			data:      data,
+			expiresAt: time.Now().Add(ttl),
+		}
+		c.mu.Unlock()
+
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*ImageMetadata), nil
+	}
+}
+
+// CleanupExpired removes expired entries from the cache
+func (c *CachedClient) CleanupExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.cache {
+		if now.After(entry.expiresAt) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// StartCleanupLoop starts a goroutine that periodically cleans up expired cache entries
+func (c *CachedClient) StartCleanupLoop(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.CleanupExpired()
+			}
+		}
+	}()
+}
+
+// RateLimitedClient wraps a Client with rate limiting capabilities
+type RateLimitedClient struct {
+	client  Client
+	limiter *rate.Limiter
+}
+
+// NewRateLimitedClient creates a new rate-limited client wrapper
+func NewRateLimitedClient(client Client, rateLimit float64, burst int) *RateLimitedClient {
+	return &RateLimitedClient{
+		client:  client,
+		limiter: rate.NewLimiter(rate.Limit(rateLimit), burst),
+	}
+}
+
+// GetImageMetadata retrieves image metadata with rate limiting
+func (c *RateLimitedClient) GetImageMetadata(
+	ctx context.Context, registry, repository, digest string,
+) (*ImageMetadata, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetImageMetadata(ctx, registry, repository, digest)
+}
+
+// NewCachedRateLimitedClient creates a client with both caching and rate limiting
+func NewCachedRateLimitedClient(baseClient Client, cacheTTL time.Duration, rateLimit float64, burst int) Client {
+	// Apply rate limiting first, then caching
+	rateLimited := NewRateLimitedClient(baseClient, rateLimit, burst)
+	return NewCachedClient(rateLimited, WithCacheTTL(cacheTTL))
+}
+
+// RateLimitedSBOMClient wraps an SBOMClient with rate limiting capabilities
+type RateLimitedSBOMClient struct {
+	client  SBOMClient
+	limiter *rate.Limiter
+}
+
+// NewRateLimitedSBOMClient creates a new rate-limited SBOM client wrapper
+func NewRateLimitedSBOMClient(client SBOMClient, rateLimit float64, burst int) *RateLimitedSBOMClient {
+	return &RateLimitedSBOMClient{
+		client:  client,
+		limiter: rate.NewLimiter(rate.Limit(rateLimit), burst),
+	}
+}
+
+// GetSBOM looks up an image's SBOM with rate limiting
+func (c *RateLimitedSBOMClient) GetSBOM(ctx context.Context, registry, repository, digest string) (*SBOM, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetSBOM(ctx, registry, repository, digest)
+}
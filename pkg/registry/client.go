@@ -0,0 +1,496 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registry reads image metadata from any registry speaking the OCI distribution
+// API, such as quay.io, ghcr.io, or a private registry. It is used for images that neither
+// Pyxis nor Docker Hub know about.
+package registry
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+const (
+	// DefaultTimeout is the default HTTP client timeout
+	DefaultTimeout = 30 * time.Second
+
+	// maxResponseBytes bounds the manifests and config blobs read from a registry
+	maxResponseBytes = 4 << 20
+	// ociLabelPrefix starts the names of the OCI standard image labels
+	ociLabelPrefix = "org.opencontainers.image."
+	// maxLabels bounds the number of labels kept per image
+	maxLabels = 64
+	// maxLabelValueLength bounds the length of each kept label value
+	maxLabelValueLength = 256
+)
+
+// manifestAccept lists the manifest media types accepted from a registry, indexes first
+var manifestAccept = strings.Join([]string{
+	MediaTypeOCIIndex, MediaTypeDockerManifestList, MediaTypeOCIManifest, MediaTypeDockerManifest,
+}, ", ")
+
+// Client interface for OCI registry operations
+type Client interface {
+	// GetImageMetadata reads the metadata of an image by digest. It returns nil if the
+	// image does not exist or cannot be read without credentials.
+	GetImageMetadata(ctx context.Context, registry, repository, digest string) (*ImageMetadata, error)
+}
+
+// HTTPClient implements the Client interface using the OCI distribution API. It reads
+// public images anonymously, requesting a pull token when the registry asks for one, and
+// private images with the credentials attached to the context by WithCredentials.
+type HTTPClient struct {
+	httpClient *http.Client
+	// plainHTTP lists registries reached over plain HTTP instead of HTTPS
+	plainHTTP []string
+	// sbomSummary makes GetSBOM read the SBOM document to summarize it
+	sbomSummary bool
+}
+
+// ClientOption is a function that configures an HTTPClient
+type ClientOption func(*HTTPClient)
+
+// WithHTTPClient sets a custom HTTP client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *HTTPClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets a custom timeout
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *HTTPClient) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithPlainHTTP reaches the given registries over plain HTTP, e.g. an in-cluster registry
+func WithPlainHTTP(registries ...string) ClientOption {
+	return func(c *HTTPClient) {
+		c.plainHTTP = append(c.plainHTTP, registries...)
+	}
+}
+
+// NewHTTPClient creates a new OCI registry HTTP client
+func NewHTTPClient(opts ...ClientOption) *HTTPClient {
+	client := &HTTPClient{
+		httpClient: &http.Client{
+			Timeout: DefaultTimeout,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// GetImageMetadata reads the image's manifest and config blob. For a multi-architecture
+// index, the architectures come from the index and the remaining fields from the
+// linux/amd64 image, or the first image if there is none.
+func (c *HTTPClient) GetImageMetadata(
+	ctx context.Context, registry, repository, digest string,
+) (*ImageMetadata, error) {
+	start := time.Now()
+	metadata, err := c.getImageMetadata(ctx, c.newSession(ctx, registry, repository), digest)
+	duration := time.Since(start).Seconds()
+
+	switch {
+	case err != nil:
+		metrics.RecordRegistryRequest("error", duration)
+	case metadata == nil:
+		metrics.RecordRegistryRequest("not_found", duration)
+	default:
+		metrics.RecordRegistryRequest("success", duration)
+	}
+	return metadata, err
+}
+
+// getImageMetadata resolves an index to one of its images and reads that image's config
+func (c *HTTPClient) getImageMetadata(ctx context.Context, s *session, digest string) (*ImageMetadata, error) {
+	m, err := s.getManifest(ctx, digest)
+	if err != nil || m == nil {
+		return nil, err
+	}
+
+	var archs []string
+	var platforms []Platform
+	if len(m.Manifests) > 0 {
+		archs = indexArchitectures(m.Manifests)
+		platforms = indexPlatforms(m.Manifests)
+		image := selectImageManifest(m.Manifests)
+		if m, err = s.getManifest(ctx, image.Digest); err != nil || m == nil {
+			return nil, err
+		}
+	}
+	if m.Config == nil {
+		return nil, fmt.Errorf("manifest %s of %s/%s has no config", digest, s.registry, s.repository)
+	}
+
+	var config imageConfig
+	found, err := s.get(ctx, "blobs/"+m.Config.Digest, "", &config)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	metadata := &ImageMetadata{
+		Architectures: archs,
+		Platforms:     platforms,
+		Labels:        truncateLabels(config.Config.Labels),
+		LayerCount:    len(m.Layers),
+	}
+	for _, layer := range m.Layers {
+		metadata.CompressedSizeBytes += layer.Size
+	}
+	if metadata.Architectures == nil && config.Architecture != "" {
+		metadata.Architectures = []string{config.Architecture}
+	}
+	if metadata.Platforms == nil && config.OS != "" {
+		metadata.Platforms = []Platform{{OS: config.OS, Architecture: config.Architecture, Variant: config.Variant}}
+	}
+	if created, err := time.Parse(time.RFC3339Nano, config.Created); err == nil {
+		metadata.Created = created
+	}
+	return metadata, nil
+}
+
+// indexArchitectures returns the sorted, distinct architectures of an index's images.
+// Attestation manifests, which have an "unknown" platform, are skipped.
+func indexArchitectures(manifests []descriptor) []string {
+	var archs []string
+	for _, d := range manifests {
+		if d.Platform == nil || d.Platform.Architecture == "" || d.Platform.Architecture == "unknown" {
+			continue
+		}
+		if !slices.Contains(archs, d.Platform.Architecture) {
+			archs = append(archs, d.Platform.Architecture)
+		}
+	}
+	slices.Sort(archs)
+	return archs
+}
+
+// indexPlatforms returns the distinct platforms of an index's images, sorted by OS,
+// architecture, and variant. Attestation manifests are skipped.
+func indexPlatforms(manifests []descriptor) []Platform {
+	var platforms []Platform
+	for _, d := range manifests {
+		if d.Platform == nil || d.Platform.OS == "" || d.Platform.OS == "unknown" {
+			continue
+		}
+		p := Platform{OS: d.Platform.OS, Architecture: d.Platform.Architecture, Variant: d.Platform.Variant}
+		if !slices.Contains(platforms, p) {
+			platforms = append(platforms, p)
+		}
+	}
+	slices.SortFunc(platforms, func(a, b Platform) int {
+		return cmp.Or(cmp.Compare(a.OS, b.OS), cmp.Compare(a.Architecture, b.Architecture),
+			cmp.Compare(a.Variant, b.Variant))
+	})
+	return platforms
+}
+
+// selectImageManifest picks the linux/amd64 image of an index, or its first image
+func selectImageManifest(manifests []descriptor) descriptor {
+	for _, d := range manifests {
+		if d.Platform != nil && d.Platform.OS == "linux" && d.Platform.Architecture == "amd64" {
+			return d
+		}
+	}
+	return manifests[0]
+}
+
+// truncateLabels keeps a bounded number of labels with bounded values so that a
+// label-heavy image cannot bloat the ImageCertificationInfo status. OCI standard
+// labels are kept ahead of the others.
+func truncateLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	keys := slices.SortedFunc(maps.Keys(labels), func(a, b string) int {
+		aOCI, bOCI := strings.HasPrefix(a, ociLabelPrefix), strings.HasPrefix(b, ociLabelPrefix)
+		if aOCI != bOCI {
+			if aOCI {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	result := make(map[string]string, min(len(keys), maxLabels))
+	for _, k := range keys[:min(len(keys), maxLabels)] {
+		v := labels[k]
+		if len(v) > maxLabelValueLength {
+			v = v[:maxLabelValueLength]
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// session performs the requests for one image, reusing the authorization obtained for it
+type session struct {
+	client     *HTTPClient
+	registry   string
+	repository string
+	// credentials are tried in order once anonymous access is refused
+	credentials []Credential
+	// attempts counts the ways of authorizing tried so far: anonymously, then each credential
+	attempts int
+	// authorization is the Authorization header sent with each request
+	authorization string
+	// denied is set once the registry refuses access to the repository
+	denied bool
+}
+
+// newSession starts a session for one image, with the credentials attached to ctx
+func (c *HTTPClient) newSession(ctx context.Context, registry, repository string) *session {
+	return &session{client: c, registry: registry, repository: repository, credentials: credentialsFrom(ctx)}
+}
+
+// getManifest fetches a manifest by digest, returning nil if it does not exist
+func (s *session) getManifest(ctx context.Context, digest string) (*manifest, error) {
+	var m manifest
+	found, err := s.get(ctx, "manifests/"+digest, manifestAccept, &m)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// get fetches /v2/<repository>/<path> and decodes the JSON body into v. It returns false if
+// the object does not exist or the registry denies access.
+func (s *session) get(ctx context.Context, path, accept string, v any) (bool, error) {
+	return s.getLimited(ctx, path, accept, maxResponseBytes, v)
+}
+
+// getLimited is get with a bound on the size of the decoded body
+func (s *session) getLimited(ctx context.Context, path, accept string, limit int64, v any) (bool, error) {
+	resp, err := s.do(ctx, http.MethodGet, path, accept)
+	if err != nil || resp == nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := json.NewDecoder(io.LimitReader(resp.Body, limit)).Decode(v); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// do sends a request for /v2/<repository>/<path>, authorizing it when the registry asks.
+// It returns the successful response, which the caller must close, or nil if the object
+// does not exist or the registry denies access.
+func (s *session) do(ctx context.Context, method, path, accept string) (*http.Response, error) {
+	requestURL := fmt.Sprintf("%s://%s/v2/%s/%s", s.scheme(), registryHost(s.registry), s.repository, path)
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, method, requestURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if s.authorization != "" {
+			req.Header.Set("Authorization", s.authorization)
+		}
+
+		resp, err := s.client.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			return resp, nil
+		case resp.StatusCode == http.StatusUnauthorized:
+			// Authorize the next way and try again
+			challenge := resp.Header.Get("WWW-Authenticate")
+			_ = resp.Body.Close()
+			if err := s.authorize(ctx, challenge); err != nil {
+				return nil, err
+			}
+			if s.authorization == "" {
+				// Private and no credentials are accepted
+				s.denied = true
+				return nil, nil
+			}
+		case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden:
+			// Missing, or private and the credentials do not grant access
+			_ = resp.Body.Close()
+			s.denied = resp.StatusCode != http.StatusNotFound
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("unexpected response status %s from %s: %s", resp.Status, s.registry, string(body))
+		}
+	}
+}
+
+// authorize sets the Authorization header for the next attempt, trying an anonymous pull
+// token first and then each credential in turn. It clears the header once every way has
+// been tried.
+func (s *session) authorize(ctx context.Context, challenge string) error {
+	for s.attempts <= len(s.credentials) {
+		var cred *Credential
+		if s.attempts > 0 {
+			cred = &s.credentials[s.attempts-1]
+		}
+		s.attempts++
+
+		authorization, err := s.answer(ctx, challenge, cred)
+		if err != nil {
+			return err
+		}
+		if authorization != "" {
+			s.authorization = authorization
+			return nil
+		}
+	}
+	s.authorization = ""
+	return nil
+}
+
+// answer returns the Authorization header answering a challenge with cred, or
+// anonymously when cred is nil. It returns an empty header if the registry refuses.
+func (s *session) answer(ctx context.Context, challenge string, cred *Credential) (string, error) {
+	scheme, _, _ := strings.Cut(challenge, " ")
+	if strings.EqualFold(scheme, "Basic") {
+		if cred == nil {
+			return "", nil
+		}
+		return "Basic " + cred.basic(), nil
+	}
+	token, err := s.fetchToken(ctx, challenge, cred)
+	if err != nil || token == "" {
+		return "", err
+	}
+	return "Bearer " + token, nil
+}
+
+// fetchToken obtains a pull token from the realm named in a Bearer challenge, with cred or
+// anonymously when cred is nil. It returns an empty token if the registry does not use
+// token authentication or refuses the request.
+func (s *session) fetchToken(ctx context.Context, challenge string, cred *Credential) (string, error) {
+	params, ok := parseBearerChallenge(challenge)
+	if !ok || params["realm"] == "" {
+		return "", nil
+	}
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", fmt.Errorf("invalid token realm %q: %w", params["realm"], err)
+	}
+	query := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", s.repository)
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	if cred != nil {
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+	resp, err := s.client.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		// Access is not allowed anonymously or with these credentials
+		return "", nil
+	}
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+	return tokenResp.AccessToken, nil
+}
+
+// parseBearerChallenge parses a WWW-Authenticate header such as
+// Bearer realm="https://quay.io/v2/auth",service="quay.io",scope="repository:org/app:pull"
+func parseBearerChallenge(header string) (map[string]string, bool) {
+	scheme, rest, _ := strings.Cut(header, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(value, "\"") {
+			end := strings.Index(value[1:], "\"")
+			if end < 0 {
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+		}
+		rest = strings.TrimLeft(rest, ", ")
+	}
+	return params, true
+}
+
+// scheme returns the URL scheme used for the session's registry
+func (s *session) scheme() string {
+	if slices.Contains(s.client.plainHTTP, s.registry) {
+		return "http"
+	}
+	return "https"
+}
+
+// registryHost maps registry names to the host serving their API
+func registryHost(registry string) string {
+	if registry == "docker.io" {
+		return "registry-1.docker.io"
+	}
+	return registry
+}
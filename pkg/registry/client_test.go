@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestRegistry serves an index, also tagged v1, with linux/amd64, linux/arm64,
+// windows/amd64, and attestation entries behind a Bearer token challenge, and returns the
+// registry name to use against it
+func newTestRegistry(t *testing.T) string {
+	t.Helper()
+	var server *httptest.Server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:org/app:pull" {
+			t.Errorf("unexpected token scope %q", r.URL.Query().Get("scope"))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "secret"})
+	})
+	mux.HandleFunc("/v2/org/app/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body any
+		switch strings.TrimPrefix(r.URL.Path, "/v2/org/app/") {
+		case "manifests/sha256:index", "manifests/v1":
+			w.Header().Set(headerContentDigest, "sha256:index")
+			body = manifest{MediaType: MediaTypeOCIIndex, Manifests: []descriptor{
+				{Digest: "sha256:arm", Platform: &platform{OS: "linux", Architecture: "arm64", Variant: "v8"}},
+				{Digest: "sha256:win", Platform: &platform{OS: "windows", Architecture: "amd64"}},
+				{Digest: "sha256:amd", Platform: &platform{OS: "linux", Architecture: "amd64"}},
+				{Digest: "sha256:att", Platform: &platform{OS: "unknown", Architecture: "unknown"}},
+			}}
+		case "manifests/sha256:amd":
+			body = manifest{
+				MediaType: MediaTypeOCIManifest,
+				Config:    &descriptor{Digest: "sha256:config"},
+				Layers:    []descriptor{{Digest: "sha256:l1", Size: 100}, {Digest: "sha256:l2", Size: 50}},
+			}
+		case "blobs/sha256:config":
+			body = map[string]any{
+				"architecture": "amd64",
+				"created":      "2026-01-02T03:04:05.123456789Z",
+				"config":       map[string]any{"Labels": map[string]string{"org.opencontainers.image.vendor": "Example"}},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+func TestHTTPClient_GetImageMetadata(t *testing.T) {
+	registry := newTestRegistry(t)
+	client := NewHTTPClient(WithPlainHTTP(registry))
+
+	metadata, err := client.GetImageMetadata(context.Background(), registry, "org/app", "sha256:index")
+	if err != nil {
+		t.Fatalf("GetImageMetadata() error = %v", err)
+	}
+	if metadata == nil {
+		t.Fatal("GetImageMetadata() returned nil")
+	}
+	if want := []string{"amd64", "arm64"}; !slices.Equal(metadata.Architectures, want) {
+		t.Errorf("Architectures = %v, want %v", metadata.Architectures, want)
+	}
+	wantPlatforms := []Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		{OS: "windows", Architecture: "amd64"},
+	}
+	if !slices.Equal(metadata.Platforms, wantPlatforms) {
+		t.Errorf("Platforms = %v, want %v", metadata.Platforms, wantPlatforms)
+	}
+	if metadata.LayerCount != 2 || metadata.CompressedSizeBytes != 150 {
+		t.Errorf("LayerCount = %d, CompressedSizeBytes = %d, want 2 and 150",
+			metadata.LayerCount, metadata.CompressedSizeBytes)
+	}
+	if metadata.Labels["org.opencontainers.image.vendor"] != "Example" {
+		t.Errorf("Labels = %v, want the vendor label", metadata.Labels)
+	}
+	if want := time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC); !metadata.Created.Equal(want) {
+		t.Errorf("Created = %v, want %v", metadata.Created, want)
+	}
+
+	// A missing image is not an error
+	metadata, err = client.GetImageMetadata(context.Background(), registry, "org/app", "sha256:missing")
+	if err != nil || metadata != nil {
+		t.Errorf("GetImageMetadata() for a missing image = %v, %v, want nil, nil", metadata, err)
+	}
+}
+
+func TestParseBearerChallenge(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   map[string]string
+		wantOK bool
+	}{
+		{
+			name:   "quoted parameters",
+			header: `Bearer realm="https://quay.io/v2/auth",service="quay.io",scope="repository:org/app:pull"`,
+			want: map[string]string{
+				"realm": "https://quay.io/v2/auth", "service": "quay.io", "scope": "repository:org/app:pull",
+			},
+			wantOK: true,
+		},
+		{
+			name:   "unquoted parameters",
+			header: "bearer realm=https://ghcr.io/token, service=ghcr.io",
+			want:   map[string]string{"realm": "https://ghcr.io/token", "service": "ghcr.io"},
+			wantOK: true,
+		},
+		{
+			name:   "basic authentication",
+			header: `Basic realm="registry"`,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseBearerChallenge(tt.header)
+			if ok != tt.wantOK {
+				t.Fatalf("parseBearerChallenge() ok = %v, want %v", ok, tt.wantOK)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("parseBearerChallenge()[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestTruncateLabels(t *testing.T) {
+	labels := make(map[string]string)
+	for i := range maxLabels + 10 {
+		labels[strings.Repeat("k", i+1)] = strings.Repeat("v", maxLabelValueLength+1)
+	}
+	// OCI labels sort after the others but are kept ahead of them
+	labels["org.opencontainers.image.vendor"] = strings.Repeat("v", maxLabelValueLength+1)
+
+	got := truncateLabels(labels)
+	if len(got) != maxLabels {
+		t.Errorf("len(truncateLabels()) = %d, want %d", len(got), maxLabels)
+	}
+	for k, v := range got {
+		if len(v) != maxLabelValueLength {
+			t.Errorf("label %q has %d characters, want %d", k, len(v), maxLabelValueLength)
+		}
+	}
+	if _, ok := got["org.opencontainers.image.vendor"]; !ok {
+		t.Errorf("truncateLabels() dropped the OCI vendor label")
+	}
+}
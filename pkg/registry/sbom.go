@@ -0,0 +1,243 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// SBOM formats recognised among the referrers of an image
+const (
+	SBOMFormatSPDX      = "SPDX"
+	SBOMFormatCycloneDX = "CycloneDX"
+)
+
+const (
+	// maxSBOMBytes bounds the SBOM documents read to summarize them
+	maxSBOMBytes = 32 << 20
+	// maxTopLicenses is the number of licenses kept in an SBOM summary
+	maxTopLicenses = 5
+)
+
+// SBOM describes a software bill of materials attached to an image
+type SBOM struct {
+	// Present is false if no SBOM is attached to the image
+	Present bool
+	// Format is SBOMFormatSPDX or SBOMFormatCycloneDX
+	Format string
+	// ArtifactType is the artifact type of the referrer, e.g. application/spdx+json
+	ArtifactType string
+	// Digest is the digest of the SBOM artifact manifest
+	Digest string
+	// Summary is set when summaries are enabled and the SBOM document could be read
+	Summary *SBOMSummary
+}
+
+// SBOMSummary summarizes the packages listed in an SBOM
+type SBOMSummary struct {
+	// PackageCount is the number of packages (SPDX) or components (CycloneDX)
+	PackageCount int
+	// TopLicenses lists the most common package licenses, most common first
+	TopLicenses []string
+}
+
+// SBOMClient discovers the SBOMs attached to images
+type SBOMClient interface {
+	// GetSBOM looks up an SBOM attached to an image by digest through the OCI referrers
+	// API. It returns nil if the registry cannot be read without credentials.
+	GetSBOM(ctx context.Context, registry, repository, digest string) (*SBOM, error)
+}
+
+// WithSBOMSummary makes GetSBOM read the SBOM document to summarize its packages
+func WithSBOMSummary() ClientOption {
+	return func(c *HTTPClient) {
+		c.sbomSummary = true
+	}
+}
+
+// GetSBOM lists the referrers of the image, falling back to the referrers tag schema on
+// registries without the referrers API, and returns the first SPDX or CycloneDX SBOM
+func (c *HTTPClient) GetSBOM(ctx context.Context, registry, repository, digest string) (*SBOM, error) {
+	start := time.Now()
+	sbom, err := c.getSBOM(ctx, c.newSession(ctx, registry, repository), digest)
+	duration := time.Since(start).Seconds()
+
+	switch {
+	case err != nil:
+		metrics.RecordRegistryRequest("error", duration)
+	case sbom == nil:
+		metrics.RecordRegistryRequest("not_found", duration)
+	default:
+		metrics.RecordRegistryRequest("success", duration)
+	}
+	return sbom, err
+}
+
+// getSBOM finds the SBOM among the referrers of an image and optionally summarizes it
+func (c *HTTPClient) getSBOM(ctx context.Context, s *session, digest string) (*SBOM, error) {
+	var referrers manifest
+	found, err := s.get(ctx, "referrers/"+digest, MediaTypeOCIIndex, &referrers)
+	if err != nil {
+		return nil, err
+	}
+	if !found && !s.denied {
+		// Registries without the referrers API list referrers under a sha256-<hex> tag
+		if found, err = s.get(ctx, "manifests/"+referrersTag(digest), MediaTypeOCIIndex, &referrers); err != nil {
+			return nil, err
+		}
+	}
+	if s.denied {
+		return nil, nil
+	}
+	if !found {
+		return &SBOM{}, nil
+	}
+
+	i := slices.IndexFunc(referrers.Manifests, func(d descriptor) bool { return sbomFormat(d.ArtifactType) != "" })
+	if i < 0 {
+		return &SBOM{}, nil
+	}
+	referrer := referrers.Manifests[i]
+	sbom := &SBOM{
+		Present:      true,
+		Format:       sbomFormat(referrer.ArtifactType),
+		ArtifactType: referrer.ArtifactType,
+		Digest:       referrer.Digest,
+	}
+	if c.sbomSummary {
+		// The SBOM is recorded even if its document cannot be summarized
+		sbom.Summary, _ = s.summarizeSBOM(ctx, sbom)
+	}
+	return sbom, nil
+}
+
+// referrersTag returns the tag under which the referrers tag schema lists the referrers of digest
+func referrersTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1)
+}
+
+// sbomFormat returns the SBOM format of a referrer's artifact type, or "" if it is not an SBOM
+func sbomFormat(artifactType string) string {
+	artifactType = strings.ToLower(artifactType)
+	switch {
+	case strings.Contains(artifactType, "spdx"):
+		return SBOMFormatSPDX
+	case strings.Contains(artifactType, "cyclonedx"):
+		return SBOMFormatCycloneDX
+	default:
+		return ""
+	}
+}
+
+// spdxDocument is the subset of an SPDX JSON document used for summaries
+type spdxDocument struct {
+	Packages []struct {
+		LicenseConcluded string `json:"licenseConcluded"`
+		LicenseDeclared  string `json:"licenseDeclared"`
+	} `json:"packages"`
+}
+
+// cycloneDXDocument is the subset of a CycloneDX JSON document used for summaries
+type cycloneDXDocument struct {
+	Components []struct {
+		Licenses []struct {
+			License *struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"license,omitempty"`
+			Expression string `json:"expression,omitempty"`
+		} `json:"licenses"`
+	} `json:"components"`
+}
+
+// summarizeSBOM reads the SBOM document from the artifact's first layer and counts its
+// packages and their licenses. Only JSON documents are summarized.
+func (s *session) summarizeSBOM(ctx context.Context, sbom *SBOM) (*SBOMSummary, error) {
+	artifact, err := s.getManifest(ctx, sbom.Digest)
+	if err != nil || artifact == nil || len(artifact.Layers) == 0 {
+		return nil, err
+	}
+	layer := artifact.Layers[0]
+	if !strings.Contains(layer.MediaType, "json") {
+		return nil, nil
+	}
+
+	var licenses []string
+	summary := &SBOMSummary{}
+	switch sbom.Format {
+	case SBOMFormatSPDX:
+		var doc spdxDocument
+		if found, err := s.getLimited(ctx, "blobs/"+layer.Digest, "", maxSBOMBytes, &doc); err != nil || !found {
+			return nil, err
+		}
+		summary.PackageCount = len(doc.Packages)
+		for _, pkg := range doc.Packages {
+			licenses = append(licenses, cmp.Or(spdxLicense(pkg.LicenseConcluded), spdxLicense(pkg.LicenseDeclared)))
+		}
+	case SBOMFormatCycloneDX:
+		var doc cycloneDXDocument
+		if found, err := s.getLimited(ctx, "blobs/"+layer.Digest, "", maxSBOMBytes, &doc); err != nil || !found {
+			return nil, err
+		}
+		summary.PackageCount = len(doc.Components)
+		for _, component := range doc.Components {
+			var license string
+			for _, l := range component.Licenses {
+				if l.License != nil {
+					license = cmp.Or(license, l.License.ID, l.License.Name)
+				}
+				license = cmp.Or(license, l.Expression)
+			}
+			licenses = append(licenses, license)
+		}
+	}
+	summary.TopLicenses = topLicenses(licenses)
+	return summary, nil
+}
+
+// spdxLicense returns an SPDX license expression, or "" if it records no license
+func spdxLicense(license string) string {
+	if license == "NOASSERTION" || license == "NONE" {
+		return ""
+	}
+	return license
+}
+
+// topLicenses returns the most common licenses, most common first and ties by name.
+// Packages without a license are not counted.
+func topLicenses(licenses []string) []string {
+	counts := make(map[string]int)
+	for _, license := range licenses {
+		if license != "" {
+			counts[license]++
+		}
+	}
+	top := slices.SortedFunc(maps.Keys(counts), func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), strings.Compare(a, b))
+	})
+	if len(top) == 0 {
+		return nil
+	}
+	return top[:min(len(top), maxTopLicenses)]
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestSBOMRegistry serves an image with an SPDX SBOM through the referrers API, an
+// image with a CycloneDX SBOM through the referrers tag schema, and a private repository
+func newTestSBOMRegistry(t *testing.T) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/private/app/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/v2/org/app/", func(w http.ResponseWriter, r *http.Request) {
+		var body any
+		switch strings.TrimPrefix(r.URL.Path, "/v2/org/app/") {
+		case "referrers/sha256:spdx":
+			body = manifest{MediaType: MediaTypeOCIIndex, Manifests: []descriptor{
+				{Digest: "sha256:sig", ArtifactType: "application/vnd.dev.cosign.artifact.sig.v1+json"},
+				{Digest: "sha256:spdxdoc", ArtifactType: "application/spdx+json"},
+			}}
+		case "referrers/sha256:none":
+			body = manifest{MediaType: MediaTypeOCIIndex}
+		case "manifests/sha256-cdx":
+			body = manifest{MediaType: MediaTypeOCIIndex, Manifests: []descriptor{
+				{Digest: "sha256:cdxdoc", ArtifactType: "application/vnd.cyclonedx+json"},
+			}}
+		case "manifests/sha256:spdxdoc":
+			body = manifest{MediaType: MediaTypeOCIManifest, Layers: []descriptor{
+				{MediaType: "application/spdx+json", Digest: "sha256:spdxblob"},
+			}}
+		case "manifests/sha256:cdxdoc":
+			body = manifest{MediaType: MediaTypeOCIManifest, Layers: []descriptor{
+				{MediaType: "application/vnd.cyclonedx+json", Digest: "sha256:cdxblob"},
+			}}
+		case "blobs/sha256:spdxblob":
+			body = map[string]any{"packages": []map[string]string{
+				{"licenseConcluded": "MIT"},
+				{"licenseConcluded": "NOASSERTION", "licenseDeclared": "Apache-2.0"},
+				{"licenseConcluded": "Apache-2.0"},
+				{"licenseConcluded": "NOASSERTION"},
+			}}
+		case "blobs/sha256:cdxblob":
+			body = map[string]any{"components": []map[string]any{
+				{"licenses": []map[string]any{{"license": map[string]string{"id": "GPL-2.0-only"}}}},
+				{"licenses": []map[string]any{{"expression": "MIT OR Apache-2.0"}}},
+			}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+func TestHTTPClient_GetSBOM(t *testing.T) {
+	registry := newTestSBOMRegistry(t)
+	client := NewHTTPClient(WithPlainHTTP(registry), WithSBOMSummary())
+	ctx := context.Background()
+
+	sbom, err := client.GetSBOM(ctx, registry, "org/app", "sha256:spdx")
+	if err != nil {
+		t.Fatalf("GetSBOM() error = %v", err)
+	}
+	if sbom == nil || !sbom.Present || sbom.Format != SBOMFormatSPDX || sbom.Digest != "sha256:spdxdoc" {
+		t.Fatalf("GetSBOM() = %+v, want the SPDX referrer", sbom)
+	}
+	if sbom.Summary == nil || sbom.Summary.PackageCount != 4 {
+		t.Fatalf("Summary = %+v, want 4 packages", sbom.Summary)
+	}
+	if want := []string{"Apache-2.0", "MIT"}; !slices.Equal(sbom.Summary.TopLicenses, want) {
+		t.Errorf("TopLicenses = %v, want %v", sbom.Summary.TopLicenses, want)
+	}
+
+	// Registries without the referrers API are read through the referrers tag
+	sbom, err = client.GetSBOM(ctx, registry, "org/app", "sha256:cdx")
+	if err != nil {
+		t.Fatalf("GetSBOM() error = %v", err)
+	}
+	if sbom == nil || sbom.Format != SBOMFormatCycloneDX || sbom.Digest != "sha256:cdxdoc" {
+		t.Fatalf("GetSBOM() = %+v, want the CycloneDX referrer", sbom)
+	}
+	if want := []string{"GPL-2.0-only", "MIT OR Apache-2.0"}; sbom.Summary == nil ||
+		sbom.Summary.PackageCount != 2 || !slices.Equal(sbom.Summary.TopLicenses, want) {
+		t.Errorf("Summary = %+v, want 2 components licensed %v", sbom.Summary, want)
+	}
+
+	// Images without an SBOM, with or without referrers
+	for _, digest := range []string{"sha256:none", "sha256:missing"} {
+		sbom, err = client.GetSBOM(ctx, registry, "org/app", digest)
+		if err != nil || sbom == nil || sbom.Present {
+			t.Errorf("GetSBOM(%s) = %+v, %v, want no SBOM", digest, sbom, err)
+		}
+	}
+
+	// A private repository is not known to lack an SBOM
+	sbom, err = client.GetSBOM(ctx, registry, "private/app", "sha256:spdx")
+	if err != nil || sbom != nil {
+		t.Errorf("GetSBOM() for a private repository = %+v, %v, want nil, nil", sbom, err)
+	}
+}
+
+func TestHTTPClient_GetSBOMWithoutSummary(t *testing.T) {
+	registry := newTestSBOMRegistry(t)
+	client := NewHTTPClient(WithPlainHTTP(registry))
+
+	sbom, err := client.GetSBOM(context.Background(), registry, "org/app", "sha256:spdx")
+	if err != nil {
+		t.Fatalf("GetSBOM() error = %v", err)
+	}
+	if sbom == nil || !sbom.Present || sbom.Summary != nil {
+		t.Errorf("GetSBOM() = %+v, want an SBOM without a summary", sbom)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/sebrandon1/imagecertinfo-operator/internal/metrics"
+)
+
+// headerContentDigest is the response header carrying the digest of a manifest
+const headerContentDigest = "Docker-Content-Digest"
+
+// TagResolver resolves image tags to digests
+type TagResolver interface {
+	// ResolveTag returns the digest the tag currently points to. It returns an empty digest
+	// if the tag does not exist or cannot be read without credentials.
+	ResolveTag(ctx context.Context, registry, repository, tag string) (string, error)
+}
+
+// ResolveTag sends a HEAD request for the tag's manifest and returns its digest, which for a
+// multi-architecture image is the digest of the index
+func (c *HTTPClient) ResolveTag(ctx context.Context, registry, repository, tag string) (string, error) {
+	start := time.Now()
+	digest, err := c.resolveTag(ctx, c.newSession(ctx, registry, repository), tag)
+	duration := time.Since(start).Seconds()
+
+	switch {
+	case err != nil:
+		metrics.RecordRegistryRequest("error", duration)
+	case digest == "":
+		metrics.RecordRegistryRequest("not_found", duration)
+	default:
+		metrics.RecordRegistryRequest("success", duration)
+	}
+	return digest, err
+}
+
+// resolveTag reads the digest header of the tag's manifest
+func (c *HTTPClient) resolveTag(ctx context.Context, s *session, tag string) (string, error) {
+	resp, err := s.do(ctx, http.MethodHead, "manifests/"+tag, manifestAccept)
+	if err != nil || resp == nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	return resp.Header.Get(headerContentDigest), nil
+}
+
+// RateLimitedTagResolver wraps a TagResolver with rate limiting capabilities
+type RateLimitedTagResolver struct {
+	resolver TagResolver
+	limiter  *rate.Limiter
+}
+
+// NewRateLimitedTagResolver creates a new rate-limited tag resolver wrapper
+func NewRateLimitedTagResolver(resolver TagResolver, rateLimit float64, burst int) *RateLimitedTagResolver {
+	return &RateLimitedTagResolver{
+		resolver: resolver,
+		limiter:  rate.NewLimiter(rate.Limit(rateLimit), burst),
+	}
+}
+
+// ResolveTag resolves a tag to a digest with rate limiting
+func (c *RateLimitedTagResolver) ResolveTag(ctx context.Context, registry, repository, tag string) (string, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	return c.resolver.ResolveTag(ctx, registry, repository, tag)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHTTPClient_ResolveTag(t *testing.T) {
+	registry := newTestRegistry(t)
+	client := NewHTTPClient(WithPlainHTTP(registry))
+
+	digest, err := client.ResolveTag(context.Background(), registry, "org/app", "v1")
+	if err != nil || digest != "sha256:index" {
+		t.Errorf("ResolveTag() = %q, %v, want sha256:index", digest, err)
+	}
+
+	// A missing tag is not an error
+	digest, err = client.ResolveTag(context.Background(), registry, "org/app", "missing")
+	if err != nil || digest != "" {
+		t.Errorf("ResolveTag() for a missing tag = %q, %v, want no digest", digest, err)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import "time"
+
+// ImageMetadata contains metadata read from an image's manifest and config blob
+type ImageMetadata struct {
+	// Architectures lists the CPU architectures the image is published for
+	Architectures []string
+	// Platforms lists the distinct platforms the image is published for
+	Platforms []Platform
+	// Labels are the image config labels
+	Labels map[string]string
+	// LayerCount is the number of layers in the image
+	LayerCount int
+	// CompressedSizeBytes is the sum of the compressed layer sizes
+	CompressedSizeBytes int64
+	// Created is when the image was built, zero if the config does not say
+	Created time.Time
+}
+
+// Platform is an operating system and CPU architecture an image is published for
+type Platform struct {
+	// OS is the operating system, e.g. linux or windows
+	OS string
+	// Architecture is the CPU architecture, e.g. amd64
+	Architecture string
+	// Variant is the CPU variant, e.g. v8 for arm64, empty if not set
+	Variant string
+}
+
+// Media types of the manifests this client understands
+const (
+	MediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+	MediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// manifest is the subset of an OCI image index, OCI image manifest, or Docker v2
+// manifest (list) used by this client
+type manifest struct {
+	MediaType string `json:"mediaType"`
+	// Manifests is set on an index or manifest list
+	Manifests []descriptor `json:"manifests,omitempty"`
+	// Config and Layers are set on an image manifest
+	Config *descriptor  `json:"config,omitempty"`
+	Layers []descriptor `json:"layers,omitempty"`
+}
+
+// descriptor references a manifest or blob by digest
+type descriptor struct {
+	MediaType string    `json:"mediaType"`
+	Digest    string    `json:"digest"`
+	Size      int64     `json:"size"`
+	Platform  *platform `json:"platform,omitempty"`
+	// ArtifactType is set on the referrers of an image, e.g. an attached SBOM
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
+// platform is the platform of a manifest within an index
+type platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// imageConfig is the subset of an image config blob used by this client
+type imageConfig struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+	Created      string `json:"created,omitempty"`
+	Config       struct {
+		Labels map[string]string `json:"Labels"`
+	} `json:"config"`
+}
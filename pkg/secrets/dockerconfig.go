@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RegistryAuth is a username and password for a container registry from a pull secret.
+type RegistryAuth struct {
+	Username string
+	Password string
+}
+
+// DockerConfig maps registry hosts to the credentials of a pull secret.
+type DockerConfig map[string]RegistryAuth
+
+// dockerConfigEntry is a registry entry of a .dockerconfigjson or .dockercfg key.
+type dockerConfigEntry struct {
+	Auth     string `json:"auth"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// ParseDockerConfig parses the data of a pull secret of type kubernetes.io/dockerconfigjson
+// or the legacy kubernetes.io/dockercfg. Entries without a username and password, such as
+// those holding only an identity token, are skipped.
+func ParseDockerConfig(data map[string][]byte) (DockerConfig, error) {
+	var entries map[string]dockerConfigEntry
+	if raw, ok := data[corev1.DockerConfigJsonKey]; ok {
+		var config struct {
+			Auths map[string]dockerConfigEntry `json:"auths"`
+		}
+		if err := json.Unmarshal(raw, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", corev1.DockerConfigJsonKey, err)
+		}
+		entries = config.Auths
+	} else if raw, ok := data[corev1.DockerConfigKey]; ok {
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", corev1.DockerConfigKey, err)
+		}
+	} else {
+		return nil, fmt.Errorf("no %s or %s key", corev1.DockerConfigJsonKey, corev1.DockerConfigKey)
+	}
+
+	config := make(DockerConfig, len(entries))
+	for server, entry := range entries {
+		auth := RegistryAuth{Username: entry.Username, Password: entry.Password}
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid auth for %s: %w", server, err)
+			}
+			auth.Username, auth.Password, _ = strings.Cut(string(decoded), ":")
+		}
+		if auth.Username == "" || auth.Password == "" {
+			continue
+		}
+		config[registryHost(server)] = auth
+	}
+	return config, nil
+}
+
+// Lookup returns the credentials for a registry, such as quay.io or docker.io.
+func (c DockerConfig) Lookup(registry string) (RegistryAuth, bool) {
+	auth, ok := c[registryHost(registry)]
+	return auth, ok
+}
+
+// registryHost normalizes a pull secret server, which may be a URL or include a
+// repository path, to the registry host. Docker Hub's aliases map to docker.io.
+func registryHost(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	host, _, _ := strings.Cut(server, "/")
+	host = strings.ToLower(host)
+	switch host {
+	case "index.docker.io", "registry-1.docker.io":
+		return "docker.io"
+	}
+	return host
+}
+
+// ReadDockerConfig reads the credentials of a pull secret.
+func (r *SecretReader) ReadDockerConfig(ctx context.Context, namespace, secretName string) (DockerConfig, error) {
+	data, err := r.ReadData(ctx, namespace, secretName)
+	if err != nil {
+		return nil, err
+	}
+	config, err := ParseDockerConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pull secret %s/%s: %w", namespace, secretName, err)
+	}
+	return config, nil
+}
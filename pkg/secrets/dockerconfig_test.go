@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseDockerConfig(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("robot:s3cr:et"))
+	tests := []struct {
+		name    string
+		data    map[string][]byte
+		lookup  string
+		want    RegistryAuth
+		found   bool
+		wantErr bool
+	}{
+		{
+			name:   "dockerconfigjson auth",
+			data:   map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"` + auth + `"}}}`)},
+			lookup: "quay.io",
+			want:   RegistryAuth{Username: "robot", Password: "s3cr:et"},
+			found:  true,
+		},
+		{
+			name: "docker hub alias",
+			data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(
+				`{"auths":{"https://index.docker.io/v1/":{"username":"user","password":"pass"}}}`)},
+			lookup: "docker.io",
+			want:   RegistryAuth{Username: "user", Password: "pass"},
+			found:  true,
+		},
+		{
+			name:   "legacy dockercfg",
+			data:   map[string][]byte{corev1.DockerConfigKey: []byte(`{"Registry.Example.com:5000":{"auth":"` + auth + `"}}`)},
+			lookup: "registry.example.com:5000",
+			want:   RegistryAuth{Username: "robot", Password: "s3cr:et"},
+			found:  true,
+		},
+		{
+			name:   "identity token only",
+			data:   map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"identitytoken":"tok"}}}`)},
+			lookup: "quay.io",
+		},
+		{
+			name:   "other registry",
+			data:   map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"` + auth + `"}}}`)},
+			lookup: "registry.redhat.io",
+		},
+		{name: "missing key", data: map[string][]byte{"token": []byte("x")}, wantErr: true},
+		{name: "malformed json", data: map[string][]byte{corev1.DockerConfigJsonKey: []byte("{")}, wantErr: true},
+		{
+			name:    "invalid auth",
+			data:    map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"auth":"!"}}}`)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := ParseDockerConfig(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDockerConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, found := config.Lookup(tt.lookup)
+			if got != tt.want || found != tt.found {
+				t.Errorf("Lookup(%q) = %+v, %v, want %+v, %v", tt.lookup, got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestSecretReader_ReadDockerConfig(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "openshift-config"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"username":"user","password":"pass"}}}`),
+		},
+	}
+	reader := NewSecretReader(fake.NewClientBuilder().WithObjects(secret).Build())
+
+	config, err := reader.ReadDockerConfig(context.Background(), "openshift-config", "pull-secret")
+	if err != nil {
+		t.Fatalf("ReadDockerConfig() error = %v", err)
+	}
+	if auth, ok := config.Lookup("quay.io"); !ok || auth.Username != "user" {
+		t.Errorf("Lookup(quay.io) = %+v, %v, want user", auth, ok)
+	}
+	if _, err := reader.ReadDockerConfig(context.Background(), "openshift-config", "absent"); err == nil {
+		t.Error("ReadDockerConfig() for a missing secret: expected error")
+	}
+}
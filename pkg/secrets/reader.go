@@ -19,19 +19,53 @@ package secrets
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// KeyRef identifies a single key within a Kubernetes Secret.
+type KeyRef struct {
+	Namespace string
+	Name      string
+	Key       string
+}
+
+// String formats the reference as namespace/name/key.
+func (r KeyRef) String() string {
+	return r.Namespace + "/" + r.Name + "/" + r.Key
+}
+
+// ParseKeyRef parses a reference of the form name, namespace/name, or namespace/name/key.
+// The defaults fill in an omitted namespace or key.
+func ParseKeyRef(ref, defaultNamespace, defaultKey string) (KeyRef, error) {
+	result := KeyRef{Namespace: defaultNamespace, Key: defaultKey}
+	parts := strings.Split(ref, "/")
+	switch len(parts) {
+	case 1:
+		result.Name = parts[0]
+	case 2:
+		result.Namespace, result.Name = parts[0], parts[1]
+	case 3:
+		result.Namespace, result.Name, result.Key = parts[0], parts[1], parts[2]
+	default:
+		return KeyRef{}, fmt.Errorf("invalid secret reference %q: expected [namespace/]name[/key]", ref)
+	}
+	if result.Namespace == "" || result.Name == "" || result.Key == "" {
+		return KeyRef{}, fmt.Errorf("invalid secret reference %q: namespace, name, and key must all be set", ref)
+	}
+	return result, nil
+}
+
 // SecretReader provides methods to read secrets from Kubernetes.
 type SecretReader struct {
-	client client.Client
+	client client.Reader
 }
 
 // NewSecretReader creates a new SecretReader with the given client.
-func NewSecretReader(c client.Client) *SecretReader {
+func NewSecretReader(c client.Reader) *SecretReader {
 	return &SecretReader{client: c}
 }
 
@@ -57,3 +91,20 @@ func (r *SecretReader) ReadAPIKey(ctx context.Context, namespace, secretName, se
 
 	return string(data), nil
 }
+
+// ReadData reads all key/value pairs of a Kubernetes Secret.
+func (r *SecretReader) ReadData(ctx context.Context, namespace, secretName string) (map[string][]byte, error) {
+	if namespace == "" || secretName == "" {
+		return nil, fmt.Errorf("namespace and secret name must both be specified")
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      secretName,
+	}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	return secret.Data, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseKeyRef(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    KeyRef
+		wantErr bool
+	}{
+		{ref: "pyxis-api-key", want: KeyRef{Namespace: "operators", Name: "pyxis-api-key", Key: "api-key"}},
+		{ref: "tenant/pyxis", want: KeyRef{Namespace: "tenant", Name: "pyxis", Key: "api-key"}},
+		{ref: "tenant/pyxis/token", want: KeyRef{Namespace: "tenant", Name: "pyxis", Key: "token"}},
+		{ref: "", wantErr: true},
+		{ref: "tenant//token", wantErr: true},
+		{ref: "a/b/c/d", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseKeyRef(tt.ref, "operators", "api-key")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseKeyRef(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseKeyRef(%q) = %+v, want %+v", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestSecretReader_ReadAPIKey(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pyxis-api-key", Namespace: "operators"},
+		Data:       map[string][]byte{"api-key": []byte("secret-value")},
+	}
+	reader := NewSecretReader(fake.NewClientBuilder().WithObjects(secret).Build())
+
+	got, err := reader.ReadAPIKey(context.Background(), "operators", "pyxis-api-key", "api-key")
+	if err != nil || got != "secret-value" {
+		t.Errorf("ReadAPIKey() = %q, %v, want secret-value", got, err)
+	}
+	if _, err := reader.ReadAPIKey(context.Background(), "operators", "pyxis-api-key", "missing"); err == nil {
+		t.Error("ReadAPIKey() for a missing key: expected error")
+	}
+	if _, err := reader.ReadAPIKey(context.Background(), "operators", "absent", "api-key"); err == nil {
+		t.Error("ReadAPIKey() for a missing secret: expected error")
+	}
+}